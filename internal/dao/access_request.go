@@ -0,0 +1,47 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/uptrace/bun"
+)
+
+// ListAccessRequests gets the access requests of an organization, newest
+// first. Empty requester, reviewer and state match any.
+func ListAccessRequests(ctx context.Context, db bun.IDB, partnerId, organizationId uuid.UUID, requester, reviewer, state string) ([]models.AccessRequest, error) {
+	var entities = []models.AccessRequest{}
+	q := db.NewSelect().Model(&entities).
+		Where("partner_id = ?", partnerId).
+		Where("organization_id = ?", organizationId).
+		Where("trash = ?", false)
+	if requester != "" {
+		q = q.Where("requester = ?", requester)
+	}
+	if reviewer != "" {
+		q = q.Where("reviewer = ?", reviewer)
+	}
+	if state != "" {
+		q = q.Where("state = ?", state)
+	}
+	err := q.Order("created_at DESC").Scan(ctx)
+	return entities, err
+}
+
+// ReviewAccessRequest records the review of an access request which is
+// in state from, it returns false when the request was reviewed first
+// by someone else
+func ReviewAccessRequest(ctx context.Context, db bun.IDB, ar *models.AccessRequest, from string) (bool, error) {
+	res, err := db.NewUpdate().Model(ar).
+		Column("state", "reviewer", "reason", "reviewed_at", "expires_at", "modified_at").
+		WherePK().
+		Where("state = ?", from).
+		Where("trash = ?", false).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n == 1, err
+}
@@ -0,0 +1,116 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/uptrace/bun"
+)
+
+// ListAccessReviews gets the access reviews of an organization, newest
+// first. Empty state matches any.
+func ListAccessReviews(ctx context.Context, db bun.IDB, partnerId, organizationId uuid.UUID, state string) ([]models.AccessReview, error) {
+	var entities = []models.AccessReview{}
+	q := db.NewSelect().Model(&entities).
+		Where("partner_id = ?", partnerId).
+		Where("organization_id = ?", organizationId).
+		Where("trash = ?", false)
+	if state != "" {
+		q = q.Where("state = ?", state)
+	}
+	err := q.Order("created_at DESC").Scan(ctx)
+	return entities, err
+}
+
+// LockAccessReview gets the access review locking it until the end of
+// the transaction, decisions and the close of a review are serialized
+// on the lock
+func LockAccessReview(ctx context.Context, db bun.IDB, id uuid.UUID) (*models.AccessReview, error) {
+	var ar models.AccessReview
+	err := db.NewSelect().Model(&ar).
+		Where("id = ?", id).
+		Where("trash = ?", false).
+		For("UPDATE").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &ar, nil
+}
+
+// ListAccessReviewItems gets the items of an access review. Empty
+// reviewer and decision match any.
+func ListAccessReviewItems(ctx context.Context, db bun.IDB, reviewId uuid.UUID, reviewer, decision string) ([]models.AccessReviewItem, error) {
+	var entities = []models.AccessReviewItem{}
+	q := db.NewSelect().Model(&entities).
+		Where("review_id = ?", reviewId)
+	if reviewer != "" {
+		q = q.Where("reviewer = ?", reviewer)
+	}
+	if decision != "" {
+		q = q.Where("decision = ?", decision)
+	}
+	err := q.Order("project", "subject", "role").Scan(ctx)
+	return entities, err
+}
+
+// GetAccessReviewItem gets an item of an access review
+func GetAccessReviewItem(ctx context.Context, db bun.IDB, reviewId, id uuid.UUID) (*models.AccessReviewItem, error) {
+	var item models.AccessReviewItem
+	err := db.NewSelect().Model(&item).
+		Where("id = ?", id).
+		Where("review_id = ?", reviewId).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+// UpdateAccessReviewItem updates the decision and result of an item of
+// an access review
+func UpdateAccessReviewItem(ctx context.Context, db bun.IDB, item *models.AccessReviewItem) error {
+	_, err := db.NewUpdate().Model(item).
+		Column("decision", "reason", "decided_by", "decided_at", "result", "applied_at", "modified_at").
+		WherePK().
+		Exec(ctx)
+	return err
+}
+
+// CloseAccessReview records the close of an access review
+func CloseAccessReview(ctx context.Context, db bun.IDB, ar *models.AccessReview) error {
+	_, err := db.NewUpdate().Model(ar).
+		Column("state", "closed_by", "closed_at", "modified_at").
+		WherePK().
+		Exec(ctx)
+	return err
+}
+
+// GetOrganizationRoleBindings returns the role bindings of the users,
+// service accounts and groups of the organization which are not yet
+// expired
+func GetOrganizationRoleBindings(ctx context.Context, db bun.IDB, organizationId uuid.UUID) ([]models.RoleBinding, error) {
+	return getRoleBindings(ctx, db, func(q *bun.SelectQuery, _ roleBindingTable) *bun.SelectQuery {
+		return q.Where("rb.organization_id = ?", organizationId).
+			Where("rb.expires_at IS NULL OR rb.expires_at > now()")
+	})
+}
+
+// GetSSOGroups returns the names of the groups which have users signed
+// up through an oidc provider among their members
+func GetSSOGroups(ctx context.Context, db bun.IDB, organizationId uuid.UUID) ([]string, error) {
+	var names []string
+	err := db.NewSelect().
+		TableExpr("authsrv_group AS g").
+		ColumnExpr("DISTINCT g.name").
+		Join("JOIN authsrv_groupaccount AS ga ON ga.group_id = g.id").
+		Join("JOIN identity_credentials AS ic ON ic.identity_id = ga.account_id").
+		Join("JOIN identity_credential_types AS ict ON ict.id = ic.identity_credential_type_id").
+		Where("g.organization_id = ?", organizationId).
+		Where("ict.name = ?", KratosOidcType).
+		Where("g.trash = ?", false).
+		Where("ga.trash = ?", false).
+		Scan(ctx, &names)
+	return names, err
+}
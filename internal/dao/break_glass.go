@@ -0,0 +1,54 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/uptrace/bun"
+)
+
+// ListBreakGlasses gets the emergency accesses of an organization,
+// newest first
+func ListBreakGlasses(ctx context.Context, db bun.IDB, partnerId, organizationId uuid.UUID, active bool) ([]models.BreakGlass, error) {
+	var entities = []models.BreakGlass{}
+	q := db.NewSelect().Model(&entities).
+		Where("partner_id = ?", partnerId).
+		Where("organization_id = ?", organizationId).
+		Where("trash = ?", false)
+	if active {
+		q = q.Where("starts_at <= now()").
+			Where("expires_at > now()")
+	}
+	err := q.Order("created_at DESC").Scan(ctx)
+	return entities, err
+}
+
+// GetActiveBreakGlasses gets the emergency accesses of an account which
+// have not yet expired
+func GetActiveBreakGlasses(ctx context.Context, db bun.IDB, organizationId, accountId uuid.UUID) ([]models.BreakGlass, error) {
+	var entities = []models.BreakGlass{}
+	err := db.NewSelect().Model(&entities).
+		Where("organization_id = ?", organizationId).
+		Where("account_id = ?", accountId).
+		Where("trash = ?", false).
+		Where("starts_at <= now()").
+		Where("expires_at > now()").
+		Scan(ctx)
+	return entities, err
+}
+
+// GetUsersBreakGlasses gets the emergency accesses of the users of an
+// organization
+func GetUsersBreakGlasses(ctx context.Context, db bun.IDB, organizationId uuid.UUID, usernames []string) ([]models.BreakGlass, error) {
+	var entities = []models.BreakGlass{}
+	if len(usernames) == 0 {
+		return entities, nil
+	}
+	err := db.NewSelect().Model(&entities).
+		Where("organization_id = ?", organizationId).
+		Where("username IN (?)", bun.In(usernames)).
+		Where("trash = ?", false).
+		Scan(ctx)
+	return entities, err
+}
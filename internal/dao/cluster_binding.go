@@ -0,0 +1,37 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/uptrace/bun"
+)
+
+// GetClusterBindings returns the cluster bindings of the organization
+func GetClusterBindings(ctx context.Context, db bun.IDB, orgID, partnerID uuid.UUID) ([]models.ClusterBinding, error) {
+	var bindings []models.ClusterBinding
+
+	err := db.NewSelect().Model(&bindings).
+		Where("organization_id = ?", orgID).
+		Where("partner_id = ?", partnerID).
+		Where("trash = ?", false).
+		Scan(ctx)
+
+	return bindings, err
+}
+
+// GetOrganizationClusters returns the id, name and labels of the
+// clusters of the organization
+func GetOrganizationClusters(ctx context.Context, db bun.IDB, orgID, partnerID uuid.UUID) ([]models.Cluster, error) {
+	var clusters []models.Cluster
+
+	err := db.NewSelect().Model(&clusters).
+		Column("id", "name", "labels").
+		Where("organization_id = ?", orgID).
+		Where("partner_id = ?", partnerID).
+		Where("trash = ?", false).
+		Scan(ctx)
+
+	return clusters, err
+}
@@ -0,0 +1,119 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/uptrace/bun"
+)
+
+// RolePermission is a permission of a role
+type RolePermission struct {
+	RoleId     uuid.UUID `bun:"role_id"`
+	Permission string    `bun:"permission"`
+}
+
+// ProjectClusterName is a cluster of a project
+type ProjectClusterName struct {
+	ProjectId uuid.UUID `bun:"project_id"`
+	Cluster   string    `bun:"cluster"`
+}
+
+// GroupMember is a user of a group
+type GroupMember struct {
+	GroupName string `bun:"group_name"`
+	Username  string `bun:"username"`
+}
+
+// GetSubjectRoleBindings returns the role bindings in effect in the
+// organization held by the account or by one of the groups, the
+// account may be uuid.Nil to get the bindings of the groups only
+func GetSubjectRoleBindings(ctx context.Context, db bun.IDB, organizationId, accountId uuid.UUID, groupIds []uuid.UUID) ([]models.RoleBinding, error) {
+	return getRoleBindings(ctx, db, func(q *bun.SelectQuery, t roleBindingTable) *bun.SelectQuery {
+		if t.group {
+			if len(groupIds) == 0 {
+				return nil
+			}
+			q = q.Where("rb.group_id IN (?)", bun.In(groupIds))
+		} else {
+			if accountId == uuid.Nil {
+				return nil
+			}
+			q = q.Where("rb.account_id = ?", accountId)
+		}
+		return bindingInEffect(q.Where("rb.organization_id = ?", organizationId), "rb")
+	})
+}
+
+// GetPermissionRoleBindings returns the role bindings in effect in the
+// project whose role has one of the permissions, the organization wide
+// bindings of the organization of the project included
+func GetPermissionRoleBindings(ctx context.Context, db bun.IDB, organizationId, projectId uuid.UUID, permissions []string) ([]models.RoleBinding, error) {
+	return getRoleBindings(ctx, db, func(q *bun.SelectQuery, t roleBindingTable) *bun.SelectQuery {
+		roles := db.NewSelect().
+			TableExpr("authsrv_resourcerolepermission AS rrp").
+			ColumnExpr("rrp.resource_role_id").
+			Join("JOIN authsrv_resourcepermission AS rp ON rp.id = rrp.resource_permission_id").
+			Where("rp.name IN (?)", bun.In(permissions)).
+			Where("rp.trash = ?", false).
+			Where("rrp.trash = ?", false)
+		q = q.Where("rb.organization_id = ?", organizationId).
+			Where("rb.role_id IN (?)", roles)
+		if t.project {
+			q = q.Where("rb.project_id = ?", projectId)
+		}
+		return bindingInEffect(q, "rb")
+	})
+}
+
+// GetRolesPermissions returns the permissions of the roles
+func GetRolesPermissions(ctx context.Context, db bun.IDB, roleIds []uuid.UUID) ([]RolePermission, error) {
+	var rps []RolePermission
+	if len(roleIds) == 0 {
+		return rps, nil
+	}
+	err := db.NewSelect().
+		TableExpr("authsrv_resourcerolepermission AS rrp").
+		ColumnExpr("rrp.resource_role_id AS role_id, rp.name AS permission").
+		Join("JOIN authsrv_resourcepermission AS rp ON rp.id = rrp.resource_permission_id").
+		Where("rrp.resource_role_id IN (?)", bun.In(roleIds)).
+		Where("rp.trash = ?", false).
+		Where("rrp.trash = ?", false).
+		Order("rp.name").
+		Scan(ctx, &rps)
+	return rps, err
+}
+
+// GetProjectsClusters returns the clusters of the projects
+func GetProjectsClusters(ctx context.Context, db bun.IDB, projectIds []uuid.UUID) ([]ProjectClusterName, error) {
+	var pcs []ProjectClusterName
+	if len(projectIds) == 0 {
+		return pcs, nil
+	}
+	err := db.NewSelect().
+		TableExpr("cluster_project_cluster AS pc").
+		ColumnExpr("pc.project_id, c.name AS cluster").
+		Join("JOIN cluster_clusters AS c ON c.id = pc.cluster_id").
+		Where("pc.project_id IN (?)", bun.In(projectIds)).
+		Where("c.trash = ?", false).
+		Order("c.name").
+		Scan(ctx, &pcs)
+	return pcs, err
+}
+
+// GetOrganizationGroupMembers returns the users of the groups of the
+// organization
+func GetOrganizationGroupMembers(ctx context.Context, db bun.IDB, organizationId uuid.UUID) ([]GroupMember, error) {
+	var members []GroupMember
+	err := db.NewSelect().
+		TableExpr("authsrv_groupaccount AS ga").
+		ColumnExpr("g.name AS group_name, i.traits ->> 'email' AS username").
+		Join("JOIN authsrv_group AS g ON g.id = ga.group_id").
+		Join("JOIN identities AS i ON i.id = ga.account_id").
+		Where("g.organization_id = ?", organizationId).
+		Where("g.trash = ?", false).
+		Where("ga.trash = ?", false).
+		Scan(ctx, &members)
+	return members, err
+}
@@ -22,9 +22,9 @@ func GetUsers(ctx context.Context, db bun.IDB, id uuid.UUID) ([]models.KratosIde
 
 func GetGroupRoles(ctx context.Context, db bun.IDB, id uuid.UUID) ([]*userv3.ProjectNamespaceRole, error) {
 	// Could possibly union them later for some speedup
-	var r = []*userv3.ProjectNamespaceRole{}
+	var r = []roleBinding{}
 	err := db.NewSelect().Table("authsrv_grouprole").
-		ColumnExpr("authsrv_resourcerole.name as role, authsrv_group.name as group").
+		ColumnExpr("authsrv_resourcerole.name as role, authsrv_group.name as group, authsrv_grouprole.starts_at, authsrv_grouprole.expires_at").
 		Join(`JOIN authsrv_resourcerole ON authsrv_resourcerole.id=authsrv_grouprole.role_id`).
 		Join(`JOIN authsrv_group ON authsrv_group.id=authsrv_grouprole.group_id`).
 		Where("authsrv_grouprole.group_id = ?", id).
@@ -35,9 +35,9 @@ func GetGroupRoles(ctx context.Context, db bun.IDB, id uuid.UUID) ([]*userv3.Pro
 		return nil, err
 	}
 
-	var pr = []*userv3.ProjectNamespaceRole{}
+	var pr = []roleBinding{}
 	err = db.NewSelect().Table("authsrv_projectgrouprole").
-		ColumnExpr("authsrv_resourcerole.name as role, authsrv_project.name as project, authsrv_group.name as group").
+		ColumnExpr("authsrv_resourcerole.name as role, authsrv_project.name as project, authsrv_group.name as group, authsrv_projectgrouprole.starts_at, authsrv_projectgrouprole.expires_at").
 		Join(`JOIN authsrv_resourcerole ON authsrv_resourcerole.id=authsrv_projectgrouprole.role_id`).
 		Join(`JOIN authsrv_project ON authsrv_project.id=authsrv_projectgrouprole.project_id`).
 		Join(`JOIN authsrv_group ON authsrv_group.id=authsrv_projectgrouprole.group_id`).
@@ -50,9 +50,9 @@ func GetGroupRoles(ctx context.Context, db bun.IDB, id uuid.UUID) ([]*userv3.Pro
 		return nil, err
 	}
 
-	var pnr = []*userv3.ProjectNamespaceRole{}
+	var pnr = []roleBinding{}
 	err = db.NewSelect().Table("authsrv_projectgroupnamespacerole").
-		ColumnExpr("authsrv_resourcerole.name as role, authsrv_project.name as project, namespace, authsrv_group.name as group").
+		ColumnExpr("authsrv_resourcerole.name as role, authsrv_project.name as project, namespace, namespace_selector, authsrv_group.name as group, authsrv_projectgroupnamespacerole.starts_at, authsrv_projectgroupnamespacerole.expires_at").
 		Join(`JOIN authsrv_resourcerole ON authsrv_resourcerole.id=authsrv_projectgroupnamespacerole.role_id`).
 		Join(`JOIN authsrv_project ON authsrv_project.id=authsrv_projectgroupnamespacerole.project_id`). // also need a namespace join
 		Join(`JOIN authsrv_group ON authsrv_group.id=authsrv_projectgroupnamespacerole.group_id`).
@@ -65,5 +65,5 @@ func GetGroupRoles(ctx context.Context, db bun.IDB, id uuid.UUID) ([]*userv3.Pro
 		return nil, err
 	}
 
-	return append(append(r, pr...), pnr...), err
+	return toProjectNamespaceRoles(append(append(r, pr...), pnr...)), err
 }
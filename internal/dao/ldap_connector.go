@@ -0,0 +1,78 @@
+package dao
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/uptrace/bun"
+)
+
+// GetLdapGroups gets the directory groups synchronized by a connector
+func GetLdapGroups(ctx context.Context, db bun.IDB, connectorId uuid.UUID) ([]models.LdapGroup, error) {
+	var entities = []models.LdapGroup{}
+	err := db.NewSelect().Model(&entities).
+		Where("connector_id = ?", connectorId).
+		Scan(ctx)
+	return entities, err
+}
+
+// IsLdapGroup returns whether a group is synchronized by any connector
+func IsLdapGroup(ctx context.Context, db bun.IDB, groupId uuid.UUID) (bool, error) {
+	return db.NewSelect().Model((*models.LdapGroup)(nil)).
+		Where("group_id = ?", groupId).
+		Exists(ctx)
+}
+
+// GetUserEmails gets the emails of the users of emails, matched case
+// insensitively and keyed by the lowercased email
+func GetUserEmails(ctx context.Context, db bun.IDB, emails []string) (map[string]string, error) {
+	found := map[string]string{}
+	if len(emails) == 0 {
+		return found, nil
+	}
+	lower := make([]string, 0, len(emails))
+	for _, e := range emails {
+		e = strings.ToLower(e)
+		if _, ok := found[e]; !ok {
+			found[e] = ""
+			lower = append(lower, e)
+		}
+	}
+	found = map[string]string{}
+	var matched []string
+	err := db.NewSelect().Model((*models.KratosIdentities)(nil)).
+		ColumnExpr("traits ->> 'email'").
+		Where("lower(traits ->> 'email') IN (?)", bun.In(lower)).
+		Scan(ctx, &matched)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range matched {
+		found[strings.ToLower(e)] = e
+	}
+	return found, nil
+}
+
+// ClaimLdapConnectorSync sets the last sync of a connector to now when
+// its previous sync is last, it returns false when another replica
+// claimed the sync first
+func ClaimLdapConnectorSync(ctx context.Context, db bun.IDB, id uuid.UUID, last time.Time, now time.Time) (bool, error) {
+	q := db.NewUpdate().Model((*models.LdapConnector)(nil)).
+		Set("last_sync_at = ?", now).
+		Where("id = ?", id).
+		Where("trash = ?", false)
+	if last.IsZero() {
+		q = q.Where("last_sync_at IS NULL")
+	} else {
+		q = q.Where("last_sync_at = ?", last)
+	}
+	res, err := q.Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n == 1, err
+}
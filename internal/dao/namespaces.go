@@ -3,66 +3,99 @@ package dao
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 
 	"github.com/google/uuid"
 	"github.com/paralus/paralus/internal/models"
 	"github.com/uptrace/bun"
 )
 
-func GetProjectNamespaces(ctx context.Context, db bun.IDB, projectID uuid.UUID) ([]string, error) {
-	var cns []string
+// ProjectNamespace is the namespace, or namespace pattern, or the
+// namespace selector a role is bound in
+type ProjectNamespace struct {
+	Namespace         string
+	NamespaceSelector string
+}
+
+// ClusterNamespaceLabels is a namespace of a cluster with its labels
+type ClusterNamespaceLabels struct {
+	Name   string          `bun:"name"`
+	Labels json.RawMessage `bun:"labels"`
+}
+
+func GetProjectNamespaces(ctx context.Context, db bun.IDB, projectID uuid.UUID) ([]ProjectNamespace, error) {
+	var cns []ProjectNamespace
 
 	var panr []models.ProjectAccountNamespaceRole
-	err := db.NewSelect().Model(&panr).Where("project_id = ?", projectID).Where("trash = ?", false).Scan(ctx)
+	q := db.NewSelect().Model(&panr).Where("project_id = ?", projectID).Where("trash = ?", false)
+	err := bindingInEffect(q, "projectaccountnamespacerole").Scan(ctx)
 	if err != nil && err != sql.ErrNoRows {
 		return nil, err
 	}
 	for _, nr := range panr {
-		cns = append(cns, nr.Namespace)
+		cns = append(cns, ProjectNamespace{nr.Namespace, nr.NamespaceSelector})
 	}
 
 	var pgnr []models.ProjectGroupNamespaceRole
-	err = db.NewSelect().Model(&pgnr).Where("project_id = ?", projectID).Where("trash = ?", false).Scan(ctx)
+	q = db.NewSelect().Model(&pgnr).Where("project_id = ?", projectID).Where("trash = ?", false)
+	err = bindingInEffect(q, "projectgroupnamespacerole").Scan(ctx)
 	if err != nil && err != sql.ErrNoRows {
 		return nil, err
 	}
 	for _, nr := range pgnr {
-		cns = append(cns, nr.Namespace)
+		cns = append(cns, ProjectNamespace{nr.Namespace, nr.NamespaceSelector})
 	}
 
 	return cns, err
 }
 
-func GetAccountProjectNamespaces(ctx context.Context, db bun.IDB, projectID uuid.UUID, accountID uuid.UUID) ([]string, error) {
-	var cns []string
+func GetAccountProjectNamespaces(ctx context.Context, db bun.IDB, projectID uuid.UUID, accountID uuid.UUID) ([]ProjectNamespace, error) {
+	var cns []ProjectNamespace
 
 	var panr []models.ProjectAccountNamespaceRole
-	err := db.NewSelect().Model(&panr).Where("project_id = ?", projectID).Where("account_id = ?", accountID).Scan(ctx)
+	q := db.NewSelect().Model(&panr).Where("project_id = ?", projectID).Where("account_id = ?", accountID)
+	err := bindingInEffect(q, "projectaccountnamespacerole").Scan(ctx)
 	if err != nil {
 		return nil, err
 	}
 	for _, nr := range panr {
-		cns = append(cns, nr.Namespace)
+		cns = append(cns, ProjectNamespace{nr.Namespace, nr.NamespaceSelector})
 	}
 
 	return cns, err
 }
 
-func GetGroupProjectNamespaces(ctx context.Context, db bun.IDB, projectID uuid.UUID, accountID uuid.UUID) ([]string, error) {
-	var cns []string
+func GetGroupProjectNamespaces(ctx context.Context, db bun.IDB, projectID uuid.UUID, accountID uuid.UUID) ([]ProjectNamespace, error) {
+	var cns []ProjectNamespace
 
 	var pgnr []models.ProjectGroupNamespaceRole
-	err := db.NewSelect().Model(&pgnr).Where("project_id = ?", projectID).
+	q := db.NewSelect().Model(&pgnr).Where("project_id = ?", projectID).
 		Join(`JOIN authsrv_groupaccount ON projectgroupnamespacerole.group_id=authsrv_groupaccount.group_id`).
 		Where("authsrv_groupaccount.account_id = ?", accountID).
 		Where("projectgroupnamespacerole.trash = ?", false).
-		Where("authsrv_groupaccount.trash = ?", false).Scan(ctx)
+		Where("authsrv_groupaccount.trash = ?", false)
+	err := bindingInEffect(q, "projectgroupnamespacerole").Scan(ctx)
 	if err != nil {
 		return nil, err
 	}
 	for _, nr := range pgnr {
-		cns = append(cns, nr.Namespace)
+		cns = append(cns, ProjectNamespace{nr.Namespace, nr.NamespaceSelector})
 	}
 
 	return cns, err
 }
+
+// GetClusterNamespaceLabels returns the namespaces synced from the
+// cluster with their labels
+func GetClusterNamespaceLabels(ctx context.Context, db bun.IDB, clusterID uuid.UUID) ([]ClusterNamespaceLabels, error) {
+	var cnl []ClusterNamespaceLabels
+
+	err := db.NewSelect().Model((*models.ClusterNamespace)(nil)).
+		ColumnExpr("cns.name, cns.namespace->'objectMeta'->'labels' AS labels").
+		Where("cns.cluster_id = ?", clusterID).
+		Where("cns.deleted_at IS NULL").
+		Order("cns.name").
+		Scan(ctx, &cnl)
+
+	return cnl, err
+}
@@ -178,8 +178,8 @@ func GetAcccountsWithApprovalPermission(ctx context.Context, db bun.IDB, orgID,
 	}
 	var aps []accountPermission
 	err := db.NewSelect().Model(&aps).
-		ColumnExpr("ki.traits -> 'email'").
-		DistinctOn("ki.traits -> 'email'").
+		ColumnExpr("ki.traits ->> 'email' AS username").
+		DistinctOn("ki.traits ->> 'email'").
 		Join("INNER JOIN identities as ki ON ?TableAlias.account_id = ki.id").
 		Where("?TableAlias.organization_id = ?", orgID).
 		Where("?TableAlias.partner_id = ?", partnerID).
@@ -326,3 +326,120 @@ func GetDefaultUserGroupAccount(ctx context.Context, db bun.IDB, accountID, grou
 		Scan(ctx)
 	return &ga, err
 }
+
+// GetAccountKubernetesRoleGrants returns the roles with kubernetes
+// rules the account holds in the projects, directly or through its
+// groups
+func GetAccountKubernetesRoleGrants(ctx context.Context, db bun.IDB, accountID, orgID, partnerID uuid.UUID, projects []uuid.UUID) ([]models.KubernetesRoleGrant, error) {
+	var grants []models.KubernetesRoleGrant
+
+	accountNamespaces := db.NewSelect().
+		TableExpr("authsrv_projectaccountnamespacerole AS panr").
+		ColumnExpr("rr.name AS role_name, panr.namespace, panr.namespace_selector, rr.kubernetes_rules").
+		Join("JOIN authsrv_resourcerole AS rr ON rr.id = panr.role_id").
+		Where("panr.account_id = ?", accountID).
+		Where("panr.project_id IN (?)", bun.In(projects)).
+		Where("panr.trash = ?", false).
+		Where("panr.starts_at IS NULL OR panr.starts_at <= now()").
+		Where("panr.expires_at IS NULL OR panr.expires_at > now()").
+		Where("rr.trash = ?", false).
+		Where("rr.kubernetes_rules IS NOT NULL")
+
+	groupNamespaces := db.NewSelect().
+		TableExpr("authsrv_projectgroupnamespacerole AS pgnr").
+		ColumnExpr("rr.name AS role_name, pgnr.namespace, pgnr.namespace_selector, rr.kubernetes_rules").
+		Join("JOIN authsrv_groupaccount AS ga ON ga.group_id = pgnr.group_id").
+		Join("JOIN authsrv_resourcerole AS rr ON rr.id = pgnr.role_id").
+		Where("ga.account_id = ?", accountID).
+		Where("pgnr.project_id IN (?)", bun.In(projects)).
+		Where("pgnr.trash = ?", false).
+		Where("pgnr.starts_at IS NULL OR pgnr.starts_at <= now()").
+		Where("pgnr.expires_at IS NULL OR pgnr.expires_at > now()").
+		Where("ga.trash = ?", false).
+		Where("rr.trash = ?", false).
+		Where("rr.kubernetes_rules IS NOT NULL")
+
+	err := db.NewSelect().
+		TableExpr("sentry_account_permission AS sap").
+		ColumnExpr("rr.name AS role_name, '' AS namespace, '' AS namespace_selector, rr.kubernetes_rules").
+		Join("JOIN authsrv_resourcerole AS rr ON rr.id = sap.role_id").
+		Where("sap.account_id = ?", accountID).
+		Where("sap.organization_id = ?", orgID).
+		Where("sap.partner_id = ?", partnerID).
+		Where("sap.project_id IN (?)", bun.In(projects)).
+		Where("rr.scope != ?", "namespace").
+		Where("rr.kubernetes_rules IS NOT NULL").
+		Union(accountNamespaces).
+		Union(groupNamespaces).
+		Scan(ctx, &grants)
+
+	return grants, err
+}
+
+// GetGroupKubernetesRoleGrants returns the roles with kubernetes rules
+// the groups hold in the projects
+func GetGroupKubernetesRoleGrants(ctx context.Context, db bun.IDB, groupNames []string, orgID, partnerID uuid.UUID, projects []string) ([]models.KubernetesRoleGrant, error) {
+	var grants []models.KubernetesRoleGrant
+
+	groupNamespaces := db.NewSelect().
+		TableExpr("authsrv_projectgroupnamespacerole AS pgnr").
+		ColumnExpr("rr.name AS role_name, pgnr.namespace, pgnr.namespace_selector, rr.kubernetes_rules").
+		Join("JOIN authsrv_group AS g ON g.id = pgnr.group_id").
+		Join("JOIN authsrv_resourcerole AS rr ON rr.id = pgnr.role_id").
+		Where("g.name IN (?)", bun.In(groupNames)).
+		Where("g.organization_id = ?", orgID).
+		Where("CAST(pgnr.project_id AS text) IN (?)", bun.In(projects)).
+		Where("pgnr.trash = ?", false).
+		Where("pgnr.starts_at IS NULL OR pgnr.starts_at <= now()").
+		Where("pgnr.expires_at IS NULL OR pgnr.expires_at > now()").
+		Where("g.trash = ?", false).
+		Where("rr.trash = ?", false).
+		Where("rr.kubernetes_rules IS NOT NULL")
+
+	err := db.NewSelect().
+		TableExpr("sentry_group_permission AS sgp").
+		ColumnExpr("rr.name AS role_name, '' AS namespace, '' AS namespace_selector, rr.kubernetes_rules").
+		Join("JOIN authsrv_resourcerole AS rr ON rr.name = sgp.role_name AND rr.organization_id = sgp.organization_id").
+		Where("sgp.group_name IN (?)", bun.In(groupNames)).
+		Where("sgp.organization_id = ?", orgID).
+		Where("sgp.partner_id = ?", partnerID).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Where("sgp.project_id IN (?)", bun.In(projects)).WhereOr("sgp.project_id IS NULL")
+		}).
+		Where("rr.scope != ?", "namespace").
+		Where("rr.trash = ?", false).
+		Where("rr.kubernetes_rules IS NOT NULL").
+		Union(groupNamespaces).
+		Scan(ctx, &grants)
+
+	return grants, err
+}
+
+// GetKubernetesRoleNames returns the names of the custom roles of the
+// organization, including deleted ones, whose kubernetes rules could
+// have been granted in clusters
+func GetKubernetesRoleNames(ctx context.Context, db bun.IDB, orgID, partnerID uuid.UUID) ([]string, error) {
+	var names []string
+
+	err := db.NewSelect().Model((*models.Role)(nil)).
+		Column("name").
+		Where("organization_id = ?", orgID).
+		Where("partner_id = ?", partnerID).
+		Where("builtin = ?", false).
+		Scan(ctx, &names)
+
+	return names, err
+}
+
+// GetDenyPolicies returns the deny policies of the organization
+func GetDenyPolicies(ctx context.Context, db bun.IDB, orgID, partnerID uuid.UUID) ([]models.DenyPolicy, error) {
+	var policies []models.DenyPolicy
+
+	err := db.NewSelect().Model(&policies).
+		Where("organization_id = ?", orgID).
+		Where("partner_id = ?", partnerID).
+		Where("trash = ?", false).
+		Scan(ctx)
+
+	return policies, err
+}
@@ -74,9 +74,9 @@ func GetFileteredProjects(ctx context.Context, db bun.IDB, account, partner, org
 }
 
 func GetProjectGroupRoles(ctx context.Context, db bun.IDB, id uuid.UUID) ([]*userv3.ProjectNamespaceRole, error) {
-	var pr = []*userv3.ProjectNamespaceRole{}
+	var pr = []roleBinding{}
 	err := db.NewSelect().Table("authsrv_projectgrouprole").
-		ColumnExpr("distinct authsrv_resourcerole.name as role, authsrv_project.name as project, authsrv_group.name as group").
+		ColumnExpr("distinct authsrv_resourcerole.name as role, authsrv_project.name as project, authsrv_group.name as group, authsrv_projectgrouprole.starts_at, authsrv_projectgrouprole.expires_at").
 		Join(`JOIN authsrv_resourcerole ON authsrv_resourcerole.id=authsrv_projectgrouprole.role_id`).
 		Join(`JOIN authsrv_group ON authsrv_group.id=authsrv_projectgrouprole.group_id`).
 		Join(`JOIN authsrv_project ON authsrv_project.id=authsrv_projectgrouprole.project_id`).
@@ -87,9 +87,9 @@ func GetProjectGroupRoles(ctx context.Context, db bun.IDB, id uuid.UUID) ([]*use
 		return nil, err
 	}
 
-	var pnr = []*userv3.ProjectNamespaceRole{}
+	var pnr = []roleBinding{}
 	err = db.NewSelect().Table("authsrv_projectgroupnamespacerole").
-		ColumnExpr("distinct authsrv_resourcerole.name as role, authsrv_project.name as project, authsrv_group.name as group, namespace").
+		ColumnExpr("distinct authsrv_resourcerole.name as role, authsrv_project.name as project, authsrv_group.name as group, namespace, namespace_selector, authsrv_projectgroupnamespacerole.starts_at, authsrv_projectgroupnamespacerole.expires_at").
 		Join(`JOIN authsrv_resourcerole ON authsrv_resourcerole.id=authsrv_projectgroupnamespacerole.role_id`).
 		Join(`JOIN authsrv_project ON authsrv_project.id=authsrv_projectgroupnamespacerole.project_id`).
 		Join(`JOIN authsrv_group ON authsrv_group.id=authsrv_projectgroupnamespacerole.group_id`).
@@ -100,14 +100,14 @@ func GetProjectGroupRoles(ctx context.Context, db bun.IDB, id uuid.UUID) ([]*use
 		return nil, err
 	}
 
-	return append(pr, pnr...), err
+	return toProjectNamespaceRoles(append(pr, pnr...)), err
 }
 
 func GetProjectUserRoles(ctx context.Context, db bun.IDB, id uuid.UUID) ([]*userv3.UserRole, error) {
 
-	var ur = []*userv3.UserRole{}
+	var ur = []roleBinding{}
 	err := db.NewSelect().Table("authsrv_projectaccountresourcerole").
-		ColumnExpr("distinct authsrv_resourcerole.name as role, identities.traits ->> 'email' as user").
+		ColumnExpr("distinct authsrv_resourcerole.name as role, identities.traits ->> 'email' as user, authsrv_projectaccountresourcerole.starts_at, authsrv_projectaccountresourcerole.expires_at").
 		Join(`JOIN authsrv_resourcerole ON authsrv_resourcerole.id=authsrv_projectaccountresourcerole.role_id`).
 		Join(`JOIN identities ON identities.id=authsrv_projectaccountresourcerole.account_id`).
 		Where("authsrv_projectaccountresourcerole.project_id = ?", id).
@@ -117,9 +117,9 @@ func GetProjectUserRoles(ctx context.Context, db bun.IDB, id uuid.UUID) ([]*user
 		return nil, err
 	}
 
-	var unr = []*userv3.UserRole{}
+	var unr = []roleBinding{}
 	err = db.NewSelect().Table("authsrv_projectaccountnamespacerole").
-		ColumnExpr("distinct authsrv_resourcerole.name as role, identities.traits ->> 'email' as user, namespace").
+		ColumnExpr("distinct authsrv_resourcerole.name as role, identities.traits ->> 'email' as user, namespace, namespace_selector, authsrv_projectaccountnamespacerole.starts_at, authsrv_projectaccountnamespacerole.expires_at").
 		Join(`JOIN authsrv_resourcerole ON authsrv_resourcerole.id=authsrv_projectaccountnamespacerole.role_id`).
 		Join(`JOIN identities ON identities.id=authsrv_projectaccountnamespacerole.account_id`).
 		Where("authsrv_projectaccountnamespacerole.project_id = ?", id).
@@ -129,5 +129,5 @@ func GetProjectUserRoles(ctx context.Context, db bun.IDB, id uuid.UUID) ([]*user
 		return nil, err
 	}
 
-	return append(ur, unr...), err
+	return toUserRoles(append(ur, unr...)), err
 }
@@ -51,3 +51,18 @@ func GetRolePermissionsByNames(ctx context.Context, db bun.IDB, permissions ...s
 	}
 	return r, nil
 }
+
+// GetRoles returns the roles with the ids
+func GetRoles(ctx context.Context, db bun.IDB, roleIds []uuid.UUID) ([]models.Role, error) {
+	var roles []models.Role
+	if len(roleIds) == 0 {
+		return roles, nil
+	}
+
+	err := db.NewSelect().Model(&roles).
+		Where("id IN (?)", bun.In(roleIds)).
+		Where("trash = ?", false).
+		Scan(ctx)
+
+	return roles, err
+}
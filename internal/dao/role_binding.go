@@ -0,0 +1,213 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/models"
+	userv3 "github.com/paralus/paralus/proto/types/userpb/v3"
+	"github.com/uptrace/bun"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// roleBindingTable is a table role bindings are stored in
+type roleBindingTable struct {
+	name      string
+	project   bool
+	namespace bool
+	group     bool
+}
+
+var roleBindingTables = []roleBindingTable{
+	{name: "authsrv_accountresourcerole"},
+	{name: "authsrv_grouprole", group: true},
+	{name: "authsrv_projectaccountresourcerole", project: true},
+	{name: "authsrv_projectaccountnamespacerole", project: true, namespace: true},
+	{name: "authsrv_projectgrouprole", project: true, group: true},
+	{name: "authsrv_projectgroupnamespacerole", project: true, namespace: true, group: true},
+}
+
+// roleBinding is a role binding read for the api types
+type roleBinding struct {
+	Role              string    `bun:"role"`
+	Project           string    `bun:"project"`
+	Namespace         string    `bun:"namespace"`
+	NamespaceSelector string    `bun:"namespace_selector"`
+	Group             string    `bun:"group"`
+	User              string    `bun:"user"`
+	StartsAt          time.Time `bun:"starts_at"`
+	ExpiresAt         time.Time `bun:"expires_at"`
+}
+
+// bindingExpiresIn returns the time remaining until a binding expiring
+// at t expires, permanent bindings have none
+func bindingExpiresIn(t time.Time, now time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	if !t.After(now) {
+		return "expired"
+	}
+	return t.Sub(now).Round(time.Second).String()
+}
+
+func bindingTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func toProjectNamespaceRoles(rbs []roleBinding) []*userv3.ProjectNamespaceRole {
+	now := time.Now()
+	pnrs := make([]*userv3.ProjectNamespaceRole, 0, len(rbs))
+	for _, rb := range rbs {
+		pnrs = append(pnrs, &userv3.ProjectNamespaceRole{
+			Role:              rb.Role,
+			Project:           rb.Project,
+			Namespace:         rb.Namespace,
+			NamespaceSelector: rb.NamespaceSelector,
+			Group:             rb.Group,
+			StartsAt:          bindingTimestamp(rb.StartsAt),
+			ExpiresAt:         bindingTimestamp(rb.ExpiresAt),
+			ExpiresIn:         bindingExpiresIn(rb.ExpiresAt, now),
+		})
+	}
+	return pnrs
+}
+
+func toUserRoles(rbs []roleBinding) []*userv3.UserRole {
+	now := time.Now()
+	urs := make([]*userv3.UserRole, 0, len(rbs))
+	for _, rb := range rbs {
+		urs = append(urs, &userv3.UserRole{
+			Role:              rb.Role,
+			User:              rb.User,
+			Namespace:         rb.Namespace,
+			NamespaceSelector: rb.NamespaceSelector,
+			StartsAt:          bindingTimestamp(rb.StartsAt),
+			ExpiresAt:         bindingTimestamp(rb.ExpiresAt),
+			ExpiresIn:         bindingExpiresIn(rb.ExpiresAt, now),
+		})
+	}
+	return urs
+}
+
+// bindingInEffect filters the role bindings of the table alias to the
+// ones in effect, started and not yet expired
+func bindingInEffect(q *bun.SelectQuery, alias string) *bun.SelectQuery {
+	return q.Where(alias + ".starts_at IS NULL OR " + alias + ".starts_at <= now()").
+		Where(alias + ".expires_at IS NULL OR " + alias + ".expires_at > now()")
+}
+
+func roleBindingQuery(db bun.IDB, t roleBindingTable) *bun.SelectQuery {
+	q := db.NewSelect().
+		TableExpr(t.name + " AS rb").
+		ColumnExpr("rb.id, rb.organization_id, rb.partner_id, rb.role_id, rb.starts_at, rb.expires_at").
+		ColumnExpr("rr.name AS role, lower(rr.scope) AS scope").
+		ColumnExpr("coalesce(o.name, '') AS organization").
+		Join("JOIN authsrv_resourcerole AS rr ON rr.id = rb.role_id").
+		Join("LEFT JOIN authsrv_organization AS o ON o.id = rb.organization_id")
+	if t.project {
+		q = q.ColumnExpr("rb.project_id, p.name AS project").
+			Join("JOIN authsrv_project AS p ON p.id = rb.project_id")
+	}
+	if t.namespace {
+		q = q.ColumnExpr("rb.namespace, rb.namespace_selector")
+	}
+	if t.group {
+		q = q.ColumnExpr("'g:' || g.name AS subject").
+			Join("JOIN authsrv_group AS g ON g.id = rb.group_id")
+	} else {
+		// accounts are either users or service accounts
+		q = q.ColumnExpr("CASE WHEN sa.id IS NULL THEN 'u:' || (i.traits ->> 'email') ELSE 'sa:' || sa.id END AS subject").
+			Join("LEFT JOIN identities AS i ON i.id = rb.account_id").
+			Join("LEFT JOIN authsrv_serviceaccount AS sa ON sa.id = rb.account_id")
+	}
+	return q.Where("rb.trash = ?", false)
+}
+
+// getRoleBindings returns the role bindings of all tables matching
+// filter, tables for which filter returns nil are skipped
+func getRoleBindings(ctx context.Context, db bun.IDB, filter func(*bun.SelectQuery, roleBindingTable) *bun.SelectQuery) ([]models.RoleBinding, error) {
+	var bindings []models.RoleBinding
+	for _, t := range roleBindingTables {
+		q := filter(roleBindingQuery(db, t), t)
+		if q == nil {
+			continue
+		}
+		var rbs []models.RoleBinding
+		err := q.Scan(ctx, &rbs)
+		if err != nil {
+			return nil, err
+		}
+		for i := range rbs {
+			rbs[i].Table = t.name
+		}
+		bindings = append(bindings, rbs...)
+	}
+	return bindings, nil
+}
+
+// GetExpiredRoleBindings returns the role bindings which expired by now
+func GetExpiredRoleBindings(ctx context.Context, db bun.IDB, now time.Time) ([]models.RoleBinding, error) {
+	return getRoleBindings(ctx, db, func(q *bun.SelectQuery, _ roleBindingTable) *bun.SelectQuery {
+		return q.Where("rb.expires_at <= ?", now)
+	})
+}
+
+// GetStartedRoleBindings returns the role bindings which started by now
+// but are not yet active
+func GetStartedRoleBindings(ctx context.Context, db bun.IDB, now time.Time) ([]models.RoleBinding, error) {
+	return getRoleBindings(ctx, db, func(q *bun.SelectQuery, _ roleBindingTable) *bun.SelectQuery {
+		return q.Where("rb.active = ?", false).
+			Where("rb.starts_at <= ?", now).
+			Where("rb.expires_at IS NULL OR rb.expires_at > ?", now)
+	})
+}
+
+// GetRoleBindingsInEffect returns the role bindings of a role in the
+// organization which are in effect
+func GetRoleBindingsInEffect(ctx context.Context, db bun.IDB, organizationId, roleId uuid.UUID) ([]models.RoleBinding, error) {
+	return getRoleBindings(ctx, db, func(q *bun.SelectQuery, _ roleBindingTable) *bun.SelectQuery {
+		q = q.Where("rb.organization_id = ?", organizationId).
+			Where("rb.role_id = ?", roleId)
+		return bindingInEffect(q, "rb")
+	})
+}
+
+// ExpireRoleBinding deletes an expired role binding, it returns false
+// when another replica deleted it first
+func ExpireRoleBinding(ctx context.Context, db bun.IDB, rb models.RoleBinding) (bool, error) {
+	res, err := db.NewUpdate().
+		Table(rb.Table).
+		Set("trash = ?", true).
+		Set("modified_at = ?", time.Now()).
+		Where("id = ?", rb.ID).
+		Where("trash = ?", false).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n == 1, err
+}
+
+// ActivateRoleBinding marks a started role binding active, it returns
+// false when another replica activated it first
+func ActivateRoleBinding(ctx context.Context, db bun.IDB, rb models.RoleBinding) (bool, error) {
+	res, err := db.NewUpdate().
+		Table(rb.Table).
+		Set("active = ?", true).
+		Set("modified_at = ?", time.Now()).
+		Where("id = ?", rb.ID).
+		Where("active = ?", false).
+		Where("trash = ?", false).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n == 1, err
+}
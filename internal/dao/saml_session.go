@@ -0,0 +1,79 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/uptrace/bun"
+)
+
+// CreateSAMLSession stores a new SAML session and drops the expired ones
+func CreateSAMLSession(ctx context.Context, db bun.IDB, s *models.SAMLSession) error {
+	_, err := db.NewDelete().Model((*models.SAMLSession)(nil)).
+		Where("expires_at < ?", time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = db.NewInsert().Model(s).Exec(ctx)
+	return err
+}
+
+// GetSAMLSession returns the active SAML session with the token hash
+func GetSAMLSession(ctx context.Context, db bun.IDB, tokenHash string) (*models.SAMLSession, error) {
+	var s models.SAMLSession
+	err := db.NewSelect().Model(&s).
+		Where("token_hash = ?", tokenHash).
+		Where("expires_at > ?", time.Now()).
+		Scan(ctx)
+	return &s, err
+}
+
+// GetSAMLSessions returns the active SAML sessions of an account
+func GetSAMLSessions(ctx context.Context, db bun.IDB, accountID uuid.UUID) ([]models.SAMLSession, error) {
+	var sessions []models.SAMLSession
+	err := db.NewSelect().Model(&sessions).
+		Where("account_id = ?", accountID).
+		Where("expires_at > ?", time.Now()).
+		Order("created_at").
+		Scan(ctx)
+	return sessions, err
+}
+
+// DeleteSAMLSession deletes a SAML session of an account, it returns
+// false if the account has no such session
+func DeleteSAMLSession(ctx context.Context, db bun.IDB, accountID, id uuid.UUID) (bool, error) {
+	res, err := db.NewDelete().Model((*models.SAMLSession)(nil)).
+		Where("account_id = ?", accountID).
+		Where("id = ?", id).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+	n, _ := res.RowsAffected()
+	return n > 0, nil
+}
+
+// DeleteSAMLSessionByToken deletes the SAML session with the token hash
+func DeleteSAMLSessionByToken(ctx context.Context, db bun.IDB, tokenHash string) error {
+	_, err := db.NewDelete().Model((*models.SAMLSession)(nil)).
+		Where("token_hash = ?", tokenHash).
+		Exec(ctx)
+	return err
+}
+
+// DeleteSAMLSessions deletes all SAML sessions of an account and
+// returns how many were active
+func DeleteSAMLSessions(ctx context.Context, db bun.IDB, accountID uuid.UUID) (int, error) {
+	res, err := db.NewDelete().Model((*models.SAMLSession)(nil)).
+		Where("account_id = ?", accountID).
+		Where("expires_at > ?", time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+	n, _ := res.RowsAffected()
+	return int(n), nil
+}
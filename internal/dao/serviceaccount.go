@@ -0,0 +1,19 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/uptrace/bun"
+)
+
+// IsServiceAccountActive checks if the service account exists in the
+// organization and has not been deleted
+func IsServiceAccountActive(ctx context.Context, db bun.IDB, id, orgId uuid.UUID) (bool, error) {
+	return db.NewSelect().Model((*models.ServiceAccount)(nil)).
+		Where("id = ?", id).
+		Where("organization_id = ?", orgId).
+		Where("trash = ?", false).
+		Exists(ctx)
+}
@@ -3,6 +3,7 @@ package dao
 import (
 	"context"
 	"database/sql"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/paralus/paralus/internal/models"
@@ -36,9 +37,9 @@ func GetGroups(ctx context.Context, db bun.IDB, id uuid.UUID) ([]models.Group, e
 func GetUserRoles(ctx context.Context, db bun.IDB, id uuid.UUID) ([]*userv3.ProjectNamespaceRole, error) {
 	// Could possibly union them later for some speedup
 	// TODO filter by org and partner
-	var r = []*userv3.ProjectNamespaceRole{}
+	var r = []roleBinding{}
 	err := db.NewSelect().Table("authsrv_accountresourcerole").
-		ColumnExpr("authsrv_resourcerole.name as role").
+		ColumnExpr("authsrv_resourcerole.name as role, authsrv_accountresourcerole.starts_at, authsrv_accountresourcerole.expires_at").
 		Join(`JOIN authsrv_resourcerole ON authsrv_resourcerole.id=authsrv_accountresourcerole.role_id`).
 		Where("authsrv_accountresourcerole.account_id = ?", id).
 		Where("authsrv_resourcerole.trash = ?", false).
@@ -48,9 +49,9 @@ func GetUserRoles(ctx context.Context, db bun.IDB, id uuid.UUID) ([]*userv3.Proj
 		return nil, err
 	}
 
-	var pr = []*userv3.ProjectNamespaceRole{}
+	var pr = []roleBinding{}
 	err = db.NewSelect().Table("authsrv_projectaccountresourcerole").
-		ColumnExpr("distinct authsrv_resourcerole.name as role, authsrv_project.name as project").
+		ColumnExpr("distinct authsrv_resourcerole.name as role, authsrv_project.name as project, authsrv_projectaccountresourcerole.starts_at, authsrv_projectaccountresourcerole.expires_at").
 		Join(`JOIN authsrv_resourcerole ON authsrv_resourcerole.id=authsrv_projectaccountresourcerole.role_id`).
 		Join(`JOIN authsrv_project ON authsrv_project.id=authsrv_projectaccountresourcerole.project_id`).
 		Where("authsrv_projectaccountresourcerole.account_id = ?", id).
@@ -62,9 +63,9 @@ func GetUserRoles(ctx context.Context, db bun.IDB, id uuid.UUID) ([]*userv3.Proj
 		return nil, err
 	}
 
-	var pnr = []*userv3.ProjectNamespaceRole{}
+	var pnr = []roleBinding{}
 	err = db.NewSelect().Table("authsrv_projectaccountnamespacerole").
-		ColumnExpr("authsrv_resourcerole.name as role, authsrv_project.name as project, namespace").
+		ColumnExpr("authsrv_resourcerole.name as role, authsrv_project.name as project, namespace, namespace_selector, authsrv_projectaccountnamespacerole.starts_at, authsrv_projectaccountnamespacerole.expires_at").
 		Join(`JOIN authsrv_resourcerole ON authsrv_resourcerole.id=authsrv_projectaccountnamespacerole.role_id`).
 		Join(`JOIN authsrv_project ON authsrv_project.id=authsrv_projectaccountnamespacerole.project_id`). // also need a namespace join
 		Where("authsrv_projectaccountnamespacerole.account_id = ?", id).
@@ -76,7 +77,7 @@ func GetUserRoles(ctx context.Context, db bun.IDB, id uuid.UUID) ([]*userv3.Proj
 		return nil, err
 	}
 
-	return append(append(r, pr...), pnr...), err
+	return toProjectNamespaceRoles(append(append(r, pr...), pnr...)), err
 }
 
 func GetQueryFilteredUsers(ctx context.Context, db bun.IDB, partner, org, group, role uuid.UUID, projects []uuid.UUID) ([]uuid.UUID, error) {
@@ -262,3 +263,68 @@ func IsSSOAccount(ctx context.Context, db bun.IDB, id uuid.UUID) (bool, error) {
 	}
 	return false, err
 }
+
+// ListOrganizationUsers returns the users of an organization
+func ListOrganizationUsers(ctx context.Context, db bun.IDB, organizationId uuid.UUID) ([]models.KratosIdentities, error) {
+	var users []models.KratosIdentities
+	err := db.NewSelect().Model(&users).
+		Where("metadata_public ->> 'Organization' = ?", organizationId.String()).
+		Order("created_at").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+// GetOrganizationUser returns the user with id if it belongs to the
+// organization
+func GetOrganizationUser(ctx context.Context, db bun.IDB, organizationId, id uuid.UUID) (*models.KratosIdentities, error) {
+	var user models.KratosIdentities
+	err := db.NewSelect().Model(&user).
+		Where("id = ?", id).
+		Where("metadata_public ->> 'Organization' = ?", organizationId.String()).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// GetOIDCProviderOfUser returns the oidc provider the user signed up
+// with, identifiers of oidc credentials are prefixed with the name of
+// the provider
+func GetOIDCProviderOfUser(ctx context.Context, db bun.IDB, id uuid.UUID) (*models.OIDCProvider, error) {
+	var identifiers []string
+	err := db.NewSelect().
+		TableExpr("identity_credential_identifiers AS ici").
+		ColumnExpr("ici.identifier").
+		Join("JOIN identity_credentials AS ic ON ic.id = ici.identity_credential_id").
+		Join("JOIN identity_credential_types AS ict ON ict.id = ic.identity_credential_type_id").
+		Where("ic.identity_id = ?", id).
+		Where("ict.name = ?", KratosOidcType).
+		Scan(ctx, &identifiers)
+	if err != nil {
+		return nil, err
+	}
+	names := []string{}
+	for _, identifier := range identifiers {
+		if i := strings.Index(identifier, ":"); i > 0 {
+			names = append(names, identifier[:i])
+		}
+	}
+	if len(names) == 0 {
+		return nil, sql.ErrNoRows
+	}
+
+	var provider models.OIDCProvider
+	err = db.NewSelect().Model(&provider).
+		Where("name IN (?)", bun.In(names)).
+		Where("trash = ?", false).
+		Limit(1).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &provider, nil
+}
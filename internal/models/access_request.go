@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type AccessRequest struct {
+	bun.BaseModel `bun:"table:authsrv_access_request,alias:accessrequest"`
+
+	ID             uuid.UUID `bun:"id,type:uuid,pk,default:uuid_generate_v4()"`
+	Name           string    `bun:"name,notnull"`
+	Description    string    `bun:"description,notnull"`
+	CreatedAt      time.Time `bun:"created_at,notnull,default:current_timestamp"`
+	ModifiedAt     time.Time `bun:"modified_at,notnull,default:current_timestamp"`
+	Trash          bool      `bun:"trash,notnull,default:false"`
+	OrganizationId uuid.UUID `bun:"organization_id,type:uuid"`
+	PartnerId      uuid.UUID `bun:"partner_id,type:uuid"`
+	RequesterId    uuid.UUID `bun:"requester_id,type:uuid,notnull"`
+	Requester      string    `bun:"requester,notnull"`
+	Role           string    `bun:"role,notnull"`
+	Project        string    `bun:"project,notnull"`
+	Namespace      string    `bun:"namespace"`
+	Duration       string    `bun:"duration,notnull"`
+	Justification  string    `bun:"justification,notnull"`
+	State          string    `bun:"state,notnull"`
+	Reviewer       string    `bun:"reviewer"`
+	Reason         string    `bun:"reason"`
+	ReviewedAt     time.Time `bun:"reviewed_at,nullzero"`
+	ExpiresAt      time.Time `bun:"expires_at,nullzero"`
+}
@@ -0,0 +1,66 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// AccessReview is a campaign recertifying the role bindings of an
+// organization, the bindings under review are its items
+type AccessReview struct {
+	bun.BaseModel `bun:"table:authsrv_access_review,alias:accessreview"`
+
+	ID              uuid.UUID                `bun:"id,type:uuid,pk,default:uuid_generate_v4()"`
+	Name            string                   `bun:"name,notnull"`
+	Description     string                   `bun:"description,notnull"`
+	CreatedAt       time.Time                `bun:"created_at,notnull,default:current_timestamp"`
+	ModifiedAt      time.Time                `bun:"modified_at,notnull,default:current_timestamp"`
+	Trash           bool                     `bun:"trash,notnull,default:false"`
+	OrganizationId  uuid.UUID                `bun:"organization_id,type:uuid"`
+	PartnerId       uuid.UUID                `bun:"partner_id,type:uuid"`
+	Projects        []string                 `bun:"projects,array"`
+	Roles           []string                 `bun:"roles,array"`
+	GroupOwners     []AccessReviewGroupOwner `bun:"group_owners,type:jsonb"`
+	DefaultReviewer string                   `bun:"default_reviewer,notnull"`
+	RevokePending   bool                     `bun:"revoke_pending,notnull"`
+	State           string                   `bun:"state,notnull"`
+	StartedBy       string                   `bun:"started_by,notnull"`
+	ClosedBy        string                   `bun:"closed_by"`
+	ClosedAt        time.Time                `bun:"closed_at,nullzero"`
+}
+
+// AccessReviewGroupOwner is the reviewer of the organization bindings
+// of a group
+type AccessReviewGroupOwner struct {
+	Group string `json:"group"`
+	Owner string `json:"owner"`
+}
+
+// AccessReviewItem is a role binding under review, the binding is
+// copied so the review keeps its record once the binding is revoked
+type AccessReviewItem struct {
+	bun.BaseModel `bun:"table:authsrv_access_review_item,alias:accessreviewitem"`
+
+	ID                uuid.UUID `bun:"id,type:uuid,pk,default:uuid_generate_v4()"`
+	ReviewId          uuid.UUID `bun:"review_id,type:uuid,notnull"`
+	CreatedAt         time.Time `bun:"created_at,notnull,default:current_timestamp"`
+	ModifiedAt        time.Time `bun:"modified_at,notnull,default:current_timestamp"`
+	BindingId         uuid.UUID `bun:"binding_id,type:uuid,notnull"`
+	BindingTable      string    `bun:"binding_table,notnull"`
+	SubjectType       string    `bun:"subject_type,notnull"`
+	Subject           string    `bun:"subject,notnull"`
+	Role              string    `bun:"role,notnull"`
+	Scope             string    `bun:"scope,notnull"`
+	Project           string    `bun:"project"`
+	Namespace         string    `bun:"namespace"`
+	NamespaceSelector string    `bun:"namespace_selector"`
+	Reviewer          string    `bun:"reviewer,notnull"`
+	Decision          string    `bun:"decision,notnull"`
+	Reason            string    `bun:"reason"`
+	DecidedBy         string    `bun:"decided_by"`
+	DecidedAt         time.Time `bun:"decided_at,nullzero"`
+	Result            string    `bun:"result"`
+	AppliedAt         time.Time `bun:"applied_at,nullzero"`
+}
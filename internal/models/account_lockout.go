@@ -0,0 +1,36 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type AccountLockout struct {
+	bun.BaseModel `bun:"table:authsrv_account_lockout,alias:lockout"`
+
+	AccountId      uuid.UUID `bun:"account_id,type:uuid,pk"`
+	OrganizationId uuid.UUID `bun:"organization_id,type:uuid,notnull"`
+	FailedAttempts int32     `bun:"failed_attempts,notnull"`
+	FirstFailedAt  time.Time `bun:"first_failed_at,notnull"`
+	LastFailedAt   time.Time `bun:"last_failed_at,notnull"`
+	LockedAt       time.Time `bun:"locked_at,nullzero"`
+}
+
+type SessionActivity struct {
+	bun.BaseModel `bun:"table:authsrv_session_activity,alias:sessionactivity"`
+
+	SessionId    string    `bun:"session_id,pk"`
+	AccountId    uuid.UUID `bun:"account_id,type:uuid,notnull"`
+	LastActiveAt time.Time `bun:"last_active_at,notnull,default:current_timestamp"`
+	ExpiresAt    time.Time `bun:"expires_at,notnull"`
+}
+
+type LoginFailure struct {
+	bun.BaseModel `bun:"table:authsrv_login_failure,alias:loginfailure"`
+
+	Id         uuid.UUID `bun:"id,type:uuid,pk,default:uuid_generate_v4()"`
+	Identifier string    `bun:"identifier,notnull"`
+	FailedAt   time.Time `bun:"failed_at,notnull,default:current_timestamp"`
+}
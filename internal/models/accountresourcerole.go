@@ -22,4 +22,6 @@ type AccountResourcerole struct {
 	RoleId         uuid.UUID `bun:"role_id,type:uuid"`
 	AccountId      uuid.UUID `bun:"account_id,type:uuid"`
 	Active         bool      `bun:"active,notnull"`
+	StartsAt       time.Time `bun:"starts_at,nullzero"`
+	ExpiresAt      time.Time `bun:"expires_at,nullzero"`
 }
@@ -22,4 +22,12 @@ type ApiKey struct {
 	PartnerID       uuid.UUID `bun:"partner_id,type:uuid"`
 	SecretMigration string    `bun:"secret_migration"`
 	Secret          string    `bun:"secret,notnull"`
+
+	ExpiresAt               time.Time `bun:"expires_at,nullzero"`
+	Permissions             []string  `bun:"permissions,array,notnull"`
+	Projects                []string  `bun:"projects,array,notnull"`
+	PreviousSecret          string    `bun:"previous_secret,nullzero"`
+	PreviousSecretExpiresAt time.Time `bun:"previous_secret_expires_at,nullzero"`
+	RotatedAt               time.Time `bun:"rotated_at,nullzero"`
+	IsServiceAccount        bool      `bun:"is_service_account,notnull,default:false"`
 }
@@ -0,0 +1,15 @@
+package models
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type ApiKeyNonce struct {
+	bun.BaseModel `bun:"table:authsrv_apikey_nonce,alias:apikeynonce"`
+
+	Key       string    `bun:"key,pk"`
+	Nonce     string    `bun:"nonce,pk"`
+	CreatedAt time.Time `bun:"created_at,notnull,default:current_timestamp"`
+}
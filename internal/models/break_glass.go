@@ -0,0 +1,42 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// BreakGlass is the emergency access of a user to a cluster, the role
+// is held on the cluster from starts_at until expires_at
+type BreakGlass struct {
+	bun.BaseModel `bun:"table:authsrv_break_glass,alias:breakglass"`
+
+	ID             uuid.UUID `bun:"id,type:uuid,pk,default:uuid_generate_v4()"`
+	Name           string    `bun:"name,notnull"`
+	Description    string    `bun:"description,notnull"`
+	CreatedAt      time.Time `bun:"created_at,notnull,default:current_timestamp"`
+	ModifiedAt     time.Time `bun:"modified_at,notnull,default:current_timestamp"`
+	Trash          bool      `bun:"trash,notnull,default:false"`
+	OrganizationId uuid.UUID `bun:"organization_id,type:uuid"`
+	PartnerId      uuid.UUID `bun:"partner_id,type:uuid"`
+	AccountId      uuid.UUID `bun:"account_id,type:uuid,notnull"`
+	Username       string    `bun:"username,notnull"`
+	ClusterId      uuid.UUID `bun:"cluster_id,type:uuid,notnull"`
+	Cluster        string    `bun:"cluster,notnull"`
+	RoleId         uuid.UUID `bun:"role_id,type:uuid,notnull"`
+	Role           string    `bun:"role,notnull"`
+	Justification  string    `bun:"justification,notnull"`
+	Incident       string    `bun:"incident,notnull"`
+	StartsAt       time.Time `bun:"starts_at,notnull"`
+	ExpiresAt      time.Time `bun:"expires_at,notnull"`
+}
+
+// BreakGlassGrant is a role held in a cluster through an emergency
+// access
+type BreakGlassGrant struct {
+	Name      string
+	Incident  string
+	ExpiresAt time.Time
+	ClusterBindingGrant
+}
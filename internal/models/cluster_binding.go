@@ -0,0 +1,38 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// ClusterBinding grants a project role to users, groups and service
+// accounts on the clusters of the organization matching the cluster
+// selector
+type ClusterBinding struct {
+	bun.BaseModel `bun:"table:authsrv_cluster_binding,alias:clusterbinding"`
+
+	ID              uuid.UUID `bun:"id,type:uuid,pk,default:uuid_generate_v4()"`
+	Name            string    `bun:"name,notnull"`
+	Description     string    `bun:"description,notnull"`
+	CreatedAt       time.Time `bun:"created_at,notnull,default:current_timestamp"`
+	ModifiedAt      time.Time `bun:"modified_at,notnull,default:current_timestamp"`
+	Trash           bool      `bun:"trash,notnull,default:false"`
+	OrganizationId  uuid.UUID `bun:"organization_id,type:uuid"`
+	PartnerId       uuid.UUID `bun:"partner_id,type:uuid"`
+	ClusterSelector string    `bun:"cluster_selector,notnull"`
+	RoleId          uuid.UUID `bun:"role_id,type:uuid"`
+	Users           []string  `bun:"users,array"`
+	Groups          []string  `bun:"groups,array"`
+	ServiceAccounts []string  `bun:"service_accounts,array"`
+}
+
+// ClusterBindingGrant is a role held in a cluster through a cluster
+// binding
+type ClusterBindingGrant struct {
+	ClusterId       uuid.UUID
+	RoleName        string
+	Permissions     []string
+	KubernetesRules []KubernetesRule
+}
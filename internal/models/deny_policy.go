@@ -0,0 +1,29 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// DenyPolicy denies permissions to users and groups in the organization,
+// a project or a namespace of the project, overriding the roles which
+// grant them
+type DenyPolicy struct {
+	bun.BaseModel `bun:"table:authsrv_deny_policy,alias:denypolicy"`
+
+	ID             uuid.UUID `bun:"id,type:uuid,pk,default:uuid_generate_v4()"`
+	Name           string    `bun:"name,notnull"`
+	Description    string    `bun:"description,notnull"`
+	CreatedAt      time.Time `bun:"created_at,notnull,default:current_timestamp"`
+	ModifiedAt     time.Time `bun:"modified_at,notnull,default:current_timestamp"`
+	Trash          bool      `bun:"trash,notnull,default:false"`
+	OrganizationId uuid.UUID `bun:"organization_id,type:uuid"`
+	PartnerId      uuid.UUID `bun:"partner_id,type:uuid"`
+	ProjectId      uuid.UUID `bun:"project_id,type:uuid,nullzero"`
+	Namespace      string    `bun:"namespace"`
+	Users          []string  `bun:"users,array"`
+	Groups         []string  `bun:"groups,array"`
+	Permissions    []string  `bun:"permissions,array,notnull"`
+}
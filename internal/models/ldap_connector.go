@@ -0,0 +1,51 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type LdapConnector struct {
+	bun.BaseModel `bun:"table:authsrv_ldap_connector,alias:ldapconnector"`
+
+	ID                  uuid.UUID       `bun:"id,type:uuid,pk,default:uuid_generate_v4()"`
+	Name                string          `bun:"name,notnull"`
+	Description         string          `bun:"description,notnull"`
+	CreatedAt           time.Time       `bun:"created_at,notnull,default:current_timestamp"`
+	ModifiedAt          time.Time       `bun:"modified_at,notnull,default:current_timestamp"`
+	Trash               bool            `bun:"trash,notnull,default:false"`
+	OrganizationId      uuid.UUID       `bun:"organization_id,type:uuid"`
+	PartnerId           uuid.UUID       `bun:"partner_id,type:uuid"`
+	URL                 string          `bun:"url,notnull"`
+	BindDN              string          `bun:"bind_dn"`
+	BindPassword        string          `bun:"bind_password"`
+	StartTLS            bool            `bun:"start_tls,notnull"`
+	InsecureSkipVerify  bool            `bun:"insecure_skip_verify,notnull"`
+	GroupSearchBases    []string        `bun:"group_search_bases,array"`
+	GroupFilter         string          `bun:"group_filter"`
+	GroupNameAttribute  string          `bun:"group_name_attribute"`
+	GroupIDAttribute    string          `bun:"group_id_attribute"`
+	MemberAttribute     string          `bun:"member_attribute"`
+	UserEmailAttribute  string          `bun:"user_email_attribute"`
+	NestedGroups        bool            `bun:"nested_groups,notnull"`
+	SyncIntervalMinutes int32           `bun:"sync_interval_minutes,notnull"`
+	LastSyncAt          time.Time       `bun:"last_sync_at,nullzero"`
+	LastSyncError       string          `bun:"last_sync_error"`
+	LastSyncDiff        json.RawMessage `bun:"last_sync_diff,type:jsonb,nullzero"`
+}
+
+// LdapGroup maps a directory group of a connector to the paralus group
+// it is synchronized to
+type LdapGroup struct {
+	bun.BaseModel `bun:"table:authsrv_ldap_group,alias:ldapgroup"`
+
+	ID          uuid.UUID `bun:"id,type:uuid,pk,default:uuid_generate_v4()"`
+	ConnectorId uuid.UUID `bun:"connector_id,type:uuid,notnull"`
+	ExternalId  string    `bun:"external_id,notnull"`
+	GroupId     uuid.UUID `bun:"group_id,type:uuid,notnull"`
+	DN          string    `bun:"dn,notnull"`
+	CreatedAt   time.Time `bun:"created_at,notnull,default:current_timestamp"`
+}
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
@@ -28,6 +29,7 @@ type OIDCProvider struct {
 	AuthURL         string                 `bun:"auth_url"`
 	TokenURL        string                 `bun:"token_url"`
 	RequestedClaims map[string]interface{} `bun:"requested_claims,type:jsonb"`
+	ClaimMappings   json.RawMessage        `bun:"claim_mappings,type:jsonb"`
 	Predefined      bool                   `bun:"predefined,notnull"`
 	Trash           bool                   `bun:"trash,default:false"`
 }
@@ -23,4 +23,6 @@ type ProjectAccountResourcerole struct {
 	AccountId      uuid.UUID `bun:"account_id,type:uuid"`
 	ProjectId      uuid.UUID `bun:"project_id,type:uuid"`
 	Active         bool      `bun:"active,notnull"`
+	StartsAt       time.Time `bun:"starts_at,nullzero"`
+	ExpiresAt      time.Time `bun:"expires_at,nullzero"`
 }
@@ -0,0 +1,16 @@
+package models
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type RateLimitBucket struct {
+	bun.BaseModel `bun:"table:authsrv_ratelimit_bucket,alias:bucket"`
+
+	Key       string    `bun:"key,pk"`
+	Tokens    float64   `bun:"tokens,notnull"`
+	Allowed   bool      `bun:"allowed,notnull"`
+	UpdatedAt time.Time `bun:"updated_at,notnull,default:current_timestamp"`
+}
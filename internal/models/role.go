@@ -21,4 +21,27 @@ type Role struct {
 	IsGlobal       bool      `bun:"is_global,notnull,default:true"`
 	Builtin        bool      `bun:"builtin,notnull,default:true"`
 	Scope          string    `bun:"scope,notnull"`
+	RequireMfa     bool      `bun:"require_mfa,notnull,default:false"`
+	// KubernetesRules are granted in clusters in addition to the
+	// kubectl permissions of the role
+	KubernetesRules []KubernetesRule `bun:"kubernetes_rules,type:jsonb,nullzero"`
+}
+
+// KubernetesRule is a Kubernetes RBAC rule of a role
+type KubernetesRule struct {
+	APIGroups     []string `json:"apiGroups"`
+	Resources     []string `json:"resources"`
+	Subresources  []string `json:"subresources,omitempty"`
+	ResourceNames []string `json:"resourceNames,omitempty"`
+	Verbs         []string `json:"verbs"`
+}
+
+// KubernetesRoleGrant is a role with Kubernetes rules held by an
+// account, Namespace or NamespaceSelector is set when the role is held
+// in namespaces
+type KubernetesRoleGrant struct {
+	RoleName          string           `bun:"role_name"`
+	Namespace         string           `bun:"namespace"`
+	NamespaceSelector string           `bun:"namespace_selector"`
+	KubernetesRules   []KubernetesRule `bun:"kubernetes_rules,type:jsonb"`
 }
@@ -0,0 +1,28 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RoleBinding is a role held by a user, service account or group in
+// one of the role binding tables, Subject is the authz subject of the
+// holder and Table the table the binding is stored in
+type RoleBinding struct {
+	ID                uuid.UUID `bun:"id"`
+	Table             string    `bun:"-"`
+	Subject           string    `bun:"subject"`
+	OrganizationId    uuid.UUID `bun:"organization_id"`
+	PartnerId         uuid.UUID `bun:"partner_id"`
+	Organization      string    `bun:"organization"`
+	ProjectId         uuid.UUID `bun:"project_id"`
+	Project           string    `bun:"project"`
+	Namespace         string    `bun:"namespace"`
+	NamespaceSelector string    `bun:"namespace_selector"`
+	RoleId            uuid.UUID `bun:"role_id"`
+	Role              string    `bun:"role"`
+	Scope             string    `bun:"scope"`
+	StartsAt          time.Time `bun:"starts_at"`
+	ExpiresAt         time.Time `bun:"expires_at"`
+}
@@ -0,0 +1,28 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// SAMLSession is a console session created by a SAML login, only the
+// hash of the session token is stored
+type SAMLSession struct {
+	bun.BaseModel `bun:"table:authsrv_saml_session,alias:samlsession"`
+
+	ID              uuid.UUID `bun:"id,type:uuid,pk,default:uuid_generate_v4()"`
+	TokenHash       string    `bun:"token_hash,notnull"`
+	AccountId       uuid.UUID `bun:"account_id,type:uuid,notnull"`
+	Username        string    `bun:"username,notnull"`
+	IdpId           uuid.UUID `bun:"idp_id,type:uuid,notnull"`
+	OrganizationId  uuid.UUID `bun:"organization_id,type:uuid,notnull"`
+	PartnerId       uuid.UUID `bun:"partner_id,type:uuid,notnull"`
+	IpAddress       string    `bun:"ip_address"`
+	UserAgent       string    `bun:"user_agent"`
+	Aal             string    `bun:"aal,notnull"`
+	AuthenticatedAt time.Time `bun:"authenticated_at,notnull"`
+	CreatedAt       time.Time `bun:"created_at,notnull,default:current_timestamp"`
+	ExpiresAt       time.Time `bun:"expires_at,notnull"`
+}
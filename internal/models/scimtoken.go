@@ -0,0 +1,24 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type ScimToken struct {
+	bun.BaseModel `bun:"table:authsrv_scim_token,alias:scimtoken"`
+
+	ID             uuid.UUID `bun:"id,type:uuid,pk,default:uuid_generate_v4()"`
+	Name           string    `bun:"name,notnull"`
+	Description    string    `bun:"description,notnull"`
+	CreatedAt      time.Time `bun:"created_at,notnull,default:current_timestamp"`
+	ModifiedAt     time.Time `bun:"modified_at,notnull,default:current_timestamp"`
+	Trash          bool      `bun:"trash,notnull,default:false"`
+	OrganizationId uuid.UUID `bun:"organization_id,type:uuid"`
+	PartnerId      uuid.UUID `bun:"partner_id,type:uuid"`
+	TokenHash      string    `bun:"token_hash,notnull"`
+	ExpiresAt      time.Time `bun:"expires_at,nullzero"`
+	LastUsedAt     time.Time `bun:"last_used_at,nullzero"`
+}
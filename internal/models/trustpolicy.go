@@ -0,0 +1,27 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type TrustPolicy struct {
+	bun.BaseModel `bun:"table:authsrv_trustpolicy,alias:trustpolicy"`
+
+	ID               uuid.UUID         `bun:"id,type:uuid,pk,default:uuid_generate_v4()"`
+	Name             string            `bun:"name,notnull"`
+	Description      string            `bun:"description,notnull"`
+	CreatedAt        time.Time         `bun:"created_at,notnull,default:current_timestamp"`
+	ModifiedAt       time.Time         `bun:"modified_at,notnull,default:current_timestamp"`
+	Trash            bool              `bun:"trash,notnull,default:false"`
+	OrganizationId   uuid.UUID         `bun:"organization_id,type:uuid"`
+	PartnerId        uuid.UUID         `bun:"partner_id,type:uuid"`
+	Issuer           string            `bun:"issuer,notnull"`
+	JwksURL          string            `bun:"jwks_url"`
+	Jwks             string            `bun:"jwks"`
+	Audience         string            `bun:"audience,notnull"`
+	ClaimMatchers    map[string]string `bun:"claim_matchers,type:jsonb"`
+	ServiceAccountId uuid.UUID         `bun:"service_account_id,type:uuid"`
+}
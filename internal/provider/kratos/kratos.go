@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	kclient "github.com/ory/kratos-client-go"
 	logv2 "github.com/paralus/paralus/pkg/log"
@@ -22,6 +23,24 @@ type IdentityPublicMetadata struct {
 	Partner      string
 }
 
+// Session is an active login session of an identity
+type Session struct {
+	ID              string
+	IPAddress       string
+	UserAgent       string
+	Location        string
+	AAL             string
+	AuthenticatedAt time.Time
+	IssuedAt        time.Time
+	ExpiresAt       time.Time
+}
+
+// states of kratos identities
+const (
+	identityStateActive   = "active"
+	identityStateInactive = "inactive"
+)
+
 type kratosAuthProvider struct {
 	kc *kclient.APIClient
 }
@@ -36,6 +55,14 @@ type AuthProvider interface {
 	Delete(context.Context, string) error
 	// Get Public metadata of Kratos id.
 	GetPublicMetadata(context.Context, string) (*IdentityPublicMetadata, error)
+	// list active sessions of user
+	ListSessions(context.Context, string) ([]Session, error)
+	// revoke session by session id
+	RevokeSession(context.Context, string) error
+	// revoke all sessions of user
+	RevokeSessions(context.Context, string) error
+	// activate or deactivate user, inactive users cannot log in
+	SetActive(context.Context, string, bool) error
 }
 
 var _log = logv2.GetLogger()
@@ -63,13 +90,20 @@ func (k *kratosAuthProvider) Create(ctx context.Context, password string, traits
 }
 
 func (k *kratosAuthProvider) Update(ctx context.Context, id string, traits map[string]interface{}, metadata IdentityPublicMetadata) error {
-	uib := kclient.NewUpdateIdentityBody("default", "active", traits)
-
-	ipm, err := k.GetPublicMetadata(ctx, id)
+	identity, err := k.getIdentity(ctx, id)
 	if err != nil {
-		_log.Error("failed to get identity public metadata ", err)
+		_log.Error("failed to get identity ", err)
 		return err
 	}
+	// updates keep the state, users are only deactivated through
+	// SetActive
+	state := identity.GetState()
+	if state == "" {
+		state = identityStateActive
+	}
+	uib := kclient.NewUpdateIdentityBody("default", state, traits)
+
+	ipm := publicMetadata(identity)
 	ipm.ForceReset = metadata.ForceReset
 	uib.SetMetadataPublic(ipm)
 
@@ -97,7 +131,7 @@ func (k *kratosAuthProvider) Delete(ctx context.Context, id string) error {
 	return err
 }
 
-func (k *kratosAuthProvider) GetPublicMetadata(ctx context.Context, id string) (*IdentityPublicMetadata, error) {
+func (k *kratosAuthProvider) getIdentity(ctx context.Context, id string) (*kclient.Identity, error) {
 	identity, res, err := k.kc.IdentityAPI.GetIdentity(ctx, id).Execute()
 	if err != nil {
 		return nil, err
@@ -105,6 +139,10 @@ func (k *kratosAuthProvider) GetPublicMetadata(ctx context.Context, id string) (
 	if res.StatusCode != http.StatusOK {
 		return nil, errors.New("failed to get identity")
 	}
+	return identity, nil
+}
+
+func publicMetadata(identity *kclient.Identity) *IdentityPublicMetadata {
 	ipm := &IdentityPublicMetadata{}
 	if identity.HasMetadataPublic() {
 		meta := identity.GetMetadataPublic()
@@ -121,5 +159,70 @@ func (k *kratosAuthProvider) GetPublicMetadata(ctx context.Context, id string) (
 			}
 		}
 	}
-	return ipm, nil
+	return ipm
+}
+
+func (k *kratosAuthProvider) GetPublicMetadata(ctx context.Context, id string) (*IdentityPublicMetadata, error) {
+	identity, err := k.getIdentity(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return publicMetadata(identity), nil
+}
+
+func (k *kratosAuthProvider) ListSessions(ctx context.Context, id string) ([]Session, error) {
+	ks, _, err := k.kc.IdentityAPI.ListIdentitySessions(ctx, id).Active(true).Execute()
+	if err != nil {
+		return nil, err
+	}
+	sessions := make([]Session, 0, len(ks))
+	for _, s := range ks {
+		session := Session{
+			ID:              s.Id,
+			AuthenticatedAt: s.GetAuthenticatedAt(),
+			IssuedAt:        s.GetIssuedAt(),
+			ExpiresAt:       s.GetExpiresAt(),
+		}
+		if s.AuthenticatorAssuranceLevel != nil {
+			session.AAL = string(*s.AuthenticatorAssuranceLevel)
+		}
+		// the last device is the one the session was last
+		// refreshed from
+		if len(s.Devices) > 0 {
+			d := s.Devices[len(s.Devices)-1]
+			session.IPAddress = d.GetIpAddress()
+			session.UserAgent = d.GetUserAgent()
+			session.Location = d.GetLocation()
+		}
+		sessions = append(sessions, session)
+	}
+	return sessions, nil
+}
+
+func (k *kratosAuthProvider) RevokeSession(ctx context.Context, sessionID string) error {
+	_, err := k.kc.IdentityAPI.DisableSession(ctx, sessionID).Execute()
+	return err
+}
+
+func (k *kratosAuthProvider) RevokeSessions(ctx context.Context, id string) error {
+	hr, err := k.kc.IdentityAPI.DeleteIdentitySessions(ctx, id).Execute()
+	// kratos responds with not found if the identity has no sessions
+	if hr != nil && hr.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	return err
+}
+
+func (k *kratosAuthProvider) SetActive(ctx context.Context, id string, active bool) error {
+	state := identityStateInactive
+	if active {
+		state = identityStateActive
+	}
+	patch := kclient.NewJsonPatch("replace", "/state")
+	patch.SetValue(state)
+	_, hr, err := k.kc.IdentityAPI.PatchIdentity(ctx, id).JsonPatch([]kclient.JsonPatch{*patch}).Execute()
+	if err != nil {
+		_log.Error("failed to update identity state ", hr)
+	}
+	return err
 }
@@ -24,9 +24,12 @@ import (
 	"github.com/paralus/paralus/pkg/grpc"
 	"github.com/paralus/paralus/pkg/log"
 	"github.com/paralus/paralus/pkg/notify"
+	"github.com/paralus/paralus/pkg/ratelimit"
 	"github.com/paralus/paralus/pkg/reconcile"
+	"github.com/paralus/paralus/pkg/scim"
 	"github.com/paralus/paralus/pkg/sentry/peering"
 	"github.com/paralus/paralus/pkg/service"
+	"github.com/paralus/paralus/pkg/sso/saml"
 	auditrpc "github.com/paralus/paralus/proto/rpc/audit"
 	rolerpc "github.com/paralus/paralus/proto/rpc/role"
 	schedulerrpc "github.com/paralus/paralus/proto/rpc/scheduler"
@@ -86,9 +89,14 @@ const (
 	coreCDRelayConnectorHostEnv = "CORE_CD_RELAY_CONNECTOR_HOST"
 	schedulerNamespaceEnv       = "SCHEDULER_NAMESPACE"
 
+	// rate limit
+	rateLimitConfigEnv = "RATE_LIMIT_CONFIG"
+
 	// kratos
 	kratosAddrEnv       = "KRATOS_ADDR"
 	kratosPublicAddrEnv = "KRATOS_PUB_ADDR"
+	// secret sent by the kratos login webhook
+	kratosWebhookSecretEnv = "KRATOS_WEBHOOK_SECRET"
 )
 
 var (
@@ -130,11 +138,15 @@ var (
 	coreCDRelayConnectorHost string
 	sentryBootstrapAddr      string
 
+	// rate limit
+	rateLimitConfig string
+
 	// kratos
-	kratosAddr       string
-	kratosPublicAddr string
-	kc               *kclient.APIClient
-	akc              *kclient.APIClient
+	kratosAddr          string
+	kratosPublicAddr    string
+	kratosWebhookSecret string
+	kc                  *kclient.APIClient
+	akc                 *kclient.APIClient
 
 	// services
 	ps    service.PartnerService
@@ -153,10 +165,24 @@ var (
 	us    service.UserService
 	ks    service.ApiKeyService
 	gs    service.GroupService
+	sas   service.ServiceAccountService
+	tps   service.TrustPolicyService
+	sts   service.ScimTokenService
+	lcs   service.LdapConnectorService
+	ars   service.AccessRequestService
+	arvs  service.AccessReviewService
+	bgs   service.BreakGlassService
+	los   service.AccountLockoutService
 	rs    service.RoleService
+	dps   service.DenyPolicyService
+	cbs   service.ClusterBindingService
+	rbr   service.RoleBindingReconciler
 	rrs   service.RolepermissionService
 	is    service.IdpService
 	oidcs service.OIDCProviderService
+	cfbs  service.ConfigBundleService
+	samls *saml.SAMLService
+	scims *scim.Server
 	aus   service.AuditLogService
 	ras   service.RelayAuditService
 	rcs   service.AuditLogService
@@ -226,6 +252,9 @@ func setup() {
 
 	viper.BindEnv(kratosAddrEnv)
 	viper.BindEnv(kratosPublicAddrEnv)
+	viper.BindEnv(kratosWebhookSecretEnv)
+
+	viper.BindEnv(rateLimitConfigEnv)
 
 	viper.BindEnv(sentryPeeringHostEnv)
 	viper.BindEnv(coreRelayConnectorHostEnv)
@@ -258,6 +287,9 @@ func setup() {
 
 	kratosAddr = viper.GetString(kratosAddrEnv)
 	kratosPublicAddr = viper.GetString(kratosPublicAddrEnv)
+	kratosWebhookSecret = viper.GetString(kratosWebhookSecretEnv)
+
+	rateLimitConfig = viper.GetString(rateLimitConfigEnv)
 
 	bootstrapKEK = viper.GetString(bootstrapKEKEnv)
 	sentryPeeringHost = viper.GetString(sentryPeeringHostEnv)
@@ -345,12 +377,24 @@ func setup() {
 		cc.Profile = "prod"
 	}
 	ks = service.NewApiKeyService(db, auditLogger)
-	us = service.NewUserService(providers.NewKratosAuthProvider(akc), db, as, ks, cc, auditLogger, dev)
+	kap := providers.NewKratosAuthProvider(akc)
+	us = service.NewUserService(kap, db, as, ks, cc, auditLogger, dev)
 	gs = service.NewGroupService(db, as, auditLogger)
+	sas = service.NewServiceAccountService(db, as, ks, auditLogger)
+	tps = service.NewTrustPolicyService(db, auditLogger)
+	sts = service.NewScimTokenService(db, auditLogger)
+	lcs = service.NewLdapConnectorService(db, gs, auditLogger)
+	los = service.NewAccountLockoutService(db, auditLogger)
 	rs = service.NewRoleService(db, as, auditLogger)
+	dps = service.NewDenyPolicyService(db, as, auditLogger)
+	cbs = service.NewClusterBindingService(db, auditLogger)
+	rbr = service.NewRoleBindingReconciler(db, as, auditLogger)
 	rrs = service.NewRolepermissionService(db)
 	is = service.NewIdpService(db, apiAddr, auditLogger)
 	oidcs = service.NewOIDCProviderService(db, sentryBootstrapAddr, auditLogger)
+	cfbs = service.NewConfigBundleService(db, kap, as, sentryBootstrapAddr, auditLogger)
+	samls = saml.NewSAMLService(db, apiAddr, kap, auditLogger)
+	scims = scim.NewServer(db, kap, us, gs, sts)
 
 	//sentry related services
 	bs = service.NewBootstrapService(db)
@@ -359,6 +403,9 @@ func setup() {
 	ns = service.NewNamespaceService(db)
 	kcs = service.NewkubectlClusterSettingsService(db)
 	aps = service.NewAccountPermissionService(db)
+	ars = service.NewAccessRequestService(db, as, aps, auditLogger)
+	arvs = service.NewAccessReviewService(db, as, auditLogger)
+	bgs = service.NewBreakGlassService(db, auditLogger)
 	gps = service.NewGroupPermissionService(db)
 
 	switch auditLogStorage {
@@ -472,7 +519,7 @@ func run() {
 	_log.Infow("registered grpc health server")
 
 	var wg sync.WaitGroup
-	wg.Add(6)
+	wg.Add(10)
 
 	go runAPI(&wg, ctx)
 	go runRPC(&wg, ctx)
@@ -480,6 +527,10 @@ func run() {
 	go runDebug(&wg, ctx)
 	go runEventHandlers(&wg, ctx)
 	go runIdpGroupSync(&wg, ctx)
+	go runLdapSync(&wg, ctx)
+	go runRoleBindingReconciler(&wg, ctx)
+	go runLoginFailureRecorder(&wg, ctx)
+	go runApiKeyNoncePurger(&wg, ctx)
 
 	<-ctx.Done()
 	_log.Infow("shutting down, waiting for children to die")
@@ -508,16 +559,31 @@ func runAPI(wg *sync.WaitGroup, ctx context.Context) {
 		systemrpc.RegisterLocationServiceHandlerFromEndpoint,
 		userrpc.RegisterUserServiceHandlerFromEndpoint,
 		userrpc.RegisterGroupServiceHandlerFromEndpoint,
+		userrpc.RegisterServiceAccountServiceHandlerFromEndpoint,
+		userrpc.RegisterTrustPolicyServiceHandlerFromEndpoint,
+		userrpc.RegisterScimTokenServiceHandlerFromEndpoint,
+		userrpc.RegisterLdapConnectorServiceHandlerFromEndpoint,
+		userrpc.RegisterAccessRequestServiceHandlerFromEndpoint,
+		userrpc.RegisterAccessReviewServiceHandlerFromEndpoint,
+		userrpc.RegisterBreakGlassServiceHandlerFromEndpoint,
+		userrpc.RegisterAccountLockoutServiceHandlerFromEndpoint,
 		rolerpc.RegisterRoleServiceHandlerFromEndpoint,
+		rolerpc.RegisterDenyPolicyServiceHandlerFromEndpoint,
+		rolerpc.RegisterClusterBindingServiceHandlerFromEndpoint,
 		rolerpc.RegisterRolepermissionServiceHandlerFromEndpoint,
 		systemrpc.RegisterIdpServiceHandlerFromEndpoint,
 		systemrpc.RegisterOIDCProviderServiceHandlerFromEndpoint,
+		systemrpc.RegisterConfigBundleServiceHandlerFromEndpoint,
 		auditrpc.RegisterAuditLogServiceHandlerFromEndpoint,
 		auditrpc.RegisterRelayAuditServiceHandlerFromEndpoint,
+		authrpc.RegisterAuthServiceHandlerFromEndpoint,
 	)
 	if err != nil {
 		_log.Fatalw("unable to create gateway", "error", err)
 	}
+	// SAML bindings are plain http, they are served next to the gateway
+	mux.Handle("/auth/v3/sso/", samls.Handler())
+	mux.Handle("/scim/v2/", scims.Handler())
 	mux.Handle("/", gwHandler)
 
 	s := http.Server{
@@ -605,12 +671,23 @@ func runRPC(wg *sync.WaitGroup, ctx context.Context) {
 	crpc := server.NewClusterServer(cs, downloadData)
 	mserver := server.NewLocationServer(ms)
 
-	userServer := server.NewUserServer(us, ks)
+	userServer := server.NewUserServer(us, ks, kratosWebhookSecret)
 	groupServer := server.NewGroupServer(gs)
+	serviceAccountServer := server.NewServiceAccountServer(sas)
+	trustPolicyServer := server.NewTrustPolicyServer(tps)
+	scimTokenServer := server.NewScimTokenServer(sts)
+	ldapConnectorServer := server.NewLdapConnectorServer(lcs)
+	accessRequestServer := server.NewAccessRequestServer(ars)
+	accessReviewServer := server.NewAccessReviewServer(arvs)
+	breakGlassServer := server.NewBreakGlassServer(bgs)
+	accountLockoutServer := server.NewAccountLockoutServer(los)
 	roleServer := server.NewRoleServer(rs)
+	denyPolicyServer := server.NewDenyPolicyServer(dps)
+	clusterBindingServer := server.NewClusterBindingServer(cbs)
 	rolepermissionServer := server.NewRolePermissionServer(rrs)
 	idpServer := server.NewIdpServer(is)
 	oidcProviderServer := server.NewOIDCServer(oidcs)
+	configBundleServer := server.NewConfigBundleServer(cfbs)
 
 	// audit
 	auditLogServer, err := server.NewAuditLogServer(aus)
@@ -628,7 +705,7 @@ func runRPC(wg *sync.WaitGroup, ctx context.Context) {
 	}
 
 	var opts []_grpc.ServerOption
-	ac := authv3.NewAuthContext(db, kc, ks, as)
+	ac := authv3.NewAuthContext(db, kc, ks, as, tps, los, auditLogger)
 	asv := authv3.NewAuthService(ac)
 	o := authv3.Option{
 		ExcludeRPCMethods: []string{
@@ -641,11 +718,51 @@ func runRPC(wg *sync.WaitGroup, ctx context.Context) {
 		ExcludeAuthzMethods: []string{
 			"/paralus.dev.rpc.user.v3.UserService/GetUserInfo",
 			"/paralus.dev.rpc.user.v3.UserService/UpdateUserForceReset",
+			// access requests are authorized by the service, any
+			// user may request access and approvers are checked
+			// against the approval permission
+			"/paralus.dev.rpc.user.v3.AccessRequestService/CreateAccessRequest",
+			"/paralus.dev.rpc.user.v3.AccessRequestService/GetAccessRequests",
+			"/paralus.dev.rpc.user.v3.AccessRequestService/GetAccessRequest",
+			"/paralus.dev.rpc.user.v3.AccessRequestService/ApproveAccessRequest",
+			"/paralus.dev.rpc.user.v3.AccessRequestService/DenyAccessRequest",
+			// reviewers decide on the bindings assigned to them
+			// without the access review permissions
+			"/paralus.dev.rpc.user.v3.AccessReviewService/GetAccessReviewItems",
+			"/paralus.dev.rpc.user.v3.AccessReviewService/DecideAccessReviewItem",
+			// users of the organization break the glass without
+			// access to the cluster when the organization allows it
+			"/paralus.dev.rpc.user.v3.BreakGlassService/CreateBreakGlass",
 		},
 	}
-	opts = append(opts, _grpc.UnaryInterceptor(
-		ac.NewAuthUnaryInterceptor(o),
-	))
+	var unaryInterceptors []_grpc.UnaryServerInterceptor
+	var streamInterceptors []_grpc.StreamServerInterceptor
+	var limiter *ratelimit.Limiter
+	if rateLimitConfig != "" {
+		cfg, err := ratelimit.LoadConfig(rateLimitConfig)
+		if err != nil {
+			_log.Fatalw("unable to load rate limit config", "error", err)
+		}
+		store := ratelimit.NewMemoryStore()
+		if cfg.Store == ratelimit.StorePostgres {
+			store = ratelimit.NewPostgresStore(db)
+		}
+		limiter = ratelimit.NewLimiter(cfg, store)
+		// client addresses are limited before authentication, which
+		// is where brute force attempts fail
+		unaryInterceptors = append(unaryInterceptors, limiter.ClientUnaryServerInterceptor())
+		streamInterceptors = append(streamInterceptors, limiter.ClientStreamServerInterceptor())
+	}
+	unaryInterceptors = append(unaryInterceptors, ac.NewAuthUnaryInterceptor(o))
+	streamInterceptors = append(streamInterceptors, ac.NewAuthStreamInterceptor(o))
+	if limiter != nil {
+		unaryInterceptors = append(unaryInterceptors, limiter.UnaryServerInterceptor())
+		streamInterceptors = append(streamInterceptors, limiter.StreamServerInterceptor())
+	}
+	opts = append(opts,
+		_grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		_grpc.ChainStreamInterceptor(streamInterceptors...),
+	)
 	s, err := grpc.NewServer(opts...)
 	if err != nil {
 		_log.Fatalw("unable to create grpc server", "error", err)
@@ -675,10 +792,21 @@ func runRPC(wg *sync.WaitGroup, ctx context.Context) {
 	systemrpc.RegisterLocationServiceServer(s, mserver)
 	userrpc.RegisterUserServiceServer(s, userServer)
 	userrpc.RegisterGroupServiceServer(s, groupServer)
+	userrpc.RegisterServiceAccountServiceServer(s, serviceAccountServer)
+	userrpc.RegisterTrustPolicyServiceServer(s, trustPolicyServer)
+	userrpc.RegisterScimTokenServiceServer(s, scimTokenServer)
+	userrpc.RegisterLdapConnectorServiceServer(s, ldapConnectorServer)
+	userrpc.RegisterAccessRequestServiceServer(s, accessRequestServer)
+	userrpc.RegisterAccessReviewServiceServer(s, accessReviewServer)
+	userrpc.RegisterBreakGlassServiceServer(s, breakGlassServer)
+	userrpc.RegisterAccountLockoutServiceServer(s, accountLockoutServer)
 	rolerpc.RegisterRoleServiceServer(s, roleServer)
+	rolerpc.RegisterDenyPolicyServiceServer(s, denyPolicyServer)
+	rolerpc.RegisterClusterBindingServiceServer(s, clusterBindingServer)
 	rolerpc.RegisterRolepermissionServiceServer(s, rolepermissionServer)
 	systemrpc.RegisterIdpServiceServer(s, idpServer)
 	systemrpc.RegisterOIDCProviderServiceServer(s, oidcProviderServer)
+	systemrpc.RegisterConfigBundleServiceServer(s, configBundleServer)
 	auditrpc.RegisterAuditLogServiceServer(s, auditLogServer)
 	auditrpc.RegisterRelayAuditServiceServer(s, relayAuditServer)
 
@@ -753,6 +881,77 @@ listen:
 	<-ctx.Done()
 }
 
+func runLdapSync(wg *sync.WaitGroup, ctx context.Context) {
+	defer wg.Done()
+	// connectors are checked every minute, each is synchronized once
+	// its sync interval elapsed
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := lcs.SyncDue(ctx); err != nil {
+				_log.Warnw("unable to sync ldap connectors", "error", err)
+			}
+		}
+	}
+}
+
+func runRoleBindingReconciler(wg *sync.WaitGroup, ctx context.Context) {
+	defer wg.Done()
+	// bindings are checked every minute, authorization filters expired
+	// bindings until they are removed
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := rbr.Reconcile(ctx); err != nil {
+				_log.Warnw("unable to reconcile role bindings", "error", err)
+			}
+		}
+	}
+}
+
+func runLoginFailureRecorder(wg *sync.WaitGroup, ctx context.Context) {
+	defer wg.Done()
+	// failed logins are recorded shortly after kratos rejected them
+	ticker := time.NewTicker(5 * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := los.RecordLoginFailures(ctx); err != nil {
+				_log.Warnw("unable to record failed logins", "error", err)
+			}
+		}
+	}
+}
+
+func runApiKeyNoncePurger(wg *sync.WaitGroup, ctx context.Context) {
+	defer wg.Done()
+	// nonces only need to be remembered for as long as the timestamp
+	// of the request would be accepted
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := ks.PurgeNonces(ctx, 2*common.ApiKeyMaxClockSkew); err != nil {
+				_log.Warnw("unable to purge api key nonces", "error", err)
+			}
+		}
+	}
+}
+
 func main() {
 	setup()
 	run()
@@ -0,0 +1,7 @@
+ALTER TABLE authsrv_apikey
+    DROP COLUMN IF EXISTS expires_at,
+    DROP COLUMN IF EXISTS permissions,
+    DROP COLUMN IF EXISTS projects,
+    DROP COLUMN IF EXISTS previous_secret,
+    DROP COLUMN IF EXISTS previous_secret_expires_at,
+    DROP COLUMN IF EXISTS rotated_at;
@@ -0,0 +1,7 @@
+ALTER TABLE authsrv_apikey
+    ADD COLUMN IF NOT EXISTS expires_at timestamp WITH time zone,
+    ADD COLUMN IF NOT EXISTS permissions text[] NOT NULL default '{}',
+    ADD COLUMN IF NOT EXISTS projects text[] NOT NULL default '{}',
+    ADD COLUMN IF NOT EXISTS previous_secret text,
+    ADD COLUMN IF NOT EXISTS previous_secret_expires_at timestamp WITH time zone,
+    ADD COLUMN IF NOT EXISTS rotated_at timestamp WITH time zone;
@@ -0,0 +1,5 @@
+UPDATE authsrv_organization
+    SET settings = settings - 'legacyApiKeyChecksum'
+    WHERE jsonb_typeof(settings) = 'object';
+
+DROP TABLE IF EXISTS authsrv_apikey_nonce;
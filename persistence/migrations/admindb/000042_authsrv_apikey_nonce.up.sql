@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS authsrv_apikey_nonce (
+    key varchar NOT NULL,
+    nonce varchar NOT NULL,
+    created_at timestamp WITH time zone NOT NULL default now(),
+    PRIMARY KEY (key, nonce)
+);
+
+CREATE INDEX IF NOT EXISTS authsrv_apikey_nonce_created_at ON authsrv_apikey_nonce (created_at);
+
+-- existing organizations keep accepting the legacy api key checksum
+-- until they are migrated to signed requests
+UPDATE authsrv_organization
+    SET settings = jsonb_set(settings, '{legacyApiKeyChecksum}', 'true'::jsonb, true)
+    WHERE jsonb_typeof(settings) = 'object';
@@ -0,0 +1,4 @@
+ALTER TABLE authsrv_apikey
+    DROP COLUMN IF EXISTS is_service_account;
+
+DROP TABLE IF EXISTS authsrv_serviceaccount;
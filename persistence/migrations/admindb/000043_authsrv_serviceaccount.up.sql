@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS authsrv_serviceaccount (
+    id uuid default uuid_generate_v4() PRIMARY KEY,
+    name character varying(256) NOT NULL,
+    description character varying(512) NOT NULL,
+    created_at timestamp with time zone NOT NULL,
+    modified_at timestamp with time zone NOT NULL,
+    trash boolean NOT NULL default false,
+    organization_id uuid NOT NULL REFERENCES authsrv_organization(id) DEFERRABLE INITIALLY DEFERRED,
+    partner_id uuid NOT NULL REFERENCES authsrv_partner(id) DEFERRABLE INITIALLY DEFERRED
+);
+
+CREATE INDEX IF NOT EXISTS authsrv_serviceaccount_name ON authsrv_serviceaccount USING btree (name);
+
+CREATE INDEX IF NOT EXISTS authsrv_serviceaccount_organization_id ON authsrv_serviceaccount USING btree (organization_id);
+
+ALTER TABLE authsrv_apikey
+    ADD COLUMN IF NOT EXISTS is_service_account boolean NOT NULL default false;
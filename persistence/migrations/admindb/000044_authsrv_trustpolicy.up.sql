@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS authsrv_trustpolicy (
+    id uuid default uuid_generate_v4() PRIMARY KEY,
+    name character varying(256) NOT NULL,
+    description character varying(512) NOT NULL,
+    created_at timestamp with time zone NOT NULL,
+    modified_at timestamp with time zone NOT NULL,
+    trash boolean NOT NULL default false,
+    organization_id uuid NOT NULL REFERENCES authsrv_organization(id) DEFERRABLE INITIALLY DEFERRED,
+    partner_id uuid NOT NULL REFERENCES authsrv_partner(id) DEFERRABLE INITIALLY DEFERRED,
+    issuer character varying(512) NOT NULL,
+    jwks_url character varying(512),
+    jwks text,
+    audience character varying(512) NOT NULL,
+    claim_matchers jsonb,
+    service_account_id uuid NOT NULL REFERENCES authsrv_serviceaccount(id) DEFERRABLE INITIALLY DEFERRED
+);
+
+CREATE INDEX IF NOT EXISTS authsrv_trustpolicy_name ON authsrv_trustpolicy USING btree (name);
+
+CREATE INDEX IF NOT EXISTS authsrv_trustpolicy_issuer ON authsrv_trustpolicy USING btree (issuer);
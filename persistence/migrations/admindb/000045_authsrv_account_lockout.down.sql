@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS authsrv_session_activity;
+DROP TABLE IF EXISTS authsrv_account_lockout;
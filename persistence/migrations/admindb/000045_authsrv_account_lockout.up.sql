@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS authsrv_account_lockout (
+    account_id uuid PRIMARY KEY,
+    organization_id uuid NOT NULL REFERENCES authsrv_organization(id) DEFERRABLE INITIALLY DEFERRED,
+    failed_attempts integer NOT NULL default 0,
+    first_failed_at timestamp with time zone NOT NULL,
+    last_failed_at timestamp with time zone NOT NULL,
+    locked_at timestamp with time zone
+);
+
+CREATE INDEX IF NOT EXISTS authsrv_account_lockout_organization_id ON authsrv_account_lockout USING btree (organization_id);
+
+CREATE TABLE IF NOT EXISTS authsrv_session_activity (
+    session_id varchar PRIMARY KEY,
+    account_id uuid NOT NULL,
+    last_active_at timestamp with time zone NOT NULL default now(),
+    expires_at timestamp with time zone NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS authsrv_session_activity_expires_at ON authsrv_session_activity (expires_at);
@@ -0,0 +1 @@
+ALTER TABLE authsrv_resourcerole ADD COLUMN IF NOT EXISTS require_mfa boolean NOT NULL default false;
@@ -0,0 +1,8 @@
+CREATE UNLOGGED TABLE IF NOT EXISTS authsrv_ratelimit_bucket (
+    key varchar PRIMARY KEY,
+    tokens double precision NOT NULL,
+    allowed boolean NOT NULL default true,
+    updated_at timestamp with time zone NOT NULL default now()
+);
+
+CREATE INDEX IF NOT EXISTS authsrv_ratelimit_bucket_updated_at ON authsrv_ratelimit_bucket (updated_at);
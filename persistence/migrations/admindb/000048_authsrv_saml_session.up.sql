@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS authsrv_saml_session (
+    id uuid default uuid_generate_v4() PRIMARY KEY,
+    token_hash character varying(64) NOT NULL UNIQUE,
+    account_id uuid NOT NULL,
+    username character varying(256) NOT NULL,
+    idp_id uuid NOT NULL REFERENCES authsrv_idp(id) ON DELETE CASCADE,
+    organization_id uuid NOT NULL,
+    partner_id uuid NOT NULL,
+    ip_address character varying(64),
+    user_agent character varying(512),
+    aal character varying(8) NOT NULL,
+    authenticated_at timestamp with time zone NOT NULL,
+    created_at timestamp with time zone NOT NULL default now(),
+    expires_at timestamp with time zone NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS authsrv_saml_session_account_id ON authsrv_saml_session USING btree (account_id);
+
+CREATE INDEX IF NOT EXISTS authsrv_saml_session_expires_at ON authsrv_saml_session USING btree (expires_at);
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS authsrv_scim_token (
+    id uuid default uuid_generate_v4() PRIMARY KEY,
+    name character varying(256) NOT NULL,
+    description character varying(512) NOT NULL,
+    created_at timestamp with time zone NOT NULL,
+    modified_at timestamp with time zone NOT NULL,
+    trash boolean NOT NULL default false,
+    organization_id uuid NOT NULL REFERENCES authsrv_organization(id) DEFERRABLE INITIALLY DEFERRED,
+    partner_id uuid NOT NULL REFERENCES authsrv_partner(id) DEFERRABLE INITIALLY DEFERRED,
+    token_hash character varying(64) NOT NULL UNIQUE,
+    expires_at timestamp with time zone,
+    last_used_at timestamp with time zone
+);
+
+CREATE INDEX IF NOT EXISTS authsrv_scim_token_name ON authsrv_scim_token USING btree (name);
@@ -0,0 +1 @@
+ALTER TABLE authsrv_oidc_provider ADD COLUMN IF NOT EXISTS claim_mappings jsonb;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS authsrv_ldap_group;
+DROP TABLE IF EXISTS authsrv_ldap_connector;
@@ -0,0 +1,40 @@
+CREATE TABLE IF NOT EXISTS authsrv_ldap_connector (
+    id uuid default uuid_generate_v4() PRIMARY KEY,
+    name character varying(256) NOT NULL,
+    description character varying(512) NOT NULL,
+    created_at timestamp with time zone NOT NULL,
+    modified_at timestamp with time zone NOT NULL,
+    trash boolean NOT NULL default false,
+    organization_id uuid NOT NULL REFERENCES authsrv_organization(id) DEFERRABLE INITIALLY DEFERRED,
+    partner_id uuid NOT NULL REFERENCES authsrv_partner(id) DEFERRABLE INITIALLY DEFERRED,
+    url character varying(2048) NOT NULL,
+    bind_dn character varying(1024),
+    bind_password character varying(1024),
+    start_tls boolean NOT NULL default false,
+    insecure_skip_verify boolean NOT NULL default false,
+    group_search_bases text[],
+    group_filter character varying(1024),
+    group_name_attribute character varying(256),
+    group_id_attribute character varying(256),
+    member_attribute character varying(256),
+    user_email_attribute character varying(256),
+    nested_groups boolean NOT NULL default false,
+    sync_interval_minutes integer NOT NULL default 0,
+    last_sync_at timestamp with time zone,
+    last_sync_error text,
+    last_sync_diff jsonb
+);
+
+CREATE INDEX IF NOT EXISTS authsrv_ldap_connector_name ON authsrv_ldap_connector USING btree (name);
+
+CREATE TABLE IF NOT EXISTS authsrv_ldap_group (
+    id uuid default uuid_generate_v4() PRIMARY KEY,
+    connector_id uuid NOT NULL REFERENCES authsrv_ldap_connector(id) ON DELETE CASCADE,
+    external_id character varying(512) NOT NULL,
+    group_id uuid NOT NULL REFERENCES authsrv_group(id) DEFERRABLE INITIALLY DEFERRED,
+    dn character varying(2048) NOT NULL,
+    created_at timestamp with time zone NOT NULL default current_timestamp,
+    UNIQUE (connector_id, external_id)
+);
+
+CREATE INDEX IF NOT EXISTS authsrv_ldap_group_group_id ON authsrv_ldap_group USING btree (group_id);
@@ -0,0 +1 @@
+ALTER TABLE authsrv_resourcerole DROP COLUMN IF EXISTS kubernetes_rules;
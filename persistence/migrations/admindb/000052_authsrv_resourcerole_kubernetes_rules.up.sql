@@ -0,0 +1 @@
+ALTER TABLE authsrv_resourcerole ADD COLUMN IF NOT EXISTS kubernetes_rules jsonb;
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS authsrv_deny_policy;
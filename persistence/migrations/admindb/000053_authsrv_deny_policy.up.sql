@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS authsrv_deny_policy (
+    id uuid default uuid_generate_v4() PRIMARY KEY,
+    name character varying(256) NOT NULL,
+    description character varying(512) NOT NULL,
+    created_at timestamp with time zone NOT NULL,
+    modified_at timestamp with time zone NOT NULL,
+    trash boolean NOT NULL default false,
+    organization_id uuid NOT NULL REFERENCES authsrv_organization(id) DEFERRABLE INITIALLY DEFERRED,
+    partner_id uuid NOT NULL REFERENCES authsrv_partner(id) DEFERRABLE INITIALLY DEFERRED,
+    project_id uuid REFERENCES authsrv_project(id) DEFERRABLE INITIALLY DEFERRED,
+    namespace character varying(512),
+    users text[],
+    groups text[],
+    permissions text[] NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS authsrv_deny_policy_name ON authsrv_deny_policy USING btree (name);
+CREATE INDEX IF NOT EXISTS authsrv_deny_policy_organization_id ON authsrv_deny_policy USING btree (organization_id);
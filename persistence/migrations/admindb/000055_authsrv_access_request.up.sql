@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS authsrv_access_request (
+    id uuid default uuid_generate_v4() PRIMARY KEY,
+    name character varying(256) NOT NULL,
+    description character varying(512) NOT NULL,
+    created_at timestamp with time zone NOT NULL,
+    modified_at timestamp with time zone NOT NULL,
+    trash boolean NOT NULL default false,
+    organization_id uuid NOT NULL REFERENCES authsrv_organization(id) DEFERRABLE INITIALLY DEFERRED,
+    partner_id uuid NOT NULL REFERENCES authsrv_partner(id) DEFERRABLE INITIALLY DEFERRED,
+    requester_id uuid NOT NULL,
+    requester character varying(256) NOT NULL,
+    role character varying(256) NOT NULL,
+    project character varying(256) NOT NULL,
+    namespace character varying(256),
+    duration character varying(64) NOT NULL,
+    justification text NOT NULL,
+    state character varying(32) NOT NULL,
+    reviewer character varying(256),
+    reason text,
+    reviewed_at timestamp with time zone,
+    expires_at timestamp with time zone
+);
+
+CREATE INDEX IF NOT EXISTS authsrv_access_request_name ON authsrv_access_request USING btree (name);
+CREATE INDEX IF NOT EXISTS authsrv_access_request_requester ON authsrv_access_request USING btree (requester);
+CREATE INDEX IF NOT EXISTS authsrv_access_request_reviewer ON authsrv_access_request USING btree (reviewer);
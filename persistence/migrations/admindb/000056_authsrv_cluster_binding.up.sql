@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS authsrv_cluster_binding (
+    id uuid default uuid_generate_v4() PRIMARY KEY,
+    name character varying(256) NOT NULL,
+    description character varying(512) NOT NULL,
+    created_at timestamp with time zone NOT NULL,
+    modified_at timestamp with time zone NOT NULL,
+    trash boolean NOT NULL default false,
+    organization_id uuid NOT NULL REFERENCES authsrv_organization(id) DEFERRABLE INITIALLY DEFERRED,
+    partner_id uuid NOT NULL REFERENCES authsrv_partner(id) DEFERRABLE INITIALLY DEFERRED,
+    cluster_selector character varying(512) NOT NULL,
+    role_id uuid NOT NULL REFERENCES authsrv_resourcerole(id) DEFERRABLE INITIALLY DEFERRED,
+    users text[],
+    groups text[],
+    service_accounts text[]
+);
+
+CREATE INDEX IF NOT EXISTS authsrv_cluster_binding_name ON authsrv_cluster_binding USING btree (name);
+CREATE INDEX IF NOT EXISTS authsrv_cluster_binding_organization_id ON authsrv_cluster_binding USING btree (organization_id);
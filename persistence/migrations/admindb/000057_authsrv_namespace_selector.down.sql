@@ -0,0 +1,2 @@
+ALTER TABLE authsrv_projectaccountnamespacerole DROP COLUMN IF EXISTS namespace_selector;
+ALTER TABLE authsrv_projectgroupnamespacerole DROP COLUMN IF EXISTS namespace_selector;
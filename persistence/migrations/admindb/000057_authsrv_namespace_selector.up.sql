@@ -0,0 +1,2 @@
+ALTER TABLE authsrv_projectaccountnamespacerole ADD COLUMN IF NOT EXISTS namespace_selector character varying(512);
+ALTER TABLE authsrv_projectgroupnamespacerole ADD COLUMN IF NOT EXISTS namespace_selector character varying(512);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS authsrv_access_review_item;
+DROP TABLE IF EXISTS authsrv_access_review;
@@ -0,0 +1,48 @@
+CREATE TABLE IF NOT EXISTS authsrv_access_review (
+    id uuid default uuid_generate_v4() PRIMARY KEY,
+    name character varying(256) NOT NULL,
+    description character varying(512) NOT NULL,
+    created_at timestamp with time zone NOT NULL,
+    modified_at timestamp with time zone NOT NULL,
+    trash boolean NOT NULL default false,
+    organization_id uuid NOT NULL REFERENCES authsrv_organization(id) DEFERRABLE INITIALLY DEFERRED,
+    partner_id uuid NOT NULL REFERENCES authsrv_partner(id) DEFERRABLE INITIALLY DEFERRED,
+    projects text[],
+    roles text[],
+    group_owners jsonb,
+    default_reviewer character varying(256) NOT NULL,
+    revoke_pending boolean NOT NULL default false,
+    state character varying(32) NOT NULL,
+    started_by character varying(256) NOT NULL,
+    closed_by character varying(256),
+    closed_at timestamp with time zone
+);
+
+CREATE INDEX IF NOT EXISTS authsrv_access_review_name ON authsrv_access_review USING btree (name);
+CREATE INDEX IF NOT EXISTS authsrv_access_review_organization_id ON authsrv_access_review USING btree (organization_id);
+
+CREATE TABLE IF NOT EXISTS authsrv_access_review_item (
+    id uuid default uuid_generate_v4() PRIMARY KEY,
+    review_id uuid NOT NULL REFERENCES authsrv_access_review(id) ON DELETE CASCADE,
+    created_at timestamp with time zone NOT NULL,
+    modified_at timestamp with time zone NOT NULL,
+    binding_id uuid NOT NULL,
+    binding_table character varying(64) NOT NULL,
+    subject_type character varying(32) NOT NULL,
+    subject character varying(256) NOT NULL,
+    role character varying(256) NOT NULL,
+    scope character varying(32) NOT NULL,
+    project character varying(256),
+    namespace character varying(256),
+    namespace_selector character varying(512),
+    reviewer character varying(256) NOT NULL,
+    decision character varying(32) NOT NULL,
+    reason text,
+    decided_by character varying(256),
+    decided_at timestamp with time zone,
+    result character varying(32),
+    applied_at timestamp with time zone
+);
+
+CREATE INDEX IF NOT EXISTS authsrv_access_review_item_review_id ON authsrv_access_review_item USING btree (review_id);
+CREATE INDEX IF NOT EXISTS authsrv_access_review_item_reviewer ON authsrv_access_review_item USING btree (reviewer);
@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS authsrv_break_glass (
+    id uuid default uuid_generate_v4() PRIMARY KEY,
+    name character varying(256) NOT NULL,
+    description character varying(512) NOT NULL,
+    created_at timestamp with time zone NOT NULL,
+    modified_at timestamp with time zone NOT NULL,
+    trash boolean NOT NULL default false,
+    organization_id uuid NOT NULL REFERENCES authsrv_organization(id) DEFERRABLE INITIALLY DEFERRED,
+    partner_id uuid NOT NULL REFERENCES authsrv_partner(id) DEFERRABLE INITIALLY DEFERRED,
+    account_id uuid NOT NULL,
+    username character varying(256) NOT NULL,
+    cluster_id uuid NOT NULL,
+    cluster character varying(256) NOT NULL,
+    role_id uuid NOT NULL REFERENCES authsrv_resourcerole(id) DEFERRABLE INITIALLY DEFERRED,
+    role character varying(256) NOT NULL,
+    justification text NOT NULL,
+    incident character varying(256) NOT NULL,
+    starts_at timestamp with time zone NOT NULL,
+    expires_at timestamp with time zone NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS authsrv_break_glass_name ON authsrv_break_glass USING btree (name);
+CREATE INDEX IF NOT EXISTS authsrv_break_glass_account_id ON authsrv_break_glass USING btree (account_id);
+CREATE INDEX IF NOT EXISTS authsrv_break_glass_organization_id ON authsrv_break_glass USING btree (organization_id);
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS trigger_login_flows_update ON selfservice_login_flows;
+DROP FUNCTION IF EXISTS login_flows_after_update() CASCADE;
+DROP TABLE IF EXISTS authsrv_login_failure;
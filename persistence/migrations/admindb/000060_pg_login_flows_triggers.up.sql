@@ -0,0 +1,36 @@
+CREATE TABLE IF NOT EXISTS authsrv_login_failure (
+    id uuid PRIMARY KEY default uuid_generate_v4(),
+    identifier varchar NOT NULL,
+    failed_at timestamp with time zone NOT NULL default now()
+);
+
+-- kratos updates the login flow of a failed password login with the
+-- invalid credentials message and the submitted identifier, the
+-- failures are counted towards the lockout of the account by paralus
+DROP FUNCTION IF EXISTS login_flows_after_update() CASCADE;
+CREATE FUNCTION login_flows_after_update() RETURNS TRIGGER AS $$
+  DECLARE
+  login_identifier TEXT;
+
+  BEGIN
+  IF NOT COALESCE((NEW.ui::jsonb -> 'messages') @> '[{"id": 4000006}]', FALSE) THEN
+    RETURN NULL;
+  END IF;
+
+  SELECT node -> 'attributes' ->> 'value' INTO login_identifier
+    FROM jsonb_array_elements(NEW.ui::jsonb -> 'nodes') AS node
+    WHERE node -> 'attributes' ->> 'name' = 'identifier'
+    LIMIT 1;
+  IF login_identifier IS NOT NULL AND login_identifier <> '' THEN
+    INSERT INTO authsrv_login_failure (identifier) VALUES (login_identifier);
+  END IF;
+  RETURN NULL;
+  END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trigger_login_flows_update ON selfservice_login_flows;
+CREATE TRIGGER trigger_login_flows_update
+  AFTER UPDATE
+  ON selfservice_login_flows
+  FOR EACH ROW
+  EXECUTE PROCEDURE login_flows_after_update();
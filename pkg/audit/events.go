@@ -242,9 +242,13 @@ func GetActorFromSessionData(sd *commonv3.SessionData) *EventActor {
 		Username: username,
 	}
 	groups := sd.Groups
+	actorType := "USER"
+	if sd.GetIsServiceAccount() {
+		actorType = "SERVICE_ACCOUNT"
+	}
 
 	return &EventActor{
-		Type:    "USER",
+		Type:    actorType,
 		Account: account,
 		Groups:  groups,
 	}
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"fmt"
 	"os"
+	"time"
 
 	kclient "github.com/ory/kratos-client-go"
 	"github.com/paralus/paralus/pkg/enforcer"
@@ -34,13 +35,26 @@ type Option struct {
 	// ExcludeAuthzMethods is a list of RPC method strings which only
 	// do authentication and not authorization.
 	ExcludeAuthzMethods []string
+
+	// StreamRevalidateInterval is how often the credentials of open
+	// streams are checked again, streams are closed once the session
+	// or api key they were opened with is revoked. Defaults to
+	// DefaultStreamRevalidateInterval.
+	StreamRevalidateInterval time.Duration
 }
 
+// DefaultStreamRevalidateInterval is used when no stream revalidation
+// interval is set
+const DefaultStreamRevalidateInterval = time.Minute
+
 type authContext struct {
-	db *bun.DB
-	kc *kclient.APIClient
-	ks service.ApiKeyService
-	as service.AuthzService
+	db  *bun.DB
+	kc  *kclient.APIClient
+	ks  service.ApiKeyService
+	as  service.AuthzService
+	tps service.TrustPolicyService
+	lo  service.AccountLockoutService
+	al  *zap.Logger
 }
 
 // SetupAuthContext sets up new authContext along with its
@@ -79,7 +93,15 @@ func SetupAuthContext(auditLogger *zap.Logger) authContext {
 	}
 	as := service.NewAuthzService(db, enforcer)
 
-	return authContext{db: db, kc: kc, as: as, ks: service.NewApiKeyService(db, auditLogger)}
+	return authContext{
+		db:  db,
+		kc:  kc,
+		as:  as,
+		ks:  service.NewApiKeyService(db, auditLogger),
+		tps: service.NewTrustPolicyService(db, auditLogger),
+		lo:  service.NewAccountLockoutService(db, auditLogger),
+		al:  auditLogger,
+	}
 }
 
 func getDSN() string {
@@ -111,11 +133,17 @@ func NewAuthContext(
 	kc *kclient.APIClient,
 	apiKeySvc service.ApiKeyService,
 	authzSvc service.AuthzService,
+	trustPolicySvc service.TrustPolicyService,
+	lockoutSvc service.AccountLockoutService,
+	auditLogger *zap.Logger,
 ) authContext {
 	return authContext{
-		db: db,
-		kc: kc,
-		ks: apiKeySvc,
-		as: authzSvc,
+		db:  db,
+		kc:  kc,
+		ks:  apiKeySvc,
+		as:  authzSvc,
+		tps: trustPolicySvc,
+		lo:  lockoutSvc,
+		al:  auditLogger,
 	}
 }
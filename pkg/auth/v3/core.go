@@ -2,13 +2,24 @@ package authv3
 
 import (
 	"context"
+	"crypto/hmac"
 	"crypto/md5"
+	"crypto/sha256"
+	"database/sql"
 	"encoding/base64"
+	"encoding/hex"
 	"errors"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/paralus/paralus/internal/dao"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/paralus/paralus/pkg/common"
+	"github.com/paralus/paralus/pkg/crypto"
+	"github.com/paralus/paralus/pkg/service"
+	samlsso "github.com/paralus/paralus/pkg/sso/saml"
 	rpcv3 "github.com/paralus/paralus/proto/rpc/user"
 	authzv1 "github.com/paralus/paralus/proto/types/authz"
 	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
@@ -19,6 +30,11 @@ var (
 	ErrInvalidAPIKey = errors.New("invalid api key")
 	// ErrInvalidSignature is returns when signature is invalid
 	ErrInvalidSignature = errors.New("invalid signature")
+	// ErrExpiredAPIKey is returned when api key has expired
+	ErrExpiredAPIKey = errors.New("api key expired")
+	// ErrRotatedAPIKey is returned when the api key secret has been
+	// rotated and its grace period ended
+	ErrRotatedAPIKey = errors.New("api key secret rotated")
 )
 
 func (ac *authContext) IsRequestAllowed(ctx context.Context, req *commonv3.IsRequestAllowedRequest) (*commonv3.IsRequestAllowedResponse, error) {
@@ -65,10 +81,24 @@ func (ac *authContext) authenticate(ctx context.Context, req *commonv3.IsRequest
 		})
 		if err != nil {
 			_log.Infow("unable to get api key", "key", req.XApiKey, "error", err)
+			service.RejectApiKeyAuditEvent(ac.al, &commonv3.SessionData{}, req.XApiKey, ErrInvalidAPIKey.Error())
 			return false, ErrInvalidAPIKey
 		}
-		if !(req.XApiToken == getTokenCheckSum([]byte(resp.Secret))) {
-			return false, ErrInvalidSignature
+		if ok, err := ac.checkApiKey(ctx, resp, res); !ok {
+			return false, err
+		}
+		secret, reason, err := ac.verifyApiKeyRequest(ctx, req, resp)
+		if err != nil {
+			return false, err
+		}
+		if reason != "" {
+			service.RejectApiKeyAuditEvent(ac.al, apiKeySessionData(resp), req.XApiKey, reason)
+			if _, err := ac.lo.RecordFailure(ctx, resp.AccountID, resp.OrganizationID, resp.Name); err != nil {
+				_log.Warnw("unable to record failed api key attempt", "key", req.XApiKey, "error", err)
+			}
+			res.Status = commonv3.RequestStatus_RequestNotAuthenticated
+			res.Reason = reason
+			return false, nil
 		}
 		_log.Info("successfully validated api key ", req.XApiKey)
 		res.Status = commonv3.RequestStatus_RequestAllowed
@@ -76,6 +106,28 @@ func (ac *authContext) authenticate(ctx context.Context, req *commonv3.IsRequest
 		res.SessionData.Account = resp.AccountID.String()
 		res.SessionData.Organization = resp.OrganizationID.String()
 		res.SessionData.Partner = resp.PartnerID.String()
+		res.SessionData.IsServiceAccount = resp.IsServiceAccount
+		res.SessionData.AuthType = commonv3.AuthType_APIKey
+		res.SessionData.ApiKeySecretDigest = apiKeySecretDigest(secret)
+	} else if len(req.BearerToken) > 0 && len(req.XSessionToken) == 0 {
+		sa, err := ac.tps.Authenticate(ctx, req.BearerToken)
+		if err == service.ErrUntrustedToken {
+			res.Status = commonv3.RequestStatus_RequestNotAuthenticated
+			res.Reason = err.Error()
+			return false, nil
+		} else if err != nil {
+			return false, err
+		}
+		_log.Info("successfully validated workload identity token for ", sa.Name)
+		res.Status = commonv3.RequestStatus_RequestAllowed
+		res.SessionData.Username = sa.Name
+		res.SessionData.Account = sa.ID.String()
+		res.SessionData.Organization = sa.OrganizationId.String()
+		res.SessionData.Partner = sa.PartnerId.String()
+		res.SessionData.IsServiceAccount = true
+		res.SessionData.AuthType = commonv3.AuthType_WorkloadIdentity
+	} else if token := samlsso.SessionToken(req.GetCookie()); len(token) > 0 && len(req.XSessionToken) == 0 {
+		return ac.authenticateSAML(ctx, token, res)
 	} else {
 		tsr := ac.kc.FrontendAPI.ToSession(ctx).XSessionToken(req.GetXSessionToken()).Cookie(req.GetCookie())
 		session, _, err := ac.kc.FrontendAPI.ToSessionExecute(tsr)
@@ -92,6 +144,8 @@ func (ac *authContext) authenticate(ctx context.Context, req *commonv3.IsRequest
 		if session.GetActive() {
 			res.Status = commonv3.RequestStatus_RequestAllowed
 			res.SessionData.Account = session.Identity.GetId()
+			res.SessionData.AuthType = commonv3.AuthType_SessionLogin
+			setAssuranceLevel(res.SessionData, session)
 			if session.Identity.HasMetadataPublic() {
 				m := session.Identity.MetadataPublic.(map[string]interface{})
 				if org, ok := m["Organization"].(string); ok {
@@ -110,17 +164,9 @@ func (ac *authContext) authenticate(ctx context.Context, req *commonv3.IsRequest
 				res.Reason = "unable to find identity"
 				return false, err
 			}
-			groups, err := dao.GetGroups(ctx, ac.db, uid)
-			if err != nil {
-				res.Status = commonv3.RequestStatus_RequestNotAuthenticated
-				res.Reason = "unable to find identity"
+			if ok, err := ac.checkSessionAccount(ctx, res, session.GetId(), uid, session.GetExpiresAt()); !ok {
 				return false, err
 			}
-			groupNames := []string{}
-			for _, g := range groups {
-				groupNames = append(groupNames, g.Name)
-			}
-			res.SessionData.Groups = groupNames
 		} else {
 			res.Status = commonv3.RequestStatus_RequestNotAuthenticated
 			res.Reason = "no active session"
@@ -129,6 +175,244 @@ func (ac *authContext) authenticate(ctx context.Context, req *commonv3.IsRequest
 	return true, nil
 }
 
+// checkSessionAccount completes the session data of a login session
+// with the groups of the account. Sessions of locked accounts and idle
+// sessions are refused.
+func (ac *authContext) checkSessionAccount(ctx context.Context, res *commonv3.IsRequestAllowedResponse, sessionID string, uid uuid.UUID, expiresAt time.Time) (bool, error) {
+	groups, err := dao.GetGroups(ctx, ac.db, uid)
+	if err != nil {
+		res.Status = commonv3.RequestStatus_RequestNotAuthenticated
+		res.Reason = "unable to find identity"
+		return false, err
+	}
+	groupNames := []string{}
+	for _, g := range groups {
+		groupNames = append(groupNames, g.Name)
+	}
+	res.SessionData.Groups = groupNames
+
+	locked, err := ac.lo.IsLocked(ctx, uid)
+	if err != nil {
+		return false, err
+	}
+	if locked {
+		res.Status = commonv3.RequestStatus_RequestNotAuthenticated
+		res.Reason = service.ErrAccountLocked.Error()
+		return false, nil
+	}
+	if orgID, err := uuid.Parse(res.SessionData.Organization); err == nil {
+		idle, err := ac.lo.IsSessionIdle(ctx, sessionID, uid, orgID, expiresAt)
+		if err != nil {
+			return false, err
+		}
+		if idle {
+			res.Status = commonv3.RequestStatus_RequestNotAuthenticated
+			res.Reason = "session idle timeout"
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// checkApiKey refuses requests with api keys which expired or whose
+// account is locked
+func (ac *authContext) checkApiKey(ctx context.Context, key *models.ApiKey, res *commonv3.IsRequestAllowedResponse) (bool, error) {
+	var reason string
+	if !key.ExpiresAt.IsZero() && key.ExpiresAt.Before(time.Now()) {
+		reason = ErrExpiredAPIKey.Error()
+	} else {
+		locked, err := ac.lo.IsLocked(ctx, key.AccountID)
+		if err != nil {
+			return false, err
+		}
+		if locked {
+			reason = service.ErrAccountLocked.Error()
+		}
+	}
+	if reason != "" {
+		service.RejectApiKeyAuditEvent(ac.al, apiKeySessionData(key), key.Key, reason)
+		res.Status = commonv3.RequestStatus_RequestNotAuthenticated
+		res.Reason = reason
+		return false, nil
+	}
+	return true, nil
+}
+
+// verifyApiKeyRequest checks the credentials of an api key request.
+// Signed requests are always accepted, the static checksum only if
+// the organization of the key still allows it. The secret the request
+// was made with is returned, a non empty reason for requests which are
+// to be rejected.
+func (ac *authContext) verifyApiKeyRequest(ctx context.Context, req *commonv3.IsRequestAllowedRequest, key *models.ApiKey) (secret string, reason string, err error) {
+	var match func(secret string) bool
+	if len(req.XApiSignature) > 0 {
+		ts, err := strconv.ParseInt(req.XApiTimestamp, 10, 64)
+		if err != nil {
+			return "", "invalid request timestamp", nil
+		}
+		if skew := time.Since(time.Unix(ts, 0)); skew > common.ApiKeyMaxClockSkew || skew < -common.ApiKeyMaxClockSkew {
+			return "", "request timestamp outside of allowed clock skew", nil
+		}
+		if len(req.XApiNonce) == 0 {
+			return "", "missing request nonce", nil
+		}
+		path := req.Path
+		if path == "" {
+			path = req.Url
+		}
+		match = func(secret string) bool {
+			sig := crypto.SignRequest(secret, req.Method, path, req.Query, req.BodyDigest, req.XApiTimestamp, req.XApiNonce)
+			return hmac.Equal([]byte(req.XApiSignature), []byte(sig))
+		}
+	} else {
+		scheme, err := ac.ks.AuthScheme(ctx, key)
+		if err != nil {
+			return "", "", err
+		}
+		if scheme != common.ApiKeyAuthSchemeLegacy {
+			return "", "legacy api key checksum disabled for organization", nil
+		}
+		match = func(secret string) bool {
+			return req.XApiToken == getTokenCheckSum([]byte(secret))
+		}
+	}
+
+	secret = key.Secret
+	if !match(key.Secret) {
+		// the previous secret stays valid for the grace period
+		// after a rotation
+		if key.PreviousSecret == "" || !match(key.PreviousSecret) {
+			return "", ErrInvalidSignature.Error(), nil
+		}
+		if key.PreviousSecretExpiresAt.Before(time.Now()) {
+			return "", ErrRotatedAPIKey.Error(), nil
+		}
+		secret = key.PreviousSecret
+	}
+
+	if len(req.XApiSignature) > 0 {
+		fresh, err := ac.ks.UseNonce(ctx, key.Key, req.XApiNonce)
+		if err != nil {
+			return "", "", err
+		}
+		if !fresh {
+			return "", "request replayed", nil
+		}
+	}
+	return secret, "", nil
+}
+
+// apiKeySecretDigest returns the digest of an api key secret kept in
+// the session data of api key requests
+func apiKeySecretDigest(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// apiKeySecretValid checks if the secret an api key request was made
+// with is still a secret of key, the previous secret is until its
+// grace period ends
+func apiKeySecretValid(key *models.ApiKey, digest string) bool {
+	if digest == apiKeySecretDigest(key.Secret) {
+		return true
+	}
+	return key.PreviousSecret != "" && digest == apiKeySecretDigest(key.PreviousSecret) &&
+		!key.PreviousSecretExpiresAt.Before(time.Now())
+}
+
+// revalidate checks if the credentials a long lived request was made
+// with are still valid and if the request is still allowed. Request
+// signatures are not checked again as their nonce can only be used
+// once, api key requests end once the secret they were signed with is
+// no longer valid.
+func (ac *authContext) revalidate(ctx context.Context, req *commonv3.IsRequestAllowedRequest, sd *commonv3.SessionData) (*commonv3.IsRequestAllowedResponse, error) {
+	res := &commonv3.IsRequestAllowedResponse{
+		Status:      commonv3.RequestStatus_RequestNotAuthenticated,
+		SessionData: sd,
+	}
+	switch sd.AuthType {
+	case commonv3.AuthType_APIKey:
+		key, err := ac.ks.GetByKey(ctx, &rpcv3.ApiKeyRequest{Id: req.XApiKey})
+		if err != nil {
+			res.Reason = ErrInvalidAPIKey.Error()
+			return res, nil
+		}
+		if ok, err := ac.checkApiKey(ctx, key, res); !ok {
+			return res, err
+		}
+		if !apiKeySecretValid(key, sd.ApiKeySecretDigest) {
+			service.RejectApiKeyAuditEvent(ac.al, sd, req.XApiKey, ErrRotatedAPIKey.Error())
+			res.Reason = ErrRotatedAPIKey.Error()
+			return res, nil
+		}
+	case commonv3.AuthType_WorkloadIdentity:
+		_, err := ac.tps.Authenticate(ctx, req.BearerToken)
+		if err == service.ErrUntrustedToken {
+			res.Reason = err.Error()
+			return res, nil
+		} else if err != nil {
+			return nil, err
+		}
+	case commonv3.AuthType_SAMLLogin:
+		token := samlsso.SessionToken(req.GetCookie())
+		if len(token) == 0 {
+			res.Reason = "no or invalid credentials"
+			return res, nil
+		}
+		if _, err := samlsso.GetSession(ctx, ac.db, token); err == sql.ErrNoRows {
+			res.Reason = "no active session"
+			return res, nil
+		} else if err != nil {
+			return nil, err
+		}
+	default:
+		tsr := ac.kc.FrontendAPI.ToSession(ctx).XSessionToken(req.GetXSessionToken()).Cookie(req.GetCookie())
+		session, _, err := ac.kc.FrontendAPI.ToSessionExecute(tsr)
+		if err != nil {
+			if strings.Contains(err.Error(), "401 Unauthorized") {
+				res.Reason = "no or invalid credentials"
+				return res, nil
+			}
+			return nil, err
+		}
+		if !session.GetActive() {
+			res.Reason = "no active session"
+			return res, nil
+		}
+	}
+
+	// accounts of api keys are checked with the key
+	if accountID, err := uuid.Parse(sd.Account); err == nil && sd.AuthType != commonv3.AuthType_APIKey {
+		locked, err := ac.lo.IsLocked(ctx, accountID)
+		if err != nil {
+			return nil, err
+		}
+		if locked {
+			res.Reason = service.ErrAccountLocked.Error()
+			return res, nil
+		}
+	}
+
+	res.Status = commonv3.RequestStatus_RequestAllowed
+	if req.NoAuthz {
+		return res, nil
+	}
+	if err := ac.authorize(ctx, req, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func apiKeySessionData(key *models.ApiKey) *commonv3.SessionData {
+	return &commonv3.SessionData{
+		Username:         key.Name,
+		Account:          key.AccountID.String(),
+		Organization:     key.OrganizationID.String(),
+		Partner:          key.PartnerID.String(),
+		IsServiceAccount: key.IsServiceAccount,
+	}
+}
+
 // authorize performs authorization of the request
 func (ac *authContext) authorize(ctx context.Context, req *commonv3.IsRequestAllowedRequest, res *commonv3.IsRequestAllowedResponse) error {
 	// user,namespace,project,org,url(perm),method
@@ -141,8 +425,12 @@ func (ac *authContext) authorize(ctx context.Context, req *commonv3.IsRequestAll
 	if org == "" {
 		org = "*"
 	}
+	sub := "u:" + res.SessionData.Username
+	if res.SessionData.IsServiceAccount {
+		sub = service.ServiceAccountSubject(res.SessionData.Account)
+	}
 	er := authzv1.EnforceRequest{
-		Params: []string{"u:" + res.SessionData.Username, "*", proj, org, req.Url, req.Method},
+		Params: []string{sub, "*", proj, org, req.Url, req.Method},
 	}
 	authenticated, err := ac.as.Enforce(ctx, &er)
 	if err != nil {
@@ -154,6 +442,39 @@ func (ac *authContext) authorize(ctx context.Context, req *commonv3.IsRequestAll
 		return nil
 	}
 
+	// api keys may be further restricted to a subset of the
+	// permissions and projects of their owner
+	if len(req.XApiKey) > 0 && len(req.XSessionToken) == 0 {
+		key, err := ac.ks.GetByKey(ctx, &rpcv3.ApiKeyRequest{Id: req.XApiKey})
+		if err != nil {
+			return err
+		}
+		ok, err := ac.ks.InScope(ctx, key, req.Project, req.Url, req.Method)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			service.RejectApiKeyAuditEvent(ac.al, res.SessionData, req.XApiKey, "request outside of api key scope")
+			res.Status = commonv3.RequestStatus_RequestMethodOrURLNotAllowed
+			res.Reason = "request outside of api key scope"
+			return nil
+		}
+	}
+
+	// sessions below aal2 have to step up for requests the mfa
+	// policy applies to
+	if service.IsConsoleSession(res.SessionData) && !service.HasAAL2(res.SessionData) {
+		stepUp, err := ac.requiresStepUp(ctx, req, &er, res.SessionData)
+		if err != nil {
+			return err
+		}
+		if stepUp {
+			res.Status = commonv3.RequestStatus_RequestStepUpRequired
+			res.Reason = service.ErrStepUpRequired.Error()
+			return nil
+		}
+	}
+
 	// the following would already be set in auth, but just in case
 	res.Status = commonv3.RequestStatus_RequestAllowed
 	return nil
@@ -0,0 +1,62 @@
+package authv3
+
+import (
+	"context"
+
+	"github.com/paralus/paralus/pkg/service"
+	authzv1 "github.com/paralus/paralus/proto/types/authz"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ExplainRequest builds the same casbin request as authorize for the
+// given user and reports how the decision for it was made
+func (ac *authContext) ExplainRequest(ctx context.Context, req *commonv3.ExplainRequestRequest) (*commonv3.ExplainRequestResponse, error) {
+	if req.Username == "" || req.Url == "" || req.Method == "" {
+		return nil, status.Error(codes.InvalidArgument, "username, url and method are required")
+	}
+	ns := req.Namespace
+	if ns == "" {
+		ns = "*"
+	}
+	proj := req.Project
+	if proj == "" {
+		proj = "*"
+	}
+	org := req.GetMetadata().GetOrganization()
+	if org == "" {
+		org = "*"
+	}
+	sub := "u:" + req.Username
+	if req.ServiceAccount {
+		var err error
+		sub, err = service.ServiceAccountSubjectByName(ctx, ac.db, req.GetMetadata().GetPartner(), req.GetMetadata().GetOrganization(), req.Username)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	return ac.as.Explain(ctx, &authzv1.EnforceRequest{
+		Params: []string{sub, ns, proj, org, req.Url, req.Method},
+	})
+}
+
+// EffectiveAccess resolves the permissions of a user, service account,
+// group or api key in the organization of the request
+func (ac *authContext) EffectiveAccess(ctx context.Context, req *commonv3.EffectiveAccessRequest) (*commonv3.EffectiveAccessResponse, error) {
+	resp, err := service.EffectiveAccess(ctx, ac.db, req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return resp, nil
+}
+
+// ListPermissionHolders lists everyone holding a permission in a
+// project of the organization of the request
+func (ac *authContext) ListPermissionHolders(ctx context.Context, req *commonv3.PermissionHoldersRequest) (*commonv3.PermissionHoldersResponse, error) {
+	resp, err := service.PermissionHolders(ctx, ac.db, req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return resp, nil
+}
@@ -18,6 +18,160 @@ type hasMetadata interface {
 	GetMetadata() *commonv3.Metadata
 }
 
+// bearerToken returns the token of a bearer Authorization header
+func bearerToken(header string) string {
+	if len(header) > 7 && strings.EqualFold(header[:7], "bearer ") {
+		return strings.TrimSpace(header[7:])
+	}
+	return ""
+}
+
+// requestScope returns the organization and project of the resource
+// a request is made for
+func requestScope(fullMethod string, req interface{}) (string, string) {
+	// We have to get the value of org and project (namespace in
+	// future) as we will be using this inorder to authorize the
+	// user's access to different resources
+	var org string
+	var project string
+	resource, ok := req.(hasMetadata)
+	if ok {
+		meta := resource.GetMetadata()
+		if meta != nil {
+			org = meta.Organization
+			project = meta.Project
+		}
+
+		// Overrides for picking up info when not in default
+		// metadata locations
+		// XXX: This requires any new items which does not follow
+		// metadata convention to be added here
+		switch strings.Split(fullMethod, "/")[1] {
+		case "paralus.dev.rpc.v3.Project":
+			project = meta.Name
+		case "paralus.dev.rpc.v3.Organization":
+			org = meta.Name
+		}
+	}
+	return org, project
+}
+
+// clientInfo is the client of a request as seen by the gateway
+type clientInfo struct {
+	host string
+	ua   string
+	ip   string
+}
+
+// newRequest builds the auth request from the metadata forwarded by
+// the gateway
+func newRequest(ctx context.Context) (*commonv3.IsRequestAllowedRequest, clientInfo, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, clientInfo{}, status.Error(codes.InvalidArgument, "grpc metadata not exist")
+	}
+	var (
+		url    string
+		query  string
+		method string
+		token  string
+		apiKey string
+		apiTkn string
+		apiTs  string
+		nonce  string
+		sig    string
+		digest string
+		bearer string
+		cookie string
+		client clientInfo
+	)
+	if len(md.Get(gateway.GatewayURL)) != 0 {
+		url = md.Get(gateway.GatewayURL)[0]
+	}
+	if len(md.Get(gateway.GatewayQuery)) != 0 {
+		query = md.Get(gateway.GatewayQuery)[0]
+	}
+	if len(md.Get(gateway.GatewayMethod)) != 0 {
+		method = md.Get(gateway.GatewayMethod)[0]
+	}
+	if len(md.Get(gateway.GatewayAPIKey)) != 0 {
+		token = md.Get(gateway.GatewayAPIKey)[0]
+	}
+	if len(md.Get("X-API-KEYID")) != 0 {
+		apiKey = md.Get("X-API-KEYID")[0]
+	}
+	if len(md.Get("X-API-TOKEN")) != 0 {
+		apiTkn = md.Get("X-API-TOKEN")[0]
+	}
+	if len(md.Get(gateway.APIKeyTimestamp)) != 0 {
+		apiTs = md.Get(gateway.APIKeyTimestamp)[0]
+	}
+	if len(md.Get(gateway.APIKeyNonce)) != 0 {
+		nonce = md.Get(gateway.APIKeyNonce)[0]
+	}
+	if len(md.Get(gateway.APIKeySignature)) != 0 {
+		sig = md.Get(gateway.APIKeySignature)[0]
+	}
+	if len(md.Get(gateway.GatewayBodyDigest)) != 0 {
+		digest = md.Get(gateway.GatewayBodyDigest)[0]
+	}
+	// the gateway forwards the Authorization header as is
+	if len(md.Get("authorization")) != 0 {
+		bearer = bearerToken(md.Get("authorization")[0])
+	}
+	if len(md.Get("grpcgateway-cookie")) != 0 {
+		cookie = md.Get("grpcgateway-cookie")[0]
+	}
+	if len(md.Get("x-gateway-host")) != 0 {
+		client.host = md.Get("x-gateway-host")[0]
+	}
+	if len(md.Get("x-gateway-user-agent")) != 0 {
+		client.ua = md.Get("x-gateway-user-agent")[0]
+	}
+	client.ip = gateway.ClientAddr(ctx)
+
+	return &commonv3.IsRequestAllowedRequest{
+		Url:           url,
+		Query:         query,
+		Method:        method,
+		XSessionToken: token,
+		XApiKey:       apiKey,
+		XApiToken:     apiTkn,
+		XApiTimestamp: apiTs,
+		XApiNonce:     nonce,
+		XApiSignature: sig,
+		BodyDigest:    digest,
+		BearerToken:   bearer,
+		Cookie:        cookie,
+	}, client, nil
+}
+
+// statusError returns the grpc error for requests which are not
+// allowed
+func statusError(res *commonv3.IsRequestAllowedResponse) error {
+	switch res.GetStatus() {
+	case commonv3.RequestStatus_RequestAllowed:
+		return nil
+	case commonv3.RequestStatus_RequestMethodOrURLNotAllowed:
+		return status.Error(codes.PermissionDenied, res.GetReason())
+	case commonv3.RequestStatus_RequestNotAuthenticated:
+		return status.Error(codes.Unauthenticated, res.GetReason())
+	case commonv3.RequestStatus_RequestStepUpRequired:
+		return gateway.StepUpError(res.GetReason())
+	}
+
+	// status should be any of the above.
+	return status.Error(codes.Internal, codes.Internal.String())
+}
+
+// withSessionData adds the session data of an allowed request to ctx
+func withSessionData(ctx context.Context, sd *commonv3.SessionData, client clientInfo) context.Context {
+	sd.ClientIp = client.ip
+	sd.ClientHost = client.host
+	sd.ClientUa = client.ua
+	return context.WithValue(ctx, common.SessionDataKey, sd)
+}
+
 func (ac authContext) NewAuthUnaryInterceptor(opt Option) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
 		// TODO: Optimize authentication for a session/gRPC
@@ -28,87 +182,12 @@ func (ac authContext) NewAuthUnaryInterceptor(opt Option) grpc.UnaryServerInterc
 			}
 		}
 
-		// We have to get the value of org and project (namespace in
-		// future) as we will be using this inorder to authorize the
-		// user's access to different resources
-		var org string
-		var project string
-		resource, ok := req.(hasMetadata)
-		if ok {
-			meta := resource.GetMetadata()
-			if meta != nil {
-				org = meta.Organization
-				project = meta.Project
-			}
-
-			// Overrides for picking up info when not in default
-			// metadata locations
-			// XXX: This requires any new items which does not follow
-			// metadata convention to be added here
-			switch strings.Split(info.FullMethod, "/")[1] {
-			case "paralus.dev.rpc.v3.Project":
-				project = meta.Name
-			case "paralus.dev.rpc.v3.Organization":
-				org = meta.Name
-			}
-		}
-
-		noAuthz := utils.Contains(opt.ExcludeAuthzMethods, info.FullMethod)
-
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			return nil, status.Error(codes.InvalidArgument, "grpc metadata not exist")
-		}
-		var (
-			url    string
-			method string
-			token  string
-			apiKey string
-			apiTkn string
-			cookie string
-			host   string
-			ua     string
-			ip     string
-		)
-		if len(md.Get(gateway.GatewayURL)) != 0 {
-			url = md.Get(gateway.GatewayURL)[0]
-		}
-		if len(md.Get(gateway.GatewayMethod)) != 0 {
-			method = md.Get(gateway.GatewayMethod)[0]
-		}
-		if len(md.Get(gateway.GatewayAPIKey)) != 0 {
-			token = md.Get(gateway.GatewayAPIKey)[0]
-		}
-		if len(md.Get("X-API-KEYID")) != 0 {
-			apiKey = md.Get("X-API-KEYID")[0]
-		}
-		if len(md.Get("X-API-TOKEN")) != 0 {
-			apiTkn = md.Get("X-API-TOKEN")[0]
-		}
-		if len(md.Get("grpcgateway-cookie")) != 0 {
-			cookie = md.Get("grpcgateway-cookie")[0]
-		}
-		if len(md.Get("x-gateway-host")) != 0 {
-			host = md.Get("x-gateway-host")[0]
-		}
-		if len(md.Get("x-gateway-user-agent")) != 0 {
-			ua = md.Get("x-gateway-user-agent")[0]
-		}
-		if len(md.Get("x-gateway-remote-addr")) != 0 {
-			ip = md.Get("x-gateway-remote-addr")[0]
-		}
-
-		acReq := &commonv3.IsRequestAllowedRequest{
-			Url:           url,
-			Method:        method,
-			XSessionToken: token,
-			XApiKey:       apiKey,
-			XApiToken:     apiTkn,
-			Cookie:        cookie,
-			Org:           org,
-			Project:       project,
-			NoAuthz:       noAuthz, // FIXME: any better way to do this?
+		acReq, client, err := newRequest(ctx)
+		if err != nil {
+			return nil, err
 		}
+		acReq.Org, acReq.Project = requestScope(info.FullMethod, req)
+		acReq.NoAuthz = utils.Contains(opt.ExcludeAuthzMethods, info.FullMethod) // FIXME: any better way to do this?
 
 		res, err := ac.IsRequestAllowed(ctx, acReq)
 		if err != nil {
@@ -116,23 +195,10 @@ func (ac authContext) NewAuthUnaryInterceptor(opt Option) grpc.UnaryServerInterc
 			return nil, status.Error(codes.Internal, codes.Internal.String())
 		}
 
-		s := res.GetStatus()
-		_log.Debug("user authentication status ", s)
-		switch s {
-		case commonv3.RequestStatus_RequestAllowed:
-			sd := res.SessionData
-			sd.ClientIp = ip
-			sd.ClientHost = host
-			sd.ClientUa = ua
-			ctx := context.WithValue(ctx, common.SessionDataKey, sd)
-			return handler(ctx, req)
-		case commonv3.RequestStatus_RequestMethodOrURLNotAllowed:
-			return nil, status.Error(codes.PermissionDenied, res.GetReason())
-		case commonv3.RequestStatus_RequestNotAuthenticated:
-			return nil, status.Error(codes.Unauthenticated, res.GetReason())
+		_log.Debug("user authentication status ", res.GetStatus())
+		if err := statusError(res); err != nil {
+			return nil, err
 		}
-
-		// status should be any of three above.
-		return nil, status.Error(codes.Internal, codes.Internal.String())
+		return handler(withSessionData(ctx, res.SessionData, client), req)
 	}
 }
@@ -0,0 +1,52 @@
+package authv3
+
+import (
+	"context"
+	"testing"
+
+	"github.com/paralus/paralus/pkg/gateway"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	"go.uber.org/zap"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthUnaryInterceptorNoSession(t *testing.T) {
+	ac := authContext{kc: unauthorizedKratos(t), lo: &lockableLockoutService{}, al: zap.NewNop()}
+	interceptor := ac.NewAuthUnaryInterceptor(Option{})
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(gateway.GatewayURL, "/v3/watch"))
+	_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: testStreamMethod}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		t.Error("expected handler not to be called for request without session")
+		return nil, nil
+	})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("expected request without session to be unauthenticated; got %v", err)
+	}
+}
+
+func TestStatusError(t *testing.T) {
+	tt := []struct {
+		status commonv3.RequestStatus
+		code   codes.Code
+		stepUp bool
+	}{
+		{commonv3.RequestStatus_RequestAllowed, codes.OK, false},
+		{commonv3.RequestStatus_RequestMethodOrURLNotAllowed, codes.PermissionDenied, false},
+		{commonv3.RequestStatus_RequestNotAuthenticated, codes.Unauthenticated, false},
+		{commonv3.RequestStatus_RequestStepUpRequired, codes.PermissionDenied, true},
+	}
+	for _, tc := range tt {
+		t.Run(tc.status.String(), func(t *testing.T) {
+			err := statusError(&commonv3.IsRequestAllowedResponse{Status: tc.status})
+			if status.Code(err) != tc.code {
+				t.Errorf("expected code %v; got %v", tc.code, err)
+			}
+			if gateway.IsStepUpError(err) != tc.stepUp {
+				t.Errorf("expected step-up %v; got %v", tc.stepUp, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package authv3
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	kclient "github.com/ory/kratos-client-go"
+	"github.com/paralus/paralus/pkg/service"
+	authzv1 "github.com/paralus/paralus/proto/types/authz"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// setAssuranceLevel copies the authenticator assurance level of the
+// session and the time it last completed an aal2 method
+func setAssuranceLevel(sd *commonv3.SessionData, session *kclient.Session) {
+	sd.Aal = string(session.GetAuthenticatorAssuranceLevel())
+	for _, m := range session.GetAuthenticationMethods() {
+		aal := string(m.GetAal())
+		if aal != service.AAL2 && aal != service.AAL3 {
+			continue
+		}
+		if sd.StepUpAt == nil || m.GetCompletedAt().After(sd.StepUpAt.AsTime()) {
+			sd.StepUpAt = timestamppb.New(m.GetCompletedAt())
+		}
+	}
+}
+
+// requiresStepUp checks if an allowed request may only be made from
+// an aal2 session. This is the case when the mfa policy of the
+// organization lists one of the permissions covering the request or
+// when every role granting the request requires mfa.
+func (ac *authContext) requiresStepUp(ctx context.Context, req *commonv3.IsRequestAllowedRequest, er *authzv1.EnforceRequest, sd *commonv3.SessionData) (bool, error) {
+	orgID, err := uuid.Parse(sd.Organization)
+	if err != nil {
+		return false, nil
+	}
+	settings, err := service.GetOrganizationSettings(ctx, ac.db, orgID)
+	if err != nil {
+		return false, err
+	}
+	policy := settings.GetMfa()
+
+	if policy.GetEnabled() && len(policy.GetPermissions()) > 0 {
+		matched, err := ac.as.MatchPermissions(ctx, req.Url, req.Method, policy.GetPermissions())
+		if err != nil {
+			return false, err
+		}
+		if len(matched) > 0 {
+			return true, nil
+		}
+	}
+
+	roles, err := ac.as.GrantingRoles(ctx, er)
+	if err != nil {
+		return false, err
+	}
+	if len(roles) == 0 {
+		return false, nil
+	}
+	mfaRoles, err := service.MfaRequiredRoles(ctx, ac.db, orgID, roles, policy)
+	if err != nil {
+		return false, err
+	}
+	required := map[string]bool{}
+	for _, r := range mfaRoles {
+		required[r] = true
+	}
+	for _, r := range roles {
+		if !required[r] {
+			return false, nil
+		}
+	}
+	return true, nil
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/paralus/paralus/internal/dao"
 	"github.com/paralus/paralus/pkg/common"
+	"github.com/paralus/paralus/pkg/gateway"
 	rpcv3 "github.com/paralus/paralus/proto/rpc/v3"
 	commonpbv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
 	"github.com/uptrace/bun"
@@ -108,14 +109,23 @@ func serveHTTP(opt Option,
 
 	req := &commonpbv3.IsRequestAllowedRequest{
 		Url:           r.URL.String(),
+		Path:          r.URL.EscapedPath(),
+		Query:         r.URL.RawQuery,
 		Method:        r.Method,
 		XSessionToken: r.Header.Get("X-Session-Token"),
 		XApiKey:       r.Header.Get("X-API-KEYID"),
 		XApiToken:     r.Header.Get("X-API-TOKEN"),
+		XApiTimestamp: r.Header.Get(gateway.APIKeyTimestamp),
+		XApiNonce:     r.Header.Get(gateway.APIKeyNonce),
+		XApiSignature: r.Header.Get(gateway.APIKeySignature),
+		BearerToken:   bearerToken(r.Header.Get("Authorization")),
 		Cookie:        r.Header.Get("Cookie"),
 		Project:       poResp.Project,
 		Org:           poResp.Organization,
 	}
+	if req.XApiSignature != "" {
+		req.BodyDigest, _ = gateway.RequestBodyDigest(r)
+	}
 	res, err := isRequestAllowed(r.Context(), req)
 	if err != nil {
 		_log.Errorf("Failed to authenticate a request: %s", err)
@@ -146,6 +156,10 @@ func serveHTTP(opt Option,
 	case commonpbv3.RequestStatus_RequestNotAuthenticated:
 		http.Error(rw, res.GetReason(), http.StatusUnauthorized)
 		return
+	case commonpbv3.RequestStatus_RequestStepUpRequired:
+		rw.Header().Set("WWW-Authenticate", gateway.StepUpChallenge)
+		http.Error(rw, res.GetReason(), http.StatusUnauthorized)
+		return
 	}
 
 	// status is unknown
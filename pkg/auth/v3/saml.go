@@ -0,0 +1,40 @@
+package authv3
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/paralus/paralus/pkg/service"
+	samlsso "github.com/paralus/paralus/pkg/sso/saml"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// authenticateSAML authenticates requests made with the session of a
+// SAML login. The assurance level was asserted by the idp at login.
+func (ac *authContext) authenticateSAML(ctx context.Context, token string, res *commonv3.IsRequestAllowedResponse) (bool, error) {
+	session, err := samlsso.GetSession(ctx, ac.db, token)
+	if err == sql.ErrNoRows {
+		res.Status = commonv3.RequestStatus_RequestNotAuthenticated
+		res.Reason = "no active session"
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	res.Status = commonv3.RequestStatus_RequestAllowed
+	res.SessionData.Account = session.AccountId.String()
+	res.SessionData.Username = session.Username
+	res.SessionData.Organization = session.OrganizationId.String()
+	res.SessionData.Partner = session.PartnerId.String()
+	res.SessionData.AuthType = commonv3.AuthType_SAMLLogin
+	res.SessionData.Aal = session.Aal
+	if service.HasAAL2(res.SessionData) {
+		res.SessionData.StepUpAt = timestamppb.New(session.AuthenticatedAt)
+	}
+	if ok, err := ac.checkSessionAccount(ctx, res, session.ID.String(), session.AccountId, session.ExpiresAt); !ok {
+		return false, err
+	}
+	return true, nil
+}
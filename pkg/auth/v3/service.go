@@ -12,6 +12,9 @@ type authService struct {
 
 type AuthService interface {
 	IsRequestAllowed(context.Context, *commonv3.IsRequestAllowedRequest) (*commonv3.IsRequestAllowedResponse, error)
+	ExplainRequest(context.Context, *commonv3.ExplainRequestRequest) (*commonv3.ExplainRequestResponse, error)
+	EffectiveAccess(context.Context, *commonv3.EffectiveAccessRequest) (*commonv3.EffectiveAccessResponse, error)
+	ListPermissionHolders(context.Context, *commonv3.PermissionHoldersRequest) (*commonv3.PermissionHoldersResponse, error)
 }
 
 func NewAuthService(ac authContext) AuthService {
@@ -24,3 +27,21 @@ func NewAuthService(ac authContext) AuthService {
 func (s *authService) IsRequestAllowed(ctx context.Context, req *commonv3.IsRequestAllowedRequest) (*commonv3.IsRequestAllowedResponse, error) {
 	return s.ac.IsRequestAllowed(ctx, req)
 }
+
+// ExplainRequest lets admins find out why a request of a user is
+// allowed or denied.
+func (s *authService) ExplainRequest(ctx context.Context, req *commonv3.ExplainRequestRequest) (*commonv3.ExplainRequestResponse, error) {
+	return s.ac.ExplainRequest(ctx, req)
+}
+
+// EffectiveAccess lets auditors find out what a user, service account,
+// group or api key can do in the organization.
+func (s *authService) EffectiveAccess(ctx context.Context, req *commonv3.EffectiveAccessRequest) (*commonv3.EffectiveAccessResponse, error) {
+	return s.ac.EffectiveAccess(ctx, req)
+}
+
+// ListPermissionHolders lets auditors find out who holds a permission
+// in a project.
+func (s *authService) ListPermissionHolders(ctx context.Context, req *commonv3.PermissionHoldersRequest) (*commonv3.PermissionHoldersResponse, error) {
+	return s.ac.ListPermissionHolders(ctx, req)
+}
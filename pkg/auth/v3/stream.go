@@ -0,0 +1,178 @@
+package authv3
+
+import (
+	context "context"
+	"sync"
+	"time"
+
+	"github.com/paralus/paralus/pkg/utils"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// authStream authorizes a stream once the resource it is opened for
+// is known from its first message and closes it when its credentials
+// are revoked
+type authStream struct {
+	grpc.ServerStream
+	ac         *authContext
+	ctx        context.Context
+	cancel     context.CancelFunc
+	fullMethod string
+
+	once       sync.Once
+	mu         sync.Mutex
+	req        *commonv3.IsRequestAllowedRequest
+	sd         *commonv3.SessionData
+	authorized bool
+	err        error
+}
+
+func (s *authStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *authStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return s.authorize(m)
+}
+
+func (s *authStream) SendMsg(m interface{}) error {
+	// nothing is sent before the stream has been authorized
+	if err := s.authorize(nil); err != nil {
+		return err
+	}
+	return s.ServerStream.SendMsg(m)
+}
+
+// authorize authorizes the stream for the resource of the first
+// message, m is nil when the server sends before receiving anything
+func (s *authStream) authorize(m interface{}) error {
+	s.once.Do(func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if s.req.NoAuthz {
+			return
+		}
+		s.req.Org, s.req.Project = requestScope(s.fullMethod, m)
+		res := &commonv3.IsRequestAllowedResponse{
+			Status:      commonv3.RequestStatus_RequestAllowed,
+			SessionData: proto.Clone(s.sd).(*commonv3.SessionData),
+		}
+		if err := s.ac.authorize(s.ctx, s.req, res); err != nil {
+			_log.Errorf("Failed to authorize a stream: %s", err)
+			s.err = status.Error(codes.Internal, codes.Internal.String())
+			return
+		}
+		s.err = statusError(res)
+		s.authorized = true
+	})
+	return s.Err()
+}
+
+// Err returns why the stream is no longer allowed
+func (s *authStream) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+// watch revalidates the credentials of the stream every interval
+// until the stream is done
+func (s *authStream) watch(interval time.Duration) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-t.C:
+		}
+
+		s.mu.Lock()
+		req := proto.Clone(s.req).(*commonv3.IsRequestAllowedRequest)
+		sd := proto.Clone(s.sd).(*commonv3.SessionData)
+		if !s.authorized {
+			req.NoAuthz = true
+		}
+		s.mu.Unlock()
+
+		res, err := s.ac.revalidate(s.ctx, req, sd)
+		if err != nil {
+			// keep the stream open when the credentials could
+			// not be checked, they are checked again next time
+			_log.Warnw("unable to revalidate stream", "method", s.fullMethod, "error", err)
+			continue
+		}
+		if err := statusError(res); err != nil {
+			_log.Infow("closing stream with revoked credentials", "method", s.fullMethod, "username", sd.Username, "reason", res.GetReason())
+			s.mu.Lock()
+			s.err = err
+			s.mu.Unlock()
+			s.cancel()
+			return
+		}
+	}
+}
+
+// NewAuthStreamInterceptor returns a stream interceptor with the same
+// options as NewAuthUnaryInterceptor. Streams are authenticated when
+// opened, authorized on their first message and revalidated for as
+// long as they are open.
+func (ac authContext) NewAuthStreamInterceptor(opt Option) grpc.StreamServerInterceptor {
+	interval := opt.StreamRevalidateInterval
+	if interval <= 0 {
+		interval = DefaultStreamRevalidateInterval
+	}
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		for _, ex := range opt.ExcludeRPCMethods {
+			if ex == info.FullMethod {
+				return handler(srv, ss)
+			}
+		}
+
+		acReq, client, err := newRequest(ss.Context())
+		if err != nil {
+			return err
+		}
+
+		// the resource of the stream is only known from its first
+		// message, only authenticate for now
+		acReq.NoAuthz = true
+		res, err := ac.IsRequestAllowed(ss.Context(), acReq)
+		if err != nil {
+			_log.Errorf("Failed to authenticate a stream: %s", err)
+			return status.Error(codes.Internal, codes.Internal.String())
+		}
+		if err := statusError(res); err != nil {
+			return err
+		}
+		acReq.NoAuthz = utils.Contains(opt.ExcludeAuthzMethods, info.FullMethod)
+
+		ctx, cancel := context.WithCancel(withSessionData(ss.Context(), res.SessionData, client))
+		defer cancel()
+		as := &authStream{
+			ServerStream: ss,
+			ac:           &ac,
+			ctx:          ctx,
+			cancel:       cancel,
+			fullMethod:   info.FullMethod,
+			req:          acReq,
+			sd:           res.SessionData,
+		}
+		go as.watch(interval)
+
+		err = handler(srv, as)
+		if serr := as.Err(); serr != nil && ctx.Err() != nil {
+			// the handler saw the stream context being canceled,
+			// report why
+			return serr
+		}
+		return err
+	}
+}
@@ -0,0 +1,210 @@
+package authv3
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	kclient "github.com/ory/kratos-client-go"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/paralus/paralus/pkg/gateway"
+	"github.com/paralus/paralus/pkg/service"
+	rpcv3 "github.com/paralus/paralus/proto/rpc/user"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	"go.uber.org/zap"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const testStreamMethod = "/paralus.dev.rpc.v3.Test/Watch"
+
+// fakeServerStream is a stream opened with a fixed context
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// lockableLockoutService locks accounts out once told to, it is safe
+// to lock accounts while streams are revalidated
+type lockableLockoutService struct {
+	service.AccountLockoutService
+	locked atomic.Bool
+}
+
+func (l *lockableLockoutService) IsLocked(ctx context.Context, accountID uuid.UUID) (bool, error) {
+	return l.locked.Load(), nil
+}
+
+// rotatableApiKeyService returns an api key which can be rotated
+// while streams are revalidated
+type rotatableApiKeyService struct {
+	fakeApiKeyService
+	current atomic.Pointer[models.ApiKey]
+}
+
+func (r *rotatableApiKeyService) GetByKey(ctx context.Context, req *rpcv3.ApiKeyRequest) (*models.ApiKey, error) {
+	return r.current.Load(), nil
+}
+
+// unauthorizedKratos returns a kratos client whose sessions are all
+// revoked
+func unauthorizedKratos(t *testing.T) *kclient.APIClient {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	t.Cleanup(srv.Close)
+	cfg := kclient.NewConfiguration()
+	cfg.Servers[0].URL = srv.URL
+	return kclient.NewAPIClient(cfg)
+}
+
+func TestAuthStreamInterceptorNoSession(t *testing.T) {
+	ac := authContext{kc: unauthorizedKratos(t), lo: &lockableLockoutService{}, al: zap.NewNop()}
+	interceptor := ac.NewAuthStreamInterceptor(Option{})
+
+	ss := &fakeServerStream{ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs(gateway.GatewayURL, "/v3/watch"))}
+	called := false
+	err := interceptor(nil, ss, &grpc.StreamServerInfo{FullMethod: testStreamMethod}, func(srv interface{}, stream grpc.ServerStream) error {
+		called = true
+		return nil
+	})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("expected stream without session to be unauthenticated; got %v", err)
+	}
+	if called {
+		t.Error("expected handler not to be called for stream without session")
+	}
+}
+
+func TestAuthStreamInterceptorLockedAccount(t *testing.T) {
+	key := &models.ApiKey{Key: "key", Secret: "secret", Name: "user@example.com", AccountID: uuid.New()}
+	lo := &lockableLockoutService{}
+	ac := authContext{ks: &fakeApiKeyService{key: key}, lo: lo, al: zap.NewNop()}
+	interceptor := ac.NewAuthStreamInterceptor(Option{
+		ExcludeAuthzMethods:      []string{testStreamMethod},
+		StreamRevalidateInterval: 10 * time.Millisecond,
+	})
+
+	req := signedApiKeyRequest("secret")
+	md := metadata.Pairs(
+		gateway.GatewayURL, req.Url,
+		gateway.GatewayQuery, req.Query,
+		gateway.GatewayMethod, req.Method,
+		gateway.APIKey, req.XApiKey,
+		gateway.APIKeyTimestamp, req.XApiTimestamp,
+		gateway.APIKeyNonce, req.XApiNonce,
+		gateway.APIKeySignature, req.XApiSignature,
+	)
+	ss := &fakeServerStream{ctx: metadata.NewIncomingContext(context.Background(), md)}
+	err := interceptor(nil, ss, &grpc.StreamServerInfo{FullMethod: testStreamMethod}, func(srv interface{}, stream grpc.ServerStream) error {
+		// the account is locked out while the stream is open
+		lo.locked.Store(true)
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case <-time.After(5 * time.Second):
+			return nil
+		}
+	})
+	if status.Code(err) != codes.Unauthenticated || status.Convert(err).Message() != service.ErrAccountLocked.Error() {
+		t.Errorf("expected stream of locked account to be closed; got %v", err)
+	}
+}
+
+func TestAuthStreamApiKeyRotated(t *testing.T) {
+	accountID := uuid.New()
+	inGrace := time.Now().Add(time.Hour)
+	tt := []struct {
+		name    string
+		secret  string
+		key     models.ApiKey
+		rotated models.ApiKey
+		closed  bool
+	}{
+		{"secret rotated in grace period", "s1",
+			models.ApiKey{Secret: "s1"},
+			models.ApiKey{Secret: "s2", PreviousSecret: "s1", PreviousSecretExpiresAt: inGrace}, false},
+		{"grace period of previous secret ended", "s1",
+			models.ApiKey{Secret: "s2", PreviousSecret: "s1", PreviousSecretExpiresAt: inGrace},
+			models.ApiKey{Secret: "s2", PreviousSecret: "s1", PreviousSecretExpiresAt: time.Now().Add(-time.Minute)}, true},
+		{"secret rotated out", "s1",
+			models.ApiKey{Secret: "s1"},
+			models.ApiKey{Secret: "s3", PreviousSecret: "s2", PreviousSecretExpiresAt: inGrace}, true},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ks := &rotatableApiKeyService{}
+			key, rotated := tc.key, tc.rotated
+			key.Key, key.AccountID = "key", accountID
+			rotated.Key, rotated.AccountID = "key", accountID
+			ks.current.Store(&key)
+			ac := authContext{ks: ks, lo: &lockableLockoutService{}, al: zap.NewNop()}
+			interceptor := ac.NewAuthStreamInterceptor(Option{
+				ExcludeAuthzMethods:      []string{testStreamMethod},
+				StreamRevalidateInterval: 10 * time.Millisecond,
+			})
+
+			req := signedApiKeyRequest(tc.secret)
+			md := metadata.Pairs(
+				gateway.GatewayURL, req.Url,
+				gateway.GatewayQuery, req.Query,
+				gateway.GatewayMethod, req.Method,
+				gateway.APIKey, req.XApiKey,
+				gateway.APIKeyTimestamp, req.XApiTimestamp,
+				gateway.APIKeyNonce, req.XApiNonce,
+				gateway.APIKeySignature, req.XApiSignature,
+			)
+			ss := &fakeServerStream{ctx: metadata.NewIncomingContext(context.Background(), md)}
+			err := interceptor(nil, ss, &grpc.StreamServerInfo{FullMethod: testStreamMethod}, func(srv interface{}, stream grpc.ServerStream) error {
+				// the key is rotated while the stream is open
+				ks.current.Store(&rotated)
+				select {
+				case <-stream.Context().Done():
+					return stream.Context().Err()
+				case <-time.After(200 * time.Millisecond):
+					return nil
+				}
+			})
+			if tc.closed && (status.Code(err) != codes.Unauthenticated || status.Convert(err).Message() != ErrRotatedAPIKey.Error()) {
+				t.Errorf("expected stream to be closed with the secret; got %v", err)
+			}
+			if !tc.closed && err != nil {
+				t.Errorf("expected stream to stay open; got %v", err)
+			}
+		})
+	}
+}
+
+func TestAuthStreamRevokedSession(t *testing.T) {
+	ac := &authContext{kc: unauthorizedKratos(t), lo: &lockableLockoutService{}, al: zap.NewNop()}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	as := &authStream{
+		ac:         ac,
+		ctx:        ctx,
+		cancel:     cancel,
+		fullMethod: testStreamMethod,
+		req:        &commonv3.IsRequestAllowedRequest{NoAuthz: true},
+		sd:         &commonv3.SessionData{Username: "user@example.com", Account: uuid.NewString(), AuthType: commonv3.AuthType_SessionLogin},
+	}
+	go as.watch(10 * time.Millisecond)
+
+	select {
+	case <-ctx.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected stream of revoked session to be closed")
+	}
+	if status.Code(as.Err()) != codes.Unauthenticated {
+		t.Errorf("expected stream of revoked session to be unauthenticated; got %v", as.Err())
+	}
+}
@@ -40,6 +40,17 @@ const (
 	MaxDials = 2
 )
 
+// api key authentication schemes advertised in the cli config
+const (
+	// ApiKeyAuthSchemeHMAC signs every request with HMAC-SHA256
+	ApiKeyAuthSchemeHMAC = "hmac-sha256"
+	// ApiKeyAuthSchemeLegacy sends a static checksum of the secret
+	ApiKeyAuthSchemeLegacy = "legacy-checksum"
+	// ApiKeyMaxClockSkew is how far the timestamp of a signed request
+	// may be from the server time
+	ApiKeyMaxClockSkew = 5 * time.Minute
+)
+
 // audit
 const (
 	EventDocType           = "event"
@@ -23,6 +23,7 @@ type CliConfigDownloadData struct {
 	Project      string `json:"project"`
 	Organization string `json:"organization"`
 	Partner      string `json:"partner"`
+	AuthScheme   string `json:"auth_scheme"`
 }
 
 type contextKey struct{}
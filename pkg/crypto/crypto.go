@@ -2,9 +2,12 @@ package crypto
 
 import (
 	"crypto/aes"
+	"crypto/hmac"
 	"crypto/sha1"
 	"crypto/sha256"
+	"encoding/base64"
 	"encoding/hex"
+	"strings"
 
 	"github.com/rs/xid"
 )
@@ -40,3 +43,19 @@ func GenerateSha256Secret() string {
 	sum := sha256.Sum256([]byte(xid.New().String()))
 	return hex.EncodeToString(sum[:])
 }
+
+// BodyDigest returns the hex encoded sha256 of a request body as used
+// in api key request signatures.
+func BodyDigest(body []byte) string {
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:])
+}
+
+// SignRequest returns the base64 encoded HMAC-SHA256 signature of an
+// api key request. The signed string is the method, escaped path, raw
+// query, body digest, unix timestamp and nonce joined by newlines.
+func SignRequest(secret, method, path, query, bodyDigest, timestamp, nonce string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strings.Join([]string{strings.ToUpper(method), path, query, bodyDigest, timestamp, nonce}, "\n")))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -27,18 +27,13 @@ func KeyMatchCu(key1 string, key2 string) bool {
 	return util.KeyMatch2(key1, key2)
 }
 
-func (e *casbinEnforcer) Init() (*casbin.CachedEnforcer, error) {
-	adapter, err := gormadapter.NewAdapterByDB(e.db)
-	if err != nil {
-		return nil, err
-	}
-
-	modelText := `
+const modelText = `
 [request_definition]
 r = sub, ns, proj, org, obj, act
 
 [policy_definition]
 p = sub, ns, proj, org, obj
+p2 = sub, ns, proj, org, obj
 
 [role_definition]
 g = _, _, _
@@ -46,11 +41,29 @@ g2 = _, _
 
 [policy_effect]
 e = some(where (p.eft == allow))
+e2 = some(where (p.eft == allow))
 
 [matchers]
 m = g2(r.sub, p.sub) && (globMatch(r.ns, p.ns) || globMatch(p.ns, r.ns)) && (globMatch(r.proj, p.proj) || globMatch(p.proj, r.proj)) && (globMatch(r.org, p.org) || globMatch(p.org, r.org)) && g(r.obj, p.obj, r.act)
+m2 = g2(r.sub, p2.sub) && (globMatch(r.ns, p2.ns) || globMatch(p2.ns, r.ns)) && (globMatch(r.proj, p2.proj) || globMatch(p2.proj, r.proj)) && (globMatch(r.org, p2.org) || globMatch(p2.org, r.org)) && g(r.obj, p2.obj, r.act)
 `
-	m, err := model.NewModelFromString(modelText)
+
+// DenyContext evaluates a request against the deny policies of p2, a
+// request allowed by p is denied when any deny policy matches it
+var DenyContext = casbin.EnforceContext{RType: "r", PType: "p2", EType: "e2", MType: "m2"}
+
+// NewModel returns the casbin model used for authorization
+func NewModel() (model.Model, error) {
+	return model.NewModelFromString(modelText)
+}
+
+func (e *casbinEnforcer) Init() (*casbin.CachedEnforcer, error) {
+	adapter, err := gormadapter.NewAdapterByDB(e.db)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := NewModel()
 	if err != nil {
 		return nil, err
 	}
@@ -1,9 +1,12 @@
 package gateway
 
 import (
+	"bytes"
 	"context"
+	"io"
 	"net/http"
 
+	"github.com/paralus/paralus/pkg/crypto"
 	"google.golang.org/grpc/metadata"
 )
 
@@ -11,27 +14,62 @@ import (
 const (
 	GatewayRequest       = "x-gateway-request"
 	GatewayURL           = "x-gateway-url"
+	GatewayQuery         = "x-gateway-query"
 	GatewaySessionCookie = "ory_kratos_session"
 	GatewayAPIKey        = "X-Session-Token"
 	APIKey               = "X-API-KEYID"
 	APIKeyToken          = "X-API-TOKEN"
+	APIKeyTimestamp      = "X-API-TIMESTAMP"
+	APIKeyNonce          = "X-API-NONCE"
+	APIKeySignature      = "X-API-SIGNATURE"
+	GatewayBodyDigest    = "x-gateway-body-sha256"
 	GatewayMethod        = "x-gateway-method"
 	UserAgent            = "x-gateway-user-agent"
 	Host                 = "x-gateway-host"
 	RemoteAddr           = "x-gateway-remote-addr"
+	WebhookSecret        = "X-Webhook-Secret"
 )
 
 // paralusGatewayAnnotator adds paralus gateway specific annotations
 var paralusGatewayAnnotator = func(ctx context.Context, r *http.Request) metadata.MD {
-	return metadata.New(map[string]string{
-		GatewayRequest: "true",
-		GatewayURL:     r.URL.EscapedPath(),
-		GatewayAPIKey:  r.Header.Get(GatewayAPIKey),
-		APIKey:         r.Header.Get(APIKey),
-		APIKeyToken:    r.Header.Get(APIKeyToken),
-		GatewayMethod:  r.Method,
-		UserAgent:      r.UserAgent(),
-		Host:           r.Host,
-		RemoteAddr:     r.RemoteAddr,
+	md := metadata.New(map[string]string{
+		GatewayRequest:  "true",
+		GatewayURL:      r.URL.EscapedPath(),
+		GatewayQuery:    r.URL.RawQuery,
+		GatewayAPIKey:   r.Header.Get(GatewayAPIKey),
+		APIKey:          r.Header.Get(APIKey),
+		APIKeyToken:     r.Header.Get(APIKeyToken),
+		APIKeyTimestamp: r.Header.Get(APIKeyTimestamp),
+		APIKeyNonce:     r.Header.Get(APIKeyNonce),
+		APIKeySignature: r.Header.Get(APIKeySignature),
+		GatewayMethod:   r.Method,
+		UserAgent:       r.UserAgent(),
+		Host:            r.Host,
+		RemoteAddr:      r.RemoteAddr,
+		WebhookSecret:   r.Header.Get(WebhookSecret),
 	})
+	// signed api key requests cover the body, so its digest has to
+	// be computed here before the gateway decodes it. An unreadable
+	// body leaves the digest empty and fails signature verification.
+	if r.Header.Get(APIKeySignature) != "" {
+		digest, _ := RequestBodyDigest(r)
+		md.Set(GatewayBodyDigest, digest)
+	}
+	return md
+}
+
+// RequestBodyDigest returns the digest used in api key request
+// signatures for the body of r. The body is restored so that it can
+// still be read by the handler.
+func RequestBodyDigest(r *http.Request) (string, error) {
+	if r.Body == nil {
+		return crypto.BodyDigest(nil), nil
+	}
+	body, err := io.ReadAll(r.Body)
+	r.Body.Close()
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	return crypto.BodyDigest(body), nil
 }
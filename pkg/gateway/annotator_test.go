@@ -0,0 +1,54 @@
+package gateway_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/paralus/paralus/pkg/crypto"
+	"github.com/paralus/paralus/pkg/gateway"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestRequestBodyDigest(t *testing.T) {
+	body := `{"metadata": {"name": "test"}}`
+	r := httptest.NewRequest("POST", "/auth/v3/users", strings.NewReader(body))
+
+	digest, err := gateway.RequestBodyDigest(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if digest != crypto.BodyDigest([]byte(body)) {
+		t.Errorf("incorrect body digest; expected '%v', got '%v'", crypto.BodyDigest([]byte(body)), digest)
+	}
+
+	rb, err := io.ReadAll(r.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(rb) != body {
+		t.Errorf("request body not restored; expected '%v', got '%v'", body, string(rb))
+	}
+}
+
+func TestClientAddr(t *testing.T) {
+	withPeer := func(addr string, md metadata.MD) context.Context {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(addr), Port: 50000}})
+		return metadata.NewIncomingContext(ctx, md)
+	}
+	forwarded := metadata.Pairs(gateway.RemoteAddr, "203.0.113.7:41000")
+
+	if addr := gateway.ClientAddr(withPeer("127.0.0.1", forwarded)); addr != "203.0.113.7" {
+		t.Errorf("expected address forwarded by gateway; got %v", addr)
+	}
+	if addr := gateway.ClientAddr(withPeer("10.0.0.5", forwarded)); addr != "10.0.0.5" {
+		t.Errorf("expected forwarded address of direct client to be ignored; got %v", addr)
+	}
+	if addr := gateway.ClientAddr(withPeer("10.0.0.5", metadata.MD{})); addr != "10.0.0.5" {
+		t.Errorf("expected peer address; got %v", addr)
+	}
+}
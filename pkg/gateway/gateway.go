@@ -16,6 +16,19 @@ var ErrNoHandlers = errors.New("no handlers defined")
 // HandlerFromEndpoint defines the function for registering grpc gateway handlers to grpc endpoint
 type HandlerFromEndpoint func(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error)
 
+// RetryAfter is the grpc header with the seconds after which a
+// throttled request may be retried, passed on as Retry-After
+const RetryAfter = "retry-after"
+
+// outgoingHeaderMatcher passes the retry after header on as is, other
+// headers keep the default prefix
+func outgoingHeaderMatcher(key string) (string, bool) {
+	if key == RetryAfter {
+		return "Retry-After", true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
+
 // NewGateway returns new grpc gateway
 func NewGateway(ctx context.Context, endpoint string, serveMuxOptions []runtime.ServeMuxOption, handlers ...HandlerFromEndpoint) (http.Handler, error) {
 
@@ -27,6 +40,8 @@ func NewGateway(ctx context.Context, endpoint string, serveMuxOptions []runtime.
 		runtime.WithMarshalerOption(jsonContentType, paralusJSON),
 		runtime.WithMarshalerOption(yamlContentType, paralusYAML),
 		runtime.WithMetadata(paralusGatewayAnnotator),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
+		runtime.WithErrorHandler(errorHandler),
 	)
 
 	mux := runtime.NewServeMux(serveMuxOptions...)
@@ -0,0 +1,64 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// ErrorDomain is the domain of the error infos of paralus
+	ErrorDomain = "paralus.dev"
+	// StepUpRequired is the reason of requests which need a session
+	// of a higher authenticator assurance level
+	StepUpRequired = "STEP_UP_REQUIRED"
+	// StepUpChallenge is the WWW-Authenticate challenge of requests
+	// which need a step-up, the console steps up when it sees it
+	StepUpChallenge = `Session error="insufficient_user_authentication", aal="aal2"`
+)
+
+// StepUpError returns the grpc error of requests which need a step-up.
+// Its error info tells it apart from requests which are not allowed.
+func StepUpError(reason string) error {
+	st, err := status.New(codes.PermissionDenied, reason).WithDetails(&errdetails.ErrorInfo{
+		Reason:   StepUpRequired,
+		Domain:   ErrorDomain,
+		Metadata: map[string]string{"aal": "aal2"},
+	})
+	if err != nil {
+		return status.Error(codes.PermissionDenied, reason)
+	}
+	return st.Err()
+}
+
+// IsStepUpError returns whether err is the error of a request which
+// needs a step-up
+func IsStepUpError(err error) bool {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.PermissionDenied {
+		return false
+	}
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok && info.GetDomain() == ErrorDomain && info.GetReason() == StepUpRequired {
+			return true
+		}
+	}
+	return false
+}
+
+// errorHandler answers requests which need a step-up like the http
+// auth middleware does, with the step-up challenge and Unauthorized,
+// other errors are handled as usual
+func errorHandler(ctx context.Context, mux *runtime.ServeMux, m runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	var httpErr *runtime.HTTPStatusError
+	if !errors.As(err, &httpErr) && IsStepUpError(err) {
+		w.Header().Set("WWW-Authenticate", StepUpChallenge)
+		err = &runtime.HTTPStatusError{HTTPStatus: http.StatusUnauthorized, Err: err}
+	}
+	runtime.DefaultHTTPErrorHandler(ctx, mux, m, w, r, err)
+}
@@ -0,0 +1,42 @@
+package gateway
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestStepUpErrorHandler(t *testing.T) {
+	tt := []struct {
+		name      string
+		err       error
+		code      int
+		challenge string
+	}{
+		{"step-up", StepUpError("step-up authentication required"), http.StatusUnauthorized, StepUpChallenge},
+		{"permission denied", status.Error(codes.PermissionDenied, "not allowed"), http.StatusForbidden, ""},
+		{"unauthenticated", status.Error(codes.Unauthenticated, "no or invalid credentials"), http.StatusUnauthorized, "no or invalid credentials"},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("GET", "/v2/sentry/kubeconfig/user", nil)
+			ctx := runtime.NewServerMetadataContext(context.Background(), runtime.ServerMetadata{})
+			errorHandler(ctx, runtime.NewServeMux(), &runtime.JSONPb{}, w, r, tc.err)
+			if w.Code != tc.code {
+				t.Errorf("expected status %d; got %d", tc.code, w.Code)
+			}
+			if challenge := w.Header().Get("WWW-Authenticate"); challenge != tc.challenge {
+				t.Errorf("expected challenge %q; got %q", tc.challenge, challenge)
+			}
+		})
+	}
+	if !IsStepUpError(StepUpError("step-up authentication required")) {
+		t.Error("expected step-up error to be recognized")
+	}
+}
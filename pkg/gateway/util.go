@@ -2,8 +2,10 @@ package gateway
 
 import (
 	"context"
+	"net"
 
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 )
 
 // IsGatewayRequest returns true if the request is originated from
@@ -17,3 +19,30 @@ func IsGatewayRequest(ctx context.Context) bool {
 	}
 	return false
 }
+
+// ClientAddr returns the address of the client of the request. The
+// address forwarded by the gateway is only trusted from the loopback
+// connections of the gateway, other clients could set it to any
+// address.
+func ClientAddr(ctx context.Context) string {
+	var addr string
+	pr, ok := peer.FromContext(ctx)
+	if ok && pr.Addr != nil {
+		addr = pr.Addr.String()
+	}
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		addr = host
+	}
+	if ip := net.ParseIP(addr); ip == nil || !ip.IsLoopback() {
+		return addr
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if fwd := md.Get(RemoteAddr); len(fwd) > 0 {
+			addr = fwd[0]
+			if host, _, err := net.SplitHostPort(addr); err == nil {
+				addr = host
+			}
+		}
+	}
+	return addr
+}
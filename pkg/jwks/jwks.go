@@ -0,0 +1,209 @@
+// Package jwks parses JSON Web Key Sets and fetches them from the
+// JWKS endpoints of token issuers.
+package jwks
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// KeySet holds the public keys of a key set by key id
+type KeySet map[string]crypto.PublicKey
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// Parse parses a JSON Web Key Set. Keys which are not used for
+// signatures or are of an unsupported type are skipped.
+func Parse(data []byte) (KeySet, error) {
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("invalid jwks: %v", err)
+	}
+	ks := KeySet{}
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		var (
+			key crypto.PublicKey
+			err error
+		)
+		switch k.Kty {
+		case "RSA":
+			key, err = rsaKey(k)
+		case "EC":
+			key, err = ecKey(k)
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid key '%v' in jwks: %v", k.Kid, err)
+		}
+		ks[k.Kid] = key
+	}
+	return ks, nil
+}
+
+// Key returns the key with the given id. Tokens without a key id can
+// only be verified against sets holding a single key.
+func (ks KeySet) Key(kid string) (crypto.PublicKey, bool) {
+	if key, ok := ks[kid]; ok {
+		return key, true
+	}
+	if kid == "" && len(ks) == 1 {
+		for _, key := range ks {
+			return key, true
+		}
+	}
+	return nil, false
+}
+
+func decode(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+func rsaKey(k jsonWebKey) (*rsa.PublicKey, error) {
+	n, err := decode(k.N)
+	if err != nil {
+		return nil, err
+	}
+	e, err := decode(k.E)
+	if err != nil {
+		return nil, err
+	}
+	if n.Sign() == 0 || !e.IsInt64() || e.Int64() < 3 {
+		return nil, fmt.Errorf("invalid rsa key")
+	}
+	return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+}
+
+func ecKey(k jsonWebKey) (*ecdsa.PublicKey, error) {
+	var curve elliptic.Curve
+	switch k.Crv {
+	case "P-256":
+		curve = elliptic.P256()
+	case "P-384":
+		curve = elliptic.P384()
+	case "P-521":
+		curve = elliptic.P521()
+	default:
+		return nil, fmt.Errorf("unsupported curve '%v'", k.Crv)
+	}
+	x, err := decode(k.X)
+	if err != nil {
+		return nil, err
+	}
+	y, err := decode(k.Y)
+	if err != nil {
+		return nil, err
+	}
+	if !curve.IsOnCurve(x, y) {
+		return nil, fmt.Errorf("point not on curve")
+	}
+	return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+}
+
+type cachedKeySet struct {
+	keys      KeySet
+	fetchedAt time.Time
+}
+
+// Fetcher fetches key sets from JWKS endpoints. Fetched key sets are
+// cached for the configured time.
+type Fetcher struct {
+	client *http.Client
+	ttl    time.Duration
+
+	mu    sync.Mutex
+	cache map[string]cachedKeySet
+}
+
+// MinRefreshInterval is the minimum time between two fetches of the
+// same key set when looking for an unknown key id
+const MinRefreshInterval = time.Minute
+
+// NewFetcher returns new key set fetcher
+func NewFetcher(client *http.Client, ttl time.Duration) *Fetcher {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &Fetcher{client: client, ttl: ttl, cache: map[string]cachedKeySet{}}
+}
+
+// Key returns the key with the given id from the key set at url. The
+// key set is fetched again when the key is unknown so that rotated
+// keys of the issuer get picked up.
+func (f *Fetcher) Key(ctx context.Context, url, kid string) (crypto.PublicKey, error) {
+	f.mu.Lock()
+	cached, ok := f.cache[url]
+	f.mu.Unlock()
+
+	if ok && time.Since(cached.fetchedAt) < f.ttl {
+		if key, found := cached.keys.Key(kid); found {
+			return key, nil
+		}
+		if time.Since(cached.fetchedAt) < MinRefreshInterval {
+			return nil, fmt.Errorf("unknown key '%v'", kid)
+		}
+	}
+
+	keys, err := f.fetch(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	f.mu.Lock()
+	f.cache[url] = cachedKeySet{keys: keys, fetchedAt: time.Now()}
+	f.mu.Unlock()
+
+	if key, found := keys.Key(kid); found {
+		return key, nil
+	}
+	return nil, fmt.Errorf("unknown key '%v'", kid)
+}
+
+func (f *Fetcher) fetch(ctx context.Context, url string) (KeySet, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch jwks: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unable to fetch jwks: %v", resp.Status)
+	}
+	// key sets are small, anything larger is not a key set
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch jwks: %v", err)
+	}
+	return Parse(body)
+}
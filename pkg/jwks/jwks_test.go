@@ -0,0 +1,137 @@
+package jwks
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func encode(i *big.Int) string {
+	return base64.RawURLEncoding.EncodeToString(i.Bytes())
+}
+
+func rsaJWK(kid string, key *rsa.PublicKey) string {
+	return fmt.Sprintf(`{"kty":"RSA","kid":"%s","use":"sig","n":"%s","e":"%s"}`, kid, encode(key.N), encode(big.NewInt(int64(key.E))))
+}
+
+func ecJWK(kid string, key *ecdsa.PublicKey) string {
+	return fmt.Sprintf(`{"kty":"EC","kid":"%s","crv":"P-256","x":"%s","y":"%s"}`, kid, encode(key.X), encode(key.Y))
+}
+
+func TestParse(t *testing.T) {
+	rk, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ek, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := fmt.Sprintf(`{"keys":[%s,%s,{"kty":"oct","kid":"hmac","k":"c2VjcmV0"},{"kty":"RSA","kid":"enc","use":"enc","n":"AQAB","e":"AQAB"}]}`,
+		rsaJWK("rsa", &rk.PublicKey), ecJWK("ec", &ek.PublicKey))
+
+	ks, err := Parse([]byte(data))
+	if err != nil {
+		t.Fatal("unable to parse jwks:", err)
+	}
+	if len(ks) != 2 {
+		t.Fatalf("expected 2 signing keys, got %v", len(ks))
+	}
+	key, ok := ks.Key("rsa")
+	if !ok || !key.(*rsa.PublicKey).Equal(&rk.PublicKey) {
+		t.Error("rsa key does not match")
+	}
+	key, ok = ks.Key("ec")
+	if !ok || !key.(*ecdsa.PublicKey).Equal(&ek.PublicKey) {
+		t.Error("ec key does not match")
+	}
+	if _, ok := ks.Key(""); ok {
+		t.Error("key without id found in set with multiple keys")
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	tt := []struct {
+		name string
+		data string
+	}{
+		{"not json", `keys`},
+		{"bad modulus", `{"keys":[{"kty":"RSA","kid":"a","n":"***","e":"AQAB"}]}`},
+		{"point off curve", `{"keys":[{"kty":"EC","kid":"a","crv":"P-256","x":"AQ","y":"AQ"}]}`},
+		{"unknown curve", `{"keys":[{"kty":"EC","kid":"a","crv":"P-1","x":"AQ","y":"AQ"}]}`},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := Parse([]byte(tc.data)); err == nil {
+				t.Error("parsed invalid jwks")
+			}
+		})
+	}
+}
+
+func TestFetcher(t *testing.T) {
+	k1, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	k2, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	jwks := fmt.Sprintf(`{"keys":[%s]}`, rsaJWK("k1", &k1.PublicKey))
+	fetches := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches++
+		fmt.Fprint(w, jwks)
+	}))
+	defer srv.Close()
+
+	f := NewFetcher(srv.Client(), time.Hour)
+	key, err := f.Key(context.Background(), srv.URL, "k1")
+	if err != nil {
+		t.Fatal("unable to fetch key:", err)
+	}
+	if !key.(*rsa.PublicKey).Equal(&k1.PublicKey) {
+		t.Error("fetched key does not match")
+	}
+	if _, err := f.Key(context.Background(), srv.URL, "k1"); err != nil || fetches != 1 {
+		t.Errorf("expected cached key set to be used; fetches %v, error %v", fetches, err)
+	}
+
+	// the issuer rotates its keys, unknown key ids are only looked
+	// up again once the minimum refresh interval has passed
+	jwks = fmt.Sprintf(`{"keys":[%s,%s]}`, rsaJWK("k1", &k1.PublicKey), rsaJWK("k2", &k2.PublicKey))
+	if _, err := f.Key(context.Background(), srv.URL, "k2"); err == nil || fetches != 1 {
+		t.Errorf("expected unknown key within refresh interval; fetches %v, error %v", fetches, err)
+	}
+	f.cache[srv.URL] = cachedKeySet{keys: f.cache[srv.URL].keys, fetchedAt: time.Now().Add(-2 * MinRefreshInterval)}
+	key, err = f.Key(context.Background(), srv.URL, "k2")
+	if err != nil || fetches != 2 {
+		t.Fatalf("expected key set to be fetched again; fetches %v, error %v", fetches, err)
+	}
+	if !key.(*rsa.PublicKey).Equal(&k2.PublicKey) {
+		t.Error("rotated key does not match")
+	}
+}
+
+func TestFetcherError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	f := NewFetcher(srv.Client(), time.Hour)
+	if _, err := f.Key(context.Background(), srv.URL, "k1"); err == nil {
+		t.Error("expected error for unavailable jwks endpoint")
+	}
+}
@@ -0,0 +1,351 @@
+// Package ldap reads groups and their members from LDAP and Active
+// Directory servers
+package ldap
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	ldapv3 "github.com/go-ldap/ldap/v3"
+)
+
+const (
+	DefaultGroupFilter        = "(objectClass=groupOfNames)"
+	DefaultGroupNameAttribute = "cn"
+	DefaultGroupIDAttribute   = "entryUUID"
+	DefaultMemberAttribute    = "member"
+	DefaultUserEmailAttribute = "mail"
+
+	// timeout of connections and requests to the server
+	timeout = 30 * time.Second
+	// page size of group searches, Active Directory limits results of
+	// searches without paging
+	pageSize = 500
+)
+
+// Config is the configuration of the server groups are read from
+type Config struct {
+	URL                string
+	BindDN             string
+	BindPassword       string
+	StartTLS           bool
+	InsecureSkipVerify bool
+	GroupSearchBases   []string
+	GroupFilter        string
+	GroupNameAttribute string
+	GroupIDAttribute   string
+	MemberAttribute    string
+	UserEmailAttribute string
+	NestedGroups       bool
+}
+
+// Group is a group of the directory, members are the emails of its
+// users
+type Group struct {
+	// ID is the stable id of the group, the DN of the group when it
+	// has no id attribute
+	ID   string
+	DN   string
+	Name string
+	// Members are the emails of the users of the group, sorted
+	Members []string
+	// Unresolved are the DNs of members without an email
+	Unresolved []string
+}
+
+func (c Config) withDefaults() Config {
+	if c.GroupFilter == "" {
+		c.GroupFilter = DefaultGroupFilter
+	}
+	if c.GroupNameAttribute == "" {
+		c.GroupNameAttribute = DefaultGroupNameAttribute
+	}
+	if c.GroupIDAttribute == "" {
+		c.GroupIDAttribute = DefaultGroupIDAttribute
+	}
+	if c.MemberAttribute == "" {
+		c.MemberAttribute = DefaultMemberAttribute
+	}
+	if c.UserEmailAttribute == "" {
+		c.UserEmailAttribute = DefaultUserEmailAttribute
+	}
+	return c
+}
+
+// Validate returns an error when c cannot be used to read groups
+func Validate(c Config) error {
+	u, err := url.Parse(c.URL)
+	if err != nil || u.Host == "" {
+		return fmt.Errorf("invalid url %q", c.URL)
+	}
+	switch u.Scheme {
+	case "ldap":
+	case "ldaps":
+		if c.StartTLS {
+			return fmt.Errorf("startTls cannot be used with ldaps urls")
+		}
+	default:
+		return fmt.Errorf("invalid url %q, scheme should be ldap or ldaps", c.URL)
+	}
+	if len(c.GroupSearchBases) == 0 {
+		return fmt.Errorf("no group search bases")
+	}
+	for _, base := range c.GroupSearchBases {
+		if _, err := ldapv3.ParseDN(base); err != nil {
+			return fmt.Errorf("invalid group search base %q: %v", base, err)
+		}
+	}
+	if _, err := ldapv3.CompileFilter(c.withDefaults().GroupFilter); err != nil {
+		return fmt.Errorf("invalid group filter %q: %v", c.GroupFilter, err)
+	}
+	if c.BindDN != "" {
+		if _, err := ldapv3.ParseDN(c.BindDN); err != nil {
+			return fmt.Errorf("invalid bind dn %q: %v", c.BindDN, err)
+		}
+	}
+	return nil
+}
+
+// searcher is the part of a connection groups are read with
+type searcher interface {
+	Search(*ldapv3.SearchRequest) (*ldapv3.SearchResult, error)
+	SearchWithPaging(*ldapv3.SearchRequest, uint32) (*ldapv3.SearchResult, error)
+}
+
+// dial connects and binds to the server of c
+func dial(c Config) (*ldapv3.Conn, error) {
+	tlsConfig := &tls.Config{InsecureSkipVerify: c.InsecureSkipVerify}
+	if u, err := url.Parse(c.URL); err == nil {
+		tlsConfig.ServerName = u.Hostname()
+	}
+	conn, err := ldapv3.DialURL(c.URL,
+		ldapv3.DialWithDialer(&net.Dialer{Timeout: timeout}),
+		ldapv3.DialWithTLSConfig(tlsConfig),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to %s: %w", c.URL, err)
+	}
+	conn.SetTimeout(timeout)
+	if c.StartTLS {
+		if err := conn.StartTLS(tlsConfig); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("unable to start tls: %w", err)
+		}
+	}
+	if c.BindDN != "" {
+		err = conn.Bind(c.BindDN, c.BindPassword)
+	} else {
+		err = conn.UnauthenticatedBind("")
+	}
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("unable to bind as %q: %w", c.BindDN, err)
+	}
+	return conn, nil
+}
+
+// Groups returns the groups of the directory of c matching its group
+// filter, sorted by name
+func Groups(ctx context.Context, c Config) ([]Group, error) {
+	if err := Validate(c); err != nil {
+		return nil, err
+	}
+	c = c.withDefaults()
+	conn, err := dial(c)
+	if err != nil {
+		return nil, err
+	}
+	// requests do not take a context, closing the connection aborts
+	// the pending one
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-done:
+		}
+	}()
+	defer conn.Close()
+
+	groups, err := readGroups(conn, c)
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	return groups, err
+}
+
+// entryGroup is a group entry and the normalized DNs of its members
+type entryGroup struct {
+	Group
+	members []string
+}
+
+// readGroups searches the group search bases of c for groups and
+// resolves their members to users
+func readGroups(s searcher, c Config) ([]Group, error) {
+	groups := map[string]*entryGroup{}
+	var order []string
+	for _, base := range c.GroupSearchBases {
+		req := ldapv3.NewSearchRequest(base, ldapv3.ScopeWholeSubtree, ldapv3.NeverDerefAliases, 0, 0, false,
+			c.GroupFilter, []string{c.GroupNameAttribute, c.GroupIDAttribute, c.MemberAttribute}, nil)
+		res, err := s.SearchWithPaging(req, pageSize)
+		if err != nil {
+			return nil, fmt.Errorf("unable to search groups of %q: %w", base, err)
+		}
+		for _, entry := range res.Entries {
+			dn := normalizeDN(entry.DN)
+			if _, ok := groups[dn]; ok {
+				// search bases can overlap
+				continue
+			}
+			g := &entryGroup{Group: Group{
+				ID:   entryID(entry, c.GroupIDAttribute),
+				DN:   entry.DN,
+				Name: entry.GetEqualFoldAttributeValue(c.GroupNameAttribute),
+			}}
+			if g.Name == "" {
+				return nil, fmt.Errorf("group %q has no %s attribute", entry.DN, c.GroupNameAttribute)
+			}
+			for _, m := range entry.GetEqualFoldAttributeValues(c.MemberAttribute) {
+				g.members = append(g.members, normalizeDN(m))
+			}
+			groups[dn] = g
+			order = append(order, dn)
+		}
+	}
+
+	r := &resolver{s: s, c: c, groups: groups, emails: map[string]string{}}
+	var result []Group
+	for _, dn := range order {
+		g := groups[dn]
+		users := map[string]bool{}
+		r.users(dn, users, map[string]bool{})
+		emails := map[string]bool{}
+		for user := range users {
+			email, err := r.email(user)
+			if err != nil {
+				return nil, err
+			}
+			if email == "" {
+				g.Unresolved = append(g.Unresolved, user)
+				continue
+			}
+			emails[email] = true
+		}
+		for email := range emails {
+			g.Members = append(g.Members, email)
+		}
+		sort.Strings(g.Members)
+		sort.Strings(g.Unresolved)
+		result = append(result, g.Group)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result, nil
+}
+
+// resolver resolves members of groups to users and users to emails
+type resolver struct {
+	s      searcher
+	c      Config
+	groups map[string]*entryGroup
+	// emails of user DNs already looked up
+	emails map[string]string
+}
+
+// users adds the DNs of the users of the group dn to users, members of
+// member groups are included when nested groups are enabled. visited
+// has the groups already expanded, which breaks cycles of nested
+// groups
+func (r *resolver) users(dn string, users map[string]bool, visited map[string]bool) {
+	if visited[dn] {
+		return
+	}
+	visited[dn] = true
+	for _, m := range r.groups[dn].members {
+		if _, ok := r.groups[m]; ok {
+			if r.c.NestedGroups {
+				r.users(m, users, visited)
+			}
+			continue
+		}
+		users[m] = true
+	}
+}
+
+// email returns the email of the user dn, empty when the user has
+// none or does not exist
+func (r *resolver) email(dn string) (string, error) {
+	if email, ok := r.emails[dn]; ok {
+		return email, nil
+	}
+	req := ldapv3.NewSearchRequest(dn, ldapv3.ScopeBaseObject, ldapv3.NeverDerefAliases, 1, 0, false,
+		"(objectClass=*)", []string{r.c.UserEmailAttribute}, nil)
+	res, err := r.s.Search(req)
+	if ldapv3.IsErrorWithCode(err, ldapv3.LDAPResultNoSuchObject) {
+		r.emails[dn] = ""
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("unable to look up member %q: %w", dn, err)
+	}
+	email := ""
+	if len(res.Entries) > 0 {
+		email = strings.TrimSpace(res.Entries[0].GetEqualFoldAttributeValue(r.c.UserEmailAttribute))
+	}
+	r.emails[dn] = email
+	return email, nil
+}
+
+// entryID returns the id attribute of entry as a string, the DN of the
+// entry when it has none. Binary ids are hex encoded, except for the
+// objectGUID of Active Directory which is formatted as a GUID.
+func entryID(entry *ldapv3.Entry, attribute string) string {
+	raw := entry.GetEqualFoldRawAttributeValue(attribute)
+	if len(raw) == 0 {
+		return normalizeDN(entry.DN)
+	}
+	if strings.EqualFold(attribute, "objectGUID") && len(raw) == 16 {
+		// the first three fields of objectGUIDs are little endian
+		b := make([]byte, 16)
+		copy(b, raw)
+		b[0], b[1], b[2], b[3] = raw[3], raw[2], raw[1], raw[0]
+		b[4], b[5] = raw[5], raw[4]
+		b[6], b[7] = raw[7], raw[6]
+		id, _ := uuid.FromBytes(b)
+		return id.String()
+	}
+	if !utf8.Valid(raw) {
+		return hex.EncodeToString(raw)
+	}
+	return string(raw)
+}
+
+// normalizeDN returns dn in a form equal DNs have in common, dn is
+// returned lowercased when it cannot be parsed
+func normalizeDN(dn string) string {
+	parsed, err := ldapv3.ParseDN(dn)
+	if err != nil {
+		return strings.ToLower(strings.TrimSpace(dn))
+	}
+	rdns := make([]string, 0, len(parsed.RDNs))
+	for _, rdn := range parsed.RDNs {
+		attrs := make([]string, 0, len(rdn.Attributes))
+		for _, a := range rdn.Attributes {
+			attrs = append(attrs, strings.ToLower(a.Type)+"="+strings.ToLower(a.Value))
+		}
+		sort.Strings(attrs)
+		rdns = append(rdns, strings.Join(attrs, "+"))
+	}
+	return strings.Join(rdns, ",")
+}
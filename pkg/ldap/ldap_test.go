@@ -0,0 +1,212 @@
+package ldap
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	ldapv3 "github.com/go-ldap/ldap/v3"
+)
+
+const (
+	testBindDN   = "cn=admin,dc=example,dc=com"
+	testPassword = "secret"
+)
+
+func testDirectory(t *testing.T) *testServer {
+	s := newTestServer(t, testBindDN, testPassword)
+	s.add("dc=example,dc=com", "objectClass", "domain")
+	s.add("ou=people,dc=example,dc=com", "objectClass", "organizationalUnit")
+	s.add("uid=alice,ou=people,dc=example,dc=com", "objectClass", "inetOrgPerson", "mail", "alice@example.com")
+	s.add("uid=bob,ou=people,dc=example,dc=com", "objectClass", "inetOrgPerson", "mail", "bob@example.com")
+	s.add("uid=carol,ou=people,dc=example,dc=com", "objectClass", "inetOrgPerson", "mail", "carol@example.com")
+	s.add("uid=dave,ou=people,dc=example,dc=com", "objectClass", "inetOrgPerson")
+	s.add("ou=groups,dc=example,dc=com", "objectClass", "organizationalUnit")
+	s.add("cn=engineering,ou=groups,dc=example,dc=com",
+		"objectClass", "groupOfNames",
+		"cn", "engineering",
+		"entryUUID", "6f1e6a8a-0d56-4c3b-9a43-2b0c1f6f9b01",
+		"member", "uid=alice,ou=people,dc=example,dc=com",
+		"member", "UID=Bob, OU=People, DC=Example, DC=Com",
+		"member", "cn=platform,ou=groups,dc=example,dc=com",
+	)
+	s.add("cn=platform,ou=groups,dc=example,dc=com",
+		"objectClass", "groupOfNames",
+		"cn", "platform",
+		"entryUUID", "0c1b7c0e-7a55-4a0e-8d59-5b8e0c9d7a02",
+		"member", "uid=carol,ou=people,dc=example,dc=com",
+		"member", "uid=dave,ou=people,dc=example,dc=com",
+		"member", "uid=gone,ou=people,dc=example,dc=com",
+		"member", "cn=engineering,ou=groups,dc=example,dc=com",
+	)
+	s.add("cn=printers,ou=groups,dc=example,dc=com",
+		"objectClass", "groupOfUniqueNames",
+		"cn", "printers",
+	)
+	s.add("ou=teams,dc=example,dc=com", "objectClass", "organizationalUnit")
+	s.add("cn=oncall,ou=teams,dc=example,dc=com",
+		"objectClass", "groupOfNames",
+		"cn", "oncall",
+		"member", "uid=bob,ou=people,dc=example,dc=com",
+	)
+	return s
+}
+
+func testConfig(s *testServer) Config {
+	return Config{
+		URL:              s.URL(),
+		BindDN:           testBindDN,
+		BindPassword:     testPassword,
+		GroupSearchBases: []string{"ou=groups,dc=example,dc=com"},
+	}
+}
+
+func TestGroups(t *testing.T) {
+	s := testDirectory(t)
+
+	groups, err := Groups(context.Background(), testConfig(s))
+	if err != nil {
+		t.Fatal("unable to read groups:", err)
+	}
+	expected := []Group{
+		{
+			ID:      "6f1e6a8a-0d56-4c3b-9a43-2b0c1f6f9b01",
+			DN:      "cn=engineering,ou=groups,dc=example,dc=com",
+			Name:    "engineering",
+			Members: []string{"alice@example.com", "bob@example.com"},
+		},
+		{
+			ID:         "0c1b7c0e-7a55-4a0e-8d59-5b8e0c9d7a02",
+			DN:         "cn=platform,ou=groups,dc=example,dc=com",
+			Name:       "platform",
+			Members:    []string{"carol@example.com"},
+			Unresolved: []string{"uid=dave,ou=people,dc=example,dc=com", "uid=gone,ou=people,dc=example,dc=com"},
+		},
+	}
+	if !reflect.DeepEqual(groups, expected) {
+		t.Errorf("expected groups %+v; got %+v", expected, groups)
+	}
+}
+
+func TestGroupsNested(t *testing.T) {
+	s := testDirectory(t)
+
+	c := testConfig(s)
+	c.NestedGroups = true
+	groups, err := Groups(context.Background(), c)
+	if err != nil {
+		t.Fatal("unable to read groups:", err)
+	}
+	// the groups are members of each other, both have all users
+	all := []string{"alice@example.com", "bob@example.com", "carol@example.com"}
+	if len(groups) != 2 {
+		t.Fatalf("expected 2 groups; got %v", len(groups))
+	}
+	for _, g := range groups {
+		if !reflect.DeepEqual(g.Members, all) {
+			t.Errorf("expected members of %s %v; got %v", g.Name, all, g.Members)
+		}
+	}
+}
+
+func TestGroupsSearchBasesAndFilter(t *testing.T) {
+	s := testDirectory(t)
+
+	c := testConfig(s)
+	c.GroupSearchBases = []string{"ou=groups,dc=example,dc=com", "ou=teams,dc=example,dc=com", "dc=example,dc=com"}
+	c.GroupFilter = "(|(objectClass=groupOfNames)(objectClass=groupOfUniqueNames))"
+	groups, err := Groups(context.Background(), c)
+	if err != nil {
+		t.Fatal("unable to read groups:", err)
+	}
+	var names []string
+	for _, g := range groups {
+		names = append(names, g.Name)
+	}
+	// groups found under several bases are only returned once
+	expected := []string{"engineering", "oncall", "platform", "printers"}
+	if !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected groups %v; got %v", expected, names)
+	}
+	// groups without an id attribute are identified by their DN
+	for _, g := range groups {
+		if g.Name == "oncall" && g.ID != "cn=oncall,ou=teams,dc=example,dc=com" {
+			t.Errorf("expected DN as id of group without id; got %q", g.ID)
+		}
+	}
+}
+
+func TestGroupsInvalidCredentials(t *testing.T) {
+	s := testDirectory(t)
+
+	c := testConfig(s)
+	c.BindPassword = "wrong"
+	_, err := Groups(context.Background(), c)
+	var lerr *ldapv3.Error
+	if !errors.As(err, &lerr) || lerr.ResultCode != ldapv3.LDAPResultInvalidCredentials {
+		t.Errorf("expected invalid credentials error; got %v", err)
+	}
+}
+
+func TestGroupsUnknownSearchBase(t *testing.T) {
+	s := testDirectory(t)
+
+	c := testConfig(s)
+	c.GroupSearchBases = []string{"ou=missing,dc=example,dc=com"}
+	if _, err := Groups(context.Background(), c); err == nil {
+		t.Error("expected error for unknown search base")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	valid := Config{URL: "ldaps://ldap.example.com", GroupSearchBases: []string{"dc=example,dc=com"}}
+	tt := []struct {
+		name   string
+		modify func(*Config)
+		valid  bool
+	}{
+		{"valid", func(c *Config) {}, true},
+		{"start tls", func(c *Config) { c.URL = "ldap://ldap.example.com:389"; c.StartTLS = true }, true},
+		{"start tls with ldaps", func(c *Config) { c.StartTLS = true }, false},
+		{"invalid scheme", func(c *Config) { c.URL = "https://ldap.example.com" }, false},
+		{"no host", func(c *Config) { c.URL = "ldap://" }, false},
+		{"no search base", func(c *Config) { c.GroupSearchBases = nil }, false},
+		{"invalid search base", func(c *Config) { c.GroupSearchBases = []string{"example"} }, false},
+		{"invalid filter", func(c *Config) { c.GroupFilter = "(objectClass=group" }, false},
+		{"invalid bind dn", func(c *Config) { c.BindDN = "admin" }, false},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			c := valid
+			tc.modify(&c)
+			err := Validate(c)
+			if tc.valid && err != nil {
+				t.Errorf("expected valid config; got %v", err)
+			}
+			if !tc.valid && err == nil {
+				t.Error("expected invalid config")
+			}
+		})
+	}
+}
+
+func TestEntryID(t *testing.T) {
+	guid := []byte{0x8a, 0x6a, 0x1e, 0x6f, 0x56, 0x0d, 0x3b, 0x4c, 0x9a, 0x43, 0x2b, 0x0c, 0x1f, 0x6f, 0x9b, 0x01}
+	entry := &ldapv3.Entry{
+		DN: "CN=Engineering,OU=Groups,DC=example,DC=com",
+		Attributes: []*ldapv3.EntryAttribute{
+			{Name: "objectGUID", Values: []string{string(guid)}, ByteValues: [][]byte{guid}},
+			{Name: "objectSid", Values: []string{"\xff\x01"}, ByteValues: [][]byte{{0xff, 0x01}}},
+		},
+	}
+	if id := entryID(entry, "objectGUID"); id != "6f1e6a8a-0d56-4c3b-9a43-2b0c1f6f9b01" {
+		t.Errorf("unexpected objectGUID id %q", id)
+	}
+	if id := entryID(entry, "objectSid"); id != "ff01" {
+		t.Errorf("unexpected binary id %q", id)
+	}
+	if id := entryID(entry, "entryUUID"); id != "cn=engineering,ou=groups,dc=example,dc=com" {
+		t.Errorf("unexpected id of entry without id attribute %q", id)
+	}
+}
@@ -0,0 +1,224 @@
+package ldap
+
+import (
+	"net"
+	"strings"
+	"sync"
+	"testing"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	ldapv3 "github.com/go-ldap/ldap/v3"
+)
+
+// testServer is an in-process stand-in of an LDAP server, it supports
+// simple binds and searches with equality, presence, and, or and not
+// filters
+type testServer struct {
+	t        *testing.T
+	ln       net.Listener
+	bindDN   string
+	password string
+
+	mu      sync.Mutex
+	entries []testEntry
+	// searches are the bases of the searches done
+	searches []string
+}
+
+type testEntry struct {
+	dn    string
+	attrs map[string][]string
+}
+
+func newTestServer(t *testing.T, bindDN, password string) *testServer {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &testServer{t: t, ln: ln, bindDN: bindDN, password: password}
+	go s.serve()
+	t.Cleanup(func() { ln.Close() })
+	return s
+}
+
+func (s *testServer) URL() string {
+	return "ldap://" + s.ln.Addr().String()
+}
+
+// add adds an entry, attrs are pairs of attribute names and values
+func (s *testServer) add(dn string, attrs ...string) {
+	e := testEntry{dn: dn, attrs: map[string][]string{}}
+	for i := 0; i+1 < len(attrs); i += 2 {
+		name := strings.ToLower(attrs[i])
+		e.attrs[name] = append(e.attrs[name], attrs[i+1])
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries = append(s.entries, e)
+}
+
+func (s *testServer) serve() {
+	for {
+		conn, err := s.ln.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *testServer) handle(conn net.Conn) {
+	defer conn.Close()
+	bound := false
+	for {
+		p, err := ber.ReadPacket(conn)
+		if err != nil || len(p.Children) < 2 {
+			return
+		}
+		id := p.Children[0].Value.(int64)
+		op := p.Children[1]
+		switch op.Tag {
+		case ldapv3.ApplicationBindRequest:
+			name := op.Children[1].Data.String()
+			password := op.Children[2].Data.String()
+			code := uint16(ldapv3.LDAPResultInvalidCredentials)
+			if (name == "" && password == "") || (name == s.bindDN && password == s.password) {
+				code = ldapv3.LDAPResultSuccess
+				bound = true
+			}
+			s.write(conn, id, result(ldapv3.ApplicationBindResponse, code))
+		case ldapv3.ApplicationUnbindRequest:
+			return
+		case ldapv3.ApplicationSearchRequest:
+			if !bound {
+				s.write(conn, id, result(ldapv3.ApplicationSearchResultDone, ldapv3.LDAPResultInsufficientAccessRights))
+				continue
+			}
+			s.search(conn, id, op)
+		default:
+			s.write(conn, id, result(ldapv3.ApplicationExtendedResponse, ldapv3.LDAPResultUnwillingToPerform))
+		}
+	}
+}
+
+func (s *testServer) search(conn net.Conn, id int64, op *ber.Packet) {
+	base := normalizeDN(op.Children[0].Data.String())
+	scope := op.Children[1].Value.(int64)
+	filter := op.Children[6]
+	var attrs []string
+	for _, a := range op.Children[7].Children {
+		attrs = append(attrs, strings.ToLower(a.Data.String()))
+	}
+
+	s.mu.Lock()
+	s.searches = append(s.searches, op.Children[0].Data.String())
+	entries := append([]testEntry(nil), s.entries...)
+	s.mu.Unlock()
+
+	found := false
+	for _, e := range entries {
+		dn := normalizeDN(e.dn)
+		if dn == base {
+			found = true
+		}
+		switch scope {
+		case ldapv3.ScopeBaseObject:
+			if dn != base {
+				continue
+			}
+		case ldapv3.ScopeSingleLevel:
+			if !strings.HasSuffix(dn, ","+base) || strings.Count(dn, ",") != strings.Count(base, ",")+1 {
+				continue
+			}
+		default:
+			if dn != base && !strings.HasSuffix(dn, ","+base) {
+				continue
+			}
+		}
+		if !matchFilter(filter, e) {
+			continue
+		}
+		entry := ber.Encode(ber.ClassApplication, ber.TypeConstructed, ldapv3.ApplicationSearchResultEntry, nil, "Search Result Entry")
+		entry.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, e.dn, "DN"))
+		list := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attributes")
+		for name, values := range e.attrs {
+			if len(attrs) > 0 && !contains(attrs, name) {
+				continue
+			}
+			attr := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attribute")
+			attr.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, name, "Type"))
+			vals := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "Values")
+			for _, v := range values {
+				vals.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, v, "Value"))
+			}
+			attr.AppendChild(vals)
+			list.AppendChild(attr)
+		}
+		entry.AppendChild(list)
+		s.write(conn, id, entry)
+	}
+	code := uint16(ldapv3.LDAPResultSuccess)
+	if !found {
+		code = ldapv3.LDAPResultNoSuchObject
+	}
+	s.write(conn, id, result(ldapv3.ApplicationSearchResultDone, code))
+}
+
+func (s *testServer) write(conn net.Conn, id int64, op *ber.Packet) {
+	p := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAP Response")
+	p.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, id, "MessageID"))
+	p.AppendChild(op)
+	if _, err := conn.Write(p.Bytes()); err != nil {
+		s.t.Log("unable to write response:", err)
+	}
+}
+
+func result(tag ber.Tag, code uint16) *ber.Packet {
+	p := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "Result")
+	p.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, int64(code), "Result Code"))
+	p.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Matched DN"))
+	p.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Diagnostic Message"))
+	return p
+}
+
+func matchFilter(f *ber.Packet, e testEntry) bool {
+	switch f.Tag {
+	case ldapv3.FilterAnd:
+		for _, c := range f.Children {
+			if !matchFilter(c, e) {
+				return false
+			}
+		}
+		return true
+	case ldapv3.FilterOr:
+		for _, c := range f.Children {
+			if matchFilter(c, e) {
+				return true
+			}
+		}
+		return false
+	case ldapv3.FilterNot:
+		return !matchFilter(f.Children[0], e)
+	case ldapv3.FilterEqualityMatch:
+		name := strings.ToLower(f.Children[0].Data.String())
+		for _, v := range e.attrs[name] {
+			if strings.EqualFold(v, f.Children[1].Data.String()) {
+				return true
+			}
+		}
+		return false
+	case ldapv3.FilterPresent:
+		name := strings.ToLower(f.Data.String())
+		return name == "objectclass" || len(e.attrs[name]) > 0
+	}
+	return false
+}
+
+func contains(values []string, v string) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
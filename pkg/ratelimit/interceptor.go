@@ -0,0 +1,98 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"strconv"
+
+	"github.com/paralus/paralus/pkg/common"
+	"github.com/paralus/paralus/pkg/gateway"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// clientPrincipal returns the address the request is made from, which
+// is known before the request is authenticated
+func clientPrincipal(ctx context.Context) Principal {
+	return Principal{IP: gateway.ClientAddr(ctx)}
+}
+
+// sessionPrincipal returns who the request is made by. Session data is
+// only available once the request has been authenticated, requests of
+// methods excluded from authentication are only limited by client
+// address.
+func sessionPrincipal(ctx context.Context) Principal {
+	var p Principal
+	md, _ := metadata.FromIncomingContext(ctx)
+	if sd, ok := ctx.Value(common.SessionDataKey).(*commonv3.SessionData); ok {
+		p.Account = sd.Account
+		p.Organization = sd.Organization
+		if sd.AuthType == commonv3.AuthType_APIKey {
+			if key := md.Get(gateway.APIKey); len(key) > 0 {
+				p.APIKey = key[0]
+			}
+		}
+	}
+	return p
+}
+
+func (l *Limiter) check(ctx context.Context, method string, p Principal) error {
+	ok, kind, retry := l.Allow(ctx, method, p)
+	if ok {
+		return nil
+	}
+	seconds := int(math.Ceil(retry.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+	grpc.SetHeader(ctx, metadata.Pairs(gateway.RetryAfter, strconv.Itoa(seconds)))
+	return status.Errorf(codes.ResourceExhausted, "rate limit exceeded for %s, retry after %ds", kind, seconds)
+}
+
+// ClientUnaryServerInterceptor limits unary requests by client
+// address. It has to be chained before the auth interceptor so that
+// requests failing authentication are limited too.
+func (l *Limiter) ClientUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := l.check(ctx, info.FullMethod, clientPrincipal(ctx)); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// ClientStreamServerInterceptor limits the opening of streams by client
+// address
+func (l *Limiter) ClientStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := l.check(ss.Context(), info.FullMethod, clientPrincipal(ss.Context())); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+// UnaryServerInterceptor limits unary requests by account, api key and
+// organization. It has to be chained after the auth interceptor.
+func (l *Limiter) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := l.check(ctx, info.FullMethod, sessionPrincipal(ctx)); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor limits the opening of streams by account, api
+// key and organization
+func (l *Limiter) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := l.check(ss.Context(), info.FullMethod, sessionPrincipal(ss.Context())); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,183 @@
+// Package ratelimit limits the requests of accounts, api keys,
+// organizations and client addresses with token buckets. Buckets are
+// kept in memory or in postgres to share them between replicas.
+package ratelimit
+
+import (
+	"context"
+	"expvar"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/paralus/paralus/pkg/log"
+	"sigs.k8s.io/yaml"
+)
+
+var _log = log.GetLogger()
+
+// throttled counts the throttled requests by key kind and method,
+// exposed on the debug server under /debug/vars
+var throttled = expvar.NewMap("ratelimit_throttled")
+
+// Kind is the kind of principal a bucket is kept for
+type Kind string
+
+// kinds of principals requests are limited for
+const (
+	KindAccount      Kind = "account"
+	KindAPIKey       Kind = "apikey"
+	KindOrganization Kind = "organization"
+	KindIP           Kind = "ip"
+)
+
+// stores buckets can be kept in
+const (
+	StoreMemory   = "memory"
+	StorePostgres = "postgres"
+)
+
+// Rule is a token bucket which is refilled with Rate tokens per
+// second up to Burst tokens. A rule without rate does not limit.
+type Rule struct {
+	Rate  float64 `json:"rate"`
+	Burst int     `json:"burst"`
+}
+
+// enabled checks if the rule limits requests
+func (r Rule) enabled() bool {
+	return r.Rate > 0
+}
+
+// burst returns the size of the bucket, at least one token
+func (r Rule) burst() float64 {
+	if r.Burst < 1 {
+		return 1
+	}
+	return float64(r.Burst)
+}
+
+// Rules are the rules for each kind of principal
+type Rules struct {
+	Account      Rule `json:"account"`
+	APIKey       Rule `json:"apiKey"`
+	Organization Rule `json:"organization"`
+	IP           Rule `json:"ip"`
+}
+
+func (r Rules) rule(kind Kind) Rule {
+	switch kind {
+	case KindAccount:
+		return r.Account
+	case KindAPIKey:
+		return r.APIKey
+	case KindOrganization:
+		return r.Organization
+	case KindIP:
+		return r.IP
+	}
+	return Rule{}
+}
+
+// Config is the rate limit configuration
+type Config struct {
+	Rules
+	// Store is where buckets are kept, memory (the default) or
+	// postgres
+	Store string `json:"store"`
+	// Methods overrides the rules for full rpc method names, for
+	// example /paralus.dev.sentry.rpc.BootstrapService/RegisterBootstrapAgent.
+	// Overridden rules use buckets of their own.
+	Methods map[string]Rules `json:"methods"`
+}
+
+// LoadConfig reads the YAML configuration at path
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("invalid rate limit config %s: %s", path, err)
+	}
+	if cfg.Store == "" {
+		cfg.Store = StoreMemory
+	}
+	if cfg.Store != StoreMemory && cfg.Store != StorePostgres {
+		return nil, fmt.Errorf("invalid rate limit store %q", cfg.Store)
+	}
+	return &cfg, nil
+}
+
+// Store keeps the token buckets
+type Store interface {
+	// Take takes a token from the bucket of key. If no token is
+	// available it returns false and the time until the next one.
+	Take(ctx context.Context, key string, rule Rule) (bool, time.Duration, error)
+}
+
+// Principal is who a request is made by. Empty fields are not limited.
+type Principal struct {
+	Account      string
+	APIKey       string
+	Organization string
+	IP           string
+}
+
+func (p Principal) value(kind Kind) string {
+	switch kind {
+	case KindAccount:
+		return p.Account
+	case KindAPIKey:
+		return p.APIKey
+	case KindOrganization:
+		return p.Organization
+	case KindIP:
+		return p.IP
+	}
+	return ""
+}
+
+// Limiter limits requests by principal and method
+type Limiter struct {
+	cfg   *Config
+	store Store
+}
+
+// NewLimiter returns a limiter for cfg keeping buckets in store
+func NewLimiter(cfg *Config, store Store) *Limiter {
+	return &Limiter{cfg: cfg, store: store}
+}
+
+// Allow takes a token from each bucket of the principal for method.
+// If one of them is empty it returns false, the kind of the bucket
+// and the time until the request may be retried. Failures of the
+// store do not throttle requests.
+func (l *Limiter) Allow(ctx context.Context, method string, p Principal) (bool, Kind, time.Duration) {
+	for _, kind := range []Kind{KindIP, KindAPIKey, KindAccount, KindOrganization} {
+		value := p.value(kind)
+		if value == "" {
+			continue
+		}
+		key := string(kind) + ":" + value
+		rule := l.cfg.rule(kind)
+		if override, ok := l.cfg.Methods[method]; ok && override.rule(kind).enabled() {
+			key = method + "|" + key
+			rule = override.rule(kind)
+		}
+		if !rule.enabled() {
+			continue
+		}
+		ok, retry, err := l.store.Take(ctx, key, rule)
+		if err != nil {
+			_log.Warnw("unable to check rate limit", "key", key, "error", err)
+			continue
+		}
+		if !ok {
+			throttled.Add(string(kind)+" "+method, 1)
+			return false, kind, retry
+		}
+	}
+	return true, "", 0
+}
@@ -0,0 +1,174 @@
+package ratelimit
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/paralus/paralus/pkg/common"
+	"github.com/paralus/paralus/pkg/gateway"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestMemoryStore(t *testing.T) {
+	now := time.Now()
+	s := &memoryStore{buckets: map[string]*bucket{}, prunedAt: now, now: func() time.Time { return now }}
+	rule := Rule{Rate: 1, Burst: 2}
+
+	for i := 0; i < 2; i++ {
+		if ok, _, _ := s.Take(context.Background(), "ip:10.0.0.1", rule); !ok {
+			t.Fatalf("request %d within burst throttled", i)
+		}
+	}
+	ok, retry, _ := s.Take(context.Background(), "ip:10.0.0.1", rule)
+	if ok {
+		t.Fatal("request beyond burst not throttled")
+	}
+	if retry != time.Second {
+		t.Errorf("expected retry after 1s; got %v", retry)
+	}
+	if ok, _, _ := s.Take(context.Background(), "ip:10.0.0.2", rule); !ok {
+		t.Error("request of other key throttled")
+	}
+
+	now = now.Add(time.Second)
+	if ok, _, _ := s.Take(context.Background(), "ip:10.0.0.1", rule); !ok {
+		t.Error("request after refill throttled")
+	}
+}
+
+type countingStore struct {
+	keys []string
+}
+
+func (s *countingStore) Take(ctx context.Context, key string, rule Rule) (bool, time.Duration, error) {
+	s.keys = append(s.keys, key)
+	return key != "ip:10.0.0.9", time.Second, nil
+}
+
+func TestLimiterAllow(t *testing.T) {
+	cfg := &Config{
+		Rules: Rules{Account: Rule{Rate: 10}, IP: Rule{Rate: 10}},
+		Methods: map[string]Rules{
+			"/paralus.dev.sentry.rpc.BootstrapService/RegisterBootstrapAgent": {IP: Rule{Rate: 1}},
+		},
+	}
+	store := &countingStore{}
+	l := NewLimiter(cfg, store)
+
+	ok, _, _ := l.Allow(context.Background(), "/paralus.dev.rpc.user.v3.UserService/GetUsers",
+		Principal{Account: "a1", APIKey: "k1", Organization: "o1", IP: "10.0.0.1"})
+	if !ok {
+		t.Fatal("request throttled")
+	}
+	// api keys and organizations have no rule
+	if len(store.keys) != 2 || store.keys[0] != "ip:10.0.0.1" || store.keys[1] != "account:a1" {
+		t.Errorf("unexpected buckets %v", store.keys)
+	}
+
+	store.keys = nil
+	l.Allow(context.Background(), "/paralus.dev.sentry.rpc.BootstrapService/RegisterBootstrapAgent", Principal{IP: "10.0.0.1"})
+	if len(store.keys) != 1 || store.keys[0] != "/paralus.dev.sentry.rpc.BootstrapService/RegisterBootstrapAgent|ip:10.0.0.1" {
+		t.Errorf("expected bucket of method override; got %v", store.keys)
+	}
+
+	ok, kind, retry := l.Allow(context.Background(), "/paralus.dev.rpc.user.v3.UserService/GetUsers", Principal{IP: "10.0.0.9"})
+	if ok || kind != KindIP || retry != time.Second {
+		t.Errorf("expected throttled ip; got %v %v %v", ok, kind, retry)
+	}
+}
+
+func TestPostgresStore(t *testing.T) {
+	sqldb, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	if err != nil {
+		t.Fatal("unable to create sqlmock:", err)
+	}
+	db := bun.NewDB(sqldb, pgdialect.New())
+	defer db.Close()
+
+	s := NewPostgresStore(db)
+	mock.ExpectQuery(`INSERT INTO "authsrv_ratelimit_bucket" AS "bucket" .*'ip:10.0.0.1', 4, TRUE.* ON CONFLICT \(key\) DO UPDATE SET tokens = CASE WHEN LEAST\(5, bucket.tokens .* RETURNING tokens, allowed`).
+		WillReturnRows(sqlmock.NewRows([]string{"tokens", "allowed"}).AddRow(0.5, false))
+
+	ok, retry, err := s.Take(context.Background(), "ip:10.0.0.1", Rule{Rate: 2, Burst: 5})
+	if err != nil {
+		t.Fatal("unable to take token:", err)
+	}
+	if ok || retry != 250*time.Millisecond {
+		t.Errorf("expected throttled with retry after 250ms; got %v %v", ok, retry)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ratelimit.yaml")
+	data := `
+store: postgres
+account:
+  rate: 5
+  burst: 20
+methods:
+  /paralus.dev.rpc.user.v3.UserService/AuditLogWebhook:
+    ip:
+      rate: 1
+`
+	if err := os.WriteFile(path, []byte(data), 0600); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := LoadConfig(path)
+	if err != nil {
+		t.Fatal("unable to load config:", err)
+	}
+	if cfg.Store != StorePostgres || cfg.Account.Burst != 20 || cfg.Methods["/paralus.dev.rpc.user.v3.UserService/AuditLogWebhook"].IP.Rate != 1 {
+		t.Errorf("unexpected config %+v", cfg)
+	}
+
+	if err := os.WriteFile(path, []byte("store: redis"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadConfig(path); err == nil {
+		t.Error("loaded config with unknown store")
+	}
+}
+
+func TestClientUnaryServerInterceptor(t *testing.T) {
+	store := &countingStore{}
+	l := NewLimiter(&Config{Rules: Rules{Account: Rule{Rate: 10}, IP: Rule{Rate: 10}}}, store)
+	info := &grpc.UnaryServerInfo{FullMethod: "/paralus.dev.rpc.user.v3.UserService/GetUsers"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil }
+
+	// requests from the direct client 10.0.0.9 are throttled whatever
+	// address they claim to be forwarded for
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.9"), Port: 50000}})
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(gateway.RemoteAddr, "10.0.0.1:41000"))
+	ctx = context.WithValue(ctx, common.SessionDataKey, &commonv3.SessionData{Account: "a1"})
+
+	_, err := l.ClientUnaryServerInterceptor()(ctx, nil, info, handler)
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("expected throttled client address; got %v", err)
+	}
+	if len(store.keys) != 1 || store.keys[0] != "ip:10.0.0.9" {
+		t.Errorf("expected only the bucket of the client address; got %v", store.keys)
+	}
+
+	store.keys = nil
+	if _, err := l.UnaryServerInterceptor()(ctx, nil, info, handler); err != nil {
+		t.Fatal("request throttled:", err)
+	}
+	if len(store.keys) != 1 || store.keys[0] != "account:a1" {
+		t.Errorf("expected only the bucket of the account; got %v", store.keys)
+	}
+}
@@ -0,0 +1,124 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/paralus/paralus/internal/models"
+	"github.com/uptrace/bun"
+)
+
+// bucketTTL is how long unused buckets are kept, buckets of all rules
+// are full again long before that
+const bucketTTL = time.Hour
+
+// retryAfter returns the time until the bucket holds a token again
+func retryAfter(tokens float64, rule Rule) time.Duration {
+	return time.Duration(math.Ceil((1-tokens)/rule.Rate*1000)) * time.Millisecond
+}
+
+type bucket struct {
+	tokens    float64
+	updatedAt time.Time
+}
+
+// memoryStore keeps buckets of a single replica
+type memoryStore struct {
+	mu       sync.Mutex
+	buckets  map[string]*bucket
+	prunedAt time.Time
+	now      func() time.Time
+}
+
+// NewMemoryStore returns a store keeping buckets in memory
+func NewMemoryStore() Store {
+	return &memoryStore{buckets: map[string]*bucket{}, prunedAt: time.Now(), now: time.Now}
+}
+
+func (s *memoryStore) Take(ctx context.Context, key string, rule Rule) (bool, time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if now.Sub(s.prunedAt) > bucketTTL {
+		for k, b := range s.buckets {
+			if now.Sub(b.updatedAt) > bucketTTL {
+				delete(s.buckets, k)
+			}
+		}
+		s.prunedAt = now
+	}
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{tokens: rule.burst(), updatedAt: now}
+		s.buckets[key] = b
+	}
+	b.tokens = math.Min(rule.burst(), b.tokens+now.Sub(b.updatedAt).Seconds()*rule.Rate)
+	b.updatedAt = now
+	if b.tokens < 1 {
+		return false, retryAfter(b.tokens, rule), nil
+	}
+	b.tokens--
+	return true, 0, nil
+}
+
+// postgresStore keeps buckets in a table shared by all replicas
+type postgresStore struct {
+	db *bun.DB
+
+	mu       sync.Mutex
+	prunedAt time.Time
+}
+
+// NewPostgresStore returns a store keeping buckets in postgres
+func NewPostgresStore(db *bun.DB) Store {
+	return &postgresStore{db: db, prunedAt: time.Now()}
+}
+
+func (s *postgresStore) prune(ctx context.Context) {
+	s.mu.Lock()
+	if time.Since(s.prunedAt) < bucketTTL {
+		s.mu.Unlock()
+		return
+	}
+	s.prunedAt = time.Now()
+	s.mu.Unlock()
+
+	_, err := s.db.NewDelete().Model((*models.RateLimitBucket)(nil)).
+		Where("updated_at < ?", time.Now().Add(-bucketTTL)).
+		Exec(ctx)
+	if err != nil {
+		_log.Warnw("unable to prune rate limit buckets", "error", err)
+	}
+}
+
+func (s *postgresStore) Take(ctx context.Context, key string, rule Rule) (bool, time.Duration, error) {
+	s.prune(ctx)
+
+	// the bucket is refilled and a token taken in a single statement
+	// so that concurrent requests of all replicas are counted
+	b := &models.RateLimitBucket{
+		Key:     key,
+		Tokens:  rule.burst() - 1,
+		Allowed: true,
+	}
+	refilled := "LEAST(?, bucket.tokens + EXTRACT(EPOCH FROM (now() - bucket.updated_at)) * ?)"
+	_, err := s.db.NewInsert().Model(b).
+		On("CONFLICT (key) DO UPDATE").
+		Set("tokens = CASE WHEN "+refilled+" >= 1 THEN "+refilled+" - 1 ELSE "+refilled+" END",
+			rule.burst(), rule.Rate, rule.burst(), rule.Rate, rule.burst(), rule.Rate).
+		Set("allowed = "+refilled+" >= 1", rule.burst(), rule.Rate).
+		Set("updated_at = now()").
+		Returning("tokens, allowed").
+		Exec(ctx)
+	if err != nil {
+		return false, 0, err
+	}
+	if !b.Allowed {
+		return false, retryAfter(b.Tokens, rule), nil
+	}
+	return true, 0, nil
+}
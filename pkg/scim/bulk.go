@@ -0,0 +1,188 @@
+package scim
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// bulkRequest is a bulk request (RFC 7644 section 3.7)
+type bulkRequest struct {
+	Schemas      []string        `json:"schemas"`
+	FailOnErrors int             `json:"failOnErrors,omitempty"`
+	Operations   []bulkOperation `json:"Operations"`
+}
+
+type bulkOperation struct {
+	Method string          `json:"method"`
+	BulkID string          `json:"bulkId,omitempty"`
+	Path   string          `json:"path"`
+	Data   json.RawMessage `json:"data,omitempty"`
+}
+
+type bulkResult struct {
+	Method   string          `json:"method"`
+	BulkID   string          `json:"bulkId,omitempty"`
+	Location string          `json:"location,omitempty"`
+	Status   string          `json:"status"`
+	Response json.RawMessage `json:"response,omitempty"`
+}
+
+// bulkIDReference matches references to resources created by other
+// operations of a bulk request
+var bulkIDReference = regexp.MustCompile(`bulkId:([^"/\s]+)`)
+
+// bulkResponseWriter keeps the response of an operation of a bulk
+// request
+type bulkResponseWriter struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (w *bulkResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *bulkResponseWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	return w.body.Write(b)
+}
+
+func (w *bulkResponseWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+}
+
+// resolveBulkIDs replaces bulkId references of s with the ids of the
+// resources created for them
+func resolveBulkIDs(s string, ids map[string]string) (string, error) {
+	var missing string
+	resolved := bulkIDReference.ReplaceAllStringFunc(s, func(ref string) string {
+		bulkID := ref[len("bulkId:"):]
+		id, ok := ids[bulkID]
+		if !ok {
+			missing = bulkID
+			return ref
+		}
+		return id
+	})
+	if missing != "" {
+		return "", fmt.Errorf("bulkId %s does not reference a created resource", missing)
+	}
+	return resolved, nil
+}
+
+// errorResult returns the result of an operation failing with err
+func errorResult(op bulkOperation, err *scimError) bulkResult {
+	body, _ := json.Marshal(errorResponse(err))
+	return bulkResult{Method: op.Method, BulkID: op.BulkID, Status: strconv.Itoa(err.Status), Response: body}
+}
+
+// bulkOperationResult performs an operation of a bulk request with
+// the handlers of the resource, ids has the ids of resources created
+// by earlier operations
+func (s *Server) bulkOperationResult(r *http.Request, op bulkOperation, ids map[string]string) bulkResult {
+	method := strings.ToUpper(op.Method)
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+	default:
+		return errorResult(op, errBadRequest("invalidValue", fmt.Sprintf("unsupported method %q", op.Method)))
+	}
+	if method == http.MethodPost && op.BulkID == "" {
+		return errorResult(op, errBadRequest("invalidValue", "bulkId is required for POST operations"))
+	}
+	if !strings.HasPrefix(op.Path, "/Users") && !strings.HasPrefix(op.Path, "/Groups") {
+		return errorResult(op, errBadRequest("invalidPath", fmt.Sprintf("unsupported path %q", op.Path)))
+	}
+	path, err := resolveBulkIDs(op.Path, ids)
+	if err != nil {
+		return errorResult(op, &scimError{Status: http.StatusConflict, ScimType: "invalidValue", Detail: err.Error()})
+	}
+	data, err := resolveBulkIDs(string(op.Data), ids)
+	if err != nil {
+		return errorResult(op, &scimError{Status: http.StatusConflict, ScimType: "invalidValue", Detail: err.Error()})
+	}
+
+	req, err := http.NewRequestWithContext(r.Context(), method, BasePath+path, strings.NewReader(data))
+	if err != nil {
+		return errorResult(op, errBadRequest("invalidPath", err.Error()))
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Host = r.Host
+	w := &bulkResponseWriter{header: http.Header{}}
+	s.mux.ServeHTTP(w, req)
+
+	result := bulkResult{Method: op.Method, BulkID: op.BulkID, Status: strconv.Itoa(w.status)}
+	if w.status >= http.StatusBadRequest {
+		result.Response = json.RawMessage(bytes.TrimSpace(w.body.Bytes()))
+		return result
+	}
+	var created struct {
+		ID   string `json:"id"`
+		Meta struct {
+			Location string `json:"location"`
+		} `json:"meta"`
+	}
+	if w.body.Len() > 0 {
+		if err := json.Unmarshal(w.body.Bytes(), &created); err != nil {
+			return errorResult(op, errInternal(err))
+		}
+	}
+	result.Location = created.Meta.Location
+	if method == http.MethodPost && created.ID != "" {
+		ids[op.BulkID] = created.ID
+	}
+	return result
+}
+
+// bulk performs the operations of a bulk request in order, processing
+// stops once failOnErrors operations failed
+func (s *Server) bulk(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		var mbe *http.MaxBytesError
+		if errors.As(err, &mbe) {
+			writeError(w, &scimError{Status: http.StatusRequestEntityTooLarge, Detail: fmt.Sprintf("bulk requests are limited to %d bytes", maxBulkPayloadSize)})
+			return
+		}
+		writeError(w, errBadRequest("invalidSyntax", err.Error()))
+		return
+	}
+	var req bulkRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		writeError(w, errBadRequest("invalidSyntax", "unable to parse request body: "+err.Error()))
+		return
+	}
+	if len(req.Operations) > maxBulkOperations {
+		writeError(w, &scimError{Status: http.StatusRequestEntityTooLarge, Detail: fmt.Sprintf("bulk requests are limited to %d operations", maxBulkOperations)})
+		return
+	}
+
+	ids := map[string]string{}
+	results := []bulkResult{}
+	failed := 0
+	for _, op := range req.Operations {
+		result := s.bulkOperationResult(r, op, ids)
+		results = append(results, result)
+		if status, _ := strconv.Atoi(result.Status); status >= http.StatusBadRequest {
+			failed++
+			if req.FailOnErrors > 0 && failed >= req.FailOnErrors {
+				break
+			}
+		}
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"schemas":    []string{bulkResponseSchema},
+		"Operations": results,
+	})
+}
@@ -0,0 +1,425 @@
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// filter is a parsed SCIM filter expression (RFC 7644 section 3.4.2.2),
+// it is evaluated against the JSON object of a resource
+type filter interface {
+	match(res map[string]interface{}) bool
+}
+
+type logicalExpr struct {
+	and         bool
+	left, right filter
+}
+
+func (e *logicalExpr) match(res map[string]interface{}) bool {
+	if e.and {
+		return e.left.match(res) && e.right.match(res)
+	}
+	return e.left.match(res) || e.right.match(res)
+}
+
+type notExpr struct {
+	f filter
+}
+
+func (e *notExpr) match(res map[string]interface{}) bool {
+	return !e.f.match(res)
+}
+
+// attrExpr compares the attribute at path with value, op pr checks
+// that the attribute has a value
+type attrExpr struct {
+	path  []string
+	op    string
+	value interface{}
+}
+
+func (e *attrExpr) match(res map[string]interface{}) bool {
+	for _, v := range lookup(res, e.path) {
+		if compare(v, e.op, e.value) {
+			return true
+		}
+	}
+	return false
+}
+
+// valuePathExpr matches resources with an element of the multi-valued
+// attribute at path matching f, e.g. emails[type eq "work"]
+type valuePathExpr struct {
+	path []string
+	f    filter
+}
+
+func (e *valuePathExpr) match(res map[string]interface{}) bool {
+	for _, v := range elements(res, e.path) {
+		if m, ok := v.(map[string]interface{}); ok && e.f.match(m) {
+			return true
+		}
+	}
+	return false
+}
+
+// stripSchema removes the schema urn attributes can be prefixed with
+func stripSchema(attr string) string {
+	if !strings.HasPrefix(strings.ToLower(attr), "urn:") {
+		return attr
+	}
+	return attr[strings.LastIndex(attr, ":")+1:]
+}
+
+// parseAttrPath splits an attribute path into the attribute and its
+// sub-attribute
+func parseAttrPath(attr string) []string {
+	return strings.Split(stripSchema(attr), ".")
+}
+
+// getKey returns the value of the attribute name of m, attribute names
+// are case insensitive
+func getKey(m map[string]interface{}, name string) (string, interface{}, bool) {
+	if v, ok := m[name]; ok {
+		return name, v, true
+	}
+	for k, v := range m {
+		if strings.EqualFold(k, name) {
+			return k, v, true
+		}
+	}
+	return name, nil, false
+}
+
+// lookup returns the values of the attribute at path, values of
+// multi-valued attributes are flattened. Multi-valued attributes
+// without a sub-attribute are compared by their value sub-attribute.
+func lookup(v interface{}, path []string) []interface{} {
+	if arr, ok := v.([]interface{}); ok {
+		var values []interface{}
+		for _, e := range arr {
+			values = append(values, lookup(e, path)...)
+		}
+		return values
+	}
+	if len(path) == 0 {
+		if m, ok := v.(map[string]interface{}); ok {
+			if _, value, ok := getKey(m, "value"); ok {
+				return []interface{}{value}
+			}
+		}
+		return []interface{}{v}
+	}
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	_, value, ok := getKey(m, path[0])
+	if !ok || value == nil {
+		return nil
+	}
+	return lookup(value, path[1:])
+}
+
+// elements returns the elements of the multi-valued attribute at path
+func elements(res map[string]interface{}, path []string) []interface{} {
+	var v interface{} = res
+	for _, name := range path {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		_, v, _ = getKey(m, name)
+	}
+	if arr, ok := v.([]interface{}); ok {
+		return arr
+	}
+	if v == nil {
+		return nil
+	}
+	return []interface{}{v}
+}
+
+func compare(v interface{}, op string, value interface{}) bool {
+	if op == "pr" {
+		switch t := v.(type) {
+		case nil:
+			return false
+		case string:
+			return t != ""
+		}
+		return true
+	}
+	switch t := v.(type) {
+	case string:
+		s, ok := value.(string)
+		if !ok {
+			return op == "ne"
+		}
+		a, b := strings.ToLower(t), strings.ToLower(s)
+		switch op {
+		case "eq":
+			return a == b
+		case "ne":
+			return a != b
+		case "co":
+			return strings.Contains(a, b)
+		case "sw":
+			return strings.HasPrefix(a, b)
+		case "ew":
+			return strings.HasSuffix(a, b)
+		case "gt":
+			return a > b
+		case "ge":
+			return a >= b
+		case "lt":
+			return a < b
+		case "le":
+			return a <= b
+		}
+	case bool:
+		b, ok := value.(bool)
+		switch op {
+		case "eq":
+			return ok && t == b
+		case "ne":
+			return !ok || t != b
+		}
+	case float64:
+		n, ok := value.(float64)
+		if !ok {
+			return op == "ne"
+		}
+		switch op {
+		case "eq":
+			return t == n
+		case "ne":
+			return t != n
+		case "gt":
+			return t > n
+		case "ge":
+			return t >= n
+		case "lt":
+			return t < n
+		case "le":
+			return t <= n
+		}
+	case nil:
+		switch op {
+		case "eq":
+			return value == nil
+		case "ne":
+			return value != nil
+		}
+	}
+	return false
+}
+
+var compareOps = map[string]bool{
+	"eq": true, "ne": true, "co": true, "sw": true, "ew": true,
+	"gt": true, "ge": true, "lt": true, "le": true,
+}
+
+// tokenize splits a filter into attribute paths, operators, values and
+// the brackets grouping them
+func tokenize(s string) ([]string, error) {
+	var tokens []string
+	for i := 0; i < len(s); {
+		switch c := s[i]; {
+		case c == ' ' || c == '\t':
+			i++
+		case c == '(' || c == ')' || c == '[' || c == ']':
+			tokens = append(tokens, string(c))
+			i++
+		case c == '"':
+			j := i + 1
+			for ; j < len(s) && s[j] != '"'; j++ {
+				if s[j] == '\\' {
+					j++
+				}
+			}
+			if j >= len(s) {
+				return nil, fmt.Errorf("unterminated string in filter")
+			}
+			tokens = append(tokens, s[i:j+1])
+			i = j + 1
+		default:
+			j := i
+			for ; j < len(s) && !strings.ContainsRune(" \t()[]\"", rune(s[j])); j++ {
+			}
+			tokens = append(tokens, s[i:j])
+			i = j
+		}
+	}
+	return tokens, nil
+}
+
+type filterParser struct {
+	tokens []string
+	pos    int
+}
+
+// parseFilter parses a SCIM filter expression
+func parseFilter(s string) (filter, error) {
+	tokens, err := tokenize(s)
+	if err != nil {
+		return nil, err
+	}
+	p := &filterParser{tokens: tokens}
+	f, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("unexpected %q in filter", p.tokens[p.pos])
+	}
+	return f, nil
+}
+
+func (p *filterParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *filterParser) next() string {
+	t := p.peek()
+	p.pos++
+	return t
+}
+
+func (p *filterParser) expect(t string) error {
+	if got := p.next(); got != t {
+		if got == "" {
+			return fmt.Errorf("expected %q at end of filter", t)
+		}
+		return fmt.Errorf("expected %q instead of %q in filter", t, got)
+	}
+	return nil
+}
+
+func (p *filterParser) parseOr() (filter, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for strings.EqualFold(p.peek(), "or") {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &logicalExpr{and: false, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseAnd() (filter, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for strings.EqualFold(p.peek(), "and") {
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &logicalExpr{and: true, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseUnary() (filter, error) {
+	t := p.peek()
+	switch {
+	case strings.EqualFold(t, "not"):
+		p.next()
+		if err := p.expect("("); err != nil {
+			return nil, err
+		}
+		f, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return &notExpr{f: f}, nil
+	case t == "(":
+		p.next()
+		f, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return f, nil
+	case t == "":
+		return nil, fmt.Errorf("unexpected end of filter")
+	}
+	return p.parseAttrExpr()
+}
+
+func (p *filterParser) parseAttrExpr() (filter, error) {
+	attr := p.next()
+	if strings.ContainsAny(attr, "()[]\"") {
+		return nil, fmt.Errorf("expected attribute instead of %q in filter", attr)
+	}
+	path := parseAttrPath(attr)
+	if p.peek() == "[" {
+		p.next()
+		f, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect("]"); err != nil {
+			return nil, err
+		}
+		return &valuePathExpr{path: path, f: f}, nil
+	}
+
+	op := strings.ToLower(p.next())
+	if op == "pr" {
+		return &attrExpr{path: path, op: op}, nil
+	}
+	if !compareOps[op] {
+		return nil, fmt.Errorf("unknown operator %q in filter", op)
+	}
+	value, err := parseValue(p.next())
+	if err != nil {
+		return nil, err
+	}
+	return &attrExpr{path: path, op: op, value: value}, nil
+}
+
+// parseValue parses the comparison value of a filter
+func parseValue(t string) (interface{}, error) {
+	switch strings.ToLower(t) {
+	case "":
+		return nil, fmt.Errorf("expected value at end of filter")
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	}
+	if strings.HasPrefix(t, "\"") {
+		var s string
+		if err := json.Unmarshal([]byte(t), &s); err != nil {
+			return nil, fmt.Errorf("invalid string %s in filter", t)
+		}
+		return s, nil
+	}
+	n, err := strconv.ParseFloat(t, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value %q in filter", t)
+	}
+	return n, nil
+}
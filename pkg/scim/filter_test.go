@@ -0,0 +1,72 @@
+package scim
+
+import "testing"
+
+func TestParseFilter(t *testing.T) {
+	user := map[string]interface{}{
+		"userName": "Jane@Example.com",
+		"active":   true,
+		"name":     map[string]interface{}{"givenName": "Jane", "familyName": "Doe"},
+		"emails": []interface{}{
+			map[string]interface{}{"value": "jane@example.com", "type": "work", "primary": true},
+		},
+		"groups": []interface{}{
+			map[string]interface{}{"value": "1b4e28ba-2fa1-11d2-883f-0016d3cca427", "display": "developers"},
+		},
+	}
+
+	tt := []struct {
+		filter  string
+		matches bool
+	}{
+		{`userName eq "jane@example.com"`, true},
+		{`USERNAME Eq "JANE@EXAMPLE.COM"`, true},
+		{`urn:ietf:params:scim:schemas:core:2.0:User:userName eq "jane@example.com"`, true},
+		{`userName ne "jane@example.com"`, false},
+		{`userName sw "jane"`, true},
+		{`userName ew "example.org"`, false},
+		{`userName co "@"`, true},
+		{`name.familyName eq "Doe"`, true},
+		{`name.middleName pr`, false},
+		{`title pr`, false},
+		{`active eq true`, true},
+		{`active eq false`, false},
+		{`emails[type eq "work" and value co "example.com"]`, true},
+		{`emails[type eq "home"]`, false},
+		{`emails.value eq "jane@example.com"`, true},
+		{`groups eq "1b4e28ba-2fa1-11d2-883f-0016d3cca427"`, true},
+		{`userName eq "john@example.com" or name.givenName eq "Jane"`, true},
+		{`userName eq "jane@example.com" and active eq false`, false},
+		{`not (active eq false)`, true},
+		{`(userName eq "john@example.com" or active eq true) and name.givenName sw "J"`, true},
+	}
+	for _, tc := range tt {
+		f, err := parseFilter(tc.filter)
+		if err != nil {
+			t.Errorf("unable to parse filter %s: %v", tc.filter, err)
+			continue
+		}
+		if got := f.match(user); got != tc.matches {
+			t.Errorf("filter %s, expected match %v; got %v", tc.filter, tc.matches, got)
+		}
+	}
+}
+
+func TestParseInvalidFilter(t *testing.T) {
+	for _, f := range []string{
+		``,
+		`userName`,
+		`userName eq`,
+		`userName is "jane"`,
+		`userName eq "jane`,
+		`(userName eq "jane"`,
+		`userName eq "jane")`,
+		`emails[type eq "work"`,
+		`not userName eq "jane"`,
+		`userName eq jane`,
+	} {
+		if _, err := parseFilter(f); err == nil {
+			t.Errorf("invalid filter %q parsed", f)
+		}
+	}
+}
@@ -0,0 +1,335 @@
+package scim
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/dao"
+	"github.com/paralus/paralus/internal/models"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	userv3 "github.com/paralus/paralus/proto/types/userpb/v3"
+)
+
+// groupResource is the SCIM Group of a paralus group, members are
+// users of the organization
+type groupResource struct {
+	Schemas     []string      `json:"schemas"`
+	ID          string        `json:"id,omitempty"`
+	DisplayName string        `json:"displayName"`
+	Members     []multiValue  `json:"members,omitempty"`
+	Meta        *resourceMeta `json:"meta,omitempty"`
+}
+
+// groupResourceOf returns the SCIM Group of a group, members are only
+// set when loaded
+func groupResourceOf(sc *scope, grp *models.Group, members []models.KratosIdentities) *groupResource {
+	res := &groupResource{
+		Schemas:     []string{groupSchema},
+		ID:          grp.ID.String(),
+		DisplayName: grp.Name,
+		Meta: &resourceMeta{
+			ResourceType: "Group",
+			Created:      grp.CreatedAt.UTC().Format(time.RFC3339),
+			LastModified: grp.ModifiedAt.UTC().Format(time.RFC3339),
+			Location:     sc.baseURL + "/Groups/" + grp.ID.String(),
+		},
+	}
+	for _, m := range members {
+		res.Members = append(res.Members, multiValue{
+			Value:   m.ID.String(),
+			Ref:     sc.baseURL + "/Users/" + m.ID.String(),
+			Display: traitString(m.Traits, "email"),
+			Type:    "User",
+		})
+	}
+	return res
+}
+
+// groupMap returns the JSON object of the SCIM Group of a group
+func (s *Server) groupMap(ctx context.Context, sc *scope, grp *models.Group) (map[string]interface{}, error) {
+	members, err := dao.GetUsers(ctx, s.db, grp.ID)
+	if err != nil {
+		return nil, err
+	}
+	return toMap(groupResourceOf(sc, grp, members))
+}
+
+// findGroup returns a group of the organization
+func (s *Server) findGroup(ctx context.Context, sc *scope, id string) (*models.Group, error) {
+	gid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, errNotFound(fmt.Sprintf("group %s not found", id))
+	}
+	grp := &models.Group{}
+	_, err = dao.GetByID(ctx, s.db, gid, grp)
+	if err == sql.ErrNoRows || (err == nil && grp.OrganizationId != sc.organizationID) {
+		return nil, errNotFound(fmt.Sprintf("group %s not found", id))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return grp, nil
+}
+
+// memberEmails returns the usernames of the members of a SCIM Group,
+// members have to be users of the organization
+func (s *Server) memberEmails(ctx context.Context, sc *scope, members []multiValue) ([]string, error) {
+	emails := []string{}
+	for _, m := range members {
+		if m.Type != "" && !strings.EqualFold(m.Type, "User") {
+			return nil, errBadRequest("invalidValue", fmt.Sprintf("member %s is not a user, nested groups are not supported", m.Value))
+		}
+		identity, err := s.getIdentity(ctx, sc, m.Value)
+		if se, ok := err.(*scimError); ok && se.Status == http.StatusNotFound {
+			return nil, errBadRequest("invalidValue", fmt.Sprintf("member %s is not a user of the organization", m.Value))
+		}
+		if err != nil {
+			return nil, err
+		}
+		emails = append(emails, traitString(identity.Traits, "email"))
+	}
+	return emails, nil
+}
+
+func (s *Server) writeGroup(w http.ResponseWriter, r *http.Request, status int, grp *models.Group) {
+	sc := scopeFromContext(r.Context())
+	q := r.URL.Query()
+	excluded := splitAttributes(q.Get("excludedAttributes"))
+	var res map[string]interface{}
+	var err error
+	if containsAttribute(excluded, "members") {
+		res, err = toMap(groupResourceOf(sc, grp, nil))
+	} else {
+		res, err = s.groupMap(r.Context(), sc, grp)
+	}
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if status == http.StatusCreated {
+		w.Header().Set("Location", sc.baseURL+"/Groups/"+grp.ID.String())
+	}
+	writeJSON(w, status, project(res, splitAttributes(q.Get("attributes")), excluded))
+}
+
+func (s *Server) listGroups(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	sc := scopeFromContext(ctx)
+	p, err := parseListParams(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	var groups []models.Group
+	_, err = dao.ListFiltered(ctx, s.db,
+		uuid.NullUUID{UUID: sc.partnerID, Valid: true}, uuid.NullUUID{UUID: sc.organizationID, Valid: true},
+		uuid.NullUUID{Valid: false},
+		&groups, "", "created_at", "asc", 0, 0,
+	)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// members are loaded for the groups of the page unless the filter
+	// needs them
+	filterMembers := strings.Contains(strings.ToLower(r.URL.Query().Get("filter")), "members")
+	var matched []map[string]interface{}
+	var matchedGroups []*models.Group
+	for i := range groups {
+		grp := &groups[i]
+		var res map[string]interface{}
+		if filterMembers {
+			res, err = s.groupMap(ctx, sc, grp)
+		} else {
+			res, err = toMap(groupResourceOf(sc, grp, nil))
+		}
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		if p.filter != nil && !p.filter.match(res) {
+			continue
+		}
+		matched = append(matched, res)
+		matchedGroups = append(matchedGroups, grp)
+	}
+
+	page := p.page(matched)
+	if !filterMembers && !containsAttribute(p.excluded, "members") {
+		offset := p.startIndex - 1
+		for i := range page {
+			res, err := s.groupMap(ctx, sc, matchedGroups[offset+i])
+			if err != nil {
+				writeError(w, err)
+				return
+			}
+			page[i] = res
+		}
+	}
+	writeJSON(w, http.StatusOK, p.listResponse(len(matched), page))
+}
+
+func (s *Server) getGroup(w http.ResponseWriter, r *http.Request) {
+	grp, err := s.findGroup(r.Context(), scopeFromContext(r.Context()), r.PathValue("id"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	s.writeGroup(w, r, http.StatusOK, grp)
+}
+
+func (s *Server) createGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	sc := scopeFromContext(ctx)
+	var res groupResource
+	if err := decode(r, &res); err != nil {
+		writeError(w, err)
+		return
+	}
+	name := strings.TrimSpace(res.DisplayName)
+	if name == "" {
+		writeError(w, errBadRequest("invalidValue", "displayName is required"))
+		return
+	}
+	partner := uuid.NullUUID{UUID: sc.partnerID, Valid: true}
+	org := uuid.NullUUID{UUID: sc.organizationID, Valid: true}
+	if e, _ := dao.GetIdByNamePartnerOrg(ctx, s.db, name, partner, org, &models.Group{}); e != nil {
+		writeError(w, errConflict(fmt.Sprintf("group %s already exists", name)))
+		return
+	}
+	emails, err := s.memberEmails(ctx, sc, res.Members)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	_, err = s.gs.Create(ctx, &userv3.Group{
+		Metadata: &commonv3.Metadata{
+			Name:         name,
+			Organization: sc.organization,
+			Partner:      sc.partner,
+		},
+		Spec: &userv3.GroupSpec{Users: emails},
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	grp := &models.Group{}
+	if _, err := dao.GetByNamePartnerOrg(ctx, s.db, name, partner, org, grp); err != nil {
+		writeError(w, err)
+		return
+	}
+	s.writeGroup(w, r, http.StatusCreated, grp)
+}
+
+// updateGroup updates the members of a group to those of the SCIM
+// Group res through the group service, roles of the group are kept
+func (s *Server) updateGroup(ctx context.Context, sc *scope, grp *models.Group, res *groupResource) (*models.Group, error) {
+	if res.DisplayName != "" && strings.TrimSpace(res.DisplayName) != grp.Name {
+		return nil, errBadRequest("mutability", "displayName cannot be changed")
+	}
+	emails, err := s.memberEmails(ctx, sc, res.Members)
+	if err != nil {
+		return nil, err
+	}
+	group, err := s.gs.GetByName(ctx, &userv3.Group{Metadata: &commonv3.Metadata{
+		Name:         grp.Name,
+		Organization: sc.organization,
+		Partner:      sc.partner,
+	}})
+	if err != nil {
+		return nil, err
+	}
+	group.Spec.Users = emails
+	if _, err := s.gs.Update(ctx, group); err != nil {
+		return nil, err
+	}
+	updated := &models.Group{}
+	if _, err := dao.GetByID(ctx, s.db, grp.ID, updated); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+func (s *Server) replaceGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	sc := scopeFromContext(ctx)
+	grp, err := s.findGroup(ctx, sc, r.PathValue("id"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	var res groupResource
+	if err := decode(r, &res); err != nil {
+		writeError(w, err)
+		return
+	}
+	grp, err = s.updateGroup(ctx, sc, grp, &res)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	s.writeGroup(w, r, http.StatusOK, grp)
+}
+
+func (s *Server) patchGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	sc := scopeFromContext(ctx)
+	grp, err := s.findGroup(ctx, sc, r.PathValue("id"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	var req patchRequest
+	if err := decode(r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+	current, err := s.groupMap(ctx, sc, grp)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if err := applyPatch(current, req.Operations); err != nil {
+		writeError(w, err)
+		return
+	}
+	var res groupResource
+	if err := fromMap(current, &res); err != nil {
+		writeError(w, err)
+		return
+	}
+	grp, err = s.updateGroup(ctx, sc, grp, &res)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	s.writeGroup(w, r, http.StatusOK, grp)
+}
+
+func (s *Server) deleteGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	sc := scopeFromContext(ctx)
+	grp, err := s.findGroup(ctx, sc, r.PathValue("id"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	_, err = s.gs.Delete(ctx, &userv3.Group{Metadata: &commonv3.Metadata{
+		Name:         grp.Name,
+		Organization: sc.organization,
+		Partner:      sc.partner,
+	}})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
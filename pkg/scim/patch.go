@@ -0,0 +1,269 @@
+package scim
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// patchRequest is a PATCH request (RFC 7644 section 3.5.2)
+type patchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []patchOperation `json:"Operations"`
+}
+
+type patchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path,omitempty"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// patchPath is the target of a patch operation, an attribute, a
+// sub-attribute or the elements of a multi-valued attribute matching a
+// filter, e.g. members[value eq "..."]
+type patchPath struct {
+	attr string
+	sub  string
+	f    filter
+}
+
+func parsePatchPath(path string) (*patchPath, error) {
+	pp := &patchPath{}
+	attr := path
+	if i := strings.Index(path, "["); i >= 0 {
+		j := strings.LastIndex(path, "]")
+		if j < i {
+			return nil, errBadRequest("invalidPath", fmt.Sprintf("invalid path %q", path))
+		}
+		f, err := parseFilter(path[i+1 : j])
+		if err != nil {
+			return nil, errBadRequest("invalidPath", err.Error())
+		}
+		pp.f = f
+		attr = path[:i]
+		if rest := path[j+1:]; rest != "" {
+			if !strings.HasPrefix(rest, ".") || len(rest) == 1 {
+				return nil, errBadRequest("invalidPath", fmt.Sprintf("invalid path %q", path))
+			}
+			pp.sub = rest[1:]
+		}
+	}
+	parts := parseAttrPath(attr)
+	if len(parts) > 2 || parts[0] == "" || (len(parts) == 2 && pp.sub != "") {
+		return nil, errBadRequest("invalidPath", fmt.Sprintf("invalid path %q", path))
+	}
+	pp.attr = parts[0]
+	if len(parts) == 2 {
+		pp.sub = parts[1]
+	}
+	return pp, nil
+}
+
+// applyPatch applies the operations of a PATCH request to the JSON
+// object of a resource
+func applyPatch(res map[string]interface{}, ops []patchOperation) error {
+	if len(ops) == 0 {
+		return errBadRequest("invalidValue", "no patch operations")
+	}
+	for _, op := range ops {
+		name := strings.ToLower(op.Op)
+		switch name {
+		case "add", "replace":
+			if op.Path == "" {
+				// attributes of the value are the targets
+				values, ok := op.Value.(map[string]interface{})
+				if !ok {
+					return errBadRequest("invalidValue", "value of an operation without path should be an object")
+				}
+				for k, v := range values {
+					pp, err := parsePatchPath(k)
+					if err != nil {
+						return err
+					}
+					if err := setValue(res, pp, v, name == "add"); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+			pp, err := parsePatchPath(op.Path)
+			if err != nil {
+				return err
+			}
+			if err := setValue(res, pp, op.Value, name == "add"); err != nil {
+				return err
+			}
+		case "remove":
+			if op.Path == "" {
+				return errBadRequest("noTarget", "remove operation without path")
+			}
+			pp, err := parsePatchPath(op.Path)
+			if err != nil {
+				return err
+			}
+			if err := removeValue(res, pp, op.Value); err != nil {
+				return err
+			}
+		default:
+			return errBadRequest("invalidSyntax", fmt.Sprintf("unknown patch operation %q", op.Op))
+		}
+	}
+	return nil
+}
+
+// setKey sets the attribute name of m, an existing attribute with a
+// name of different case is replaced
+func setKey(m map[string]interface{}, name string, v interface{}) {
+	key, _, _ := getKey(m, name)
+	m[key] = v
+}
+
+// sameValue checks if elements of a multi-valued attribute are the
+// same, elements with a value sub-attribute are compared by it
+func sameValue(a, b interface{}) bool {
+	am, aok := a.(map[string]interface{})
+	bm, bok := b.(map[string]interface{})
+	if aok && bok {
+		_, av, ok1 := getKey(am, "value")
+		_, bv, ok2 := getKey(bm, "value")
+		if ok1 && ok2 {
+			return reflect.DeepEqual(av, bv)
+		}
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func setValue(res map[string]interface{}, pp *patchPath, value interface{}, add bool) error {
+	key, cur, _ := getKey(res, pp.attr)
+	if pp.f != nil {
+		arr, _ := cur.([]interface{})
+		matched := false
+		for i, e := range arr {
+			m, ok := e.(map[string]interface{})
+			if !ok || !pp.f.match(m) {
+				continue
+			}
+			matched = true
+			switch {
+			case pp.sub != "":
+				setKey(m, pp.sub, value)
+			case add:
+				if vm, ok := value.(map[string]interface{}); ok {
+					for k, v := range vm {
+						setKey(m, k, v)
+					}
+				}
+			default:
+				arr[i] = value
+			}
+		}
+		if !matched {
+			return errBadRequest("noTarget", fmt.Sprintf("no value of %s matches filter", pp.attr))
+		}
+		res[key] = arr
+		return nil
+	}
+	if pp.sub != "" {
+		m, ok := cur.(map[string]interface{})
+		if !ok {
+			m = map[string]interface{}{}
+		}
+		setKey(m, pp.sub, value)
+		res[key] = m
+		return nil
+	}
+	if !add {
+		res[key] = value
+		return nil
+	}
+
+	switch c := cur.(type) {
+	case []interface{}:
+		values, ok := value.([]interface{})
+		if !ok {
+			values = []interface{}{value}
+		}
+		for _, v := range values {
+			exists := false
+			for _, e := range c {
+				if sameValue(e, v) {
+					exists = true
+					break
+				}
+			}
+			if !exists {
+				c = append(c, v)
+			}
+		}
+		res[key] = c
+	case map[string]interface{}:
+		vm, ok := value.(map[string]interface{})
+		if !ok {
+			res[key] = value
+			return nil
+		}
+		for k, v := range vm {
+			setKey(c, k, v)
+		}
+	default:
+		res[key] = value
+	}
+	return nil
+}
+
+func removeValue(res map[string]interface{}, pp *patchPath, value interface{}) error {
+	key, cur, ok := getKey(res, pp.attr)
+	if !ok {
+		return nil
+	}
+	if pp.f != nil {
+		arr, _ := cur.([]interface{})
+		kept := []interface{}{}
+		for _, e := range arr {
+			m, ok := e.(map[string]interface{})
+			if !ok || !pp.f.match(m) {
+				kept = append(kept, e)
+				continue
+			}
+			if pp.sub != "" {
+				k, _, _ := getKey(m, pp.sub)
+				delete(m, k)
+				kept = append(kept, m)
+			}
+		}
+		res[key] = kept
+		return nil
+	}
+	if pp.sub != "" {
+		if m, ok := cur.(map[string]interface{}); ok {
+			k, _, _ := getKey(m, pp.sub)
+			delete(m, k)
+		}
+		return nil
+	}
+	// some clients remove elements of a multi-valued attribute by
+	// listing them as value instead of using a filter
+	if arr, ok := cur.([]interface{}); ok && value != nil {
+		values, ok := value.([]interface{})
+		if !ok {
+			values = []interface{}{value}
+		}
+		kept := []interface{}{}
+		for _, e := range arr {
+			remove := false
+			for _, v := range values {
+				if sameValue(e, v) {
+					remove = true
+					break
+				}
+			}
+			if !remove {
+				kept = append(kept, e)
+			}
+		}
+		res[key] = kept
+		return nil
+	}
+	delete(res, key)
+	return nil
+}
@@ -0,0 +1,451 @@
+// Package scim implements a SCIM 2.0 (RFC 7643, RFC 7644) service
+// provider, idps provision the users and groups of an organization
+// through it.
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/dao"
+	"github.com/paralus/paralus/internal/models"
+	providers "github.com/paralus/paralus/internal/provider/kratos"
+	"github.com/paralus/paralus/pkg/common"
+	"github.com/paralus/paralus/pkg/log"
+	"github.com/paralus/paralus/pkg/service"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	"github.com/uptrace/bun"
+)
+
+var _log = log.GetLogger()
+
+const (
+	// BasePath is the path the SCIM endpoints are served under
+	BasePath = "/scim/v2"
+
+	contentType = "application/scim+json"
+
+	userSchema           = "urn:ietf:params:scim:schemas:core:2.0:User"
+	groupSchema          = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	listResponseSchema   = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	bulkResponseSchema   = "urn:ietf:params:scim:api:messages:2.0:BulkResponse"
+	errorSchema          = "urn:ietf:params:scim:api:messages:2.0:Error"
+	spConfigSchema       = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	resourceTypeSchema   = "urn:ietf:params:scim:schemas:core:2.0:ResourceType"
+	defaultCount         = 100
+	maxCount             = 200
+	maxBulkOperations    = 100
+	maxBulkPayloadSize   = 1 << 20
+	maxRequestBodyLength = 1 << 20
+)
+
+// Server is the SCIM service provider, users and groups are managed
+// through the user and group services of paralus
+type Server struct {
+	db *bun.DB
+	ap providers.AuthProvider
+	us service.UserService
+	gs service.GroupService
+	ts service.ScimTokenService
+	// mux routes requests to the handlers of the endpoints, bulk
+	// operations are performed through it as well
+	mux *http.ServeMux
+}
+
+// NewServer returns the SCIM service provider
+func NewServer(db *bun.DB, ap providers.AuthProvider, us service.UserService, gs service.GroupService, ts service.ScimTokenService) *Server {
+	s := &Server{db: db, ap: ap, us: us, gs: gs, ts: ts}
+	s.mux = s.routes()
+	return s
+}
+
+// scope is the organization a SCIM token provisions
+type scope struct {
+	organizationID uuid.UUID
+	partnerID      uuid.UUID
+	organization   string
+	partner        string
+	// baseURL is the url of the SCIM endpoints resource locations
+	// are generated with
+	baseURL string
+}
+
+type scopeKey struct{}
+
+func scopeFromContext(ctx context.Context) *scope {
+	sc, _ := ctx.Value(scopeKey{}).(*scope)
+	return sc
+}
+
+// Handler returns the handler of the SCIM endpoints, it is mounted on
+// the API server next to the gateway
+func (s *Server) Handler() http.Handler {
+	return s.authenticate(s.mux)
+}
+
+func (s *Server) routes() *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+BasePath+"/ServiceProviderConfig", s.serviceProviderConfig)
+	mux.HandleFunc("GET "+BasePath+"/ResourceTypes", s.resourceTypes)
+	mux.HandleFunc("GET "+BasePath+"/Users", s.listUsers)
+	mux.HandleFunc("POST "+BasePath+"/Users", s.createUser)
+	mux.HandleFunc("GET "+BasePath+"/Users/{id}", s.getUser)
+	mux.HandleFunc("PUT "+BasePath+"/Users/{id}", s.replaceUser)
+	mux.HandleFunc("PATCH "+BasePath+"/Users/{id}", s.patchUser)
+	mux.HandleFunc("DELETE "+BasePath+"/Users/{id}", s.deleteUser)
+	mux.HandleFunc("GET "+BasePath+"/Groups", s.listGroups)
+	mux.HandleFunc("POST "+BasePath+"/Groups", s.createGroup)
+	mux.HandleFunc("GET "+BasePath+"/Groups/{id}", s.getGroup)
+	mux.HandleFunc("PUT "+BasePath+"/Groups/{id}", s.replaceGroup)
+	mux.HandleFunc("PATCH "+BasePath+"/Groups/{id}", s.patchGroup)
+	mux.HandleFunc("DELETE "+BasePath+"/Groups/{id}", s.deleteGroup)
+	mux.HandleFunc("POST "+BasePath+"/Bulk", s.bulk)
+	mux.HandleFunc(BasePath+"/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, errNotFound("no such endpoint"))
+	})
+	return mux
+}
+
+// bearerToken returns the bearer token of the Authorization header
+func bearerToken(r *http.Request) string {
+	auth := r.Header.Get("Authorization")
+	if len(auth) > 7 && strings.EqualFold(auth[:7], "bearer ") {
+		return strings.TrimSpace(auth[7:])
+	}
+	return ""
+}
+
+// clientIP returns the address of the peer of r, X-Forwarded-For is
+// set by the client and can't be trusted
+func clientIP(r *http.Request) string {
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		return host
+	}
+	return r.RemoteAddr
+}
+
+// authenticate authenticates requests with the SCIM token of an
+// organization. Services are called with session data of the token so
+// changes are authorized and audited as done by the SCIM client.
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		token, err := s.ts.Authenticate(ctx, bearerToken(r))
+		if err != nil {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="scim"`)
+			writeError(w, &scimError{Status: http.StatusUnauthorized, Detail: "invalid or missing bearer token"})
+			return
+		}
+		sc, err := s.newScope(ctx, token, r)
+		if err != nil {
+			_log.Errorw("unable to get organization of scim token", "name", token.Name, "error", err)
+			writeError(w, errInternal(err))
+			return
+		}
+
+		sd := &commonv3.SessionData{
+			Username:     "scim:" + token.Name,
+			Account:      token.ID.String(),
+			Organization: token.OrganizationId.String(),
+			Partner:      token.PartnerId.String(),
+			ClientIp:     clientIP(r),
+			ClientUa:     r.UserAgent(),
+			ClientHost:   r.Host,
+			AuthType:     commonv3.AuthType_SCIMToken,
+		}
+		ctx = context.WithValue(ctx, common.SessionDataKey, sd)
+		ctx = context.WithValue(ctx, scopeKey{}, sc)
+		r.Body = http.MaxBytesReader(w, r.Body, maxRequestBodyLength)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func (s *Server) newScope(ctx context.Context, token *models.ScimToken, r *http.Request) (*scope, error) {
+	org := &models.Organization{}
+	if _, err := dao.GetNameById(ctx, s.db, token.OrganizationId, org); err != nil {
+		return nil, err
+	}
+	partner := &models.Partner{}
+	if _, err := dao.GetNameById(ctx, s.db, token.PartnerId, partner); err != nil {
+		return nil, err
+	}
+	scheme := "http"
+	if r.TLS != nil || strings.EqualFold(r.Header.Get("X-Forwarded-Proto"), "https") {
+		scheme = "https"
+	}
+	return &scope{
+		organizationID: token.OrganizationId,
+		partnerID:      token.PartnerId,
+		organization:   org.Name,
+		partner:        partner.Name,
+		baseURL:        scheme + "://" + r.Host + BasePath,
+	}, nil
+}
+
+// scimError is the error response of the SCIM protocol
+type scimError struct {
+	Status   int
+	ScimType string
+	Detail   string
+}
+
+func (e *scimError) Error() string {
+	return e.Detail
+}
+
+func errBadRequest(scimType, detail string) *scimError {
+	return &scimError{Status: http.StatusBadRequest, ScimType: scimType, Detail: detail}
+}
+
+func errNotFound(detail string) *scimError {
+	return &scimError{Status: http.StatusNotFound, Detail: detail}
+}
+
+func errConflict(detail string) *scimError {
+	return &scimError{Status: http.StatusConflict, ScimType: "uniqueness", Detail: detail}
+}
+
+func errInternal(err error) *scimError {
+	return &scimError{Status: http.StatusInternalServerError, Detail: err.Error()}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		_log.Warnw("unable to write scim response", "error", err)
+	}
+}
+
+// writeError writes err as SCIM error, errors other than scimError are
+// internal errors
+func writeError(w http.ResponseWriter, err error) {
+	se, ok := err.(*scimError)
+	if !ok {
+		se = errInternal(err)
+	}
+	if se.Status >= http.StatusInternalServerError {
+		_log.Errorw("scim request failed", "error", se.Detail)
+	}
+	writeJSON(w, se.Status, errorResponse(se))
+}
+
+func errorResponse(err *scimError) map[string]interface{} {
+	res := map[string]interface{}{
+		"schemas": []string{errorSchema},
+		"status":  strconv.Itoa(err.Status),
+		"detail":  err.Detail,
+	}
+	if err.ScimType != "" {
+		res["scimType"] = err.ScimType
+	}
+	return res
+}
+
+// decode decodes the JSON body of r into v
+func decode(r *http.Request, v interface{}) error {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		return errBadRequest("invalidSyntax", "unable to parse request body: "+err.Error())
+	}
+	return nil
+}
+
+// listParams are the query parameters of list requests
+type listParams struct {
+	filter     filter
+	startIndex int
+	count      int
+	attributes []string
+	excluded   []string
+}
+
+func splitAttributes(v string) []string {
+	var attrs []string
+	for _, a := range strings.Split(v, ",") {
+		if a = strings.TrimSpace(a); a != "" {
+			attrs = append(attrs, a)
+		}
+	}
+	return attrs
+}
+
+func parseListParams(r *http.Request) (*listParams, error) {
+	q := r.URL.Query()
+	p := &listParams{
+		startIndex: 1,
+		count:      defaultCount,
+		attributes: splitAttributes(q.Get("attributes")),
+		excluded:   splitAttributes(q.Get("excludedAttributes")),
+	}
+	if v := q.Get("filter"); v != "" {
+		f, err := parseFilter(v)
+		if err != nil {
+			return nil, errBadRequest("invalidFilter", err.Error())
+		}
+		p.filter = f
+	}
+	if v := q.Get("startIndex"); v != "" {
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, errBadRequest("invalidValue", "startIndex should be a number")
+		}
+		// values less than 1 are interpreted as 1
+		if i > 1 {
+			p.startIndex = i
+		}
+	}
+	if v := q.Get("count"); v != "" {
+		c, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, errBadRequest("invalidValue", "count should be a number")
+		}
+		if c < 0 {
+			c = 0
+		}
+		if c > maxCount {
+			c = maxCount
+		}
+		p.count = c
+	}
+	return p, nil
+}
+
+// page returns the resources of the requested page
+func (p *listParams) page(resources []map[string]interface{}) []map[string]interface{} {
+	start := p.startIndex - 1
+	if start > len(resources) {
+		start = len(resources)
+	}
+	end := start + p.count
+	if end > len(resources) {
+		end = len(resources)
+	}
+	return resources[start:end]
+}
+
+// listResponse returns the list response of a page of total resources
+func (p *listParams) listResponse(total int, page []map[string]interface{}) map[string]interface{} {
+	items := make([]map[string]interface{}, 0, len(page))
+	for _, res := range page {
+		items = append(items, project(res, p.attributes, p.excluded))
+	}
+	return map[string]interface{}{
+		"schemas":      []string{listResponseSchema},
+		"totalResults": total,
+		"startIndex":   p.startIndex,
+		"itemsPerPage": len(items),
+		"Resources":    items,
+	}
+}
+
+// alwaysReturned are the attributes returned regardless of the
+// attributes requested
+var alwaysReturned = map[string]bool{"schemas": true, "id": true, "meta": true}
+
+// project returns the requested attributes of res, sub-attributes
+// are requested with their parent
+func project(res map[string]interface{}, attributes, excluded []string) map[string]interface{} {
+	if len(attributes) == 0 && len(excluded) == 0 {
+		return res
+	}
+	out := map[string]interface{}{}
+	for k, v := range res {
+		if len(attributes) > 0 && !alwaysReturned[k] && !containsAttribute(attributes, k) {
+			continue
+		}
+		if !alwaysReturned[k] && containsAttribute(excluded, k) {
+			continue
+		}
+		out[k] = v
+	}
+	return out
+}
+
+func containsAttribute(attrs []string, name string) bool {
+	for _, a := range attrs {
+		a = stripSchema(a)
+		if i := strings.Index(a, "."); i >= 0 {
+			a = a[:i]
+		}
+		if strings.EqualFold(a, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// toMap returns the JSON object of a resource
+func toMap(v interface{}) (map[string]interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	m := map[string]interface{}{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// fromMap decodes the JSON object of a resource into v
+func fromMap(m map[string]interface{}, v interface{}) error {
+	b, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return errBadRequest("invalidValue", err.Error())
+	}
+	return nil
+}
+
+func (s *Server) serviceProviderConfig(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"schemas": []string{spConfigSchema},
+		"patch":   map[string]interface{}{"supported": true},
+		"bulk": map[string]interface{}{
+			"supported":      true,
+			"maxOperations":  maxBulkOperations,
+			"maxPayloadSize": maxBulkPayloadSize,
+		},
+		"filter":         map[string]interface{}{"supported": true, "maxResults": maxCount},
+		"changePassword": map[string]interface{}{"supported": false},
+		"sort":           map[string]interface{}{"supported": false},
+		"etag":           map[string]interface{}{"supported": false},
+		"authenticationSchemes": []map[string]interface{}{{
+			"type":        "oauthbearertoken",
+			"name":        "OAuth Bearer Token",
+			"description": "Authentication with a SCIM token of the organization",
+			"primary":     true,
+		}},
+	})
+}
+
+func (s *Server) resourceTypes(w http.ResponseWriter, r *http.Request) {
+	sc := scopeFromContext(r.Context())
+	types := []map[string]interface{}{
+		{
+			"schemas":  []string{resourceTypeSchema},
+			"id":       "User",
+			"name":     "User",
+			"endpoint": "/Users",
+			"schema":   userSchema,
+			"meta":     map[string]interface{}{"resourceType": "ResourceType", "location": sc.baseURL + "/ResourceTypes/User"},
+		},
+		{
+			"schemas":  []string{resourceTypeSchema},
+			"id":       "Group",
+			"name":     "Group",
+			"endpoint": "/Groups",
+			"schema":   groupSchema,
+			"meta":     map[string]interface{}{"resourceType": "ResourceType", "location": sc.baseURL + "/ResourceTypes/Group"},
+		},
+	}
+	p := &listParams{startIndex: 1, count: len(types)}
+	writeJSON(w, http.StatusOK, p.listResponse(len(types), types))
+}
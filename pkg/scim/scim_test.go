@@ -0,0 +1,381 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/paralus/paralus/pkg/service"
+	userv3 "github.com/paralus/paralus/proto/types/userpb/v3"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+)
+
+const testToken = "scim-secret"
+
+func getDB(t *testing.T) (*bun.DB, sqlmock.Sqlmock) {
+	sqldb, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	if err != nil {
+		t.Fatal("unable to create sqlmock:", err)
+	}
+	return bun.NewDB(sqldb, pgdialect.New()), mock
+}
+
+// fakeTokenService accepts testToken as the token of an organization
+type fakeTokenService struct {
+	service.ScimTokenService
+	token *models.ScimToken
+}
+
+func (f *fakeTokenService) Authenticate(ctx context.Context, token string) (*models.ScimToken, error) {
+	if token != testToken {
+		return nil, service.ErrInvalidScimToken
+	}
+	return f.token, nil
+}
+
+// fakeGroupService records the groups deleted
+type fakeGroupService struct {
+	service.GroupService
+	deleted []string
+}
+
+func (f *fakeGroupService) Delete(ctx context.Context, group *userv3.Group) (*userv3.Group, error) {
+	f.deleted = append(f.deleted, group.GetMetadata().GetName())
+	return group, nil
+}
+
+type testSetup struct {
+	s     *Server
+	gs    *fakeGroupService
+	mock  sqlmock.Sqlmock
+	token *models.ScimToken
+}
+
+func newTestSetup(t *testing.T) *testSetup {
+	db, mock := getDB(t)
+	t.Cleanup(func() { db.Close() })
+	token := &models.ScimToken{
+		ID:             uuid.New(),
+		Name:           "idp",
+		OrganizationId: uuid.New(),
+		PartnerId:      uuid.New(),
+	}
+	gs := &fakeGroupService{}
+	s := NewServer(db, nil, nil, gs, &fakeTokenService{token: token})
+	return &testSetup{s: s, gs: gs, mock: mock, token: token}
+}
+
+// expectScope adds the expectations of the organization and partner
+// lookups of an authenticated request
+func (ts *testSetup) expectScope() {
+	ts.mock.ExpectQuery(`SELECT "organization"."name" FROM "authsrv_organization" AS "organization" WHERE .id = '` + ts.token.OrganizationId.String() + `'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("acme"))
+	ts.mock.ExpectQuery(`SELECT "partner"."name" FROM "authsrv_partner" AS "partner" WHERE .id = '` + ts.token.PartnerId.String() + `'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("paralus"))
+}
+
+func (ts *testSetup) do(method, path, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, "http://paralus.local"+BasePath+path, strings.NewReader(body))
+	req.Header.Set("Authorization", "Bearer "+testToken)
+	req.Header.Set("Content-Type", contentType)
+	w := httptest.NewRecorder()
+	ts.s.Handler().ServeHTTP(w, req)
+	return w
+}
+
+func decodeBody(t *testing.T, w *httptest.ResponseRecorder) map[string]interface{} {
+	var m map[string]interface{}
+	if err := json.Unmarshal(w.Body.Bytes(), &m); err != nil {
+		t.Fatalf("invalid response body %q: %v", w.Body.String(), err)
+	}
+	return m
+}
+
+func TestAuthenticate(t *testing.T) {
+	ts := newTestSetup(t)
+	for _, auth := range []string{"", "Bearer wrong", "Basic " + testToken} {
+		req := httptest.NewRequest(http.MethodGet, "http://paralus.local"+BasePath+"/Users", nil)
+		if auth != "" {
+			req.Header.Set("Authorization", auth)
+		}
+		w := httptest.NewRecorder()
+		ts.s.Handler().ServeHTTP(w, req)
+		if w.Code != http.StatusUnauthorized {
+			t.Errorf("authorization %q, expected status 401; got %d", auth, w.Code)
+		}
+		if w.Header().Get("WWW-Authenticate") == "" {
+			t.Errorf("authorization %q, expected WWW-Authenticate header", auth)
+		}
+		if ct := w.Header().Get("Content-Type"); ct != contentType {
+			t.Errorf("expected content type %s; got %s", contentType, ct)
+		}
+	}
+}
+
+func TestServiceProviderConfig(t *testing.T) {
+	ts := newTestSetup(t)
+	ts.expectScope()
+	w := ts.do(http.MethodGet, "/ServiceProviderConfig", "")
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200; got %d: %s", w.Code, w.Body.String())
+	}
+	cfg := decodeBody(t, w)
+	if patch, _ := cfg["patch"].(map[string]interface{}); patch["supported"] != true {
+		t.Errorf("expected patch to be supported; got %v", cfg["patch"])
+	}
+	if bulk, _ := cfg["bulk"].(map[string]interface{}); bulk["maxOperations"] != float64(maxBulkOperations) {
+		t.Errorf("expected bulk max operations %d; got %v", maxBulkOperations, cfg["bulk"])
+	}
+	if err := ts.mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUnknownEndpoint(t *testing.T) {
+	ts := newTestSetup(t)
+	ts.expectScope()
+	w := ts.do(http.MethodGet, "/Schemas/unknown", "")
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("expected status 404; got %d", w.Code)
+	}
+	res := decodeBody(t, w)
+	if res["status"] != "404" {
+		t.Errorf("expected status 404 in error response; got %v", res["status"])
+	}
+}
+
+func TestClientIP(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "http://paralus.local"+BasePath+"/Users", nil)
+	req.RemoteAddr = "10.0.0.1:4321"
+	req.Header.Set("X-Forwarded-For", "203.0.113.7")
+	if ip := clientIP(req); ip != "10.0.0.1" {
+		t.Errorf("expected address of peer; got %v", ip)
+	}
+}
+
+func TestDeleteGroup(t *testing.T) {
+	ts := newTestSetup(t)
+	gid := uuid.NewString()
+	ts.expectScope()
+	ts.mock.ExpectQuery(`SELECT "group"."id", .* FROM "authsrv_group" AS "group" WHERE .id = '` + gid + `'. AND .trash = FALSE.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "organization_id", "partner_id"}).
+			AddRow(gid, "developers", ts.token.OrganizationId.String(), ts.token.PartnerId.String()))
+
+	w := ts.do(http.MethodDelete, "/Groups/"+gid, "")
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("expected status 204; got %d: %s", w.Code, w.Body.String())
+	}
+	if !reflect.DeepEqual(ts.gs.deleted, []string{"developers"}) {
+		t.Errorf("expected group developers to be deleted; got %v", ts.gs.deleted)
+	}
+	if err := ts.mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDeleteGroupOfOtherOrganization(t *testing.T) {
+	ts := newTestSetup(t)
+	gid := uuid.NewString()
+	ts.expectScope()
+	ts.mock.ExpectQuery(`SELECT "group"."id", .* FROM "authsrv_group" AS "group" WHERE .id = '` + gid + `'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "organization_id"}).
+			AddRow(gid, "developers", uuid.NewString()))
+
+	w := ts.do(http.MethodDelete, "/Groups/"+gid, "")
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("expected status 404; got %d", w.Code)
+	}
+	if len(ts.gs.deleted) != 0 {
+		t.Errorf("group of other organization deleted: %v", ts.gs.deleted)
+	}
+}
+
+func TestBulkValidation(t *testing.T) {
+	ts := newTestSetup(t)
+	ts.expectScope()
+	body := `{
+		"schemas": ["urn:ietf:params:scim:api:messages:2.0:BulkRequest"],
+		"Operations": [
+			{"method": "GET", "path": "/Users"},
+			{"method": "POST", "path": "/Users", "data": {}},
+			{"method": "POST", "bulkId": "a", "path": "/Tokens", "data": {}},
+			{"method": "PATCH", "path": "/Groups/bulkId:missing", "data": {}}
+		]
+	}`
+	w := ts.do(http.MethodPost, "/Bulk", body)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200; got %d: %s", w.Code, w.Body.String())
+	}
+	var res struct {
+		Operations []bulkResult `json:"Operations"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &res); err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"400", "400", "400", "409"}
+	if len(res.Operations) != len(expected) {
+		t.Fatalf("expected %d results; got %d", len(expected), len(res.Operations))
+	}
+	for i, op := range res.Operations {
+		if op.Status != expected[i] {
+			t.Errorf("operation %d, expected status %s; got %s", i, expected[i], op.Status)
+		}
+	}
+}
+
+func TestBulkFailOnErrors(t *testing.T) {
+	ts := newTestSetup(t)
+	ts.expectScope()
+	body := `{
+		"failOnErrors": 1,
+		"Operations": [
+			{"method": "GET", "path": "/Users"},
+			{"method": "GET", "path": "/Groups"}
+		]
+	}`
+	w := ts.do(http.MethodPost, "/Bulk", body)
+	var res struct {
+		Operations []bulkResult `json:"Operations"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &res); err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Operations) != 1 {
+		t.Errorf("expected processing to stop after first error; got %d results", len(res.Operations))
+	}
+}
+
+func TestResolveBulkIDs(t *testing.T) {
+	ids := map[string]string{"u1": "9b8c6e5a-7b3e-4f8e-a1b2-c3d4e5f60718"}
+	got, err := resolveBulkIDs(`{"members":[{"value":"bulkId:u1"}]}`, ids)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != `{"members":[{"value":"9b8c6e5a-7b3e-4f8e-a1b2-c3d4e5f60718"}]}` {
+		t.Errorf("bulkId not resolved: %s", got)
+	}
+	if _, err := resolveBulkIDs(`/Users/bulkId:u2`, ids); err == nil {
+		t.Error("unknown bulkId resolved")
+	}
+}
+
+func patchOf(t *testing.T, ops string) []patchOperation {
+	var req patchRequest
+	if err := json.Unmarshal([]byte(`{"Operations":`+ops+`}`), &req); err != nil {
+		t.Fatal(err)
+	}
+	return req.Operations
+}
+
+func resourceOf(t *testing.T, s string) map[string]interface{} {
+	var m map[string]interface{}
+	if err := json.Unmarshal([]byte(s), &m); err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+func TestApplyPatch(t *testing.T) {
+	group := `{"displayName":"developers","members":[{"value":"a"},{"value":"b"}]}`
+	user := `{"userName":"jane@example.com","active":true,"name":{"givenName":"Jane","familyName":"Doe"}}`
+	tt := []struct {
+		name     string
+		res      string
+		ops      string
+		expected string
+	}{
+		{
+			"add members",
+			group,
+			`[{"op":"add","path":"members","value":[{"value":"b"},{"value":"c"}]}]`,
+			`{"displayName":"developers","members":[{"value":"a"},{"value":"b"},{"value":"c"}]}`,
+		},
+		{
+			"remove member by filter",
+			group,
+			`[{"op":"remove","path":"members[value eq \"a\"]"}]`,
+			`{"displayName":"developers","members":[{"value":"b"}]}`,
+		},
+		{
+			"remove members by value",
+			group,
+			`[{"op":"Remove","path":"members","value":[{"value":"a"},{"value":"b"}]}]`,
+			`{"displayName":"developers","members":[]}`,
+		},
+		{
+			"remove all members",
+			group,
+			`[{"op":"remove","path":"members"}]`,
+			`{"displayName":"developers"}`,
+		},
+		{
+			"replace members",
+			group,
+			`[{"op":"replace","path":"members","value":[{"value":"c"}]}]`,
+			`{"displayName":"developers","members":[{"value":"c"}]}`,
+		},
+		{
+			"replace without path",
+			user,
+			`[{"op":"Replace","value":{"active":false,"name.givenName":"Janet"}}]`,
+			`{"userName":"jane@example.com","active":false,"name":{"givenName":"Janet","familyName":"Doe"}}`,
+		},
+		{
+			"replace sub-attribute",
+			user,
+			`[{"op":"replace","path":"name.familyName","value":"Smith"}]`,
+			`{"userName":"jane@example.com","active":true,"name":{"givenName":"Jane","familyName":"Smith"}}`,
+		},
+		{
+			"attribute names are case insensitive",
+			user,
+			`[{"op":"replace","path":"Active","value":false}]`,
+			`{"userName":"jane@example.com","active":false,"name":{"givenName":"Jane","familyName":"Doe"}}`,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			res := resourceOf(t, tc.res)
+			if err := applyPatch(res, patchOf(t, tc.ops)); err != nil {
+				t.Fatal(err)
+			}
+			if expected := resourceOf(t, tc.expected); !reflect.DeepEqual(res, expected) {
+				t.Errorf("expected %v; got %v", expected, res)
+			}
+		})
+	}
+}
+
+func TestApplyInvalidPatch(t *testing.T) {
+	group := `{"displayName":"developers","members":[{"value":"a"}]}`
+	tt := []struct {
+		ops      string
+		scimType string
+	}{
+		{`[]`, "invalidValue"},
+		{`[{"op":"move","path":"members"}]`, "invalidSyntax"},
+		{`[{"op":"remove"}]`, "noTarget"},
+		{`[{"op":"replace","path":"members[value eq \"z\"].display","value":"z"}]`, "noTarget"},
+		{`[{"op":"replace","path":"members[value eq","value":"z"}]`, "invalidPath"},
+		{`[{"op":"replace","value":"developers"}]`, "invalidValue"},
+	}
+	for _, tc := range tt {
+		err := applyPatch(resourceOf(t, group), patchOf(t, tc.ops))
+		se, ok := err.(*scimError)
+		if !ok {
+			t.Errorf("patch %s, expected scim error; got %v", tc.ops, err)
+			continue
+		}
+		if se.ScimType != tc.scimType {
+			t.Errorf("patch %s, expected scimType %s; got %s", tc.ops, tc.scimType, se.ScimType)
+		}
+	}
+}
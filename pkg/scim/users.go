@@ -0,0 +1,393 @@
+package scim
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/dao"
+	"github.com/paralus/paralus/internal/models"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	userv3 "github.com/paralus/paralus/proto/types/userpb/v3"
+)
+
+// state of kratos identities of deactivated users
+const identityStateInactive = "inactive"
+
+// flexBool is a boolean which is also accepted as string, some clients
+// send "True" and "False"
+type flexBool bool
+
+func (b *flexBool) UnmarshalJSON(data []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch t := v.(type) {
+	case bool:
+		*b = flexBool(t)
+	case string:
+		switch strings.ToLower(t) {
+		case "true":
+			*b = true
+		case "false":
+			*b = false
+		default:
+			return fmt.Errorf("invalid boolean %q", t)
+		}
+	default:
+		return fmt.Errorf("invalid boolean %s", data)
+	}
+	return nil
+}
+
+type userName struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+// multiValue is an element of a multi-valued attribute
+type multiValue struct {
+	Value   string `json:"value"`
+	Ref     string `json:"$ref,omitempty"`
+	Display string `json:"display,omitempty"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+type resourceMeta struct {
+	ResourceType string `json:"resourceType"`
+	Created      string `json:"created,omitempty"`
+	LastModified string `json:"lastModified,omitempty"`
+	Location     string `json:"location,omitempty"`
+}
+
+// userResource is the SCIM User of a paralus user, the userName is the
+// email of the user
+type userResource struct {
+	Schemas     []string      `json:"schemas"`
+	ID          string        `json:"id,omitempty"`
+	UserName    string        `json:"userName"`
+	Name        *userName     `json:"name,omitempty"`
+	DisplayName string        `json:"displayName,omitempty"`
+	Emails      []multiValue  `json:"emails,omitempty"`
+	Active      *flexBool     `json:"active,omitempty"`
+	Password    string        `json:"password,omitempty"`
+	Groups      []multiValue  `json:"groups,omitempty"`
+	Meta        *resourceMeta `json:"meta,omitempty"`
+}
+
+func traitString(traits map[string]interface{}, name string) string {
+	s, _ := traits[name].(string)
+	return s
+}
+
+// userResourceOf returns the SCIM User of an identity, groups are
+// only set when loaded
+func userResourceOf(sc *scope, identity *models.KratosIdentities, groups []models.Group) *userResource {
+	email := traitString(identity.Traits, "email")
+	first := traitString(identity.Traits, "first_name")
+	last := traitString(identity.Traits, "last_name")
+	active := flexBool(identity.State != identityStateInactive)
+	res := &userResource{
+		Schemas:     []string{userSchema},
+		ID:          identity.ID.String(),
+		UserName:    email,
+		DisplayName: strings.TrimSpace(first + " " + last),
+		Active:      &active,
+		Meta: &resourceMeta{
+			ResourceType: "User",
+			Created:      identity.CreatedAt.UTC().Format(time.RFC3339),
+			LastModified: identity.UpdatedAt.UTC().Format(time.RFC3339),
+			Location:     sc.baseURL + "/Users/" + identity.ID.String(),
+		},
+	}
+	if first != "" || last != "" {
+		res.Name = &userName{Formatted: res.DisplayName, GivenName: first, FamilyName: last}
+	}
+	if email != "" {
+		res.Emails = []multiValue{{Value: email, Type: "work", Primary: true}}
+	}
+	for _, g := range groups {
+		res.Groups = append(res.Groups, multiValue{
+			Value:   g.ID.String(),
+			Ref:     sc.baseURL + "/Groups/" + g.ID.String(),
+			Display: g.Name,
+		})
+	}
+	return res
+}
+
+// userMap returns the JSON object of the SCIM User of an identity
+func (s *Server) userMap(ctx context.Context, sc *scope, identity *models.KratosIdentities) (map[string]interface{}, error) {
+	groups, err := dao.GetGroups(ctx, s.db, identity.ID)
+	if err != nil {
+		return nil, err
+	}
+	return toMap(userResourceOf(sc, identity, groups))
+}
+
+// getIdentity returns the identity of a user of the organization
+func (s *Server) getIdentity(ctx context.Context, sc *scope, id string) (*models.KratosIdentities, error) {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, errNotFound(fmt.Sprintf("user %s not found", id))
+	}
+	identity, err := dao.GetOrganizationUser(ctx, s.db, sc.organizationID, uid)
+	if err == sql.ErrNoRows {
+		return nil, errNotFound(fmt.Sprintf("user %s not found", id))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return identity, nil
+}
+
+func (s *Server) writeUser(w http.ResponseWriter, r *http.Request, status int, identity *models.KratosIdentities) {
+	sc := scopeFromContext(r.Context())
+	res, err := s.userMap(r.Context(), sc, identity)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if status == http.StatusCreated {
+		w.Header().Set("Location", sc.baseURL+"/Users/"+identity.ID.String())
+	}
+	q := r.URL.Query()
+	writeJSON(w, status, project(res, splitAttributes(q.Get("attributes")), splitAttributes(q.Get("excludedAttributes"))))
+}
+
+func (s *Server) listUsers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	sc := scopeFromContext(ctx)
+	p, err := parseListParams(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	identities, err := dao.ListOrganizationUsers(ctx, s.db, sc.organizationID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// groups are loaded for the users of the page unless the filter
+	// needs them
+	filterGroups := strings.Contains(strings.ToLower(r.URL.Query().Get("filter")), "groups")
+	var matched []map[string]interface{}
+	var matchedIdentities []*models.KratosIdentities
+	for i := range identities {
+		identity := &identities[i]
+		var res map[string]interface{}
+		if filterGroups {
+			res, err = s.userMap(ctx, sc, identity)
+		} else {
+			res, err = toMap(userResourceOf(sc, identity, nil))
+		}
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		if p.filter != nil && !p.filter.match(res) {
+			continue
+		}
+		matched = append(matched, res)
+		matchedIdentities = append(matchedIdentities, identity)
+	}
+
+	page := p.page(matched)
+	if !filterGroups && !containsAttribute(p.excluded, "groups") {
+		offset := p.startIndex - 1
+		for i := range page {
+			res, err := s.userMap(ctx, sc, matchedIdentities[offset+i])
+			if err != nil {
+				writeError(w, err)
+				return
+			}
+			page[i] = res
+		}
+	}
+	writeJSON(w, http.StatusOK, p.listResponse(len(matched), page))
+}
+
+func (s *Server) getUser(w http.ResponseWriter, r *http.Request) {
+	identity, err := s.getIdentity(r.Context(), scopeFromContext(r.Context()), r.PathValue("id"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	s.writeUser(w, r, http.StatusOK, identity)
+}
+
+func (s *Server) createUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	sc := scopeFromContext(ctx)
+	var res userResource
+	if err := decode(r, &res); err != nil {
+		writeError(w, err)
+		return
+	}
+	username := strings.TrimSpace(res.UserName)
+	if username == "" {
+		writeError(w, errBadRequest("invalidValue", "userName is required"))
+		return
+	}
+	if _, err := dao.GetUserIdByEmail(ctx, s.db, username, &models.KratosIdentities{}); err == nil {
+		writeError(w, errConflict(fmt.Sprintf("user %s already exists", username)))
+		return
+	}
+
+	user := &userv3.User{
+		Metadata: &commonv3.Metadata{
+			Name:         username,
+			Organization: sc.organization,
+			Partner:      sc.partner,
+		},
+		Spec: &userv3.UserSpec{
+			Password: res.Password,
+		},
+	}
+	if res.Name != nil {
+		user.Spec.FirstName = res.Name.GivenName
+		user.Spec.LastName = res.Name.FamilyName
+	}
+	if _, err := s.us.Create(ctx, user); err != nil {
+		writeError(w, err)
+		return
+	}
+	identity := &models.KratosIdentities{}
+	if _, err := dao.GetUserByEmail(ctx, s.db, username, identity); err != nil {
+		writeError(w, err)
+		return
+	}
+	// users can be provisioned deactivated
+	if res.Active != nil && !bool(*res.Active) {
+		if err := s.us.SetActive(ctx, username, false); err != nil {
+			writeError(w, err)
+			return
+		}
+		identity.State = identityStateInactive
+	}
+	s.writeUser(w, r, http.StatusCreated, identity)
+}
+
+// updateUser updates a user to the SCIM User res. Names are updated
+// through the user service, users are deactivated and activated with
+// their active attribute.
+func (s *Server) updateUser(ctx context.Context, sc *scope, identity *models.KratosIdentities, res *userResource) (*models.KratosIdentities, error) {
+	email := traitString(identity.Traits, "email")
+	if res.UserName != "" && !strings.EqualFold(strings.TrimSpace(res.UserName), email) {
+		return nil, errBadRequest("mutability", "userName cannot be changed")
+	}
+
+	if res.Name != nil && (res.Name.GivenName != traitString(identity.Traits, "first_name") || res.Name.FamilyName != traitString(identity.Traits, "last_name")) {
+		user, err := s.us.GetByName(ctx, &userv3.User{Metadata: &commonv3.Metadata{Name: email}})
+		if err != nil {
+			return nil, err
+		}
+		user.Metadata.Organization = sc.organization
+		user.Metadata.Partner = sc.partner
+		user.Spec.FirstName = res.Name.GivenName
+		user.Spec.LastName = res.Name.FamilyName
+		if _, err := s.us.Update(ctx, user); err != nil {
+			return nil, err
+		}
+	}
+
+	active := identity.State != identityStateInactive
+	if res.Active != nil && bool(*res.Active) != active {
+		if err := s.us.SetActive(ctx, email, bool(*res.Active)); err != nil {
+			return nil, err
+		}
+	}
+	return dao.GetOrganizationUser(ctx, s.db, sc.organizationID, identity.ID)
+}
+
+func (s *Server) replaceUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	sc := scopeFromContext(ctx)
+	identity, err := s.getIdentity(ctx, sc, r.PathValue("id"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	var res userResource
+	if err := decode(r, &res); err != nil {
+		writeError(w, err)
+		return
+	}
+	identity, err = s.updateUser(ctx, sc, identity, &res)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	s.writeUser(w, r, http.StatusOK, identity)
+}
+
+func (s *Server) patchUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	sc := scopeFromContext(ctx)
+	identity, err := s.getIdentity(ctx, sc, r.PathValue("id"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	var req patchRequest
+	if err := decode(r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+	current, err := s.userMap(ctx, sc, identity)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if err := applyPatch(current, req.Operations); err != nil {
+		writeError(w, err)
+		return
+	}
+	var res userResource
+	if err := fromMap(current, &res); err != nil {
+		writeError(w, err)
+		return
+	}
+	identity, err = s.updateUser(ctx, sc, identity, &res)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	s.writeUser(w, r, http.StatusOK, identity)
+}
+
+// deleteUser deprovisions a user, the user is deactivated, which
+// revokes its sessions, kubeconfigs and api keys, before it is deleted
+func (s *Server) deleteUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	sc := scopeFromContext(ctx)
+	identity, err := s.getIdentity(ctx, sc, r.PathValue("id"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	email := traitString(identity.Traits, "email")
+	if err := s.us.SetActive(ctx, email, false); err != nil {
+		writeError(w, err)
+		return
+	}
+	_, err = s.us.Delete(ctx, &userv3.User{Metadata: &commonv3.Metadata{
+		Name:         email,
+		Organization: sc.organization,
+		Partner:      sc.partner,
+	}})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
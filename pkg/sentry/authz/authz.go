@@ -2,14 +2,18 @@ package authz
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/paralus/paralus/internal/constants"
+	"github.com/paralus/paralus/internal/models"
 	"github.com/paralus/paralus/pkg/controller/runtime"
 	"github.com/paralus/paralus/pkg/log"
 	"github.com/paralus/paralus/pkg/query"
@@ -50,6 +54,8 @@ const (
 	authzRefreshedLabel = "authz-refreshed"
 	systemUsername      = "admin@paralus.co"
 	authzExpiryLabel    = "authz-expiry"
+	breakGlassLabel     = "break-glass"
+	breakGlassNameLabel = "break-glass-name"
 )
 
 type roleBindExclusionList struct {
@@ -57,6 +63,38 @@ type roleBindExclusionList struct {
 	namespace string
 }
 
+// authorizationChecks records the checks applied while authorizing a
+// user, it is nil unless the authorization is previewed
+type authorizationChecks struct {
+	checks []*sentryrpc.UserAuthorizationCheck
+}
+
+// record records the result of the check and returns its error
+func (c *authorizationChecks) record(name string, err error) error {
+	if c == nil {
+		return err
+	}
+	check := &sentryrpc.UserAuthorizationCheck{Name: name, Passed: err == nil}
+	if err != nil {
+		check.Reason = err.Error()
+	}
+	c.checks = append(c.checks, check)
+	return err
+}
+
+// bypass records the checks skipped for the user
+func (c *authorizationChecks) bypass(name, reason string) {
+	if c == nil {
+		return
+	}
+	c.checks = append(c.checks, &sentryrpc.UserAuthorizationCheck{Name: name, Passed: true, Reason: reason})
+}
+
+// denied returns true if the last check recorded failed
+func (c *authorizationChecks) denied() bool {
+	return len(c.checks) > 0 && !c.checks[len(c.checks)-1].Passed
+}
+
 func getCurrentEpoch() string {
 	return strconv.FormatInt(time.Now().Unix(), 10)
 }
@@ -70,9 +108,18 @@ func getAuthzLabels(userName, saValidityDuration string) map[string]string {
 	}
 }
 
-func getAccountProjectNamespace(ctx context.Context, projectID, accountID string, pns service.NamespaceService) ([]string, error) {
+// getBreakGlassName returns the name of the emergency access the
+// service account is labelled with, empty when it has none
+func getBreakGlassName(sa *corev1.ServiceAccount) string {
+	if sa.Labels[breakGlassLabel] != "true" {
+		return ""
+	}
+	return sa.Labels[breakGlassNameLabel]
+}
 
-	apns, err := pns.GetAccountProjectNamespaces(ctx, uuid.MustParse(projectID), uuid.MustParse(accountID))
+func getAccountProjectNamespace(ctx context.Context, projectID, accountID string, clusterID uuid.UUID, pns service.NamespaceService) ([]string, error) {
+
+	apns, err := pns.GetAccountProjectNamespaces(ctx, uuid.MustParse(projectID), uuid.MustParse(accountID), clusterID)
 	if err != nil {
 		return nil, err
 	}
@@ -80,9 +127,9 @@ func getAccountProjectNamespace(ctx context.Context, projectID, accountID string
 	return apns, nil
 }
 
-func getGroupAccountProjectNamespace(ctx context.Context, projectID, accountID string, apn service.NamespaceService) ([]string, error) {
+func getGroupAccountProjectNamespace(ctx context.Context, projectID, accountID string, clusterID uuid.UUID, apn service.NamespaceService) ([]string, error) {
 
-	apns, err := apn.GetGroupProjectNamespaces(ctx, uuid.MustParse(projectID), uuid.MustParse(accountID))
+	apns, err := apn.GetGroupProjectNamespaces(ctx, uuid.MustParse(projectID), uuid.MustParse(accountID), clusterID)
 	if err != nil {
 		return nil, err
 	}
@@ -91,8 +138,7 @@ func getGroupAccountProjectNamespace(ctx context.Context, projectID, accountID s
 }
 
 func getProjectPermissions(ctx context.Context, projects []string, accountID, orgID, partnerID string, aps service.AccountPermissionService) (map[string][]string, string, error) {
-	projects = append(projects, "")
-	accountPermissions, err := aps.GetAccountPermissionsByProjectIDPermissions(ctx, accountID, orgID, partnerID, projects, permissions)
+	projectPermissions, err := getAccountProjectPermissions(ctx, projects, accountID, orgID, partnerID, aps)
 	if err != nil {
 		return nil, "", err
 	}
@@ -101,6 +147,17 @@ func getProjectPermissions(ctx context.Context, projects []string, accountID, or
 	if err != nil {
 		return nil, "", err
 	}
+	return projectPermissions, accountData.Username, nil
+}
+
+// getAccountProjectPermissions returns the kubeconfig permissions of
+// the account in the given projects
+func getAccountProjectPermissions(ctx context.Context, projects []string, accountID, orgID, partnerID string, aps service.AccountPermissionService) (map[string][]string, error) {
+	projects = append(projects, "")
+	accountPermissions, err := aps.GetAccountPermissionsByProjectIDPermissions(ctx, accountID, orgID, partnerID, projects, permissions)
+	if err != nil {
+		return nil, err
+	}
 
 	projectPermissions := make(map[string][]string)
 OUTER:
@@ -116,7 +173,7 @@ OUTER:
 		}
 		projectPermissions[p] = append(projectPermissions[p], accountPermission.PermissionName)
 	}
-	return projectPermissions, accountData.Username, nil
+	return projectPermissions, nil
 }
 
 func getSSOProjectPermissions(ctx context.Context, projects []string, orgID, partnerID, accountID string, aps service.AccountPermissionService, gps service.GroupPermissionService) (map[string][]string, string, []string, error) {
@@ -199,6 +256,134 @@ func getRoleName(nsName, permission string) string {
 	return ""
 }
 
+// getKubernetesName returns name as a valid name of kubernetes objects,
+// the hash of name keeps names of different roles apart
+func getKubernetesName(name string) string {
+	var b strings.Builder
+	for _, c := range strings.ToLower(name) {
+		if (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') {
+			b.WriteRune(c)
+		} else {
+			b.WriteByte('-')
+		}
+	}
+	n := b.String()
+	if len(n) > 40 {
+		n = n[:40]
+	}
+	n = strings.Trim(n, "-")
+	sum := sha256.Sum256([]byte(name))
+	if n == "" {
+		return hex.EncodeToString(sum[:4])
+	}
+	return n + "-" + hex.EncodeToString(sum[:4])
+}
+
+// getCustomClusterRoleName returns the name of the cluster role with
+// the kubernetes rules of the custom role roleName
+func getCustomClusterRoleName(roleName string) string {
+	return "paralus-custom-" + getKubernetesName(roleName)
+}
+
+// getCustomRoleName returns the name of the role with the kubernetes
+// rules of the custom role roleName in the namespace nsName
+func getCustomRoleName(nsName, roleName string) string {
+	return "paralus-ns-custom-" + getKubernetesName(roleName) + "-" + nsName
+}
+
+// isPermissionDenied returns whether a deny policy applying to the user
+// or its groups denies permission in one of projects. An empty namespace
+// is used for cluster scoped permissions, they span all namespaces and
+// are denied by the deny policies of any namespace
+func isPermissionDenied(policies []models.DenyPolicy, user string, groups, projects []string, namespace, permission string) bool {
+	for _, dp := range policies {
+		if !slices.Contains(dp.Permissions, permission) || !service.DenyPolicyApplies(dp, user, groups) {
+			continue
+		}
+		if dp.ProjectId != uuid.Nil && !slices.Contains(projects, dp.ProjectId.String()) {
+			continue
+		}
+		if namespace != "" && dp.Namespace != "" && dp.Namespace != namespace {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+// getKubernetesGrantPermissions returns the kubectl permissions the
+// kubernetes rules of a custom role amount to, rules with verbs other
+// than reads amount to both the read and write permission
+func getKubernetesGrantPermissions(grant models.KubernetesRoleGrant) []string {
+	read, write := sentry.KubectlClusterReadPermission, sentry.KubectlClusterWritePermission
+	if grant.Namespace != "" || grant.NamespaceSelector != "" {
+		read, write = sentry.KubectlNamespaceReadPermission, sentry.KubectlNamespaceWritePermission
+	}
+	for _, rule := range grant.KubernetesRules {
+		for _, verb := range rule.Verbs {
+			if verb != "get" && verb != "list" && verb != "watch" {
+				return []string{read, write}
+			}
+		}
+	}
+	return []string{read}
+}
+
+// isKubernetesGrantDenied returns whether a deny policy denies one of
+// the kubectl permissions the kubernetes rules of a custom role amount
+// to in namespace
+func isKubernetesGrantDenied(policies []models.DenyPolicy, user string, groups, projects []string, namespace string, grant models.KubernetesRoleGrant) bool {
+	for _, permission := range getKubernetesGrantPermissions(grant) {
+		if isPermissionDenied(policies, user, groups, projects, namespace, permission) {
+			return true
+		}
+	}
+	return false
+}
+
+// getPolicyRules returns the kubernetes rules of a custom role as RBAC
+// policy rules, subresources are joined to their resources
+func getPolicyRules(rules []models.KubernetesRule) []rbacv1.PolicyRule {
+	var policyRules []rbacv1.PolicyRule
+	for _, rule := range rules {
+		resources := rule.Resources
+		if len(rule.Subresources) > 0 {
+			resources = nil
+			for _, resource := range rule.Resources {
+				for _, subresource := range rule.Subresources {
+					resources = append(resources, resource+"/"+subresource)
+				}
+			}
+		}
+		policyRules = append(policyRules, rbacv1.PolicyRule{
+			APIGroups:     rule.APIGroups,
+			Resources:     resources,
+			ResourceNames: rule.ResourceNames,
+			Verbs:         rule.Verbs,
+		})
+	}
+	return policyRules
+}
+
+func getCustomClusterRole(grant models.KubernetesRoleGrant) *rbacv1.ClusterRole {
+	cr := &rbacv1.ClusterRole{}
+	cr.APIVersion = "rbac.authorization.k8s.io/v1"
+	cr.Kind = "ClusterRole"
+	cr.Name = getCustomClusterRoleName(grant.RoleName)
+	cr.Rules = getPolicyRules(grant.KubernetesRules)
+	return cr
+}
+
+func getCustomRole(grant models.KubernetesRoleGrant) *rbacv1.Role {
+	r := &rbacv1.Role{}
+	r.APIVersion = "rbac.authorization.k8s.io/v1"
+	r.Kind = "Role"
+	r.Name = getCustomRoleName(grant.Namespace, grant.RoleName)
+	r.Namespace = grant.Namespace
+	r.Rules = getPolicyRules(grant.KubernetesRules)
+	return r
+}
+
 func setRoleValues(r *rbacv1.Role, nsName, permission string) {
 	r.Name = getRoleName(nsName, permission)
 	r.Namespace = nsName
@@ -316,6 +501,10 @@ func getProjectsFromLabels(labels map[string]string) ([]string, error) {
 //   - NO Access to cluster scoped resources
 //   - Read Access to namespace scoped resources (only within the environment)
 func GetAuthorization(ctx context.Context, req *sentryrpc.GetUserAuthorizationRequest, bs service.BootstrapService, aps service.AccountPermissionService, gps service.GroupPermissionService, krs service.KubeconfigRevocationService, kcs service.KubectlClusterSettingsService, kss service.KubeconfigSettingService, ns service.NamespaceService) (resp *sentryrpc.GetUserAuthorizationResponse, err error) {
+	return getAuthorization(ctx, req, nil, bs, aps, gps, krs, kcs, kss, ns)
+}
+
+func getAuthorization(ctx context.Context, req *sentryrpc.GetUserAuthorizationRequest, checks *authorizationChecks, bs service.BootstrapService, aps service.AccountPermissionService, gps service.GroupPermissionService, krs service.KubeconfigRevocationService, kcs service.KubectlClusterSettingsService, kss service.KubeconfigSettingService, ns service.NamespaceService) (resp *sentryrpc.GetUserAuthorizationResponse, err error) {
 	var userName string
 	var groups []string
 	var rolePrevilage int
@@ -358,7 +547,9 @@ func GetAuthorization(ctx context.Context, req *sentryrpc.GetUserAuthorizationRe
 		_log.Infow("Error getting partner/super admin permission info", "accountID", accountID, "orgID", orgID, "partnerID", partnerID, "error", err)
 	}
 	_log.Infow("check for partner/super admin", " isPartnerAdmin ", isPartnerAdmin, " isSuperAdmin ", isSuperAdmin)
-	if !isSuperAdmin && !isPartnerAdmin {
+	if isSuperAdmin || isPartnerAdmin {
+		checks.bypass("partner/super admin", "kubectl settings, session and revocation checks are bypassed")
+	} else {
 		existUserLevel := true
 		// get org level setting if exist
 		ksOrg, errOrg := kss.Get(ctx, orgID, "", cnAttr.IsSSO)
@@ -368,7 +559,7 @@ func GetAuthorization(ctx context.Context, req *sentryrpc.GetUserAuthorizationRe
 		}
 		if errOrg == nil && ksOrg != nil {
 			// check for kubectl org settings
-			err = verifyKubectlSettings(cnAttr, ksOrg, "organization")
+			err = checks.record("organization kubectl settings", verifyKubectlSettings(cnAttr, ksOrg, "organization"))
 			if err != nil {
 				_log.Errorw("kubectl denied as per org level kubectl settings for", "userCN", req.UserCN)
 				return nil, err
@@ -377,7 +568,7 @@ func GetAuthorization(ctx context.Context, req *sentryrpc.GetUserAuthorizationRe
 		}
 
 		// check for kubectl cluster settings
-		err = verifyClusterKubectlSettings(ctx, bs, kcs, cnAttr, req.ClusterID, orgID)
+		err = checks.record("cluster kubectl settings", verifyClusterKubectlSettings(ctx, bs, kcs, cnAttr, req.ClusterID, orgID))
 		if err != nil {
 			_log.Errorw("failed to verify kubectl cluster settings for", "userCN", req.UserCN)
 			return nil, err
@@ -396,14 +587,15 @@ func GetAuthorization(ctx context.Context, req *sentryrpc.GetUserAuthorizationRe
 
 		if existUserLevel && ks != nil {
 			// check for kubectl user settings
-			errVerify := verifyKubectlSettings(cnAttr, ks, "user")
+			errVerify := checks.record("user kubectl settings", verifyKubectlSettings(cnAttr, ks, "user"))
 			if errVerify != nil {
 				_log.Errorw("kubectl denied as per user level kubectl settings for", "userCN", req.UserCN)
 				return nil, errVerify
 			}
 		}
 
-		if errUser == nil && ks != nil && ks.EnableSessionCheck {
+		// service accounts never log in to the portal
+		if errUser == nil && ks != nil && ks.EnableSessionCheck && !cnAttr.ServiceAccount {
 			// check the last login timestamp
 			var lastLogin time.Time
 			accountData, err := aps.GetAccount(ctx, accountID)
@@ -414,20 +606,32 @@ func GetAuthorization(ctx context.Context, req *sentryrpc.GetUserAuthorizationRe
 			t1 := time.Now()
 			if t1.Sub(lastLogin) > time.Hour*12 {
 				_log.Infow("get kubectl authorization block access. user did not login to portal in last 12 Hour")
-				return nil, fmt.Errorf("enforce session enabled. user did not login to portal in last 12 Hour")
+				return nil, checks.record("session check", fmt.Errorf("enforce session enabled. user did not login to portal in last 12 Hour"))
 			}
+			checks.record("session check", nil)
 		}
 
-		// is local user active
-		if ok, _ := aps.IsSSOAccount(ctx, accountID); !ok {
+		// is service account active
+		if cnAttr.ServiceAccount {
+			active, err := aps.IsServiceAccountActive(ctx, accountID, orgID)
+			if err != nil {
+				return nil, err
+			}
+			if !active {
+				return nil, checks.record("service account active", fmt.Errorf("kubeconfig service account deleted"))
+			}
+			checks.record("service account active", nil)
+		} else if ok, _ := aps.IsSSOAccount(ctx, accountID); !ok {
+			// is local user active
 			active, err := aps.IsAccountActive(ctx, accountID, orgID)
 			_log.Infow("accountID ", accountID, "orgID ", orgID, "active ", fmt.Sprint(active))
 			if err != nil {
 				return nil, err
 			}
 			if !active {
-				return nil, fmt.Errorf("kubeconfig user deactivated")
+				return nil, checks.record("account active", fmt.Errorf("kubeconfig user deactivated"))
 			}
+			checks.record("account active", nil)
 		}
 
 		// get revocation timestamp
@@ -435,8 +639,9 @@ func GetAuthorization(ctx context.Context, req *sentryrpc.GetUserAuthorizationRe
 		if err != nil && err != constants.ErrNotFound {
 			return nil, err
 		} else if err == nil && kr.RevokedAt.AsTime().Unix() >= req.CertIssueSeconds {
-			return nil, fmt.Errorf("kubeconfig revoked")
+			return nil, checks.record("kubeconfig revocation", fmt.Errorf("kubeconfig revoked"))
 		}
+		checks.record("kubeconfig revocation", nil)
 	}
 
 	opts := commonv3.QueryOptions{
@@ -457,6 +662,10 @@ func GetAuthorization(ctx context.Context, req *sentryrpc.GetUserAuthorizationRe
 	}
 	ba := bal.Items[0]
 	labels := ba.Metadata.GetLabels()
+	clusterID, err := uuid.Parse(req.ClusterID)
+	if err != nil {
+		return nil, err
+	}
 
 	// get projects
 	projects, err := getProjectsFromLabels(labels)
@@ -467,7 +676,10 @@ func GetAuthorization(ctx context.Context, req *sentryrpc.GetUserAuthorizationRe
 
 	// get permissions in the cluster's projects
 	var projectPermissions map[string][]string
-	if !cnAttr.IsSSO {
+	if cnAttr.ServiceAccount {
+		projectPermissions, err = getAccountProjectPermissions(ctx, projects, accountID, orgID, partnerID, aps)
+		userName = cnAttr.Username
+	} else if !cnAttr.IsSSO {
 		projectPermissions, userName, err = getProjectPermissions(ctx, projects, accountID, orgID, partnerID, aps)
 	} else {
 		projectPermissions, userName, groups, err = getSSOProjectPermissions(ctx, projects, orgID, partnerID, accountID, aps, gps)
@@ -477,6 +689,64 @@ func GetAuthorization(ctx context.Context, req *sentryrpc.GetUserAuthorizationRe
 		return nil, err
 	}
 
+	// get kubernetes rules of custom roles in the cluster's projects
+	var kubernetesGrants []models.KubernetesRoleGrant
+	if cnAttr.IsSSO && !cnAttr.ServiceAccount {
+		kubernetesGrants, err = gps.GetGroupKubernetesRoleGrants(ctx, groups, orgID, partnerID, projects)
+	} else {
+		kubernetesGrants, err = aps.GetAccountKubernetesRoleGrants(ctx, accountID, orgID, partnerID, projects)
+	}
+	if err != nil {
+		_log.Errorw("error getting kubernetes rules", "projects", projects, "userCN", req.UserCN, "error", err.Error())
+		return nil, err
+	}
+	customRoles, err := aps.GetKubernetesRoleNames(ctx, orgID, partnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	// deny policies override the permissions granted by roles
+	denyPolicies, err := aps.GetDenyPolicies(ctx, orgID, partnerID)
+	if err != nil {
+		return nil, err
+	}
+	accountGroups := groups
+	if !cnAttr.IsSSO || cnAttr.ServiceAccount {
+		accountGroups, err = aps.GetAccountGroups(ctx, accountID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// roles granted on the cluster by cluster bindings whose selector
+	// matches the current labels of the cluster
+	clusterBindingGrants, err := aps.GetClusterBindingGrants(ctx, orgID, partnerID, userName, accountGroups, cnAttr.ServiceAccount)
+	if err != nil {
+		_log.Errorw("error getting cluster binding grants", "userCN", req.UserCN, "error", err.Error())
+		return nil, err
+	}
+
+	// roles granted on the cluster by emergency accesses of the user,
+	// applied like cluster bindings until they expire
+	var breakGlass *models.BreakGlassGrant
+	if !cnAttr.ServiceAccount {
+		breakGlassGrants, err := aps.GetBreakGlassGrants(ctx, orgID, accountID)
+		if err != nil {
+			_log.Errorw("error getting break-glass grants", "userCN", req.UserCN, "error", err.Error())
+			return nil, err
+		}
+		for i, grant := range breakGlassGrants {
+			if grant.ClusterId.String() != req.ClusterID {
+				continue
+			}
+			_log.Infow("authorization", "breakglass", grant.Name, "incident", grant.Incident, "user", cnAttr.Username)
+			clusterBindingGrants = append(clusterBindingGrants, grant.ClusterBindingGrant)
+			if breakGlass == nil || grant.ExpiresAt.Before(breakGlass.ExpiresAt) {
+				breakGlass = &breakGlassGrants[i]
+			}
+		}
+	}
+
 	// get sa, clusterroles, roles, bindings
 	sa := &corev1.ServiceAccount{}
 	sa.APIVersion = "v1"
@@ -497,7 +767,7 @@ func GetAuthorization(ctx context.Context, req *sentryrpc.GetUserAuthorizationRe
 		nsl := make([]string, 0)
 
 		for _, project := range projects {
-			namespaces, err := ns.GetProjectNamespaces(ctx, uuid.MustParse(project))
+			namespaces, err := ns.GetProjectNamespaces(ctx, uuid.MustParse(project), clusterID)
 
 			if err != nil {
 				_log.Infow("error ", err.Error())
@@ -536,14 +806,24 @@ func GetAuthorization(ctx context.Context, req *sentryrpc.GetUserAuthorizationRe
 		}
 	}
 
+	// bindings of custom roles no longer held are deleted
+	for _, roleName := range customRoles {
+		crbName := getClusterRoleBindingName(sa.Name, getCustomClusterRoleName(roleName))
+		crbExclusionMap[crbName] = true
+		for _, nsName := range projectNamespaces {
+			rbName := getRoleBindingName(sa.Name, getCustomRoleName(nsName, roleName))
+			rbExclusionMap[rbName] = &roleBindExclusionList{true, nsName}
+		}
+	}
+
 	rolePrevilage = -1
 	for project, permissions := range projectPermissions {
 		var namespaces []string
 		_log.Infow("authorization", "project", project, "user", sa.Name, "permissions", permissions)
 		groups = append(groups, permissions...)
 		// need to get the namesapces assigned to this user.
-		ns1, _ := getAccountProjectNamespace(ctx, project, accountID, ns)
-		ns2, _ := getGroupAccountProjectNamespace(ctx, project, accountID, ns)
+		ns1, _ := getAccountProjectNamespace(ctx, project, accountID, clusterID, ns)
+		ns2, _ := getGroupAccountProjectNamespace(ctx, project, accountID, clusterID, ns)
 		if len(ns1) > 0 {
 			namespaces = append(namespaces, ns1...)
 		}
@@ -555,6 +835,10 @@ func GetAuthorization(ctx context.Context, req *sentryrpc.GetUserAuthorizationRe
 		// org scope
 		if project == "" {
 			for _, permission := range permissions {
+				if isPermissionDenied(denyPolicies, userName, accountGroups, projects, "", permission) {
+					_log.Infow("authorization denied by deny policy", "user", sa.Name, "permission", permission)
+					continue
+				}
 				cr, err := getClusterRole(permission)
 				if err != nil {
 					return nil, err
@@ -574,6 +858,10 @@ func GetAuthorization(ctx context.Context, req *sentryrpc.GetUserAuthorizationRe
 			break
 		}
 		for _, permission := range permissions {
+			if !isNamespaceScopePermission(permission) && isPermissionDenied(denyPolicies, userName, accountGroups, []string{project}, "", permission) {
+				_log.Infow("authorization denied by deny policy", "project", project, "user", sa.Name, "permission", permission)
+				continue
+			}
 
 			rp := sentry.GetKubeConfigPermissionPrivilege(permission)
 			if rp > rolePrevilage {
@@ -592,6 +880,10 @@ func GetAuthorization(ctx context.Context, req *sentryrpc.GetUserAuthorizationRe
 				crbExclusionMap[crb.Name] = false
 			} else if isNamespaceScopePermission(permission) {
 				for _, namespace := range namespaces {
+					if isPermissionDenied(denyPolicies, userName, accountGroups, []string{project}, namespace, permission) {
+						_log.Infow("authorization denied by deny policy", "project", project, "namespace", namespace, "user", sa.Name, "permission", permission)
+						continue
+					}
 					ns, err := GetNamespace()
 					if err != nil {
 						return nil, err
@@ -614,10 +906,100 @@ func GetAuthorization(ctx context.Context, req *sentryrpc.GetUserAuthorizationRe
 
 	}
 
-	// add authz labels
+	for _, grant := range clusterBindingGrants {
+		if grant.ClusterId.String() != req.ClusterID {
+			continue
+		}
+		_log.Infow("authorization", "clusterbinding role", grant.RoleName, "user", sa.Name, "permissions", grant.Permissions)
+		for _, permission := range grant.Permissions {
+			if !isClusterScopePermission(permission) {
+				continue
+			}
+			if isPermissionDenied(denyPolicies, userName, accountGroups, projects, "", permission) {
+				_log.Infow("authorization denied by deny policy", "user", sa.Name, "permission", permission)
+				continue
+			}
+
+			rp := sentry.GetKubeConfigPermissionPrivilege(permission)
+			if rp > rolePrevilage {
+				rolePrevilage = rp
+				highestRole = permission
+			}
+
+			cr, err := getClusterRole(permission)
+			if err != nil {
+				return nil, err
+			}
+			crb := getClusterRoleBinding(sa, cr.Name)
+			crMap[cr.Name] = cr
+			crbMap[crb.Name] = crb
+			crbExclusionMap[crb.Name] = false
+		}
+		if len(grant.KubernetesRules) > 0 {
+			kubernetesGrants = append(kubernetesGrants, models.KubernetesRoleGrant{
+				RoleName:        grant.RoleName,
+				KubernetesRules: grant.KubernetesRules,
+			})
+		}
+	}
+
+	for _, grant := range kubernetesGrants {
+		_log.Infow("authorization", "role", grant.RoleName, "namespace", grant.Namespace, "user", sa.Name)
+		if grant.Namespace == "" && grant.NamespaceSelector == "" {
+			if isKubernetesGrantDenied(denyPolicies, userName, accountGroups, projects, "", grant) {
+				_log.Infow("authorization denied by deny policy", "user", sa.Name, "role", grant.RoleName)
+				continue
+			}
+			cr := getCustomClusterRole(grant)
+			crb := getClusterRoleBinding(sa, cr.Name)
+			crMap[cr.Name] = cr
+			crbMap[crb.Name] = crb
+			crbExclusionMap[crb.Name] = false
+			continue
+		}
+		// namespace patterns and selectors are bound in each matching
+		// namespace of the cluster
+		grantNamespaces, err := ns.MatchClusterNamespaces(ctx, clusterID, grant.Namespace, grant.NamespaceSelector)
+		if err != nil {
+			return nil, err
+		}
+		for _, namespace := range grantNamespaces {
+			if isKubernetesGrantDenied(denyPolicies, userName, accountGroups, projects, namespace, grant) {
+				_log.Infow("authorization denied by deny policy", "namespace", namespace, "user", sa.Name, "role", grant.RoleName)
+				continue
+			}
+			ns, err := GetNamespace()
+			if err != nil {
+				return nil, err
+			}
+			ns.Name = namespace
+			nsMap[namespace] = ns
+
+			grant.Namespace = namespace
+			r := getCustomRole(grant)
+			rb := getRoleBinding(sa, r.Name, namespace)
+			rMap[r.Name] = r
+			rbMap[rb.Name] = rb
+			rbExclusionMap[rb.Name] = &roleBindExclusionList{false, namespace}
+		}
+	}
+
+	// add authz labels, the authorization of an emergency access is
+	// refreshed when the access expires
+	if breakGlass != nil && breakGlass.ExpiresAt.Unix() < expiryTime {
+		fmtSaValidityDuration = strconv.FormatInt(breakGlass.ExpiresAt.Unix(), 10)
+	}
 	authzLabels := getAuthzLabels(cnAttr.Username, fmtSaValidityDuration)
 
 	sa.Labels = authzLabels
+	if breakGlass != nil {
+		sa.Labels = make(map[string]string, len(authzLabels)+2)
+		for k, v := range authzLabels {
+			sa.Labels[k] = v
+		}
+		sa.Labels[breakGlassLabel] = "true"
+		sa.Labels[breakGlassNameLabel] = breakGlass.Name
+	}
 	for k := range crMap {
 		crMap[k].Labels = authzLabels
 	}
@@ -702,6 +1084,7 @@ func GetAuthorization(ctx context.Context, req *sentryrpc.GetUserAuthorizationRe
 	resp.IsRead = sentry.GetKubeConfigPermissionIsRead(highestRole)
 	resp.EnforceOrgAdminOnlySecretAccess = enforceOrgAdminOnlySecretAccess
 	resp.IsOrgAdmin = isOrgAdmin
+	resp.BreakGlass = getBreakGlassName(sa)
 
 	_log.Infof("username %s", userName)
 
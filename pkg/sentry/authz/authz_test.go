@@ -0,0 +1,142 @@
+package authz
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/models"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+func TestGetPolicyRules(t *testing.T) {
+	rules := []models.KubernetesRule{
+		{APIGroups: []string{""}, Resources: []string{"pods", "services"}, Subresources: []string{"log", "proxy"}, Verbs: []string{"get"}},
+		{APIGroups: []string{"apps"}, Resources: []string{"deployments"}, ResourceNames: []string{"web"}, Verbs: []string{"get", "patch"}},
+	}
+	expected := []rbacv1.PolicyRule{
+		{APIGroups: []string{""}, Resources: []string{"pods/log", "pods/proxy", "services/log", "services/proxy"}, Verbs: []string{"get"}},
+		{APIGroups: []string{"apps"}, Resources: []string{"deployments"}, ResourceNames: []string{"web"}, Verbs: []string{"get", "patch"}},
+	}
+	if pr := getPolicyRules(rules); !reflect.DeepEqual(pr, expected) {
+		t.Errorf("expected policy rules %v; got %v", expected, pr)
+	}
+}
+
+func TestGetCustomRoleNames(t *testing.T) {
+	valid := regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+	names := map[string]bool{}
+	for _, roleName := range []string{"pod-reader", "Pod Reader", "pod_reader", "___", "a-very-long-role-name-that-goes-on-and-on-and-on"} {
+		cr := getCustomClusterRoleName(roleName)
+		if !valid.MatchString(cr) {
+			t.Errorf("invalid cluster role name %q for role %q", cr, roleName)
+		}
+		if names[cr] {
+			t.Errorf("cluster role name %q of role %q is not unique", cr, roleName)
+		}
+		names[cr] = true
+		if r := getCustomRoleName("web", roleName); !valid.MatchString(r) {
+			t.Errorf("invalid role name %q for role %q", r, roleName)
+		}
+	}
+	if getCustomClusterRoleName("pod-reader") != getCustomClusterRoleName("pod-reader") {
+		t.Error("expected cluster role names to be stable")
+	}
+}
+
+func TestGetCustomRoles(t *testing.T) {
+	grant := models.KubernetesRoleGrant{
+		RoleName:        "cm-editor",
+		Namespace:       "web",
+		KubernetesRules: []models.KubernetesRule{{APIGroups: []string{""}, Resources: []string{"configmaps"}, Verbs: []string{"update"}}},
+	}
+	r := getCustomRole(grant)
+	if r.Namespace != "web" || r.Name != getCustomRoleName("web", "cm-editor") || len(r.Rules) != 1 {
+		t.Errorf("unexpected role %+v", r)
+	}
+	grant.Namespace = ""
+	cr := getCustomClusterRole(grant)
+	if cr.Name != getCustomClusterRoleName("cm-editor") || cr.Kind != "ClusterRole" || len(cr.Rules) != 1 {
+		t.Errorf("unexpected cluster role %+v", cr)
+	}
+}
+
+func TestIsPermissionDenied(t *testing.T) {
+	prod := uuid.New()
+	dev := uuid.New()
+	policies := []models.DenyPolicy{
+		{ProjectId: prod, Groups: []string{"contractors"}, Permissions: []string{"kubectl.fullaccess"}},
+		{ProjectId: dev, Namespace: "payments", Users: []string{"user@example.com"}, Permissions: []string{"kubectl.namespace.write"}},
+	}
+	tt := []struct {
+		name       string
+		user       string
+		groups     []string
+		projects   []string
+		namespace  string
+		permission string
+		denied     bool
+	}{
+		{"group in project", "other@example.com", []string{"contractors"}, []string{prod.String()}, "", "kubectl.fullaccess", true},
+		{"org scope spanning project", "other@example.com", []string{"contractors"}, []string{dev.String(), prod.String()}, "", "kubectl.fullaccess", true},
+		{"other project", "other@example.com", []string{"contractors"}, []string{dev.String()}, "", "kubectl.fullaccess", false},
+		{"other group", "other@example.com", []string{"staff"}, []string{prod.String()}, "", "kubectl.fullaccess", false},
+		{"namespace", "User@example.com", nil, []string{dev.String()}, "payments", "kubectl.namespace.write", true},
+		{"other namespace", "user@example.com", nil, []string{dev.String()}, "web", "kubectl.namespace.write", false},
+		{"other permission", "user@example.com", nil, []string{dev.String()}, "payments", "kubectl.namespace.read", false},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			if denied := isPermissionDenied(policies, tc.user, tc.groups, tc.projects, tc.namespace, tc.permission); denied != tc.denied {
+				t.Errorf("expected denied %v; got %v", tc.denied, denied)
+			}
+		})
+	}
+}
+
+func TestIsKubernetesGrantDenied(t *testing.T) {
+	prod := uuid.New()
+	policies := []models.DenyPolicy{
+		{ProjectId: prod, Groups: []string{"contractors"}, Permissions: []string{"kubectl.cluster.write"}},
+		{ProjectId: prod, Namespace: "payments", Users: []string{"user@example.com"}, Permissions: []string{"kubectl.namespace.read"}},
+	}
+	reader := []models.KubernetesRule{{APIGroups: []string{""}, Resources: []string{"configmaps"}, Verbs: []string{"get", "list"}}}
+	editor := []models.KubernetesRule{{APIGroups: []string{""}, Resources: []string{"configmaps"}, Verbs: []string{"get", "update"}}}
+	tt := []struct {
+		name      string
+		user      string
+		groups    []string
+		namespace string
+		grant     models.KubernetesRoleGrant
+		denied    bool
+	}{
+		{"cluster write", "other@example.com", []string{"contractors"}, "", models.KubernetesRoleGrant{RoleName: "cm-editor", KubernetesRules: editor}, true},
+		{"cluster read", "other@example.com", []string{"contractors"}, "", models.KubernetesRoleGrant{RoleName: "cm-reader", KubernetesRules: reader}, false},
+		{"namespace read", "user@example.com", nil, "payments", models.KubernetesRoleGrant{RoleName: "cm-reader", Namespace: "payments", KubernetesRules: reader}, true},
+		{"namespace write", "user@example.com", nil, "payments", models.KubernetesRoleGrant{RoleName: "cm-editor", NamespaceSelector: "team=payments", KubernetesRules: editor}, true},
+		{"other namespace", "user@example.com", nil, "web", models.KubernetesRoleGrant{RoleName: "cm-editor", Namespace: "web", KubernetesRules: editor}, false},
+		{"other user", "other@example.com", nil, "payments", models.KubernetesRoleGrant{RoleName: "cm-reader", Namespace: "payments", KubernetesRules: reader}, false},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			if denied := isKubernetesGrantDenied(policies, tc.user, tc.groups, []string{prod.String()}, tc.namespace, tc.grant); denied != tc.denied {
+				t.Errorf("expected denied %v; got %v", tc.denied, denied)
+			}
+		})
+	}
+}
+
+func TestGetBreakGlassName(t *testing.T) {
+	sa := &corev1.ServiceAccount{}
+	sa.Labels = getAuthzLabels("oncall@example.com", "0")
+	if name := getBreakGlassName(sa); name != "" {
+		t.Errorf("expected no break-glass access; got %v", name)
+	}
+	sa.Labels[breakGlassLabel] = "true"
+	sa.Labels[breakGlassNameLabel] = "breakglass-1"
+	if name := getBreakGlassName(sa); name != "breakglass-1" {
+		t.Errorf("expected break-glass access breakglass-1; got %v", name)
+	}
+}
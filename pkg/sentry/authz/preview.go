@@ -0,0 +1,154 @@
+package authz
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/paralus/paralus/pkg/query"
+	"github.com/paralus/paralus/pkg/sentry/kubeconfig"
+	"github.com/paralus/paralus/pkg/sentry/util"
+	"github.com/paralus/paralus/pkg/service"
+	sentryrpc "github.com/paralus/paralus/proto/rpc/sentry"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	"github.com/paralus/paralus/proto/types/controller"
+	"sigs.k8s.io/yaml"
+)
+
+const clusterNameLabel = "paralus.dev/clusterName"
+
+// PreviewAuthorization returns the authorization the relay would get for
+// the user in the cluster along with the checks applied to the user. A
+// failed check is returned as the reason of the denial. Nothing is sent
+// to the relay.
+func PreviewAuthorization(ctx context.Context, req *sentryrpc.PreviewUserAuthorizationRequest, bs service.BootstrapService, aps service.AccountPermissionService, gps service.GroupPermissionService, krs service.KubeconfigRevocationService, kcs service.KubectlClusterSettingsService, kss service.KubeconfigSettingService, ns service.NamespaceService) (*sentryrpc.PreviewUserAuthorizationResponse, error) {
+	if req.GetUsername() == "" {
+		return nil, fmt.Errorf("username is required")
+	}
+	if req.GetCluster() == "" {
+		return nil, fmt.Errorf("cluster is required")
+	}
+	partnerID, orgID, err := aps.GetOrganizationIDs(ctx, req.GetMetadata().GetPartner(), req.GetMetadata().GetOrganization())
+	if err != nil {
+		return nil, err
+	}
+	accountID, err := aps.GetAccountID(ctx, req.GetUsername(), orgID, partnerID, req.GetServiceAccount())
+	if err != nil {
+		return nil, err
+	}
+	isSSO := false
+	if !req.GetServiceAccount() {
+		isSSO, err = aps.IsSSOAccount(ctx, accountID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	bal, err := bs.SelectBootstrapAgents(ctx, "-",
+		query.WithOptions(&commonv3.QueryOptions{
+			Organization: orgID,
+			Partner:      partnerID,
+			Selector:     fmt.Sprintf("%s=%s", clusterNameLabel, req.GetCluster()),
+		}),
+		query.WithIgnoreScopeDefault(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(bal.Items) == 0 {
+		return nil, fmt.Errorf("unable to find cluster '%v'", req.GetCluster())
+	}
+
+	sessionType := req.GetSessionType()
+	if sessionType == "" {
+		sessionType = kubeconfig.TerminalShell
+	}
+	cnAttr := kubeconfig.CNAttributes{
+		AccountID:      accountID,
+		PartnerID:      partnerID,
+		OrganizationID: orgID,
+		IsSSO:          isSSO,
+		Username:       util.SanitizeUsername(req.GetUsername()),
+		SessionType:    sessionType,
+		ServiceAccount: req.GetServiceAccount(),
+	}
+	// a kubeconfig issued now unless the issue time of one is given
+	certIssueSeconds := req.GetCertIssueSeconds()
+	if certIssueSeconds == 0 {
+		certIssueSeconds = time.Now().Unix()
+	}
+	authzReq := &sentryrpc.GetUserAuthorizationRequest{
+		UserCN:           cnAttr.GetCN(),
+		ClusterID:        bal.Items[0].Metadata.Name,
+		CertIssueSeconds: certIssueSeconds,
+	}
+
+	checks := &authorizationChecks{}
+	resp, err := getAuthorization(ctx, authzReq, checks, bs, aps, gps, krs, kcs, kss, ns)
+	preview := &sentryrpc.PreviewUserAuthorizationResponse{
+		UserName: cnAttr.Username,
+		Checks:   checks.checks,
+	}
+	if err != nil {
+		if !checks.denied() {
+			return nil, err
+		}
+		preview.Reason = err.Error()
+		return preview, nil
+	}
+
+	preview.Yaml, err = getAuthorizationYAML(resp)
+	if err != nil {
+		return nil, err
+	}
+	preview.Allowed = true
+	preview.UserName = resp.UserName
+	preview.RoleName = resp.RoleName
+	preview.IsRead = resp.IsRead
+	preview.EnforceOrgAdminOnlySecretAccess = resp.EnforceOrgAdminOnlySecretAccess
+	preview.IsOrgAdmin = resp.IsOrgAdmin
+	return preview, nil
+}
+
+// getAuthorizationYAML returns the objects of the authorization as a
+// multi document yaml, the bindings the relay deletes come last
+func getAuthorizationYAML(resp *sentryrpc.GetUserAuthorizationResponse) (string, error) {
+	groups := []struct {
+		objects []*controller.StepObject
+		deleted bool
+	}{
+		{objects: []*controller.StepObject{resp.ServiceAccount}},
+		{objects: resp.Namespaces},
+		{objects: resp.ClusterRoles},
+		{objects: resp.ClusterRoleBindings},
+		{objects: resp.Roles},
+		{objects: resp.RoleBindings},
+		{objects: resp.DeleteClusterRoleBindings, deleted: true},
+		{objects: resp.DeleteRoleBindings, deleted: true},
+	}
+
+	var docs []string
+	for _, group := range groups {
+		var groupDocs []string
+		for _, so := range group.objects {
+			if so == nil {
+				continue
+			}
+			yb, err := yaml.JSONToYAML(so.Raw)
+			if err != nil {
+				return "", err
+			}
+			doc := string(yb)
+			if group.deleted {
+				doc = "# deleted by the relay\n" + doc
+			}
+			groupDocs = append(groupDocs, doc)
+		}
+		// objects are built from maps
+		sort.Strings(groupDocs)
+		docs = append(docs, groupDocs...)
+	}
+	return strings.Join(docs, "---\n"), nil
+}
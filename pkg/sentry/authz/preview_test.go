@@ -0,0 +1,90 @@
+package authz
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/paralus/paralus/pkg/controller/runtime"
+	sentryrpc "github.com/paralus/paralus/proto/rpc/sentry"
+	"github.com/paralus/paralus/proto/types/controller"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestAuthorizationChecks(t *testing.T) {
+	var nochecks *authorizationChecks
+	err := errors.New("kubeconfig revoked")
+	if nochecks.record("kubeconfig revocation", err) != err {
+		t.Error("expected the error of the check")
+	}
+	nochecks.bypass("partner/super admin", "bypassed")
+
+	checks := &authorizationChecks{}
+	checks.bypass("partner/super admin", "bypassed")
+	if checks.record("cluster kubectl settings", nil) != nil || checks.denied() {
+		t.Error("expected the check to pass")
+	}
+	if checks.record("kubeconfig revocation", err) != err || !checks.denied() {
+		t.Error("expected the check to deny")
+	}
+	if len(checks.checks) != 3 || checks.checks[2].Reason != "kubeconfig revoked" || checks.checks[0].Reason != "bypassed" {
+		t.Errorf("unexpected checks %v", checks.checks)
+	}
+}
+
+func TestGetAuthorizationYAML(t *testing.T) {
+	sa := &corev1.ServiceAccount{}
+	sa.APIVersion = "v1"
+	sa.Kind = "ServiceAccount"
+	sa.Name = "user"
+	sa.Namespace = "paralus-system"
+	saObject, err := runtime.FromObject(sa)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var roles []*controller.StepObject
+	for _, permission := range []string{"kubectl.cluster.write", "kubectl.cluster.read"} {
+		cr, err := getClusterRole(permission)
+		if err != nil {
+			t.Fatal(err)
+		}
+		crObject, err := runtime.FromObject(cr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		roles = append(roles, crObject)
+	}
+	crbObject, err := runtime.FromObject(getDeleteClusterRoleBinding("stale-binding"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp := &sentryrpc.GetUserAuthorizationResponse{
+		ServiceAccount:            saObject,
+		ClusterRoles:              roles,
+		DeleteClusterRoleBindings: []*controller.StepObject{crbObject},
+	}
+	y, err := getAuthorizationYAML(resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	docs := strings.Split(y, "---\n")
+	if len(docs) != 4 {
+		t.Fatalf("expected 4 documents; got %d", len(docs))
+	}
+	if !strings.Contains(docs[0], "kind: ServiceAccount") {
+		t.Errorf("expected the service account first; got %s", docs[0])
+	}
+	if !strings.HasPrefix(docs[3], "# deleted by the relay\n") || !strings.Contains(docs[3], "name: stale-binding") {
+		t.Errorf("expected the deleted binding last; got %s", docs[3])
+	}
+	again, _ := getAuthorizationYAML(&sentryrpc.GetUserAuthorizationResponse{
+		ServiceAccount:            saObject,
+		ClusterRoles:              []*controller.StepObject{roles[1], roles[0]},
+		DeleteClusterRoleBindings: []*controller.StepObject{crbObject},
+	})
+	if again != y {
+		t.Error("expected the yaml to not depend on the order of the objects")
+	}
+}
@@ -19,6 +19,8 @@ const (
 	SessionTypeCN = "st"
 	// SystemUserCN is system user attribute key of CN
 	SystemUserCN = "su"
+	// ServiceAccountCN is service account attribute key of CN
+	ServiceAccountCN = "sa"
 
 	// TerminalShell is the session originated for a terminal based kubectl cli
 	TerminalShell = "ts"
@@ -41,6 +43,7 @@ type CNAttributes struct {
 	SessionType    string
 	SystemUser     bool
 	RelayNetwork   bool
+	ServiceAccount bool
 }
 
 // GetCNAttributes gets attributes from CN
@@ -70,6 +73,8 @@ func GetCNAttributes(cn string) (cnAttr CNAttributes) {
 			cnAttr.SystemUser = GetBoolFromString(kv[1])
 		case RelayNetworkCN:
 			cnAttr.RelayNetwork = GetBoolFromString(kv[1])
+		case ServiceAccountCN:
+			cnAttr.ServiceAccount = GetBoolFromString(kv[1])
 		}
 	}
 	return
@@ -147,6 +152,12 @@ func (cn *CNAttributes) GetCN() string {
 	sb.WriteString(GetStringFromBool(cn.RelayNetwork))
 	sb.WriteRune('/')
 
+	// service account
+	sb.WriteString(ServiceAccountCN)
+	sb.WriteRune('=')
+	sb.WriteString(GetStringFromBool(cn.ServiceAccount))
+	sb.WriteRune('/')
+
 	return sb.String()
 }
 
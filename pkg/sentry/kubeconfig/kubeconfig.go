@@ -111,6 +111,65 @@ func getProjectsForAccount(ctx context.Context, accountID, orgID, partnerID stri
 	return projects, isOrgScope, nil
 }
 
+// getClusterBindingClusters returns the ids of the clusters the cluster
+// bindings applying to the account grant kubeconfig.read on, and of the
+// clusters of its emergency accesses
+func getClusterBindingClusters(ctx context.Context, accountID, orgID, partnerID, username string, groups []string, isSSO, serviceAccount bool, aps service.AccountPermissionService) (map[string]bool, error) {
+	if !isSSO || serviceAccount {
+		var err error
+		groups, err = aps.GetAccountGroups(ctx, accountID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	grants, err := aps.GetClusterBindingGrants(ctx, orgID, partnerID, username, groups, serviceAccount)
+	if err != nil {
+		return nil, err
+	}
+	clusters := make(map[string]bool)
+	for _, grant := range grants {
+		for _, p := range grant.Permissions {
+			if p == kubeconfigPermission {
+				clusters[grant.ClusterId.String()] = true
+			}
+		}
+	}
+	if serviceAccount {
+		return clusters, nil
+	}
+	breakGlassGrants, err := aps.GetBreakGlassGrants(ctx, orgID, accountID)
+	if err != nil {
+		return nil, err
+	}
+	for _, grant := range breakGlassGrants {
+		clusters[grant.ClusterId.String()] = true
+	}
+	return clusters, nil
+}
+
+// checkStepUp refuses kubeconfigs to sessions which did not recently
+// step up to aal2 when the mfa policy of the organization asks for it
+func checkStepUp(ctx context.Context, os service.OrganizationService, orgID string) error {
+	sd, ok := service.GetSessionDataFromContext(ctx)
+	if !ok || !service.IsConsoleSession(sd) {
+		return nil
+	}
+	org, err := os.GetByID(ctx, orgID)
+	if err != nil {
+		_log.Errorw("error getting organization data", "error", err.Error())
+		return fmt.Errorf("failed to retrieve organization %s", err.Error())
+	}
+	policy := org.GetSpec().GetSettings().GetMfa()
+	if !policy.GetEnabled() || policy.GetKubeconfigStepUpMin() <= 0 {
+		return nil
+	}
+	if !service.HasRecentStepUp(sd, time.Duration(policy.GetKubeconfigStepUpMin())*time.Minute) {
+		_log.Infow("refusing kubeconfig without recent step-up", "username", sd.Username)
+		return service.ErrStepUpRequired
+	}
+	return nil
+}
+
 // GetConfigForUser returns YAML encoding of kubeconfig
 func GetConfigForUser(ctx context.Context, bs service.BootstrapService, aps service.AccountPermissionService, gps service.GroupPermissionService, req *sentryrpc.GetForUserRequest, pf cryptoutil.PasswordFunc, kss service.KubeconfigSettingService, ksvc service.ApiKeyService, os service.OrganizationService, ps service.PartnerService, al *zap.Logger) ([]byte, error) {
 	opts := req.Opts
@@ -143,6 +202,7 @@ func GetConfigForUser(ctx context.Context, bs service.BootstrapService, aps serv
 	sessionUserName := opts.Username
 	groups := opts.Groups
 	enforceSession := false
+	serviceAccount := false
 
 	if sessionUserName == "" && opts.Account != "" {
 		accountData, err := aps.GetAccount(ctx, opts.Account)
@@ -161,6 +221,7 @@ func GetConfigForUser(ctx context.Context, bs service.BootstrapService, aps serv
 		sessionUserName = apiKey.Name
 		username = apiKey.Name
 		opts.Account = apiKey.AccountID.String()
+		serviceAccount = apiKey.IsServiceAccount
 	} else if sessionUserName == "" && opts.Account == "" {
 		_log.Errorw("error getting account data", "error", err.Error())
 		return nil, fmt.Errorf("account information not present in request")
@@ -198,6 +259,10 @@ func GetConfigForUser(ctx context.Context, bs service.BootstrapService, aps serv
 		opts.Partner = part.Metadata.Id
 	}
 
+	if err := checkStepUp(ctx, os, opts.Organization); err != nil {
+		return nil, err
+	}
+
 	// get user level settings if exist
 	ks, err := kss.Get(ctx, opts.Organization, opts.Account, isSSOAcc)
 	if err == constants.ErrNotFound {
@@ -224,6 +289,7 @@ func GetConfigForUser(ctx context.Context, bs service.BootstrapService, aps serv
 		Username:       util.SanitizeUsername(username),
 		SessionType:    TerminalShell,
 		RelayNetwork:   false,
+		ServiceAccount: serviceAccount,
 	}
 	cn := cnAttr.GetCN()
 
@@ -296,6 +362,31 @@ func GetConfigForUser(ctx context.Context, bs service.BootstrapService, aps serv
 				}
 			}
 		}
+
+		// clusters whose labels match the selector of a cluster binding
+		clusters, err := getClusterBindingClusters(ctx, opts.Account, opts.Organization, opts.Partner, username, groups, isSSOAcc, serviceAccount, aps)
+		if err != nil {
+			_log.Errorw("error getting cluster binding clusters", "account", opts.Account, "error", err.Error())
+			return nil, err
+		}
+		if len(clusters) > 0 {
+			bal, err := bs.SelectBootstrapAgents(ctx, "-",
+				query.WithOptions(opts),
+				// ignore project id, because kubeconfig is not project scoped
+				query.WithIgnoreScopeDefault(),
+			)
+			if err != nil {
+				_log.Errorw("error getting bootstrap agents", "error", err.Error())
+				return nil, err
+			}
+			for _, ba := range bal.Items {
+				if _, ok := set[ba.Metadata.Name]; ok || !clusters[ba.Metadata.Name] {
+					continue
+				}
+				set[ba.Metadata.Name] = ba
+				bas = append(bas, ba)
+			}
+		}
 	}
 
 	serverHost := ""
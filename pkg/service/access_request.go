@@ -0,0 +1,438 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/dao"
+	"github.com/paralus/paralus/internal/models"
+	authzv1 "github.com/paralus/paralus/proto/types/authz"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	userv3 "github.com/paralus/paralus/proto/types/userpb/v3"
+	bun "github.com/uptrace/bun"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	accessRequestKind     = "AccessRequest"
+	accessRequestListKind = "AccessRequestList"
+)
+
+// states of access requests
+const (
+	AccessRequestPending  = "PENDING"
+	AccessRequestApproved = "APPROVED"
+	AccessRequestDenied   = "DENIED"
+)
+
+// AccessRequestService is the interface for access request operations
+type AccessRequestService interface {
+	// create access request of the user of the session
+	Create(context.Context, *userv3.AccessRequest) (*userv3.AccessRequest, error)
+	// get access request by name
+	GetByName(context.Context, *userv3.AccessRequest) (*userv3.AccessRequest, error)
+	// list access requests
+	List(context.Context, *userv3.AccessRequestQuery) (*userv3.AccessRequestList, error)
+	// Approve approves a pending request and grants the requested role
+	// until the requested duration elapsed
+	Approve(context.Context, *userv3.AccessRequestReview) (*userv3.AccessRequest, error)
+	// Deny denies a pending request
+	Deny(context.Context, *userv3.AccessRequestReview) (*userv3.AccessRequest, error)
+}
+
+// accessRequestService implements AccessRequestService
+type accessRequestService struct {
+	db  *bun.DB
+	azc AuthzService
+	aps AccountPermissionService
+	al  *zap.Logger
+}
+
+// NewAccessRequestService return new access request service
+func NewAccessRequestService(db *bun.DB, azc AuthzService, aps AccountPermissionService, al *zap.Logger) AccessRequestService {
+	return &accessRequestService{db: db, azc: azc, aps: aps, al: al}
+}
+
+// getRequestSession returns the session of a request on the access
+// requests of an organization. Access requests are not authorized by
+// role, so users may only act in their own organization.
+func (s *accessRequestService) getRequestSession(ctx context.Context, partner, org string) (*commonv3.SessionData, uuid.UUID, uuid.UUID, error) {
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok || sd.GetUsername() == "" {
+		return nil, uuid.Nil, uuid.Nil, fmt.Errorf("unable to get session of access request")
+	}
+	if sd.GetIsServiceAccount() {
+		return nil, uuid.Nil, uuid.Nil, fmt.Errorf("access requests are not supported for service accounts")
+	}
+	partnerId, organizationId, err := getPartnerOrganization(ctx, s.db, partner, org)
+	if err != nil {
+		return nil, uuid.Nil, uuid.Nil, fmt.Errorf("unable to get partner and org id")
+	}
+	if sd.GetOrganization() != organizationId.String() || sd.GetPartner() != partnerId.String() {
+		return nil, uuid.Nil, uuid.Nil, fmt.Errorf("not authorized to access requests of organization '%v'", org)
+	}
+	return sd, partnerId, organizationId, nil
+}
+
+// isApprover returns whether the user of sd may approve and deny access
+// requests of the organization
+func (s *accessRequestService) isApprover(ctx context.Context, sd *commonv3.SessionData, partnerId, organizationId uuid.UUID) (bool, error) {
+	approvers, err := s.aps.GetAcccountsWithApprovalPermission(ctx, organizationId.String(), partnerId.String())
+	if err != nil {
+		return false, err
+	}
+	ssoApprovers, err := s.aps.GetSSOAcccountsWithApprovalPermission(ctx, organizationId.String(), partnerId.String())
+	if err != nil {
+		return false, err
+	}
+	for _, a := range append(approvers, ssoApprovers...) {
+		if strings.EqualFold(a, sd.GetUsername()) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *accessRequestService) getAccessRequest(ctx context.Context, name string, partnerId, organizationId uuid.UUID) (*models.AccessRequest, error) {
+	entity, err := dao.GetByNamePartnerOrg(ctx, s.db, name, uuid.NullUUID{UUID: partnerId, Valid: true}, uuid.NullUUID{UUID: organizationId, Valid: true}, &models.AccessRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("no access request found with name '%v'", name)
+	}
+	if ar, ok := entity.(*models.AccessRequest); ok {
+		return ar, nil
+	}
+	return nil, fmt.Errorf("no access request found with name '%v'", name)
+}
+
+// getRequestedRole returns the role of spec, the role has to be a
+// project role or a namespace role requested on a namespace
+func (s *accessRequestService) getRequestedRole(ctx context.Context, spec *userv3.AccessRequestSpec) (*models.Role, error) {
+	entity, err := dao.GetByName(ctx, s.db, spec.GetRole(), &models.Role{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to find role '%v'", spec.GetRole())
+	}
+	role, ok := entity.(*models.Role)
+	if !ok {
+		return nil, fmt.Errorf("unable to find role '%v'", spec.GetRole())
+	}
+	switch strings.ToLower(role.Scope) {
+	case "project":
+		if spec.GetNamespace() != "" {
+			return nil, fmt.Errorf("role '%v' cannot be requested on a namespace", role.Name)
+		}
+	case "namespace":
+		if spec.GetNamespace() == "" {
+			return nil, fmt.Errorf("no namespace provided for role '%v'", role.Name)
+		}
+	default:
+		return nil, fmt.Errorf("only project and namespace roles can be requested")
+	}
+	return role, nil
+}
+
+func (s *accessRequestService) toV3AccessRequest(req *userv3.AccessRequest, ar *models.AccessRequest, partner, org string) *userv3.AccessRequest {
+	req.ApiVersion = apiVersion
+	req.Kind = accessRequestKind
+	req.Metadata = &commonv3.Metadata{
+		Name:         ar.Name,
+		Description:  ar.Description,
+		Id:           ar.ID.String(),
+		Organization: org,
+		Partner:      partner,
+		Project:      ar.Project,
+		ModifiedAt:   timestamppb.New(ar.ModifiedAt),
+		CreatedAt:    timestamppb.New(ar.CreatedAt),
+	}
+	req.Spec = &userv3.AccessRequestSpec{
+		Role:          ar.Role,
+		Project:       ar.Project,
+		Namespace:     ar.Namespace,
+		Duration:      ar.Duration,
+		Justification: ar.Justification,
+	}
+	status := &userv3.AccessRequestStatus{
+		State:       ar.State,
+		Requester:   ar.Requester,
+		Reviewer:    ar.Reviewer,
+		Reason:      ar.Reason,
+		RequestedAt: timestamppb.New(ar.CreatedAt),
+	}
+	if !ar.ReviewedAt.IsZero() {
+		status.ReviewedAt = timestamppb.New(ar.ReviewedAt)
+	}
+	if !ar.ExpiresAt.IsZero() {
+		status.ExpiresAt = timestamppb.New(ar.ExpiresAt)
+	}
+	req.Status = status
+	return req
+}
+
+func (s *accessRequestService) Create(ctx context.Context, req *userv3.AccessRequest) (*userv3.AccessRequest, error) {
+	sd, partnerId, organizationId, err := s.getRequestSession(ctx, req.GetMetadata().GetPartner(), req.GetMetadata().GetOrganization())
+	if err != nil {
+		return nil, err
+	}
+	spec := req.GetSpec()
+	role, err := s.getRequestedRole(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+	if spec.GetProject() == "" {
+		return nil, fmt.Errorf("no project provided for role '%v'", role.Name)
+	}
+	if _, err := dao.GetProjectId(ctx, s.db, spec.GetProject()); err != nil {
+		return nil, fmt.Errorf("unable to find project '%v'", spec.GetProject())
+	}
+	duration, err := time.ParseDuration(spec.GetDuration())
+	if err != nil || duration <= 0 {
+		return nil, fmt.Errorf("invalid duration '%v'", spec.GetDuration())
+	}
+	if strings.TrimSpace(spec.GetJustification()) == "" {
+		return nil, fmt.Errorf("justification of access request is required")
+	}
+	requesterId, err := uuid.Parse(sd.GetAccount())
+	if err != nil {
+		return nil, fmt.Errorf("unable to get account of access request")
+	}
+
+	name := req.GetMetadata().GetName()
+	if name == "" {
+		name = "accessrequest-" + strings.Split(uuid.New().String(), "-")[0]
+	}
+	e, _ := dao.GetIdByNamePartnerOrg(ctx, s.db, name, uuid.NullUUID{UUID: partnerId, Valid: true}, uuid.NullUUID{UUID: organizationId, Valid: true}, &models.AccessRequest{})
+	if e != nil {
+		return nil, fmt.Errorf("access request '%v' already exists", name)
+	}
+
+	ar := models.AccessRequest{
+		Name:           name,
+		Description:    req.GetMetadata().GetDescription(),
+		CreatedAt:      time.Now(),
+		ModifiedAt:     time.Now(),
+		Trash:          false,
+		OrganizationId: organizationId,
+		PartnerId:      partnerId,
+		RequesterId:    requesterId,
+		Requester:      sd.GetUsername(),
+		Role:           role.Name,
+		Project:        spec.GetProject(),
+		Namespace:      spec.GetNamespace(),
+		Duration:       duration.String(),
+		Justification:  spec.GetJustification(),
+		State:          AccessRequestPending,
+	}
+	_, err = dao.Create(ctx, s.db, &ar)
+	if err != nil {
+		return &userv3.AccessRequest{}, err
+	}
+
+	CreateAccessRequestAuditEvent(ctx, s.al, AuditActionCreate, &ar)
+	return s.toV3AccessRequest(req, &ar, req.GetMetadata().GetPartner(), req.GetMetadata().GetOrganization()), nil
+}
+
+func (s *accessRequestService) GetByName(ctx context.Context, req *userv3.AccessRequest) (*userv3.AccessRequest, error) {
+	sd, partnerId, organizationId, err := s.getRequestSession(ctx, req.GetMetadata().GetPartner(), req.GetMetadata().GetOrganization())
+	if err != nil {
+		return &userv3.AccessRequest{}, err
+	}
+	ar, err := s.getAccessRequest(ctx, req.GetMetadata().GetName(), partnerId, organizationId)
+	if err != nil {
+		return &userv3.AccessRequest{}, err
+	}
+	if !strings.EqualFold(ar.Requester, sd.GetUsername()) {
+		approver, err := s.isApprover(ctx, sd, partnerId, organizationId)
+		if err != nil {
+			return &userv3.AccessRequest{}, err
+		}
+		if !approver {
+			return &userv3.AccessRequest{}, fmt.Errorf("no access request found with name '%v'", ar.Name)
+		}
+	}
+	return s.toV3AccessRequest(req, ar, req.GetMetadata().GetPartner(), req.GetMetadata().GetOrganization()), nil
+}
+
+func (s *accessRequestService) List(ctx context.Context, q *userv3.AccessRequestQuery) (*userv3.AccessRequestList, error) {
+	arList := &userv3.AccessRequestList{
+		ApiVersion: apiVersion,
+		Kind:       accessRequestListKind,
+		Metadata: &commonv3.ListMetadata{
+			Count: 0,
+		},
+	}
+	sd, partnerId, organizationId, err := s.getRequestSession(ctx, q.GetPartner(), q.GetOrganization())
+	if err != nil {
+		return arList, err
+	}
+	requester := q.GetRequester()
+	approver, err := s.isApprover(ctx, sd, partnerId, organizationId)
+	if err != nil {
+		return arList, err
+	}
+	// users who cannot approve only see their own requests
+	if !approver {
+		if requester != "" && !strings.EqualFold(requester, sd.GetUsername()) {
+			return arList, fmt.Errorf("not authorized to list access requests of '%v'", requester)
+		}
+		requester = sd.GetUsername()
+	}
+
+	ars, err := dao.ListAccessRequests(ctx, s.db, partnerId, organizationId, requester, q.GetReviewer(), strings.ToUpper(q.GetState()))
+	if err != nil {
+		return arList, err
+	}
+	var items []*userv3.AccessRequest
+	for i := range ars {
+		items = append(items, s.toV3AccessRequest(&userv3.AccessRequest{}, &ars[i], q.GetPartner(), q.GetOrganization()))
+	}
+	arList.Metadata = &commonv3.ListMetadata{
+		Count: int64(len(items)),
+	}
+	arList.Items = items
+	return arList, nil
+}
+
+// review moves a pending request of the review to state, approved
+// requests get their role granted in the same transaction
+func (s *accessRequestService) review(ctx context.Context, rv *userv3.AccessRequestReview, state string) (*userv3.AccessRequest, error) {
+	sd, partnerId, organizationId, err := s.getRequestSession(ctx, rv.GetMetadata().GetPartner(), rv.GetMetadata().GetOrganization())
+	if err != nil {
+		return &userv3.AccessRequest{}, err
+	}
+	approver, err := s.isApprover(ctx, sd, partnerId, organizationId)
+	if err != nil {
+		return &userv3.AccessRequest{}, err
+	}
+	if !approver {
+		return &userv3.AccessRequest{}, fmt.Errorf("not authorized to review access requests")
+	}
+	ar, err := s.getAccessRequest(ctx, rv.GetMetadata().GetName(), partnerId, organizationId)
+	if err != nil {
+		return &userv3.AccessRequest{}, err
+	}
+	if strings.EqualFold(ar.Requester, sd.GetUsername()) {
+		return &userv3.AccessRequest{}, fmt.Errorf("access requests cannot be reviewed by their requester")
+	}
+	if ar.State != AccessRequestPending {
+		return &userv3.AccessRequest{}, fmt.Errorf("access request '%v' is already %v", ar.Name, strings.ToLower(ar.State))
+	}
+
+	now := time.Now()
+	ar.State = state
+	ar.Reviewer = sd.GetUsername()
+	ar.Reason = rv.GetReason()
+	ar.ReviewedAt = now
+	ar.ModifiedAt = now
+	if state == AccessRequestApproved {
+		duration, err := time.ParseDuration(ar.Duration)
+		if err != nil {
+			return &userv3.AccessRequest{}, fmt.Errorf("invalid duration '%v'", ar.Duration)
+		}
+		ar.ExpiresAt = now.Add(duration)
+	}
+
+	err = s.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		claimed, err := dao.ReviewAccessRequest(ctx, tx, ar, AccessRequestPending)
+		if err != nil {
+			return err
+		}
+		if !claimed {
+			return fmt.Errorf("access request '%v' was already reviewed", ar.Name)
+		}
+		if state != AccessRequestApproved {
+			return nil
+		}
+		return s.grant(ctx, tx, ar, rv.GetMetadata().GetOrganization())
+	})
+	if err != nil {
+		return &userv3.AccessRequest{}, err
+	}
+
+	action := "deny"
+	if state == AccessRequestApproved {
+		action = "approve"
+	}
+	CreateAccessRequestAuditEvent(ctx, s.al, action, ar)
+	return s.toV3AccessRequest(&userv3.AccessRequest{}, ar, rv.GetMetadata().GetPartner(), rv.GetMetadata().GetOrganization()), nil
+}
+
+// grant binds the requested role to the requester until the request
+// expires, the binding is removed by the RoleBindingReconciler
+func (s *accessRequestService) grant(ctx context.Context, db bun.IDB, ar *models.AccessRequest, org string) error {
+	entity, err := dao.GetByName(ctx, db, ar.Role, &models.Role{})
+	if err != nil {
+		return fmt.Errorf("unable to find role '%v'", ar.Role)
+	}
+	role, ok := entity.(*models.Role)
+	if !ok {
+		return fmt.Errorf("unable to find role '%v'", ar.Role)
+	}
+	projectId, err := dao.GetProjectId(ctx, db, ar.Project)
+	if err != nil {
+		return fmt.Errorf("unable to find project '%v'", ar.Project)
+	}
+
+	p := &authzv1.Policy{Sub: "u:" + ar.Requester, Ns: policyNamespace(ar.Namespace), Proj: ar.Project, Org: org, Obj: role.Name}
+	// requests for a role the requester already holds are not granted
+	rbs, err := dao.GetRoleBindingsInEffect(ctx, db, ar.OrganizationId, role.ID)
+	if err != nil {
+		return err
+	}
+	for _, rb := range rbs {
+		if proto.Equal(roleBindingPolicy(rb), p) {
+			return fmt.Errorf("'%v' already has role '%v' in project '%v'", ar.Requester, ar.Role, ar.Project)
+		}
+	}
+
+	if ar.Namespace != "" {
+		_, err = dao.Create(ctx, db, &models.ProjectAccountNamespaceRole{
+			CreatedAt:      ar.ReviewedAt,
+			ModifiedAt:     ar.ReviewedAt,
+			Trash:          false,
+			PartnerId:      ar.PartnerId,
+			OrganizationId: ar.OrganizationId,
+			RoleId:         role.ID,
+			AccountId:      ar.RequesterId,
+			ProjectId:      projectId,
+			Namespace:      ar.Namespace,
+			ExpiresAt:      ar.ExpiresAt,
+			Active:         true,
+		})
+	} else {
+		_, err = dao.Create(ctx, db, &models.ProjectAccountResourcerole{
+			CreatedAt:      ar.ReviewedAt,
+			ModifiedAt:     ar.ReviewedAt,
+			Trash:          false,
+			Default:        true,
+			RoleId:         role.ID,
+			PartnerId:      ar.PartnerId,
+			OrganizationId: ar.OrganizationId,
+			AccountId:      ar.RequesterId,
+			ProjectId:      projectId,
+			ExpiresAt:      ar.ExpiresAt,
+			Active:         true,
+		})
+	}
+	if err != nil {
+		return err
+	}
+
+	success, err := s.azc.CreatePolicies(ctx, &authzv1.Policies{Policies: []*authzv1.Policy{p}})
+	if err != nil || !success.Res {
+		return fmt.Errorf("unable to create mapping in authz; %v", err)
+	}
+	return nil
+}
+
+func (s *accessRequestService) Approve(ctx context.Context, rv *userv3.AccessRequestReview) (*userv3.AccessRequest, error) {
+	return s.review(ctx, rv, AccessRequestApproved)
+}
+
+func (s *accessRequestService) Deny(ctx context.Context, rv *userv3.AccessRequestReview) (*userv3.AccessRequest, error) {
+	return s.review(ctx, rv, AccessRequestDenied)
+}
@@ -0,0 +1,336 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/pkg/common"
+	v3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	userv3 "github.com/paralus/paralus/proto/types/userpb/v3"
+)
+
+// fakeAccountPermissionService returns fixed approvers
+type fakeAccountPermissionService struct {
+	AccountPermissionService
+	approvers    []string
+	ssoApprovers []string
+}
+
+func (f *fakeAccountPermissionService) GetAcccountsWithApprovalPermission(ctx context.Context, orgID, partnerID string) ([]string, error) {
+	return f.approvers, nil
+}
+
+func (f *fakeAccountPermissionService) GetSSOAcccountsWithApprovalPermission(ctx context.Context, orgID, partnerID string) ([]string, error) {
+	return f.ssoApprovers, nil
+}
+
+func accessRequestContext(username, account, puuid, ouuid string) context.Context {
+	sd := &v3.SessionData{Username: username, Account: account, Partner: puuid, Organization: ouuid}
+	return context.WithValue(context.Background(), common.SessionDataKey, sd)
+}
+
+func accessRequestRows(id, name, requester, requesterId, namespace string) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"id", "name", "requester", "requester_id", "role", "project", "namespace", "duration", "justification", "state"}).
+		AddRow(id, name, requester, requesterId, "PROJECT_READ_ONLY", "prod", namespace, "4h0m0s", "incident", AccessRequestPending)
+}
+
+func TestCreateAccessRequest(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	ars := NewAccessRequestService(db, &mockAuthzClient{}, &fakeAccountPermissionService{}, getLogger())
+	auuid := uuid.NewString()
+	accuuid := uuid.NewString()
+
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+	mock.ExpectQuery(`SELECT "resourcerole"."id", .* FROM "authsrv_resourcerole" AS "resourcerole" WHERE .name = 'PROJECT_READ_ONLY'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "scope"}).AddRow(uuid.NewString(), "PROJECT_READ_ONLY", "PROJECT"))
+	mock.ExpectQuery(`SELECT "project"."id" FROM "authsrv_project" AS "project" WHERE .*name = 'prod'`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.NewString()))
+	mock.ExpectQuery(`SELECT "accessrequest"."id" FROM "authsrv_access_request" AS "accessrequest" WHERE .organization_id = '` + ouuid + `'. AND .partner_id = '` + puuid + `'. AND .name = 'accessrequest-` + auuid + `'.`).
+		WillReturnError(fmt.Errorf("no data available"))
+	mock.ExpectQuery(`INSERT INTO "authsrv_access_request" .* VALUES .*'` + accuuid + `', 'user@example.com', 'PROJECT_READ_ONLY', 'prod', '', '4h0m0s', 'incident', 'PENDING'`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(auuid))
+
+	ar := &userv3.AccessRequest{
+		Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Name: "accessrequest-" + auuid},
+		Spec:     &userv3.AccessRequestSpec{Role: "PROJECT_READ_ONLY", Project: "prod", Duration: "4h", Justification: "incident"},
+	}
+	ar, err := ars.Create(accessRequestContext("user@example.com", accuuid, puuid, ouuid), ar)
+	if err != nil {
+		t.Fatal("could not create access request:", err)
+	}
+	if ar.GetStatus().GetState() != AccessRequestPending || ar.GetStatus().GetRequester() != "user@example.com" {
+		t.Errorf("unexpected status of access request %v", ar.GetStatus())
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error("unfulfilled expectations:", err)
+	}
+}
+
+func TestCreateAccessRequestValidation(t *testing.T) {
+	tt := []struct {
+		name  string
+		scope string
+		spec  *userv3.AccessRequestSpec
+	}{
+		{"namespace role without namespace", "NAMESPACE", &userv3.AccessRequestSpec{Role: "NAMESPACE_ADMIN", Project: "prod", Duration: "1h", Justification: "incident"}},
+		{"project role on namespace", "PROJECT", &userv3.AccessRequestSpec{Role: "PROJECT_ADMIN", Project: "prod", Namespace: "payments", Duration: "1h", Justification: "incident"}},
+		{"organization role", "ORGANIZATION", &userv3.AccessRequestSpec{Role: "ADMIN", Project: "prod", Duration: "1h", Justification: "incident"}},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock := getDB(t)
+			defer db.Close()
+
+			ars := NewAccessRequestService(db, &mockAuthzClient{}, &fakeAccountPermissionService{}, getLogger())
+			puuid, ouuid := addParterOrgFetchExpectation(mock)
+			mock.ExpectQuery(`SELECT "resourcerole"."id", .* FROM "authsrv_resourcerole" AS "resourcerole"`).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "name", "scope"}).AddRow(uuid.NewString(), tc.spec.Role, tc.scope))
+
+			ar := &userv3.AccessRequest{
+				Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid},
+				Spec:     tc.spec,
+			}
+			_, err := ars.Create(accessRequestContext("user@example.com", uuid.NewString(), puuid, ouuid), ar)
+			if err == nil {
+				t.Error("expected error creating access request")
+			}
+		})
+	}
+}
+
+func TestCreateAccessRequestOtherOrganization(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	ars := NewAccessRequestService(db, &mockAuthzClient{}, &fakeAccountPermissionService{}, getLogger())
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+
+	ar := &userv3.AccessRequest{
+		Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid},
+		Spec:     &userv3.AccessRequestSpec{Role: "PROJECT_READ_ONLY", Project: "prod", Duration: "4h", Justification: "incident"},
+	}
+	_, err := ars.Create(accessRequestContext("user@example.com", uuid.NewString(), puuid, uuid.NewString()), ar)
+	if err == nil {
+		t.Error("expected error creating access request in another organization")
+	}
+}
+
+func TestApproveAccessRequest(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	mazc := mockAuthzClient{}
+	ars := NewAccessRequestService(db, &mazc, &fakeAccountPermissionService{approvers: []string{"admin@example.com"}}, getLogger())
+	auuid := uuid.NewString()
+	accuuid := uuid.NewString()
+	pruuid := uuid.NewString()
+
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+	mock.ExpectQuery(`SELECT "accessrequest"."id", .* FROM "authsrv_access_request" AS "accessrequest" WHERE .organization_id = '` + ouuid + `'. AND .partner_id = '` + puuid + `'. AND .name = 'accessrequest-` + auuid + `'.`).
+		WillReturnRows(accessRequestRows(auuid, "accessrequest-"+auuid, "user@example.com", accuuid, ""))
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "authsrv_access_request" AS "accessrequest" SET "state" = 'APPROVED', "reviewer" = 'admin@example.com', "reason" = 'approved for incident', .* WHERE .state = 'PENDING'. AND .trash = FALSE. AND ."accessrequest"."id" = '` + auuid + `'.`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery(`SELECT "resourcerole"."id", .* FROM "authsrv_resourcerole" AS "resourcerole" WHERE .name = 'PROJECT_READ_ONLY'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "scope"}).AddRow(uuid.NewString(), "PROJECT_READ_ONLY", "PROJECT"))
+	mock.ExpectQuery(`SELECT "project"."id" FROM "authsrv_project" AS "project" WHERE .*name = 'prod'`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(pruuid))
+	for _, table := range roleBindingTestTables {
+		mock.ExpectQuery(`SELECT rb.id, .* FROM ` + table + ` AS rb .* \(rb.starts_at IS NULL OR rb.starts_at <= now\(\)\)`).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	}
+	mock.ExpectQuery(`INSERT INTO "authsrv_projectaccountresourcerole" .* VALUES .*'` + accuuid + `', '` + pruuid + `'`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.NewString()))
+	mock.ExpectCommit()
+
+	rv := &userv3.AccessRequestReview{
+		Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Name: "accessrequest-" + auuid},
+		Reason:   "approved for incident",
+	}
+	ar, err := ars.Approve(accessRequestContext("admin@example.com", uuid.NewString(), puuid, ouuid), rv)
+	if err != nil {
+		t.Fatal("could not approve access request:", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error("unfulfilled expectations:", err)
+	}
+
+	if ar.GetStatus().GetState() != AccessRequestApproved || ar.GetStatus().GetReviewer() != "admin@example.com" {
+		t.Errorf("unexpected status of access request %v", ar.GetStatus())
+	}
+	expiresIn := time.Until(ar.GetStatus().GetExpiresAt().AsTime())
+	if expiresIn < 3*time.Hour || expiresIn > 4*time.Hour {
+		t.Errorf("expected role to expire in 4h; got %v", expiresIn)
+	}
+	if len(mazc.cp) != 1 || len(mazc.cp[0].Policies) != 1 {
+		t.Fatalf("expected one policy created in authz; got %v", mazc.cp)
+	}
+	p := mazc.cp[0].Policies[0]
+	if p.Sub != "u:user@example.com" || p.Ns != "*" || p.Proj != "prod" || p.Org != "org-"+ouuid || p.Obj != "PROJECT_READ_ONLY" {
+		t.Errorf("unexpected policy created in authz %v", p)
+	}
+}
+
+func TestApproveAccessRequestAlreadyGranted(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	mazc := mockAuthzClient{}
+	ars := NewAccessRequestService(db, &mazc, &fakeAccountPermissionService{approvers: []string{"admin@example.com"}}, getLogger())
+	auuid := uuid.NewString()
+	ruuid := uuid.NewString()
+
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+	mock.ExpectQuery(`SELECT "accessrequest"."id", .* FROM "authsrv_access_request" AS "accessrequest"`).
+		WillReturnRows(accessRequestRows(auuid, "accessrequest-"+auuid, "user@example.com", uuid.NewString(), ""))
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "authsrv_access_request" AS "accessrequest" SET "state" = 'APPROVED'`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery(`SELECT "resourcerole"."id", .* FROM "authsrv_resourcerole" AS "resourcerole" WHERE .name = 'PROJECT_READ_ONLY'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "scope"}).AddRow(ruuid, "PROJECT_READ_ONLY", "PROJECT"))
+	mock.ExpectQuery(`SELECT "project"."id" FROM "authsrv_project" AS "project" WHERE .*name = 'prod'`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.NewString()))
+	// the requester holds the role in the project permanently
+	for _, table := range roleBindingTestTables {
+		rows := sqlmock.NewRows([]string{"id", "organization_id", "role_id", "role", "scope", "organization", "project", "subject"})
+		if table == "authsrv_projectaccountresourcerole" {
+			rows.AddRow(uuid.NewString(), ouuid, ruuid, "PROJECT_READ_ONLY", "project", "org-"+ouuid, "prod", "u:user@example.com")
+		}
+		mock.ExpectQuery(`SELECT rb.id, .* FROM ` + table + ` AS rb .* \(rb.role_id = '` + ruuid + `'\)`).
+			WillReturnRows(rows)
+	}
+	mock.ExpectRollback()
+
+	rv := &userv3.AccessRequestReview{Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Name: "accessrequest-" + auuid}}
+	_, err := ars.Approve(accessRequestContext("admin@example.com", uuid.NewString(), puuid, ouuid), rv)
+	if err == nil {
+		t.Error("expected error approving access request for a role already held")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error("unfulfilled expectations:", err)
+	}
+	if len(mazc.cp) != 0 {
+		t.Errorf("expected no policy created in authz; got %v", mazc.cp)
+	}
+}
+
+func TestApproveAccessRequestNotApprover(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	mazc := mockAuthzClient{}
+	ars := NewAccessRequestService(db, &mazc, &fakeAccountPermissionService{approvers: []string{"admin@example.com"}}, getLogger())
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+
+	rv := &userv3.AccessRequestReview{Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Name: "accessrequest"}}
+	_, err := ars.Approve(accessRequestContext("user@example.com", uuid.NewString(), puuid, ouuid), rv)
+	if err == nil {
+		t.Error("expected error approving access request without approval permission")
+	}
+	if len(mazc.cp) != 0 {
+		t.Errorf("expected no policy created in authz; got %v", mazc.cp)
+	}
+}
+
+func TestApproveOwnAccessRequest(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	ars := NewAccessRequestService(db, &mockAuthzClient{}, &fakeAccountPermissionService{ssoApprovers: []string{"admin@example.com"}}, getLogger())
+	auuid := uuid.NewString()
+
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+	mock.ExpectQuery(`SELECT "accessrequest"."id", .* FROM "authsrv_access_request" AS "accessrequest"`).
+		WillReturnRows(accessRequestRows(auuid, "accessrequest-"+auuid, "admin@example.com", uuid.NewString(), ""))
+
+	rv := &userv3.AccessRequestReview{Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Name: "accessrequest-" + auuid}}
+	_, err := ars.Approve(accessRequestContext("admin@example.com", uuid.NewString(), puuid, ouuid), rv)
+	if err == nil {
+		t.Error("expected error approving own access request")
+	}
+}
+
+func TestDenyAccessRequestReviewedElsewhere(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	mazc := mockAuthzClient{}
+	ars := NewAccessRequestService(db, &mazc, &fakeAccountPermissionService{approvers: []string{"admin@example.com"}}, getLogger())
+	auuid := uuid.NewString()
+
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+	mock.ExpectQuery(`SELECT "accessrequest"."id", .* FROM "authsrv_access_request" AS "accessrequest"`).
+		WillReturnRows(accessRequestRows(auuid, "accessrequest-"+auuid, "user@example.com", uuid.NewString(), "payments"))
+	mock.ExpectBegin()
+	// another approver reviewed the request first
+	mock.ExpectExec(`UPDATE "authsrv_access_request" AS "accessrequest" SET "state" = 'DENIED'`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	rv := &userv3.AccessRequestReview{Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Name: "accessrequest-" + auuid}}
+	_, err := ars.Deny(accessRequestContext("admin@example.com", uuid.NewString(), puuid, ouuid), rv)
+	if err == nil {
+		t.Error("expected error denying access request reviewed elsewhere")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error("unfulfilled expectations:", err)
+	}
+}
+
+func TestListAccessRequests(t *testing.T) {
+	tt := []struct {
+		name      string
+		username  string
+		requester string
+		reviewer  string
+		expected  string
+	}{
+		{"requester only sees own requests", "user@example.com", "", "", `WHERE .*partner_id = '%s'. AND .organization_id = '%s'. AND .trash = FALSE. AND .requester = 'user@example.com'. ORDER BY`},
+		{"approver sees all requests", "admin@example.com", "", "", `WHERE .*partner_id = '%s'. AND .organization_id = '%s'. AND .trash = FALSE. ORDER BY`},
+		{"approver filters by reviewer", "admin@example.com", "", "admin@example.com", `WHERE .*partner_id = '%s'. AND .organization_id = '%s'. AND .trash = FALSE. AND .reviewer = 'admin@example.com'. ORDER BY`},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock := getDB(t)
+			defer db.Close()
+
+			ars := NewAccessRequestService(db, &mockAuthzClient{}, &fakeAccountPermissionService{approvers: []string{"admin@example.com"}}, getLogger())
+			auuid := uuid.NewString()
+			puuid, ouuid := addParterOrgFetchExpectation(mock)
+			mock.ExpectQuery(`SELECT "accessrequest"."id", .* FROM "authsrv_access_request" AS "accessrequest" ` + fmt.Sprintf(tc.expected, puuid, ouuid)).
+				WillReturnRows(accessRequestRows(auuid, "accessrequest-"+auuid, "user@example.com", uuid.NewString(), ""))
+
+			q := &userv3.AccessRequestQuery{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Requester: tc.requester, Reviewer: tc.reviewer}
+			arl, err := ars.List(accessRequestContext(tc.username, uuid.NewString(), puuid, ouuid), q)
+			if err != nil {
+				t.Fatal("could not list access requests:", err)
+			}
+			if arl.GetMetadata().GetCount() != 1 || arl.GetItems()[0].GetStatus().GetRequester() != "user@example.com" {
+				t.Errorf("unexpected access requests %v", arl.GetItems())
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error("unfulfilled expectations:", err)
+			}
+		})
+	}
+}
+
+func TestListAccessRequestsOfOthers(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	ars := NewAccessRequestService(db, &mockAuthzClient{}, &fakeAccountPermissionService{}, getLogger())
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+
+	q := &userv3.AccessRequestQuery{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Requester: "other@example.com"}
+	_, err := ars.List(accessRequestContext("user@example.com", uuid.NewString(), puuid, ouuid), q)
+	if err == nil {
+		t.Error("expected error listing access requests of another user")
+	}
+}
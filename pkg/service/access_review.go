@@ -0,0 +1,807 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/dao"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/paralus/paralus/pkg/utils"
+	authzv1 "github.com/paralus/paralus/proto/types/authz"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	userv3 "github.com/paralus/paralus/proto/types/userpb/v3"
+	bun "github.com/uptrace/bun"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	accessReviewKind         = "AccessReview"
+	accessReviewListKind     = "AccessReviewList"
+	accessReviewItemListKind = "AccessReviewItemList"
+	projectAdminRole         = "PROJECT_ADMIN"
+)
+
+// states of access reviews
+const (
+	AccessReviewOpen   = "OPEN"
+	AccessReviewClosed = "CLOSED"
+)
+
+// decisions on the bindings under review
+const (
+	AccessReviewPending = "PENDING"
+	AccessReviewKeep    = "KEEP"
+	AccessReviewRevoke  = "REVOKE"
+)
+
+// results of the bindings under review once the review is closed
+const (
+	AccessReviewKept           = "KEPT"
+	AccessReviewRevoked        = "REVOKED"
+	AccessReviewAlreadyRemoved = "ALREADY_REMOVED"
+)
+
+// types of the holders of the bindings under review
+const (
+	AccessReviewUser     = "USER"
+	AccessReviewGroup    = "GROUP"
+	AccessReviewSSOGroup = "SSO_GROUP"
+)
+
+// AccessReviewService is the interface for access review operations
+type AccessReviewService interface {
+	// Create starts an access review, the bindings in its scope are
+	// snapshotted and assigned to reviewers
+	Create(context.Context, *userv3.AccessReview) (*userv3.AccessReview, error)
+	// get access review by name
+	GetByName(context.Context, *userv3.AccessReview) (*userv3.AccessReview, error)
+	// list access reviews
+	List(context.Context, *userv3.AccessReviewQuery) (*userv3.AccessReviewList, error)
+	// Close closes an open review and revokes the bindings decided to
+	// be revoked
+	Close(context.Context, *userv3.AccessReview) (*userv3.AccessReview, error)
+	// ListItems lists the bindings under review, reviewers only see
+	// the bindings assigned to them
+	ListItems(context.Context, *userv3.AccessReviewItemQuery) (*userv3.AccessReviewItemList, error)
+	// Decide records the decision of a reviewer on a binding
+	Decide(context.Context, *userv3.AccessReviewDecision) (*userv3.AccessReviewItem, error)
+	// Report returns the report of a review as json or csv
+	Report(context.Context, *userv3.AccessReviewReportRequest) ([]byte, error)
+}
+
+// accessReviewService implements AccessReviewService
+type accessReviewService struct {
+	db  *bun.DB
+	azc AuthzService
+	al  *zap.Logger
+}
+
+// NewAccessReviewService return new access review service
+func NewAccessReviewService(db *bun.DB, azc AuthzService, al *zap.Logger) AccessReviewService {
+	return &accessReviewService{db: db, azc: azc, al: al}
+}
+
+// getReviewSession returns the session of a request on the access
+// reviews of an organization, reviewers may only act in their own
+// organization
+func (s *accessReviewService) getReviewSession(ctx context.Context, partner, org string) (*commonv3.SessionData, uuid.UUID, uuid.UUID, error) {
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok || sd.GetUsername() == "" {
+		return nil, uuid.Nil, uuid.Nil, fmt.Errorf("unable to get session of access review")
+	}
+	partnerId, organizationId, err := getPartnerOrganization(ctx, s.db, partner, org)
+	if err != nil {
+		return nil, uuid.Nil, uuid.Nil, fmt.Errorf("unable to get partner and org id")
+	}
+	if sd.GetOrganization() != organizationId.String() || sd.GetPartner() != partnerId.String() {
+		return nil, uuid.Nil, uuid.Nil, fmt.Errorf("not authorized to access reviews of organization '%v'", org)
+	}
+	return sd, partnerId, organizationId, nil
+}
+
+// isAllowed returns whether the user of sd is authorized for the method
+// on the path of the access review, the item endpoints are open to the
+// reviewers and checked against the review permissions here
+func (s *accessReviewService) isAllowed(ctx context.Context, sd *commonv3.SessionData, meta *commonv3.Metadata, path, method string) (bool, error) {
+	sub := "u:" + sd.GetUsername()
+	if sd.GetIsServiceAccount() {
+		sub = ServiceAccountSubject(sd.GetAccount())
+	}
+	url := fmt.Sprintf("/auth/v3/partner/%s/organization/%s/accessreview/%s%s", meta.GetPartner(), meta.GetOrganization(), meta.GetName(), path)
+	res, err := s.azc.Enforce(ctx, &authzv1.EnforceRequest{
+		Params: []string{sub, "*", "*", meta.GetOrganization(), url, method},
+	})
+	if err != nil {
+		return false, err
+	}
+	return res.GetRes(), nil
+}
+
+func (s *accessReviewService) getAccessReview(ctx context.Context, db bun.IDB, name string, partnerId, organizationId uuid.UUID) (*models.AccessReview, error) {
+	entity, err := dao.GetByNamePartnerOrg(ctx, db, name, uuid.NullUUID{UUID: partnerId, Valid: true}, uuid.NullUUID{UUID: organizationId, Valid: true}, &models.AccessReview{})
+	if err != nil {
+		return nil, fmt.Errorf("no access review found with name '%v'", name)
+	}
+	if ar, ok := entity.(*models.AccessReview); ok {
+		return ar, nil
+	}
+	return nil, fmt.Errorf("no access review found with name '%v'", name)
+}
+
+func reviewTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func (s *accessReviewService) toV3AccessReview(ar *models.AccessReview, items []models.AccessReviewItem, partner, org string) *userv3.AccessReview {
+	var owners []*userv3.AccessReviewGroupOwner
+	for _, o := range ar.GroupOwners {
+		owners = append(owners, &userv3.AccessReviewGroupOwner{Group: o.Group, Owner: o.Owner})
+	}
+	status := &userv3.AccessReviewStatus{
+		State:     ar.State,
+		StartedBy: ar.StartedBy,
+		StartedAt: timestamppb.New(ar.CreatedAt),
+		ClosedBy:  ar.ClosedBy,
+		ClosedAt:  reviewTimestamp(ar.ClosedAt),
+		Total:     int32(len(items)),
+	}
+	for _, item := range items {
+		switch {
+		case item.Result == AccessReviewRevoked || item.Result == AccessReviewAlreadyRemoved:
+			status.Revoked++
+		case item.Decision == AccessReviewPending && item.Result == "":
+			status.Pending++
+		default:
+			status.Kept++
+		}
+	}
+	return &userv3.AccessReview{
+		ApiVersion: apiVersion,
+		Kind:       accessReviewKind,
+		Metadata: &commonv3.Metadata{
+			Name:         ar.Name,
+			Description:  ar.Description,
+			Id:           ar.ID.String(),
+			Organization: org,
+			Partner:      partner,
+			ModifiedAt:   timestamppb.New(ar.ModifiedAt),
+			CreatedAt:    timestamppb.New(ar.CreatedAt),
+		},
+		Spec: &userv3.AccessReviewSpec{
+			Projects:        ar.Projects,
+			Roles:           ar.Roles,
+			GroupOwners:     owners,
+			DefaultReviewer: ar.DefaultReviewer,
+			RevokePending:   ar.RevokePending,
+		},
+		Status: status,
+	}
+}
+
+func toV3AccessReviewItem(item *models.AccessReviewItem) *userv3.AccessReviewItem {
+	return &userv3.AccessReviewItem{
+		Id:                item.ID.String(),
+		SubjectType:       item.SubjectType,
+		Subject:           item.Subject,
+		Role:              item.Role,
+		Scope:             item.Scope,
+		Project:           item.Project,
+		Namespace:         item.Namespace,
+		NamespaceSelector: item.NamespaceSelector,
+		Reviewer:          item.Reviewer,
+		Decision:          item.Decision,
+		Reason:            item.Reason,
+		DecidedBy:         item.DecidedBy,
+		DecidedAt:         reviewTimestamp(item.DecidedAt),
+		Result:            item.Result,
+		AppliedAt:         reviewTimestamp(item.AppliedAt),
+	}
+}
+
+// accessReviewScope selects the bindings of a review
+type accessReviewScope struct {
+	projects []string
+	roles    []string
+}
+
+// includes returns whether the binding is in the scope, organization
+// bindings are only reviewed when the review is not limited to projects
+func (sc accessReviewScope) includes(rb models.RoleBinding) bool {
+	if len(sc.projects) > 0 && !utils.Contains(sc.projects, rb.Project) {
+		return false
+	}
+	if len(sc.roles) > 0 && !utils.Contains(sc.roles, rb.Role) {
+		return false
+	}
+	return true
+}
+
+// accessReviewers assigns the bindings under review to reviewers
+type accessReviewers struct {
+	// project admins by project
+	projectAdmins map[string][]string
+	// group owners by group
+	groupOwners map[string]string
+	// reviewer of the bindings without project admin or group owner
+	defaultReviewer string
+	// the user starting the review, reviewer of last resort
+	starter string
+}
+
+func newAccessReviewers(bindings []models.RoleBinding, members []dao.GroupMember, owners []models.AccessReviewGroupOwner, defaultReviewer, starter string) *accessReviewers {
+	groupMembers := map[string][]string{}
+	for _, m := range members {
+		groupMembers[m.GroupName] = append(groupMembers[m.GroupName], m.Username)
+	}
+	r := &accessReviewers{
+		projectAdmins:   map[string][]string{},
+		groupOwners:     map[string]string{},
+		defaultReviewer: defaultReviewer,
+		starter:         starter,
+	}
+	for _, rb := range bindings {
+		if rb.Role != projectAdminRole || rb.Project == "" {
+			continue
+		}
+		switch {
+		case strings.HasPrefix(rb.Subject, "u:"):
+			r.projectAdmins[rb.Project] = append(r.projectAdmins[rb.Project], strings.TrimPrefix(rb.Subject, "u:"))
+		case strings.HasPrefix(rb.Subject, "g:"):
+			r.projectAdmins[rb.Project] = append(r.projectAdmins[rb.Project], groupMembers[strings.TrimPrefix(rb.Subject, "g:")]...)
+		}
+	}
+	for p, admins := range r.projectAdmins {
+		admins = utils.Unique(admins)
+		sort.Strings(admins)
+		r.projectAdmins[p] = admins
+	}
+	for _, o := range owners {
+		r.groupOwners[o.Group] = o.Owner
+	}
+	if r.defaultReviewer == "" {
+		r.defaultReviewer = starter
+	}
+	return r
+}
+
+// reviewer returns the reviewer of a binding, bindings on a project go
+// to a project admin and organization bindings of groups to the owner
+// of the group. Users do not review their own bindings.
+func (r *accessReviewers) reviewer(item *models.AccessReviewItem) string {
+	var candidates []string
+	if item.Project != "" {
+		candidates = r.projectAdmins[item.Project]
+	} else if item.SubjectType != AccessReviewUser && r.groupOwners[item.Subject] != "" {
+		candidates = []string{r.groupOwners[item.Subject]}
+	}
+	candidates = append(candidates, r.defaultReviewer)
+	for _, c := range candidates {
+		if item.SubjectType != AccessReviewUser || !strings.EqualFold(c, item.Subject) {
+			return c
+		}
+	}
+	return r.starter
+}
+
+func (s *accessReviewService) Create(ctx context.Context, req *userv3.AccessReview) (*userv3.AccessReview, error) {
+	sd, partnerId, organizationId, err := s.getReviewSession(ctx, req.GetMetadata().GetPartner(), req.GetMetadata().GetOrganization())
+	if err != nil {
+		return nil, err
+	}
+	spec := req.GetSpec()
+	for _, p := range spec.GetProjects() {
+		if _, err := dao.GetProjectId(ctx, s.db, p); err != nil {
+			return nil, fmt.Errorf("unable to find project '%v'", p)
+		}
+	}
+	for _, r := range spec.GetRoles() {
+		if _, err := dao.GetByName(ctx, s.db, r, &models.Role{}); err != nil {
+			return nil, fmt.Errorf("unable to find role '%v'", r)
+		}
+	}
+	var owners []models.AccessReviewGroupOwner
+	for _, o := range spec.GetGroupOwners() {
+		if o.GetOwner() == "" {
+			return nil, fmt.Errorf("no owner provided for group '%v'", o.GetGroup())
+		}
+		if _, err := dao.GetIdByNamePartnerOrg(ctx, s.db, o.GetGroup(), uuid.NullUUID{UUID: partnerId, Valid: true}, uuid.NullUUID{UUID: organizationId, Valid: true}, &models.Group{}); err != nil {
+			return nil, fmt.Errorf("unable to find group '%v'", o.GetGroup())
+		}
+		owners = append(owners, models.AccessReviewGroupOwner{Group: o.GetGroup(), Owner: o.GetOwner()})
+	}
+
+	name := req.GetMetadata().GetName()
+	if name == "" {
+		name = "accessreview-" + strings.Split(uuid.New().String(), "-")[0]
+	}
+	e, _ := dao.GetIdByNamePartnerOrg(ctx, s.db, name, uuid.NullUUID{UUID: partnerId, Valid: true}, uuid.NullUUID{UUID: organizationId, Valid: true}, &models.AccessReview{})
+	if e != nil {
+		return nil, fmt.Errorf("access review '%v' already exists", name)
+	}
+
+	now := time.Now()
+	ar := models.AccessReview{
+		Name:            name,
+		Description:     req.GetMetadata().GetDescription(),
+		CreatedAt:       now,
+		ModifiedAt:      now,
+		Trash:           false,
+		OrganizationId:  organizationId,
+		PartnerId:       partnerId,
+		Projects:        spec.GetProjects(),
+		Roles:           spec.GetRoles(),
+		GroupOwners:     owners,
+		DefaultReviewer: spec.GetDefaultReviewer(),
+		RevokePending:   spec.GetRevokePending(),
+		State:           AccessReviewOpen,
+		StartedBy:       sd.GetUsername(),
+	}
+	var items []models.AccessReviewItem
+	err = s.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		items, err = s.snapshot(ctx, tx, &ar)
+		if err != nil {
+			return err
+		}
+		if _, err := dao.Create(ctx, tx, &ar); err != nil {
+			return err
+		}
+		for i := range items {
+			items[i].ReviewId = ar.ID
+		}
+		if len(items) > 0 {
+			if _, err := dao.Create(ctx, tx, &items); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	CreateAccessReviewAuditEvent(ctx, s.al, AuditActionCreate, &ar, len(items))
+	return s.toV3AccessReview(&ar, items, req.GetMetadata().GetPartner(), req.GetMetadata().GetOrganization()), nil
+}
+
+// snapshot returns the items of the bindings in the scope of the
+// review, the bindings of service accounts are not reviewed
+func (s *accessReviewService) snapshot(ctx context.Context, db bun.IDB, ar *models.AccessReview) ([]models.AccessReviewItem, error) {
+	bindings, err := dao.GetOrganizationRoleBindings(ctx, db, ar.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+	members, err := dao.GetOrganizationGroupMembers(ctx, db, ar.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+	ssoGroups, err := dao.GetSSOGroups(ctx, db, ar.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+	reviewers := newAccessReviewers(bindings, members, ar.GroupOwners, ar.DefaultReviewer, ar.StartedBy)
+	scope := accessReviewScope{projects: ar.Projects, roles: ar.Roles}
+
+	var items []models.AccessReviewItem
+	for _, rb := range bindings {
+		if !scope.includes(rb) {
+			continue
+		}
+		item := models.AccessReviewItem{
+			CreatedAt:         ar.CreatedAt,
+			ModifiedAt:        ar.CreatedAt,
+			BindingId:         rb.ID,
+			BindingTable:      rb.Table,
+			Role:              rb.Role,
+			Scope:             rb.Scope,
+			Project:           rb.Project,
+			Namespace:         rb.Namespace,
+			NamespaceSelector: rb.NamespaceSelector,
+			Decision:          AccessReviewPending,
+		}
+		switch {
+		case strings.HasPrefix(rb.Subject, "u:"):
+			item.SubjectType = AccessReviewUser
+			item.Subject = strings.TrimPrefix(rb.Subject, "u:")
+		case strings.HasPrefix(rb.Subject, "g:"):
+			item.SubjectType = AccessReviewGroup
+			item.Subject = strings.TrimPrefix(rb.Subject, "g:")
+			if utils.Contains(ssoGroups, item.Subject) {
+				item.SubjectType = AccessReviewSSOGroup
+			}
+		default:
+			continue
+		}
+		item.Reviewer = reviewers.reviewer(&item)
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+func (s *accessReviewService) GetByName(ctx context.Context, req *userv3.AccessReview) (*userv3.AccessReview, error) {
+	_, partnerId, organizationId, err := s.getReviewSession(ctx, req.GetMetadata().GetPartner(), req.GetMetadata().GetOrganization())
+	if err != nil {
+		return &userv3.AccessReview{}, err
+	}
+	ar, err := s.getAccessReview(ctx, s.db, req.GetMetadata().GetName(), partnerId, organizationId)
+	if err != nil {
+		return &userv3.AccessReview{}, err
+	}
+	items, err := dao.ListAccessReviewItems(ctx, s.db, ar.ID, "", "")
+	if err != nil {
+		return &userv3.AccessReview{}, err
+	}
+	return s.toV3AccessReview(ar, items, req.GetMetadata().GetPartner(), req.GetMetadata().GetOrganization()), nil
+}
+
+func (s *accessReviewService) List(ctx context.Context, q *userv3.AccessReviewQuery) (*userv3.AccessReviewList, error) {
+	arList := &userv3.AccessReviewList{
+		ApiVersion: apiVersion,
+		Kind:       accessReviewListKind,
+		Metadata: &commonv3.ListMetadata{
+			Count: 0,
+		},
+	}
+	_, partnerId, organizationId, err := s.getReviewSession(ctx, q.GetPartner(), q.GetOrganization())
+	if err != nil {
+		return arList, err
+	}
+	ars, err := dao.ListAccessReviews(ctx, s.db, partnerId, organizationId, strings.ToUpper(q.GetState()))
+	if err != nil {
+		return arList, err
+	}
+	var items []*userv3.AccessReview
+	for i := range ars {
+		reviewItems, err := dao.ListAccessReviewItems(ctx, s.db, ars[i].ID, "", "")
+		if err != nil {
+			return arList, err
+		}
+		items = append(items, s.toV3AccessReview(&ars[i], reviewItems, q.GetPartner(), q.GetOrganization()))
+	}
+	arList.Metadata = &commonv3.ListMetadata{
+		Count: int64(len(items)),
+	}
+	arList.Items = items
+	return arList, nil
+}
+
+func (s *accessReviewService) ListItems(ctx context.Context, q *userv3.AccessReviewItemQuery) (*userv3.AccessReviewItemList, error) {
+	itemList := &userv3.AccessReviewItemList{
+		ApiVersion: apiVersion,
+		Kind:       accessReviewItemListKind,
+		Metadata: &commonv3.ListMetadata{
+			Count: 0,
+		},
+	}
+	sd, partnerId, organizationId, err := s.getReviewSession(ctx, q.GetMetadata().GetPartner(), q.GetMetadata().GetOrganization())
+	if err != nil {
+		return itemList, err
+	}
+	ar, err := s.getAccessReview(ctx, s.db, q.GetMetadata().GetName(), partnerId, organizationId)
+	if err != nil {
+		return itemList, err
+	}
+	reviewer := q.GetReviewer()
+	allowed, err := s.isAllowed(ctx, sd, q.GetMetadata(), "", "GET")
+	if err != nil {
+		return itemList, err
+	}
+	// users who cannot read the review only see their own items
+	if !allowed {
+		if reviewer != "" && !strings.EqualFold(reviewer, sd.GetUsername()) {
+			return itemList, fmt.Errorf("not authorized to list access review items of '%v'", reviewer)
+		}
+		reviewer = sd.GetUsername()
+	}
+
+	items, err := dao.ListAccessReviewItems(ctx, s.db, ar.ID, reviewer, strings.ToUpper(q.GetDecision()))
+	if err != nil {
+		return itemList, err
+	}
+	for i := range items {
+		itemList.Items = append(itemList.Items, toV3AccessReviewItem(&items[i]))
+	}
+	itemList.Metadata = &commonv3.ListMetadata{
+		Count: int64(len(items)),
+	}
+	return itemList, nil
+}
+
+func (s *accessReviewService) Decide(ctx context.Context, req *userv3.AccessReviewDecision) (*userv3.AccessReviewItem, error) {
+	sd, partnerId, organizationId, err := s.getReviewSession(ctx, req.GetMetadata().GetPartner(), req.GetMetadata().GetOrganization())
+	if err != nil {
+		return &userv3.AccessReviewItem{}, err
+	}
+	decision := strings.ToUpper(req.GetDecision())
+	if decision != AccessReviewKeep && decision != AccessReviewRevoke {
+		return &userv3.AccessReviewItem{}, fmt.Errorf("invalid decision '%v', one of KEEP or REVOKE is required", req.GetDecision())
+	}
+	itemId, err := uuid.Parse(req.GetItem())
+	if err != nil {
+		return &userv3.AccessReviewItem{}, fmt.Errorf("no access review item found with id '%v'", req.GetItem())
+	}
+	ar, err := s.getAccessReview(ctx, s.db, req.GetMetadata().GetName(), partnerId, organizationId)
+	if err != nil {
+		return &userv3.AccessReviewItem{}, err
+	}
+
+	var item *models.AccessReviewItem
+	err = s.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		locked, err := dao.LockAccessReview(ctx, tx, ar.ID)
+		if err != nil {
+			return err
+		}
+		if locked.State != AccessReviewOpen {
+			return fmt.Errorf("access review '%v' is already closed", ar.Name)
+		}
+		item, err = dao.GetAccessReviewItem(ctx, tx, ar.ID, itemId)
+		if err != nil {
+			return fmt.Errorf("no access review item found with id '%v'", req.GetItem())
+		}
+		if item.SubjectType == AccessReviewUser && strings.EqualFold(item.Subject, sd.GetUsername()) {
+			return fmt.Errorf("bindings cannot be reviewed by their holder")
+		}
+		// administrators of the review decide for reviewers who left
+		if !strings.EqualFold(item.Reviewer, sd.GetUsername()) {
+			allowed, err := s.isAllowed(ctx, sd, req.GetMetadata(), "/close", "POST")
+			if err != nil {
+				return err
+			}
+			if !allowed {
+				return fmt.Errorf("access review item '%v' is not assigned to '%v'", req.GetItem(), sd.GetUsername())
+			}
+		}
+		now := time.Now()
+		item.Decision = decision
+		item.Reason = req.GetReason()
+		item.DecidedBy = sd.GetUsername()
+		item.DecidedAt = now
+		item.ModifiedAt = now
+		return dao.UpdateAccessReviewItem(ctx, tx, item)
+	})
+	if err != nil {
+		return &userv3.AccessReviewItem{}, err
+	}
+
+	CreateAccessReviewItemAuditEvent(ctx, s.al, "decide", ar, item)
+	return toV3AccessReviewItem(item), nil
+}
+
+// accessReviewBinding returns the role binding of an item
+func accessReviewBinding(item *models.AccessReviewItem, org string) models.RoleBinding {
+	subject := "g:" + item.Subject
+	if item.SubjectType == AccessReviewUser {
+		subject = "u:" + item.Subject
+	}
+	return models.RoleBinding{
+		ID:                item.BindingId,
+		Table:             item.BindingTable,
+		Subject:           subject,
+		Organization:      org,
+		Project:           item.Project,
+		Namespace:         item.Namespace,
+		NamespaceSelector: item.NamespaceSelector,
+		Role:              item.Role,
+		Scope:             item.Scope,
+	}
+}
+
+func (s *accessReviewService) Close(ctx context.Context, req *userv3.AccessReview) (*userv3.AccessReview, error) {
+	sd, partnerId, organizationId, err := s.getReviewSession(ctx, req.GetMetadata().GetPartner(), req.GetMetadata().GetOrganization())
+	if err != nil {
+		return &userv3.AccessReview{}, err
+	}
+	org := req.GetMetadata().GetOrganization()
+	ar, err := s.getAccessReview(ctx, s.db, req.GetMetadata().GetName(), partnerId, organizationId)
+	if err != nil {
+		return &userv3.AccessReview{}, err
+	}
+
+	var items []models.AccessReviewItem
+	var revoked []*models.AccessReviewItem
+	err = s.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		locked, err := dao.LockAccessReview(ctx, tx, ar.ID)
+		if err != nil {
+			return err
+		}
+		if locked.State != AccessReviewOpen {
+			return fmt.Errorf("access review '%v' is already closed", ar.Name)
+		}
+		items, err = dao.ListAccessReviewItems(ctx, tx, ar.ID, "", "")
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		var rbs []models.RoleBinding
+		for i := range items {
+			item := &items[i]
+			item.Result = AccessReviewKept
+			item.AppliedAt = now
+			item.ModifiedAt = now
+			if item.Decision == AccessReviewRevoke || item.Decision == AccessReviewPending && ar.RevokePending {
+				rb := accessReviewBinding(item, org)
+				// the binding may have been removed or expired since
+				// the review started
+				claimed, err := dao.ExpireRoleBinding(ctx, tx, rb)
+				if err != nil {
+					return err
+				}
+				item.Result = AccessReviewAlreadyRemoved
+				if claimed {
+					item.Result = AccessReviewRevoked
+					rbs = append(rbs, rb)
+				}
+				revoked = append(revoked, item)
+			}
+			if err := dao.UpdateAccessReviewItem(ctx, tx, item); err != nil {
+				return err
+			}
+		}
+
+		ar.State = AccessReviewClosed
+		ar.ClosedBy = sd.GetUsername()
+		ar.ClosedAt = now
+		ar.ModifiedAt = now
+		if err := dao.CloseAccessReview(ctx, tx, ar); err != nil {
+			return err
+		}
+
+		for _, rb := range rbs {
+			err := deleteRoleBindingPolicy(ctx, tx, s.azc, rb)
+			if err != nil {
+				return fmt.Errorf("unable to delete revoked role binding from authz; %v", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return &userv3.AccessReview{}, err
+	}
+
+	for _, item := range revoked {
+		CreateAccessReviewItemAuditEvent(ctx, s.al, "revoke", ar, item)
+	}
+	CreateAccessReviewAuditEvent(ctx, s.al, "close", ar, len(items))
+	return s.toV3AccessReview(ar, items, req.GetMetadata().GetPartner(), org), nil
+}
+
+// accessReviewReport is the report of an access review, items hold
+// the full trail of each binding from assignment to its result
+type accessReviewReport struct {
+	Review       string                   `json:"review"`
+	Description  string                   `json:"description,omitempty"`
+	Organization string                   `json:"organization"`
+	Projects     []string                 `json:"projects,omitempty"`
+	Roles        []string                 `json:"roles,omitempty"`
+	State        string                   `json:"state"`
+	StartedBy    string                   `json:"startedBy"`
+	StartedAt    string                   `json:"startedAt"`
+	ClosedBy     string                   `json:"closedBy,omitempty"`
+	ClosedAt     string                   `json:"closedAt,omitempty"`
+	Items        []accessReviewReportItem `json:"items"`
+}
+
+type accessReviewReportItem struct {
+	ID                string `json:"id"`
+	SubjectType       string `json:"subjectType"`
+	Subject           string `json:"subject"`
+	Role              string `json:"role"`
+	Scope             string `json:"scope"`
+	Project           string `json:"project,omitempty"`
+	Namespace         string `json:"namespace,omitempty"`
+	NamespaceSelector string `json:"namespaceSelector,omitempty"`
+	Reviewer          string `json:"reviewer"`
+	Decision          string `json:"decision"`
+	Reason            string `json:"reason,omitempty"`
+	DecidedBy         string `json:"decidedBy,omitempty"`
+	DecidedAt         string `json:"decidedAt,omitempty"`
+	Result            string `json:"result,omitempty"`
+	AppliedAt         string `json:"appliedAt,omitempty"`
+}
+
+var accessReviewReportColumns = []string{
+	"id", "subjectType", "subject", "role", "scope", "project", "namespace", "namespaceSelector",
+	"reviewer", "decision", "reason", "decidedBy", "decidedAt", "result", "appliedAt",
+}
+
+func (i accessReviewReportItem) record() []string {
+	return []string{
+		i.ID, i.SubjectType, i.Subject, i.Role, i.Scope, i.Project, i.Namespace, i.NamespaceSelector,
+		i.Reviewer, i.Decision, i.Reason, i.DecidedBy, i.DecidedAt, i.Result, i.AppliedAt,
+	}
+}
+
+func reportTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func newAccessReviewReport(ar *models.AccessReview, items []models.AccessReviewItem, org string) *accessReviewReport {
+	r := &accessReviewReport{
+		Review:       ar.Name,
+		Description:  ar.Description,
+		Organization: org,
+		Projects:     ar.Projects,
+		Roles:        ar.Roles,
+		State:        ar.State,
+		StartedBy:    ar.StartedBy,
+		StartedAt:    reportTime(ar.CreatedAt),
+		ClosedBy:     ar.ClosedBy,
+		ClosedAt:     reportTime(ar.ClosedAt),
+		Items:        []accessReviewReportItem{},
+	}
+	for _, item := range items {
+		r.Items = append(r.Items, accessReviewReportItem{
+			ID:                item.ID.String(),
+			SubjectType:       item.SubjectType,
+			Subject:           item.Subject,
+			Role:              item.Role,
+			Scope:             item.Scope,
+			Project:           item.Project,
+			Namespace:         item.Namespace,
+			NamespaceSelector: item.NamespaceSelector,
+			Reviewer:          item.Reviewer,
+			Decision:          item.Decision,
+			Reason:            item.Reason,
+			DecidedBy:         item.DecidedBy,
+			DecidedAt:         reportTime(item.DecidedAt),
+			Result:            item.Result,
+			AppliedAt:         reportTime(item.AppliedAt),
+		})
+	}
+	return r
+}
+
+// csv returns the items of the report as csv
+func (r *accessReviewReport) csv() ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(accessReviewReportColumns); err != nil {
+		return nil, err
+	}
+	for _, item := range r.Items {
+		if err := w.Write(item.record()); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+func (s *accessReviewService) Report(ctx context.Context, req *userv3.AccessReviewReportRequest) ([]byte, error) {
+	_, partnerId, organizationId, err := s.getReviewSession(ctx, req.GetMetadata().GetPartner(), req.GetMetadata().GetOrganization())
+	if err != nil {
+		return nil, err
+	}
+	format := strings.ToLower(req.GetFormat())
+	if format != "" && format != "json" && format != "csv" {
+		return nil, fmt.Errorf("invalid report format '%v', one of json or csv is required", req.GetFormat())
+	}
+	ar, err := s.getAccessReview(ctx, s.db, req.GetMetadata().GetName(), partnerId, organizationId)
+	if err != nil {
+		return nil, err
+	}
+	items, err := dao.ListAccessReviewItems(ctx, s.db, ar.ID, "", "")
+	if err != nil {
+		return nil, err
+	}
+	report := newAccessReviewReport(ar, items, req.GetMetadata().GetOrganization())
+	if format == "csv" {
+		return report.csv()
+	}
+	return json.MarshalIndent(report, "", "  ")
+}
@@ -0,0 +1,155 @@
+package service
+
+import (
+	"encoding/csv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/dao"
+	"github.com/paralus/paralus/internal/models"
+	v3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	userv3 "github.com/paralus/paralus/proto/types/userpb/v3"
+)
+
+func TestAccessReviewReviewers(t *testing.T) {
+	bindings := []models.RoleBinding{
+		{Subject: "u:admin@example.com", Project: "prod", Role: projectAdminRole},
+		{Subject: "g:prod-admins", Project: "prod", Role: projectAdminRole},
+		{Subject: "u:dev@example.com", Project: "dev", Role: "PROJECT_READ_ONLY"},
+	}
+	members := []dao.GroupMember{{GroupName: "prod-admins", Username: "lead@example.com"}}
+	owners := []models.AccessReviewGroupOwner{{Group: "auditors", Owner: "owner@example.com"}}
+	r := newAccessReviewers(bindings, members, owners, "", "starter@example.com")
+
+	tt := []struct {
+		name     string
+		item     models.AccessReviewItem
+		reviewer string
+	}{
+		{"project admin reviews project binding", models.AccessReviewItem{SubjectType: AccessReviewUser, Subject: "user@example.com", Project: "prod"}, "admin@example.com"},
+		{"project admin does not review own binding", models.AccessReviewItem{SubjectType: AccessReviewUser, Subject: "admin@example.com", Project: "prod"}, "lead@example.com"},
+		{"project without admin falls back to default reviewer", models.AccessReviewItem{SubjectType: AccessReviewUser, Subject: "dev@example.com", Project: "dev"}, "starter@example.com"},
+		{"group owner reviews organization binding of group", models.AccessReviewItem{SubjectType: AccessReviewSSOGroup, Subject: "auditors"}, "owner@example.com"},
+		{"group owner does not review user binding", models.AccessReviewItem{SubjectType: AccessReviewUser, Subject: "auditors"}, "starter@example.com"},
+		{"starter reviews own binding of default reviewer", models.AccessReviewItem{SubjectType: AccessReviewUser, Subject: "starter@example.com"}, "starter@example.com"},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			if reviewer := r.reviewer(&tc.item); reviewer != tc.reviewer {
+				t.Errorf("expected reviewer %v; got %v", tc.reviewer, reviewer)
+			}
+		})
+	}
+}
+
+func TestAccessReviewScope(t *testing.T) {
+	sc := accessReviewScope{projects: []string{"prod"}, roles: []string{"PROJECT_ADMIN"}}
+	if !sc.includes(models.RoleBinding{Project: "prod", Role: "PROJECT_ADMIN"}) {
+		t.Error("expected binding on project to be reviewed")
+	}
+	if sc.includes(models.RoleBinding{Role: "PROJECT_ADMIN"}) {
+		t.Error("expected organization binding not to be reviewed when limited to projects")
+	}
+	if sc.includes(models.RoleBinding{Project: "prod", Role: "PROJECT_READ_ONLY"}) {
+		t.Error("expected binding of other role not to be reviewed")
+	}
+	if !(accessReviewScope{}).includes(models.RoleBinding{Role: "ADMIN"}) {
+		t.Error("expected all bindings to be reviewed without scope")
+	}
+}
+
+func TestAccessReviewReportCSV(t *testing.T) {
+	ar := &models.AccessReview{Name: "q3", State: AccessReviewClosed, StartedBy: "starter@example.com", CreatedAt: time.Now()}
+	items := []models.AccessReviewItem{{
+		ID:          uuid.New(),
+		SubjectType: AccessReviewUser,
+		Subject:     "user@example.com",
+		Role:        "PROJECT_ADMIN",
+		Project:     "prod",
+		Reviewer:    "admin@example.com",
+		Decision:    AccessReviewRevoke,
+		Reason:      "left the team, moved to sales",
+		DecidedAt:   time.Now(),
+		Result:      AccessReviewRevoked,
+	}}
+	b, err := newAccessReviewReport(ar, items, "org").csv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	records, err := csv.NewReader(strings.NewReader(string(b))).ReadAll()
+	if err != nil {
+		t.Fatal("unable to read report:", err)
+	}
+	if len(records) != 2 || len(records[1]) != len(accessReviewReportColumns) {
+		t.Fatalf("expected header and one item; got %v", records)
+	}
+	if records[1][10] != "left the team, moved to sales" || records[1][13] != AccessReviewRevoked || records[1][14] != "" {
+		t.Errorf("unexpected report item %v", records[1])
+	}
+}
+
+func accessReviewItemRows(id, subject, reviewer string) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"id", "subject_type", "subject", "role", "project", "reviewer", "decision"}).
+		AddRow(id, AccessReviewUser, subject, "PROJECT_ADMIN", "prod", reviewer, AccessReviewPending)
+}
+
+func TestDecideAccessReviewItem(t *testing.T) {
+	tt := []struct {
+		name    string
+		subject string
+		state   string
+		ok      bool
+	}{
+		{"reviewer decides", "user@example.com", AccessReviewOpen, true},
+		{"reviewer does not decide own binding", "admin@example.com", AccessReviewOpen, false},
+		{"closed review", "user@example.com", AccessReviewClosed, false},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock := getDB(t)
+			defer db.Close()
+
+			arvs := NewAccessReviewService(db, &mockAuthzClient{}, getLogger())
+			aruuid := uuid.NewString()
+			iuuid := uuid.NewString()
+
+			puuid, ouuid := addParterOrgFetchExpectation(mock)
+			mock.ExpectQuery(`SELECT "accessreview"."id", .* FROM "authsrv_access_review" AS "accessreview" WHERE .*name = 'q3'`).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "name", "state"}).AddRow(aruuid, "q3", AccessReviewOpen))
+			mock.ExpectBegin()
+			mock.ExpectQuery(`SELECT .* FROM "authsrv_access_review" AS "accessreview" WHERE .id = '` + aruuid + `'. .* FOR UPDATE`).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "name", "state"}).AddRow(aruuid, "q3", tc.state))
+			if tc.state == AccessReviewOpen {
+				mock.ExpectQuery(`SELECT .* FROM "authsrv_access_review_item" AS "accessreviewitem" WHERE .id = '` + iuuid + `'.`).
+					WillReturnRows(accessReviewItemRows(iuuid, tc.subject, "admin@example.com"))
+			}
+			if tc.ok {
+				mock.ExpectExec(`UPDATE "authsrv_access_review_item" AS "accessreviewitem" SET "decision" = 'REVOKE', "reason" = 'left', "decided_by" = 'admin@example.com'`).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			} else {
+				mock.ExpectRollback()
+			}
+
+			d := &userv3.AccessReviewDecision{
+				Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Name: "q3"},
+				Item:     iuuid,
+				Decision: "revoke",
+				Reason:   "left",
+			}
+			item, err := arvs.Decide(accessRequestContext("admin@example.com", uuid.NewString(), puuid, ouuid), d)
+			if tc.ok && (err != nil || item.GetDecision() != AccessReviewRevoke) {
+				t.Errorf("expected binding to be decided; got %v, %v", item, err)
+			}
+			if !tc.ok && err == nil {
+				t.Error("expected error deciding on binding")
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error("unfulfilled expectations:", err)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"github.com/google/uuid"
 	"github.com/paralus/paralus/internal/dao"
@@ -25,6 +26,14 @@ type AccountPermissionService interface {
 	GetAccountGroups(ctx context.Context, accountID string) ([]string, error)
 	IsAccountActive(ctx context.Context, accountID, orgID string) (bool, error)
 	IsSSOAccount(ctx context.Context, accountID string) (bool, error)
+	IsServiceAccountActive(ctx context.Context, accountID, orgID string) (bool, error)
+	GetAccountKubernetesRoleGrants(ctx context.Context, accountID, orgID, partnerID string, projects []string) ([]models.KubernetesRoleGrant, error)
+	GetKubernetesRoleNames(ctx context.Context, orgID, partnerID string) ([]string, error)
+	GetDenyPolicies(ctx context.Context, orgID, partnerID string) ([]models.DenyPolicy, error)
+	GetClusterBindingGrants(ctx context.Context, orgID, partnerID, username string, groups []string, isServiceAccount bool) ([]models.ClusterBindingGrant, error)
+	GetBreakGlassGrants(ctx context.Context, orgID, accountID string) ([]models.BreakGlassGrant, error)
+	GetOrganizationIDs(ctx context.Context, partner, organization string) (partnerID, orgID string, err error)
+	GetAccountID(ctx context.Context, username, orgID, partnerID string, isServiceAccount bool) (string, error)
 }
 
 // accountPermissionService implements AccountPermissionService
@@ -87,6 +96,63 @@ func (a *accountPermissionService) GetAccountPermissionsByProjectIDPermissions(c
 	return accountPermissions, nil
 }
 
+func (a *accountPermissionService) GetAccountKubernetesRoleGrants(ctx context.Context, accountID, orgID, partnerID string, projects []string) ([]models.KubernetesRoleGrant, error) {
+	projids := []uuid.UUID{uuid.Nil}
+	for _, proj := range projects {
+		id, err := uuid.Parse(proj)
+		if err == nil {
+			projids = append(projids, id)
+		}
+	}
+	return dao.GetAccountKubernetesRoleGrants(ctx, a.db, uuid.MustParse(accountID), uuid.MustParse(orgID), uuid.MustParse(partnerID), projids)
+}
+
+func (a *accountPermissionService) GetKubernetesRoleNames(ctx context.Context, orgID, partnerID string) ([]string, error) {
+	return dao.GetKubernetesRoleNames(ctx, a.db, uuid.MustParse(orgID), uuid.MustParse(partnerID))
+}
+
+func (a *accountPermissionService) GetDenyPolicies(ctx context.Context, orgID, partnerID string) ([]models.DenyPolicy, error) {
+	return dao.GetDenyPolicies(ctx, a.db, uuid.MustParse(orgID), uuid.MustParse(partnerID))
+}
+
+func (a *accountPermissionService) GetOrganizationIDs(ctx context.Context, partner, organization string) (string, string, error) {
+	partnerID, err := dao.GetPartnerId(ctx, a.db, partner)
+	if err != nil {
+		return "", "", fmt.Errorf("unable to find partner '%v'", partner)
+	}
+	orgID, err := dao.GetOrganizationId(ctx, a.db, organization)
+	if err != nil {
+		return "", "", fmt.Errorf("unable to find organization '%v'", organization)
+	}
+	return partnerID.String(), orgID.String(), nil
+}
+
+func (a *accountPermissionService) GetAccountID(ctx context.Context, username, orgID, partnerID string, isServiceAccount bool) (string, error) {
+	if isServiceAccount {
+		entity, err := dao.GetIdByNamePartnerOrg(ctx, a.db, username,
+			uuid.NullUUID{UUID: uuid.MustParse(partnerID), Valid: true},
+			uuid.NullUUID{UUID: uuid.MustParse(orgID), Valid: true},
+			&models.ServiceAccount{})
+		if err != nil {
+			return "", fmt.Errorf("unable to find service account '%v'", username)
+		}
+		return entity.(*models.ServiceAccount).ID.String(), nil
+	}
+	entity, err := dao.GetUserIdByEmail(ctx, a.db, username, &models.KratosIdentities{})
+	if err != nil {
+		return "", fmt.Errorf("unable to find user '%v'", username)
+	}
+	return entity.(*models.KratosIdentities).ID.String(), nil
+}
+
+func (a *accountPermissionService) GetClusterBindingGrants(ctx context.Context, orgID, partnerID, username string, groups []string, isServiceAccount bool) ([]models.ClusterBindingGrant, error) {
+	return getClusterBindingGrants(ctx, a.db, uuid.MustParse(orgID), uuid.MustParse(partnerID), username, groups, isServiceAccount)
+}
+
+func (a *accountPermissionService) GetBreakGlassGrants(ctx context.Context, orgID, accountID string) ([]models.BreakGlassGrant, error) {
+	return getBreakGlassGrants(ctx, a.db, uuid.MustParse(orgID), uuid.MustParse(accountID))
+}
+
 func (a *accountPermissionService) GetAccount(ctx context.Context, accountID string) (*models.Account, error) {
 	return dao.GetAccountBasics(ctx, a.db, uuid.MustParse(accountID))
 }
@@ -171,6 +237,10 @@ func (a *accountPermissionService) IsSSOAccount(ctx context.Context, accountID s
 	return dao.IsSSOAccount(ctx, a.db, uuid.MustParse(accountID))
 }
 
+func (a *accountPermissionService) IsServiceAccountActive(ctx context.Context, accountID, orgID string) (bool, error) {
+	return dao.IsServiceAccountActive(ctx, a.db, uuid.MustParse(accountID), uuid.MustParse(orgID))
+}
+
 func prepareAccountPermissionResponse(aps models.AccountPermission) sentry.AccountPermission {
 	var urls []*sentry.PermissionURL
 	if aps.Urls != nil {
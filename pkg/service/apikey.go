@@ -3,13 +3,19 @@ package service
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/paralus/paralus/internal/dao"
 	"github.com/paralus/paralus/internal/models"
+	"github.com/paralus/paralus/pkg/common"
 	"github.com/paralus/paralus/pkg/crypto"
+	"github.com/paralus/paralus/pkg/enforcer"
 	rpcv3 "github.com/paralus/paralus/proto/rpc/user"
+	systemv3 "github.com/paralus/paralus/proto/types/systempb/v3"
 	"github.com/uptrace/bun"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -19,10 +25,22 @@ import (
 type ApiKeyService interface {
 	// create api key
 	Create(ctx context.Context, req *rpcv3.ApiKeyRequest) (*models.ApiKey, error)
+	// create api key owned by a service account
+	CreateForServiceAccount(ctx context.Context, req *rpcv3.ApiKeyRequest) (*models.ApiKey, error)
 	// get by user
 	Get(ctx context.Context, req *rpcv3.ApiKeyRequest) (*models.ApiKey, error)
 	// get by key
 	GetByKey(ctx context.Context, req *rpcv3.ApiKeyRequest) (*models.ApiKey, error)
+	// check whether the request falls within the key's restrictions
+	InScope(ctx context.Context, apikey *models.ApiKey, project, url, method string) (bool, error)
+	// record a request nonce, false if it was already used
+	UseNonce(ctx context.Context, key, nonce string) (bool, error)
+	// purge request nonces older than window
+	PurgeNonces(ctx context.Context, window time.Duration) error
+	// authentication scheme required for the key's organization
+	AuthScheme(ctx context.Context, apikey *models.ApiKey) (string, error)
+	// rotate api key secret
+	Rotate(ctx context.Context, req *rpcv3.ApiKeyRequest) (*models.ApiKey, error)
 	// delete api key
 	Delete(ctx context.Context, req *rpcv3.ApiKeyRequest) (*rpcv3.UserDeleteApiKeysResponse, error)
 	// list api keys
@@ -40,15 +58,74 @@ func NewApiKeyService(db *bun.DB, al *zap.Logger) ApiKeyService {
 	return &apiKeyService{db, al}
 }
 
+// DefaultApiKeyRotationGracePeriod is how long the previous secret of
+// a rotated api key stays valid when the request does not specify it.
+const DefaultApiKeyRotationGracePeriod = 24 * time.Hour
+
+func (s *apiKeyService) validateScope(ctx context.Context, req *rpcv3.ApiKeyRequest) error {
+	if len(req.GetPermissions()) > 0 {
+		rps, err := dao.GetRolePermissionsByNames(ctx, s.db, req.GetPermissions()...)
+		if err != nil {
+			return err
+		}
+		found := map[string]bool{}
+		for _, rp := range rps {
+			found[rp.Name] = true
+		}
+		for _, p := range req.GetPermissions() {
+			if !found[p] {
+				return fmt.Errorf("unknown permission '%v'", p)
+			}
+		}
+	}
+	for _, p := range req.GetProjects() {
+		if _, err := dao.GetProjectId(ctx, s.db, p); err != nil {
+			return fmt.Errorf("unable to find project '%v'", p)
+		}
+	}
+	return nil
+}
+
 func (s *apiKeyService) Create(ctx context.Context, req *rpcv3.ApiKeyRequest) (*models.ApiKey, error) {
+	return s.create(ctx, req, false)
+}
+
+// CreateForServiceAccount creates an api key for the service account
+// with the id req.Id. Requests made with the key are authorized as
+// the service account.
+func (s *apiKeyService) CreateForServiceAccount(ctx context.Context, req *rpcv3.ApiKeyRequest) (*models.ApiKey, error) {
+	return s.create(ctx, req, true)
+}
+
+func (s *apiKeyService) create(ctx context.Context, req *rpcv3.ApiKeyRequest, serviceAccount bool) (*models.ApiKey, error) {
+	if req.GetExpiresAt() != nil && !req.GetExpiresAt().AsTime().After(time.Now()) {
+		return nil, fmt.Errorf("api key expiry should be in the future")
+	}
+	if err := s.validateScope(ctx, req); err != nil {
+		return nil, err
+	}
+
 	apikey := &models.ApiKey{
-		Name:       req.Username,
-		CreatedAt:  time.Now(),
-		ModifiedAt: time.Now(),
-		Trash:      false,
-		AccountID:  uuid.MustParse(req.Id),
-		Key:        crypto.GenerateSha1Key(),
-		Secret:     crypto.GenerateSha256Secret(),
+		Name:        req.Username,
+		CreatedAt:   time.Now(),
+		ModifiedAt:  time.Now(),
+		Trash:       false,
+		AccountID:   uuid.MustParse(req.Id),
+		Key:         crypto.GenerateSha1Key(),
+		Secret:      crypto.GenerateSha256Secret(),
+		Permissions: append([]string{}, req.GetPermissions()...),
+		Projects:    append([]string{}, req.GetProjects()...),
+
+		IsServiceAccount: serviceAccount,
+	}
+	if req.GetExpiresAt() != nil {
+		apikey.ExpiresAt = req.GetExpiresAt().AsTime()
+	}
+	if oid, err := uuid.Parse(req.GetOrganizationId()); err == nil {
+		apikey.OrganizationID = oid
+	}
+	if pid, err := uuid.Parse(req.GetPartnerId()); err == nil {
+		apikey.PartnerID = pid
 	}
 
 	entity, err := dao.Create(ctx, s.db, apikey)
@@ -56,12 +133,61 @@ func (s *apiKeyService) Create(ctx context.Context, req *rpcv3.ApiKeyRequest) (*
 		return nil, err
 	}
 
-	if ak, ok := entity.(*models.Group); ok {
-		CreateApiKeyAuditEvent(ctx, s.al, AuditActionCreate, ak.ID.String())
+	if ak, ok := entity.(*models.ApiKey); ok {
+		CreateApiKeyAuditEvent(ctx, s.al, AuditActionCreate, ak.Key)
 	}
 	return apikey, nil
 }
 
+// Rotate issues a new secret for the api key. The previous secret
+// keeps working until the grace period runs out so that clients can
+// be updated without downtime.
+func (s *apiKeyService) Rotate(ctx context.Context, req *rpcv3.ApiKeyRequest) (*models.ApiKey, error) {
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("unable to retrieve session data")
+	}
+	// the new secret is returned, only the owner of the key may rotate it
+	if req.Username != sd.GetAccount() && req.Username != sd.GetUsername() {
+		return nil, fmt.Errorf("api keys can only be rotated for the current user")
+	}
+	var apikey models.ApiKey
+	err := s.db.NewSelect().Model(&apikey).
+		Where("account_id = ?", sd.GetAccount()).
+		Where("key = ?", req.Id).
+		Where("trash = ?", false).
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find api key '%v'", req.Id)
+	}
+	if !apikey.ExpiresAt.IsZero() && apikey.ExpiresAt.Before(time.Now()) {
+		return nil, fmt.Errorf("cannot rotate expired api key '%v'", req.Id)
+	}
+
+	grace := DefaultApiKeyRotationGracePeriod
+	if req.GetGracePeriodSeconds() > 0 {
+		grace = time.Duration(req.GetGracePeriodSeconds()) * time.Second
+	}
+
+	now := time.Now()
+	apikey.PreviousSecret = apikey.Secret
+	apikey.PreviousSecretExpiresAt = now.Add(grace)
+	apikey.Secret = crypto.GenerateSha256Secret()
+	apikey.RotatedAt = now
+	apikey.ModifiedAt = now
+
+	_, err = s.db.NewUpdate().Model(&apikey).
+		Column("secret", "previous_secret", "previous_secret_expires_at", "rotated_at", "modified_at").
+		WherePK().
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	CreateApiKeyAuditEvent(ctx, s.al, AuditActionRotate, apikey.Key)
+	return &apikey, nil
+}
+
 func (s *apiKeyService) Delete(ctx context.Context, req *rpcv3.ApiKeyRequest) (*rpcv3.UserDeleteApiKeysResponse, error) {
 	_, err := s.db.NewUpdate().Model(&models.ApiKey{}).
 		Set("trash = ?", true).
@@ -87,12 +213,7 @@ func (s *apiKeyService) List(ctx context.Context, req *rpcv3.ApiKeyRequest) (*rp
 			Items: make([]*rpcv3.ApiKeyResponse, 0),
 		}
 		for _, apikey := range *apikeys {
-			apiKeyResp.Items = append(apiKeyResp.Items, &rpcv3.ApiKeyResponse{
-				Name:       apikey.Name,
-				CreatedAt:  timestamppb.New(apikey.CreatedAt),
-				ModifiedAt: timestamppb.New(apikey.ModifiedAt),
-				Key:        apikey.Key,
-			})
+			apiKeyResp.Items = append(apiKeyResp.Items, ApiKeyToResponse(&apikey, false))
 		}
 		return apiKeyResp, nil
 	}
@@ -116,3 +237,129 @@ func (s *apiKeyService) GetByKey(ctx context.Context, req *rpcv3.ApiKeyRequest)
 	}
 	return &apikey, err
 }
+
+// InScope reports whether a request for url/method in project is
+// covered by the permission and project restrictions of apikey. Keys
+// without restrictions are always in scope, keys restricted to
+// projects are not for requests outside of a project.
+func (s *apiKeyService) InScope(ctx context.Context, apikey *models.ApiKey, project, url, method string) (bool, error) {
+	if len(apikey.Projects) > 0 {
+		found := false
+		for _, p := range apikey.Projects {
+			if p == project {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false, nil
+		}
+	}
+	if len(apikey.Permissions) == 0 {
+		return true, nil
+	}
+
+	var rpms []models.ResourcePermission
+	err := s.db.NewSelect().Model(&rpms).
+		Where("name IN (?)", bun.In(apikey.Permissions)).
+		Where("trash = ?", false).
+		Scan(ctx)
+	if err != nil {
+		return false, err
+	}
+	for _, rpm := range rpms {
+		for _, ua := range processRpms(rpm) {
+			if !enforcer.KeyMatchCu(url, ua.url) {
+				continue
+			}
+			for _, m := range ua.methods {
+				if m == "*" || strings.EqualFold(m, method) {
+					return true, nil
+				}
+			}
+		}
+	}
+	return false, nil
+}
+
+// UseNonce records nonce as used for key and reports whether it was
+// unused
+func (s *apiKeyService) UseNonce(ctx context.Context, key, nonce string) (bool, error) {
+	res, err := s.db.NewInsert().Model(&models.ApiKeyNonce{
+		Key:       key,
+		Nonce:     nonce,
+		CreatedAt: time.Now(),
+	}).On("CONFLICT (key, nonce) DO NOTHING").Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n == 1, nil
+}
+
+// PurgeNonces deletes the nonces older than window, requests that old
+// are rejected by their timestamp anyway
+func (s *apiKeyService) PurgeNonces(ctx context.Context, window time.Duration) error {
+	_, err := s.db.NewDelete().Model((*models.ApiKeyNonce)(nil)).
+		Where("created_at < ?", time.Now().Add(-window)).
+		Exec(ctx)
+	return err
+}
+
+// AuthScheme returns the api key authentication scheme required by
+// the organization of apikey. Keys created before organizations were
+// recorded on them fall back to the organization of the owner's
+// default project.
+func (s *apiKeyService) AuthScheme(ctx context.Context, apikey *models.ApiKey) (string, error) {
+	orgID := apikey.OrganizationID
+	if orgID == uuid.Nil {
+		ap, err := dao.GetDefaultAccountProject(ctx, s.db, apikey.AccountID)
+		if err != nil {
+			return "", err
+		}
+		orgID = ap.OrganizationId
+	}
+	var org models.Organization
+	_, err := dao.GetByID(ctx, s.db, orgID, &org)
+	if err != nil {
+		return "", err
+	}
+	return orgApiKeyAuthScheme(&org), nil
+}
+
+func orgApiKeyAuthScheme(org *models.Organization) string {
+	var settings systemv3.OrganizationSettings
+	if org.Settings != nil {
+		_ = json.Unmarshal(org.Settings, &settings) // ignore any unmarshelling issues
+	}
+	if settings.LegacyApiKeyChecksum {
+		return common.ApiKeyAuthSchemeLegacy
+	}
+	return common.ApiKeyAuthSchemeHMAC
+}
+
+// ApiKeyToResponse converts the api key model to its rpc
+// representation. The secret is only included when withSecret is set.
+func ApiKeyToResponse(apikey *models.ApiKey, withSecret bool) *rpcv3.ApiKeyResponse {
+	resp := &rpcv3.ApiKeyResponse{
+		Name:        apikey.Name,
+		CreatedAt:   timestamppb.New(apikey.CreatedAt),
+		ModifiedAt:  timestamppb.New(apikey.ModifiedAt),
+		Key:         apikey.Key,
+		Permissions: apikey.Permissions,
+		Projects:    apikey.Projects,
+	}
+	if !apikey.ExpiresAt.IsZero() {
+		resp.ExpiresAt = timestamppb.New(apikey.ExpiresAt)
+	}
+	if !apikey.RotatedAt.IsZero() {
+		resp.RotatedAt = timestamppb.New(apikey.RotatedAt)
+	}
+	if withSecret {
+		resp.Secret = apikey.Secret
+	}
+	return resp
+}
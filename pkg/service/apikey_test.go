@@ -3,10 +3,15 @@ package service
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/paralus/paralus/pkg/common"
 	userrpcv3 "github.com/paralus/paralus/proto/rpc/user"
+	v3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestApiKeyCreate(t *testing.T) {
@@ -120,3 +125,184 @@ func TestApiKeyList(t *testing.T) {
 		t.Errorf("incorrect name for apikey; expected '%v', got '%v'", "apikey-"+uuuid, resp.Items[0].Name)
 	}
 }
+
+func TestApiKeyCreateScoped(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	ak := NewApiKeyService(db, getLogger())
+	uuuid := uuid.NewString()
+	auuid := uuid.NewString()
+	req := &userrpcv3.ApiKeyRequest{
+		Username:    "user-" + uuuid,
+		Id:          uuuid,
+		ExpiresAt:   timestamppb.New(time.Now().Add(time.Hour)),
+		Permissions: []string{"cluster.read"},
+		Projects:    []string{"project-" + uuuid},
+	}
+
+	// mocks
+	mock.ExpectQuery(`SELECT authsrv_resourcepermission.name as name, .* FROM "authsrv_resourcepermission" WHERE \(name IN \('cluster.read'\)\)`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("cluster.read"))
+	mock.ExpectQuery(`SELECT "project"."id" FROM "authsrv_project" AS "project" WHERE \(name = 'project-` + uuuid + `'\)`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.NewString()))
+	mock.ExpectQuery(`INSERT INTO "authsrv_apikey" .*'{"cluster.read"}', '{"project-` + uuuid + `"}'`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(auuid, "apikey-"+auuid))
+
+	resp, err := ak.Create(context.Background(), req)
+	if err != nil {
+		t.Fatal("unable to create apikey:", err)
+	}
+	if resp.ExpiresAt.IsZero() {
+		t.Error("expiry not set for apikey")
+	}
+}
+
+func TestApiKeyCreateExpired(t *testing.T) {
+	db, _ := getDB(t)
+	defer db.Close()
+
+	ak := NewApiKeyService(db, getLogger())
+	uuuid := uuid.NewString()
+	req := &userrpcv3.ApiKeyRequest{
+		Username:  "user-" + uuuid,
+		Id:        uuuid,
+		ExpiresAt: timestamppb.New(time.Now().Add(-time.Hour)),
+	}
+
+	_, err := ak.Create(context.Background(), req)
+	if err == nil {
+		t.Error("able to create apikey with expiry in the past")
+	}
+}
+
+func TestApiKeyRotate(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	ak := NewApiKeyService(db, getLogger())
+	uuuid := uuid.NewString()
+	auuid := uuid.NewString()
+	req := &userrpcv3.ApiKeyRequest{Username: auuid, Id: uuuid, GracePeriodSeconds: 60}
+
+	// mocks
+	mock.ExpectQuery(`SELECT "apikey"."id", "apikey"."name", .*FROM "authsrv_apikey" AS "apikey" WHERE \(account_id = '` + auuid + `'\) AND \(key = '` + uuuid + `'\) AND \(trash = FALSE\)`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "key", "secret"}).AddRow(uuuid, uuuid, "old-secret"))
+	mock.ExpectExec(`UPDATE "authsrv_apikey" AS "apikey" SET "secret" = .*, "previous_secret" = 'old-secret', "previous_secret_expires_at" = .* WHERE \("apikey"."id" = '` + uuuid + `'\)`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	ctx := context.WithValue(context.Background(), common.SessionDataKey, &v3.SessionData{Username: "user@example.com", Account: auuid})
+	resp, err := ak.Rotate(ctx, req)
+	if err != nil {
+		t.Fatal("unable to rotate apikey:", err)
+	}
+	if resp.Secret == "old-secret" || resp.PreviousSecret != "old-secret" {
+		t.Errorf("secret not rotated for apikey; got secret '%v', previous '%v'", resp.Secret, resp.PreviousSecret)
+	}
+	if d := time.Until(resp.PreviousSecretExpiresAt); d <= 0 || d > time.Minute {
+		t.Errorf("incorrect grace period for apikey; got '%v'", d)
+	}
+}
+
+func TestApiKeyRotateOtherAccount(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	ak := NewApiKeyService(db, getLogger())
+	req := &userrpcv3.ApiKeyRequest{Username: uuid.NewString(), Id: uuid.NewString()}
+
+	ctx := context.WithValue(context.Background(), common.SessionDataKey, &v3.SessionData{Username: "user@example.com", Account: uuid.NewString()})
+	if _, err := ak.Rotate(ctx, req); err == nil {
+		t.Error("expected rotation of api key of other account to be rejected")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestApiKeyInScope(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	ak := NewApiKeyService(db, getLogger())
+	key := &models.ApiKey{Permissions: []string{"cluster.read"}, Projects: []string{"default"}}
+
+	ok, err := ak.InScope(context.Background(), key, "other", "/infra/v3/project/other/cluster", "GET")
+	if err != nil {
+		t.Fatal("unable to check apikey scope:", err)
+	}
+	if ok {
+		t.Error("apikey in scope for project outside of its restriction")
+	}
+
+	ok, err = ak.InScope(context.Background(), key, "", "/auth/v3/partner/p/organization/o/users", "GET")
+	if err != nil {
+		t.Fatal("unable to check apikey scope:", err)
+	}
+	if ok {
+		t.Error("project restricted apikey in scope for organization request")
+	}
+
+	// mocks
+	mock.ExpectQuery(`SELECT .* FROM "authsrv_resourcepermission" AS "resourcepermission" WHERE \(name IN \('cluster.read'\)\) AND \(trash = FALSE\)`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"name", "base_url", "resource_urls"}).
+		AddRow("cluster.read", "/infra/v3/project/:project/cluster", `[{"url": "", "methods": ["GET"]}]`))
+
+	ok, err = ak.InScope(context.Background(), key, "default", "/infra/v3/project/default/cluster", "POST")
+	if err != nil {
+		t.Fatal("unable to check apikey scope:", err)
+	}
+	if ok {
+		t.Error("apikey in scope for method outside of its permissions")
+	}
+}
+
+func TestApiKeyUseNonce(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	ak := NewApiKeyService(db, getLogger())
+	uuuid := uuid.NewString()
+
+	// mocks
+	mock.ExpectExec(`INSERT INTO "authsrv_apikey_nonce" .*'` + uuuid + `', 'nonce-1'.* ON CONFLICT \(key, nonce\) DO NOTHING`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`INSERT INTO "authsrv_apikey_nonce" .*'` + uuuid + `', 'nonce-1'.* ON CONFLICT \(key, nonce\) DO NOTHING`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	fresh, err := ak.UseNonce(context.Background(), uuuid, "nonce-1")
+	if err != nil {
+		t.Fatal("unable to use nonce:", err)
+	}
+	if !fresh {
+		t.Error("unused nonce reported as replayed")
+	}
+	fresh, err = ak.UseNonce(context.Background(), uuuid, "nonce-1")
+	if err != nil {
+		t.Fatal("unable to use nonce:", err)
+	}
+	if fresh {
+		t.Error("replayed nonce reported as unused")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestApiKeyPurgeNonces(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	ak := NewApiKeyService(db, getLogger())
+
+	// mocks
+	mock.ExpectExec(`DELETE FROM "authsrv_apikey_nonce" AS "apikeynonce" WHERE \(created_at < .*\)`).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+
+	if err := ak.PurgeNonces(context.Background(), time.Minute); err != nil {
+		t.Fatal("unable to purge nonces:", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
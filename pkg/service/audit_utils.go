@@ -2,17 +2,24 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/paralus/paralus/internal/dao"
 	"github.com/paralus/paralus/internal/models"
 	"github.com/paralus/paralus/pkg/audit"
 	"github.com/paralus/paralus/pkg/utils"
+	userrpcv3 "github.com/paralus/paralus/proto/rpc/user"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	rolev3 "github.com/paralus/paralus/proto/types/rolepb/v3"
 	systemv3 "github.com/paralus/paralus/proto/types/systempb/v3"
 	"github.com/uptrace/bun"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
@@ -21,6 +28,7 @@ const (
 	AuditActionUpdate   = "update"
 	AuditActionDownload = "download"
 	AuditActionUpsert   = "upsert"
+	AuditActionRotate   = "rotate"
 )
 
 func CreateUserAuditEvent(ctx context.Context, al *zap.Logger, db bun.IDB, action string, name string, id uuid.UUID, rolesBefore, rolesAfter, groupsBefore, groupsAfter []uuid.UUID) {
@@ -132,6 +140,58 @@ func CreateUserLoginAuditEvent(ctx context.Context, al *zap.Logger, action strin
 
 }
 
+// FailedUserLoginAuditEvent records a login attempt that was refused
+func FailedUserLoginAuditEvent(ctx context.Context, al *zap.Logger, name string, reason string) {
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok {
+		_log.Warn("unable to create audit event: could not fetch info from context")
+		return
+	}
+
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("User login failed: %s", name),
+		Meta: map[string]string{
+			"user":   name,
+			"reason": reason,
+		},
+	}
+	if err := audit.CreateV1Event(al, sd, detail, "user.login.failed", ""); err != nil {
+		_log.Warn("unable to create audit event", err)
+	}
+}
+
+// SAMLLoginAuditEvent records a login through a SAML idp. The login
+// happens outside of the gateway, so the session data is passed in.
+func SAMLLoginAuditEvent(al *zap.Logger, sd *commonv3.SessionData, idp string) {
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("User login: %s", sd.Username),
+		Meta: map[string]string{
+			"user":   sd.Username,
+			"idp":    idp,
+			"method": "saml",
+		},
+	}
+	if err := audit.CreateV1Event(al, sd, detail, "user.login.success", ""); err != nil {
+		_log.Warn("unable to create audit event", err)
+	}
+}
+
+// FailedSAMLLoginAuditEvent records a SAML login that was refused
+func FailedSAMLLoginAuditEvent(al *zap.Logger, sd *commonv3.SessionData, name string, idp string, reason string) {
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("User login failed: %s", name),
+		Meta: map[string]string{
+			"user":   name,
+			"idp":    idp,
+			"method": "saml",
+			"reason": reason,
+		},
+	}
+	if err := audit.CreateV1Event(al, sd, detail, "user.login.failed", ""); err != nil {
+		_log.Warn("unable to create audit event", err)
+	}
+}
+
 func CreateGroupAuditEvent(ctx context.Context, al *zap.Logger, db bun.IDB, action string, name string, id uuid.UUID, usersBefore, usersAfter, rolesBefore, rolesAfter []uuid.UUID) {
 	sd, ok := GetSessionDataFromContext(ctx)
 	if !ok {
@@ -224,7 +284,7 @@ func CreateGroupAuditEvent(ctx context.Context, al *zap.Logger, db bun.IDB, acti
 
 }
 
-func CreateRoleAuditEvent(ctx context.Context, al *zap.Logger, action string, name string, id uuid.UUID, permissions []string) {
+func CreateRoleAuditEvent(ctx context.Context, al *zap.Logger, action string, name string, id uuid.UUID, permissions []string, rules []*rolev3.KubernetesRule) {
 	sd, ok := GetSessionDataFromContext(ctx)
 	if !ok {
 		_log.Warn("unable to create audit event: could not fetch info from context")
@@ -238,11 +298,137 @@ func CreateRoleAuditEvent(ctx context.Context, al *zap.Logger, action string, na
 			"permissions": strings.Join(permissions, ","), // TODO: Should we split it into individual ones?
 		},
 	}
+	if len(rules) > 0 {
+		kr, err := json.Marshal(toModelKubernetesRules(rules))
+		if err == nil {
+			detail.Meta["kubernetes_rules"] = string(kr)
+		}
+	}
 	if err := audit.CreateV1Event(al, sd, detail, fmt.Sprintf("role.%s.success", action), ""); err != nil {
 		_log.Warn("unable to create audit event", err)
 	}
 }
 
+func CreateDenyPolicyAuditEvent(ctx context.Context, al *zap.Logger, action string, name string, id uuid.UUID, permissions []string) {
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok {
+		_log.Warn("unable to create audit event: could not fetch info from context")
+		return
+	}
+
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("DenyPolicy %s %sd", name, action),
+		Meta: map[string]string{
+			"denypolicy_name": name,
+			"permissions":     strings.Join(permissions, ","),
+		},
+	}
+	if err := audit.CreateV1Event(al, sd, detail, fmt.Sprintf("denypolicy.%s.success", action), ""); err != nil {
+		_log.Warn("unable to create audit event", err)
+	}
+}
+
+func CreateClusterBindingAuditEvent(ctx context.Context, al *zap.Logger, action string, name string, selector string, role string) {
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok {
+		_log.Warn("unable to create audit event: could not fetch info from context")
+		return
+	}
+
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("ClusterBinding %s %sd", name, action),
+		Meta: map[string]string{
+			"clusterbinding_name": name,
+			"cluster_selector":    selector,
+			"role":                role,
+		},
+	}
+	if err := audit.CreateV1Event(al, sd, detail, fmt.Sprintf("clusterbinding.%s.success", action), ""); err != nil {
+		_log.Warn("unable to create audit event", err)
+	}
+}
+
+func CreateConfigBundleAuditEvent(ctx context.Context, al *zap.Logger, organization string, changes []*systemv3.ConfigBundleChange) {
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok {
+		_log.Warn("unable to create audit event: could not fetch info from context")
+		return
+	}
+
+	counts := map[string]int{}
+	var objects []string
+	for _, c := range changes {
+		counts[c.Action]++
+		objects = append(objects, fmt.Sprintf("%s %s/%s", c.Action, c.Kind, c.Name))
+	}
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("ConfigBundle applied to %s", organization),
+		Meta: map[string]string{
+			"organization": organization,
+			"created":      fmt.Sprint(counts["create"]),
+			"updated":      fmt.Sprint(counts["update"]),
+			"deleted":      fmt.Sprint(counts["delete"]),
+			"changes":      strings.Join(objects, ", "),
+		},
+	}
+	if err := audit.CreateV1Event(al, sd, detail, "configbundle.apply.success", ""); err != nil {
+		_log.Warn("unable to create audit event", err)
+	}
+}
+
+func CreateServiceAccountAuditEvent(ctx context.Context, al *zap.Logger, db bun.IDB, action string, name string, rolesBefore, rolesAfter []uuid.UUID) {
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok {
+		_log.Warn("unable to create audit event: could not fetch info from context")
+		return
+	}
+
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("ServiceAccount %s %sd", name, action),
+		Meta: map[string]string{
+			"serviceaccount_name": name,
+		},
+	}
+	if err := audit.CreateV1Event(al, sd, detail, fmt.Sprintf("serviceaccount.%s.success", action), ""); err != nil {
+		_log.Warn("unable to create audit event", err)
+	}
+
+	cr, _, dr := utils.DiffU(rolesBefore, rolesAfter)
+	ncr, err := dao.GetNamesByIds(ctx, db, cr, &models.Role{})
+	if err != nil {
+		_log.Warn("unable to create audit event", err)
+	}
+	ndr, err := dao.GetNamesByIds(ctx, db, dr, &models.Role{})
+	if err != nil {
+		_log.Warn("unable to create audit event", err)
+	}
+	for _, r := range ncr {
+		detail := &audit.EventDetail{
+			Message: fmt.Sprintf("Role %s added to service account %s", r, name),
+			Meta: map[string]string{
+				"serviceaccount_name": name,
+				"role_name":           r,
+			},
+		}
+		if err := audit.CreateV1Event(al, sd, detail, "serviceaccount.role.created", ""); err != nil {
+			_log.Warn("unable to create audit event", err)
+		}
+	}
+
+	for _, r := range ndr {
+		detail := &audit.EventDetail{
+			Message: fmt.Sprintf("Role %s deleted from service account %s", r, name),
+			Meta: map[string]string{
+				"serviceaccount_name": name,
+				"role_name":           r,
+			},
+		}
+		if err := audit.CreateV1Event(al, sd, detail, "serviceaccount.role.deleted", ""); err != nil {
+			_log.Warn("unable to create audit event", err)
+		}
+	}
+}
+
 func CreateProjectAuditEvent(ctx context.Context, al *zap.Logger, action string, name string, id uuid.UUID) {
 	sd, ok := GetSessionDataFromContext(ctx)
 	if !ok {
@@ -352,6 +538,23 @@ func CreateOrganizationAuditEvent(ctx context.Context, al *zap.Logger, action st
 			_log.Warn("unable to create audit event", err)
 		}
 	}
+
+	if !proto.Equal(settingsBefore.GetBreakGlass(), settingsAfter.GetBreakGlass()) {
+		policy := settingsAfter.GetBreakGlass()
+		detail := &audit.EventDetail{
+			Message: fmt.Sprintf("Break-glass settings updated for organization %s", name),
+			Meta: map[string]string{
+				"organization_name":      name,
+				"breakglass_enabled":     strconv.FormatBool(policy.GetEnabled()),
+				"breakglass_role":        policy.GetRole(),
+				"breakglass_duration":    BreakGlassDuration(policy).String(),
+				"breakglass_webhook_url": policy.GetWebhookUrl(),
+			},
+		}
+		if err := audit.CreateV1Event(al, sd, detail, "organization.breakglass.settings.updated", ""); err != nil {
+			_log.Warn("unable to create audit event", err)
+		}
+	}
 }
 
 func CreateIdpAuditEvent(ctx context.Context, al *zap.Logger, action string, name string, id uuid.UUID) {
@@ -410,6 +613,249 @@ func CreateApiKeyAuditEvent(ctx context.Context, al *zap.Logger, action string,
 	}
 }
 
+func CreateTrustPolicyAuditEvent(ctx context.Context, al *zap.Logger, action string, name string) {
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok {
+		_log.Warn("unable to create audit event: could not fetch info from context")
+		return
+	}
+
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("TrustPolicy %s %sd", name, action),
+		Meta: map[string]string{
+			"trustpolicy_name": name,
+		},
+	}
+	if err := audit.CreateV1Event(al, sd, detail, fmt.Sprintf("trustpolicy.%s.success", action), ""); err != nil {
+		_log.Warn("unable to create audit event", err)
+	}
+}
+
+func CreateScimTokenAuditEvent(ctx context.Context, al *zap.Logger, action string, name string) {
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok {
+		_log.Warn("unable to create audit event: could not fetch info from context")
+		return
+	}
+
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("ScimToken %s %sd", name, action),
+		Meta: map[string]string{
+			"scimtoken_name": name,
+		},
+	}
+	if err := audit.CreateV1Event(al, sd, detail, fmt.Sprintf("scimtoken.%s.success", action), ""); err != nil {
+		_log.Warn("unable to create audit event", err)
+	}
+}
+
+func CreateLdapConnectorAuditEvent(ctx context.Context, al *zap.Logger, action string, name string) {
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok {
+		_log.Warn("unable to create audit event: could not fetch info from context")
+		return
+	}
+
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("LdapConnector %s %sd", name, action),
+		Meta: map[string]string{
+			"ldapconnector_name": name,
+		},
+	}
+	if err := audit.CreateV1Event(al, sd, detail, fmt.Sprintf("ldapconnector.%s.success", action), ""); err != nil {
+		_log.Warn("unable to create audit event", err)
+	}
+}
+
+func LdapConnectorSyncAuditEvent(ctx context.Context, al *zap.Logger, name string, meta map[string]string) {
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok {
+		_log.Warn("unable to create audit event: could not fetch info from context")
+		return
+	}
+
+	meta["ldapconnector_name"] = name
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("Groups of LdapConnector %s synced", name),
+		Meta:    meta,
+	}
+	if err := audit.CreateV1Event(al, sd, detail, "ldapconnector.sync.success", ""); err != nil {
+		_log.Warn("unable to create audit event", err)
+	}
+}
+
+// CreateAccessRequestAuditEvent records a transition of an access
+// request, the audit events of a request are found by the requester
+// and reviewer in their meta
+func CreateAccessRequestAuditEvent(ctx context.Context, al *zap.Logger, action string, ar *models.AccessRequest) {
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok {
+		_log.Warn("unable to create audit event: could not fetch info from context")
+		return
+	}
+
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("AccessRequest %s of %s for role %s %s", ar.Name, ar.Requester, ar.Role, strings.ToLower(ar.State)),
+		Meta: map[string]string{
+			"accessrequest_name": ar.Name,
+			"requester":          ar.Requester,
+			"reviewer":           ar.Reviewer,
+			"role":               ar.Role,
+			"project":            ar.Project,
+			"namespace":          ar.Namespace,
+			"duration":           ar.Duration,
+			"state":              ar.State,
+		},
+	}
+	if !ar.ExpiresAt.IsZero() {
+		detail.Meta["expires_at"] = ar.ExpiresAt.Format(time.RFC3339)
+	}
+	if err := audit.CreateV1Event(al, sd, detail, fmt.Sprintf("accessrequest.%s.success", action), ar.Project); err != nil {
+		_log.Warn("unable to create audit event", err)
+	}
+}
+
+func CreateAccessReviewAuditEvent(ctx context.Context, al *zap.Logger, action string, ar *models.AccessReview, items int) {
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok {
+		_log.Warn("unable to create audit event: could not fetch info from context")
+		return
+	}
+
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("AccessReview %s of %d bindings %s", ar.Name, items, strings.ToLower(ar.State)),
+		Meta: map[string]string{
+			"accessreview_name": ar.Name,
+			"projects":          strings.Join(ar.Projects, ","),
+			"roles":             strings.Join(ar.Roles, ","),
+			"state":             ar.State,
+			"items":             fmt.Sprint(items),
+		},
+	}
+	if err := audit.CreateV1Event(al, sd, detail, fmt.Sprintf("accessreview.%s.success", action), ""); err != nil {
+		_log.Warn("unable to create audit event", err)
+	}
+}
+
+func CreateAccessReviewItemAuditEvent(ctx context.Context, al *zap.Logger, action string, ar *models.AccessReview, item *models.AccessReviewItem) {
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok {
+		_log.Warn("unable to create audit event: could not fetch info from context")
+		return
+	}
+
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("AccessReview %s binding of %s to role %s %s", ar.Name, item.Subject, item.Role, strings.ToLower(item.Decision)),
+		Meta: map[string]string{
+			"accessreview_name": ar.Name,
+			"subject_type":      item.SubjectType,
+			"subject":           item.Subject,
+			"role":              item.Role,
+			"project":           item.Project,
+			"namespace":         item.Namespace,
+			"reviewer":          item.Reviewer,
+			"decision":          item.Decision,
+			"reason":            item.Reason,
+		},
+	}
+	if item.Result != "" {
+		detail.Meta["result"] = item.Result
+	}
+	if err := audit.CreateV1Event(al, sd, detail, fmt.Sprintf("accessreview.%s.success", action), item.Project); err != nil {
+		_log.Warn("unable to create audit event", err)
+	}
+}
+
+func CreateBreakGlassAuditEvent(ctx context.Context, al *zap.Logger, bg *models.BreakGlass) {
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok {
+		_log.Warn("unable to create audit event: could not fetch info from context")
+		return
+	}
+
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("BreakGlass %s granted role %s on cluster %s until %s", bg.Name, bg.Role, bg.Cluster, bg.ExpiresAt.Format(time.RFC3339)),
+		Meta: map[string]string{
+			"breakglass_name": bg.Name,
+			"cluster_name":    bg.Cluster,
+			"role":            bg.Role,
+			"justification":   bg.Justification,
+			"incident":        bg.Incident,
+			"expires_at":      bg.ExpiresAt.Format(time.RFC3339),
+		},
+	}
+	if err := audit.CreateV1Event(al, sd, detail, "breakglass.create.success", ""); err != nil {
+		_log.Warn("unable to create audit event", err)
+	}
+}
+
+func CreateAccountLockoutAuditEvent(ctx context.Context, al *zap.Logger, action string, name string) {
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok {
+		_log.Warn("unable to create audit event: could not fetch info from context")
+		return
+	}
+
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("Account %s %sed", name, action),
+		Meta: map[string]string{
+			"account_name": name,
+		},
+	}
+	if err := audit.CreateV1Event(al, sd, detail, fmt.Sprintf("account.%s.success", action), ""); err != nil {
+		_log.Warn("unable to create audit event", err)
+	}
+}
+
+// LockAccountAuditEvent records an account locked after too many
+// failed login attempts
+func LockAccountAuditEvent(al *zap.Logger, sd *commonv3.SessionData, name string, attempts int32) {
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("Account %s locked after %d failed login attempts", name, attempts),
+		Meta: map[string]string{
+			"account_name": name,
+			"attempts":     fmt.Sprint(attempts),
+		},
+	}
+	if err := audit.CreateV1Event(al, sd, detail, "account.lock.success", ""); err != nil {
+		_log.Warn("unable to create audit event", err)
+	}
+}
+
+// RejectApiKeyAuditEvent records an api key that was refused during
+// authentication or authorization. There is no session at that
+// point, so the actor is taken from the key itself.
+func RejectApiKeyAuditEvent(al *zap.Logger, sd *commonv3.SessionData, key string, reason string) {
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("ApiKey %s rejected: %s", key, reason),
+		Meta: map[string]string{
+			"apikey": key,
+			"reason": reason,
+		},
+	}
+	if err := audit.CreateV1Event(al, sd, detail, "apikey.auth.rejected", ""); err != nil {
+		_log.Warn("unable to create audit event", err)
+	}
+}
+
+// ExpireRoleBindingAuditEvent records a role binding removed by the
+// role binding reconciler once it expired
+func ExpireRoleBindingAuditEvent(al *zap.Logger, sd *commonv3.SessionData, rb models.RoleBinding) {
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("Role %s of %s expired", rb.Role, rb.Subject),
+		Meta: map[string]string{
+			"subject":    rb.Subject,
+			"role":       rb.Role,
+			"project":    rb.Project,
+			"namespace":  rb.Namespace,
+			"expired_at": rb.ExpiresAt.Format(time.RFC3339),
+		},
+	}
+	if err := audit.CreateV1Event(al, sd, detail, "rolebinding.expire.success", rb.Project); err != nil {
+		_log.Warn("unable to create audit event", err)
+	}
+}
+
 func DownloadCliConfigAuditEvent(ctx context.Context, al *zap.Logger, action string, user string) {
 	sd, ok := GetSessionDataFromContext(ctx)
 	if !ok {
@@ -464,6 +910,97 @@ func RevokeKubeconfigAuditEvent(ctx context.Context, al *zap.Logger, user string
 	}
 }
 
+// RevokeUserSessionAuditEvent records the revocation of a session of
+// a user, all sessions if session is empty
+func RevokeUserSessionAuditEvent(ctx context.Context, al *zap.Logger, user string, session string) {
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok {
+		_log.Warn("unable to create audit event: could not fetch info from context")
+		return
+	}
+
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("User %s sessions revoked", user),
+		Meta: map[string]string{
+			"user": user,
+		},
+	}
+	if session != "" {
+		detail.Message = fmt.Sprintf("User %s session %s revoked", user, session)
+		detail.Meta["session"] = session
+	}
+	if err := audit.CreateV1Event(al, sd, detail, "user.session.revoke", ""); err != nil {
+		_log.Warn("unable to create audit event", err)
+	}
+}
+
+// RevokeUserAccessAuditEvent records the revocation of the sessions,
+// kubeconfigs and api keys of a user as a single event
+func RevokeUserAccessAuditEvent(ctx context.Context, al *zap.Logger, user string, resp *userrpcv3.UserRevokeAccessResponse) {
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok {
+		_log.Warn("unable to create audit event: could not fetch info from context")
+		return
+	}
+
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("User %s access revoked", user),
+		Meta: map[string]string{
+			"user":                user,
+			"revoked_sessions":    fmt.Sprint(resp.RevokedSessions),
+			"revoked_apikeys":     fmt.Sprint(resp.RevokedApiKeys),
+			"kubeconfigs_revoked": fmt.Sprint(resp.KubeconfigsRevoked),
+		},
+	}
+	if err := audit.CreateV1Event(al, sd, detail, "user.access.revoke", ""); err != nil {
+		_log.Warn("unable to create audit event", err)
+	}
+}
+
+// SetUserActiveAuditEvent records a user being deactivated or
+// activated again
+func SetUserActiveAuditEvent(ctx context.Context, al *zap.Logger, user string, active bool) {
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok {
+		_log.Warn("unable to create audit event: could not fetch info from context")
+		return
+	}
+
+	action := "deactivate"
+	if active {
+		action = "activate"
+	}
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("User %s %sd", user, action),
+		Meta: map[string]string{
+			"user": user,
+		},
+	}
+	if err := audit.CreateV1Event(al, sd, detail, fmt.Sprintf("user.%s.success", action), ""); err != nil {
+		_log.Warn("unable to create audit event", err)
+	}
+}
+
+// IdpGroupSyncAuditEvent records the groups and roles the claims of a
+// user resolved to on a sync of the user with the idp
+func IdpGroupSyncAuditEvent(ctx context.Context, al *zap.Logger, user string, op string, meta map[string]string) {
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok {
+		_log.Warn("unable to create audit event: could not fetch info from context")
+		return
+	}
+
+	meta["user"] = user
+	meta["operation"] = op
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("Idp groups of user %s synced", user),
+		Meta:    meta,
+	}
+	if err := audit.CreateV1Event(al, sd, detail, "user.idp.sync.success", ""); err != nil {
+		_log.Warn("unable to create audit event", err)
+	}
+}
+
 func CreateClusterAuditEvent(ctx context.Context, al *zap.Logger, action string, name string, id uuid.UUID, project string) {
 	sd, ok := GetSessionDataFromContext(ctx)
 	if !ok {
@@ -5,9 +5,12 @@ import (
 	"fmt"
 
 	"github.com/casbin/casbin/v2"
+	"github.com/casbin/casbin/v2/util"
 	"github.com/paralus/paralus/internal/dao"
 	"github.com/paralus/paralus/internal/models"
+	"github.com/paralus/paralus/pkg/enforcer"
 	authzpbv1 "github.com/paralus/paralus/proto/types/authz"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
 	"github.com/uptrace/bun"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -18,12 +21,24 @@ type AuthzService interface {
 	ListPolicies(context.Context, *authzpbv1.Policy) (*authzpbv1.Policies, error)
 	CreatePolicies(context.Context, *authzpbv1.Policies) (*authzpbv1.BoolReply, error)
 	DeletePolicies(context.Context, *authzpbv1.Policy) (*authzpbv1.BoolReply, error)
+	// CreateDenyPolicies adds policies which deny the requests they
+	// match even when a policy allows them
+	CreateDenyPolicies(context.Context, *authzpbv1.Policies) (*authzpbv1.BoolReply, error)
+	// DeleteDenyPolicies removes the deny policies matching the filter
+	DeleteDenyPolicies(context.Context, *authzpbv1.Policy) (*authzpbv1.BoolReply, error)
 	ListUserGroups(context.Context, *authzpbv1.UserGroup) (*authzpbv1.UserGroups, error)
 	CreateUserGroups(ctx context.Context, p *authzpbv1.UserGroups) (*authzpbv1.BoolReply, error)
 	DeleteUserGroups(ctx context.Context, p *authzpbv1.UserGroup) (*authzpbv1.BoolReply, error)
 	ListRolePermissionMappings(ctx context.Context, p *authzpbv1.FilteredRolePermissionMapping) (*authzpbv1.RolePermissionMappingList, error)
 	CreateRolePermissionMappings(ctx context.Context, p *authzpbv1.RolePermissionMappingList) (*authzpbv1.BoolReply, error)
 	DeleteRolePermissionMappings(ctx context.Context, p *authzpbv1.FilteredRolePermissionMapping) (*authzpbv1.BoolReply, error)
+	// GrantingRoles returns the roles through which an enforce request is allowed
+	GrantingRoles(ctx context.Context, req *authzpbv1.EnforceRequest) ([]string, error)
+	// MatchPermissions returns the permissions which cover the url and method
+	MatchPermissions(ctx context.Context, url, method string, permissions []string) ([]string, error)
+	// Explain evaluates an enforce request and reports how each
+	// candidate policy was matched
+	Explain(ctx context.Context, req *authzpbv1.EnforceRequest) (*commonv3.ExplainRequestResponse, error)
 }
 
 type authzService struct {
@@ -44,6 +59,7 @@ func NewAuthzService(db *bun.DB, en *casbin.CachedEnforcer) AuthzService {
 const (
 	groupGtype = "g2"
 	roleGtype  = "g"
+	denyPtype  = "p2"
 )
 
 type rpmUrlAction struct {
@@ -235,10 +251,188 @@ func (s *authzService) Enforce(ctx context.Context, req *authzpbv1.EnforceReques
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	if res {
+		// deny policies override the policies allowing the request
+		denied, err := s.enforcer.Enforce(append([]interface{}{enforcer.DenyContext}, params...)...)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		res = !denied
+	}
 
 	return &authzpbv1.BoolReply{Res: res}, nil
 }
 
+func globMatchEither(key1, key2 string) bool {
+	if ok, _ := util.GlobMatch(key1, key2); ok {
+		return true
+	}
+	ok, _ := util.GlobMatch(key2, key1)
+	return ok
+}
+
+func (s *authzService) GrantingRoles(ctx context.Context, req *authzpbv1.EnforceRequest) ([]string, error) {
+	if len(req.Params) != 6 {
+		return nil, status.Error(codes.InvalidArgument, "invalid enforce request")
+	}
+	sub, ns, proj, org, obj, act := req.Params[0], req.Params[1], req.Params[2], req.Params[3], req.Params[4], req.Params[5]
+
+	// same checks as the matcher of the enforcer, evaluated for each
+	// policy of the subject and its groups
+	subjects := []string{sub}
+	for _, ug := range s.enforcer.GetFilteredNamedGroupingPolicy(groupGtype, 0, sub) {
+		subjects = append(subjects, ug[1])
+	}
+	rm := s.enforcer.GetRoleManager()
+	roles := []string{}
+	seen := map[string]bool{}
+	for _, subject := range subjects {
+		for _, p := range s.enforcer.GetFilteredPolicy(0, subject) {
+			if len(p) < 5 || seen[p[4]] {
+				continue
+			}
+			if !globMatchEither(ns, p[1]) || !globMatchEither(proj, p[2]) || !globMatchEither(org, p[3]) {
+				continue
+			}
+			ok, err := rm.HasLink(obj, p[4], act)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				seen[p[4]] = true
+				roles = append(roles, p[4])
+			}
+		}
+	}
+	return roles, nil
+}
+
+// permissionUrl returns the url pattern mapped to the role which
+// matches the url and method, mirroring the g clause of the matcher
+func (s *authzService) permissionUrl(obj, role, act string) string {
+	for _, g := range s.enforcer.GetFilteredNamedGroupingPolicy(roleGtype, 1, role) {
+		if len(g) < 3 || g[2] != act {
+			continue
+		}
+		if enforcer.KeyMatchCu(obj, g[0]) {
+			return g[0]
+		}
+	}
+	return ""
+}
+
+func (s *authzService) Explain(ctx context.Context, req *authzpbv1.EnforceRequest) (*commonv3.ExplainRequestResponse, error) {
+	if len(req.Params) != 6 {
+		return nil, status.Error(codes.InvalidArgument, "invalid enforce request")
+	}
+	sub, ns, proj, org, obj, act := req.Params[0], req.Params[1], req.Params[2], req.Params[3], req.Params[4], req.Params[5]
+	res := &commonv3.ExplainRequestResponse{
+		Params:  req.Params,
+		Subject: sub,
+		Groups:  []string{},
+	}
+
+	subjects := []string{sub}
+	for _, ug := range s.enforcer.GetFilteredNamedGroupingPolicy(groupGtype, 0, sub) {
+		res.Groups = append(res.Groups, ug[1])
+		subjects = append(subjects, ug[1])
+	}
+	for _, subject := range subjects {
+		for _, p := range s.enforcer.GetFilteredPolicy(0, subject) {
+			if pe := s.evaluatePolicy(p, ns, proj, org, obj, act); pe != nil {
+				res.Policies = append(res.Policies, pe)
+			}
+		}
+		for _, p := range s.enforcer.GetFilteredNamedPolicy(denyPtype, 0, subject) {
+			if pe := s.evaluatePolicy(p, ns, proj, org, obj, act); pe != nil {
+				pe.Deny = true
+				res.Policies = append(res.Policies, pe)
+			}
+		}
+	}
+
+	allowed, err := s.Enforce(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	res.Allowed = allowed.Res
+	switch {
+	case len(res.Policies) == 0:
+		res.Reason = "no policies for subject or its groups"
+	case res.Allowed:
+		for _, pe := range res.Policies {
+			if pe.Matched && !pe.Deny {
+				res.Reason = fmt.Sprintf("allowed by role %s through %s", pe.Role, pe.PermissionUrl)
+				break
+			}
+		}
+	default:
+		res.Reason = "no policy matched the request"
+		for _, pe := range res.Policies {
+			if pe.Matched && pe.Deny {
+				res.Reason = fmt.Sprintf("denied by policy %s through %s", pe.Role, pe.PermissionUrl)
+				break
+			}
+		}
+	}
+	return res, nil
+}
+
+// evaluatePolicy checks the policy p against the request, the clauses
+// are checked in the order of the matcher
+func (s *authzService) evaluatePolicy(p []string, ns, proj, org, obj, act string) *commonv3.PolicyEvaluation {
+	if len(p) < 5 {
+		return nil
+	}
+	pe := &commonv3.PolicyEvaluation{
+		Subject:      p[0],
+		Namespace:    p[1],
+		Project:      p[2],
+		Organization: p[3],
+		Role:         p[4],
+	}
+	switch {
+	case !globMatchEither(ns, p[1]):
+		pe.FailedClause = "namespace"
+	case !globMatchEither(proj, p[2]):
+		pe.FailedClause = "project"
+	case !globMatchEither(org, p[3]):
+		pe.FailedClause = "organization"
+	default:
+		pe.PermissionUrl = s.permissionUrl(obj, p[4], act)
+		if pe.PermissionUrl == "" {
+			pe.FailedClause = "permission"
+		} else {
+			pe.Matched = true
+		}
+	}
+	return pe
+}
+
+func (s *authzService) MatchPermissions(ctx context.Context, url, method string, permissions []string) ([]string, error) {
+	if len(s.mappingCache) == 0 {
+		if err := s.cacheResourceRolePermissions(ctx); err != nil {
+			return nil, err
+		}
+	}
+	matched := []string{}
+	for _, permission := range permissions {
+	urls:
+		for _, rpm := range s.mappingCache[permission] {
+			if !enforcer.KeyMatchCu(url, rpm.url) {
+				continue
+			}
+			for _, m := range rpm.methods {
+				if m == method {
+					matched = append(matched, permission)
+					break urls
+				}
+			}
+		}
+	}
+	return matched, nil
+}
+
 func (s *authzService) ListPolicies(ctx context.Context, p *authzpbv1.Policy) (*authzpbv1.Policies, error) {
 	return s.toPolicies(s.enforcer.GetFilteredPolicy(0, p.GetSub(), p.GetNs(), p.GetProj(), p.GetOrg(), p.GetObj())), nil
 }
@@ -271,6 +465,30 @@ func (s *authzService) DeletePolicies(ctx context.Context, p *authzpbv1.Policy)
 	return &authzpbv1.BoolReply{Res: res}, nil
 }
 
+func (s *authzService) CreateDenyPolicies(ctx context.Context, p *authzpbv1.Policies) (*authzpbv1.BoolReply, error) {
+	if len(p.GetPolicies()) == 0 {
+		return &authzpbv1.BoolReply{Res: false}, nil
+	}
+	policies, err := s.fromPolicies(p)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	res, err := s.enforcer.AddNamedPolicies(denyPtype, policies)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &authzpbv1.BoolReply{Res: res}, nil
+}
+
+func (s *authzService) DeleteDenyPolicies(ctx context.Context, p *authzpbv1.Policy) (*authzpbv1.BoolReply, error) {
+	res, err := s.enforcer.RemoveFilteredNamedPolicy(denyPtype, 0, p.GetSub(), p.GetNs(), p.GetProj(), p.GetOrg(), p.GetObj())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &authzpbv1.BoolReply{Res: res}, nil
+}
+
 func (s *authzService) ListUserGroups(ctx context.Context, p *authzpbv1.UserGroup) (*authzpbv1.UserGroups, error) {
 	return s.toUserGroups(s.enforcer.GetFilteredNamedGroupingPolicy(groupGtype, 0, p.GetUser(), p.GetGrp())), nil
 }
@@ -321,6 +539,10 @@ func (s *authzService) CreateRolePermissionMappings(ctx context.Context, p *auth
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	if len(rpms) == 0 {
+		// permissions without urls, eg: kubectl permissions
+		return &authzpbv1.BoolReply{Res: false}, nil
+	}
 
 	res, err := s.enforcer.AddNamedGroupingPolicies(roleGtype, rpms)
 	if err != nil {
@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/casbin/casbin/v2"
+	"github.com/paralus/paralus/pkg/enforcer"
+	authzpbv1 "github.com/paralus/paralus/proto/types/authz"
+)
+
+func getEnforcer(t *testing.T) *casbin.CachedEnforcer {
+	m, err := enforcer.NewModel()
+	if err != nil {
+		t.Fatal("unable to create model:", err)
+	}
+	en, err := casbin.NewCachedEnforcer(m)
+	if err != nil {
+		t.Fatal("unable to create enforcer:", err)
+	}
+	en.AddNamedMatchingFunc("g", "", enforcer.KeyMatchCu)
+
+	en.AddPolicy("u:user@example.com", "*", "project-a", "org", "PROJECT_READ_ONLY")
+	en.AddPolicy("g:admins", "*", "*", "org", "ADMIN")
+	en.AddPolicy("g:other", "*", "*", "other-org", "ADMIN")
+	en.AddNamedGroupingPolicy("g2", "u:user@example.com", "g:admins")
+	en.AddNamedGroupingPolicy("g2", "u:user@example.com", "g:other")
+	en.AddNamedGroupingPolicy("g", "/auth/v3/partner/:partner/organization/:organization/projects", "PROJECT_READ_ONLY", "GET")
+	en.AddNamedGroupingPolicy("g", "/auth/v3/partner/:partner/organization/:organization/users", "ADMIN", "GET")
+	en.AddNamedGroupingPolicy("g", "/auth/v3/partner/:partner/organization/:organization/users", "ADMIN", "POST")
+	return en
+}
+
+func TestExplain(t *testing.T) {
+	tt := []struct {
+		name    string
+		params  []string
+		allowed bool
+		failed  map[string]string
+	}{
+		{"allowed through group", []string{"u:user@example.com", "*", "*", "org", "/auth/v3/partner/p/organization/org/users", "POST"}, true,
+			map[string]string{"u:user@example.com": "permission", "g:other": "organization"}},
+		{"wrong project", []string{"u:user@example.com", "*", "project-b", "org", "/auth/v3/partner/p/organization/org/projects", "GET"}, false,
+			map[string]string{"u:user@example.com": "project", "g:admins": "permission", "g:other": "organization"}},
+		{"unknown user", []string{"u:other@example.com", "*", "*", "org", "/auth/v3/partner/p/organization/org/users", "GET"}, false, nil},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			as := NewAuthzService(nil, getEnforcer(t))
+			res, err := as.Explain(context.Background(), &authzpbv1.EnforceRequest{Params: tc.params})
+			if err != nil {
+				t.Fatal("unable to explain request:", err)
+			}
+			if res.Allowed != tc.allowed {
+				t.Errorf("expected allowed %v; got %v (%s)", tc.allowed, res.Allowed, res.Reason)
+			}
+			if tc.failed == nil && len(res.Policies) != 0 {
+				t.Errorf("expected no candidate policies; got %v", res.Policies)
+			}
+			for _, pe := range res.Policies {
+				if pe.Matched {
+					if pe.Subject != "g:admins" || pe.PermissionUrl == "" {
+						t.Errorf("unexpected matched policy %v", pe)
+					}
+					continue
+				}
+				if pe.FailedClause != tc.failed[pe.Subject] {
+					t.Errorf("expected failed clause '%s' for %s; got '%s'", tc.failed[pe.Subject], pe.Subject, pe.FailedClause)
+				}
+			}
+		})
+	}
+}
+
+func TestExplainGroups(t *testing.T) {
+	as := NewAuthzService(nil, getEnforcer(t))
+	res, err := as.Explain(context.Background(), &authzpbv1.EnforceRequest{
+		Params: []string{"u:user@example.com", "*", "*", "org", "/auth/v3/partner/p/organization/org/users", "GET"},
+	})
+	if err != nil {
+		t.Fatal("unable to explain request:", err)
+	}
+	if len(res.Groups) != 2 || len(res.Policies) != 3 {
+		t.Errorf("expected 2 groups and 3 candidate policies; got %v and %v", res.Groups, res.Policies)
+	}
+	if res.Reason == "" {
+		t.Error("expected reason for decision")
+	}
+}
+
+func TestEnforceDeny(t *testing.T) {
+	as := NewAuthzService(nil, getEnforcer(t))
+	params := []string{"u:user@example.com", "*", "project-a", "org", "/auth/v3/partner/p/organization/org/users", "POST"}
+	res, err := as.Enforce(context.Background(), &authzpbv1.EnforceRequest{Params: params})
+	if err != nil {
+		t.Fatal("unable to enforce request:", err)
+	}
+	if !res.Res {
+		t.Fatal("expected request to be allowed before deny policy")
+	}
+
+	_, err = as.CreateDenyPolicies(context.Background(), &authzpbv1.Policies{Policies: []*authzpbv1.Policy{
+		{Sub: "g:admins", Ns: "*", Proj: "project-a", Org: "org", Obj: "ADMIN"},
+	}})
+	if err != nil {
+		t.Fatal("unable to create deny policies:", err)
+	}
+	res, err = as.Enforce(context.Background(), &authzpbv1.EnforceRequest{Params: params})
+	if err != nil {
+		t.Fatal("unable to enforce request:", err)
+	}
+	if res.Res {
+		t.Error("expected request to be denied by deny policy")
+	}
+	params[2] = "project-b"
+	res, err = as.Enforce(context.Background(), &authzpbv1.EnforceRequest{Params: params})
+	if err != nil {
+		t.Fatal("unable to enforce request:", err)
+	}
+	if !res.Res {
+		t.Error("expected request outside the deny policy project to be allowed")
+	}
+
+	explained, err := as.Explain(context.Background(), &authzpbv1.EnforceRequest{
+		Params: []string{"u:user@example.com", "*", "project-a", "org", "/auth/v3/partner/p/organization/org/users", "POST"},
+	})
+	if err != nil {
+		t.Fatal("unable to explain request:", err)
+	}
+	if explained.Allowed || !strings.HasPrefix(explained.Reason, "denied by policy") {
+		t.Errorf("expected request to be explained as denied; got %v (%s)", explained.Allowed, explained.Reason)
+	}
+
+	if _, err := as.DeleteDenyPolicies(context.Background(), &authzpbv1.Policy{Obj: "ADMIN"}); err != nil {
+		t.Fatal("unable to delete deny policies:", err)
+	}
+	params[2] = "project-a"
+	res, err = as.Enforce(context.Background(), &authzpbv1.EnforceRequest{Params: params})
+	if err != nil {
+		t.Fatal("unable to enforce request:", err)
+	}
+	if !res.Res {
+		t.Error("expected request to be allowed after deleting deny policy")
+	}
+}
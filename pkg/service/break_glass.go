@@ -0,0 +1,544 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/dao"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/paralus/paralus/pkg/utils"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	"github.com/paralus/paralus/proto/types/sentry"
+	systemv3 "github.com/paralus/paralus/proto/types/systempb/v3"
+	userv3 "github.com/paralus/paralus/proto/types/userpb/v3"
+	bun "github.com/uptrace/bun"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	breakGlassKind     = "BreakGlass"
+	breakGlassListKind = "BreakGlassList"
+	// DefaultBreakGlassDuration is the duration of emergency accesses
+	// when the break-glass policy of the organization has none
+	DefaultBreakGlassDuration = time.Hour
+	// MaxBreakGlassDuration is the longest emergency access a
+	// break-glass policy may grant
+	MaxBreakGlassDuration = 24 * time.Hour
+	// organization admins notified of emergency accesses
+	breakGlassAdminRole = "ADMIN"
+)
+
+// BreakGlassService is the interface for emergency access operations
+type BreakGlassService interface {
+	// Create grants the break-glass role of the organization on a
+	// cluster to the user for the duration of the policy
+	Create(context.Context, *userv3.BreakGlass) (*userv3.BreakGlass, error)
+	// get emergency access by name
+	GetByName(context.Context, *userv3.BreakGlass) (*userv3.BreakGlass, error)
+	// list emergency accesses
+	List(context.Context, *userv3.BreakGlassQuery) (*userv3.BreakGlassList, error)
+}
+
+// breakGlassService implements BreakGlassService
+type breakGlassService struct {
+	db     *bun.DB
+	al     *zap.Logger
+	client *http.Client
+}
+
+// NewBreakGlassService return new break-glass service
+func NewBreakGlassService(db *bun.DB, al *zap.Logger) BreakGlassService {
+	return &breakGlassService{db: db, al: al, client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+// BreakGlassDuration returns the duration of the emergency accesses
+// granted by the policy
+func BreakGlassDuration(policy *systemv3.BreakGlassPolicy) time.Duration {
+	if policy.GetDurationMin() <= 0 {
+		return DefaultBreakGlassDuration
+	}
+	return time.Duration(policy.GetDurationMin()) * time.Minute
+}
+
+// ValidateBreakGlassPolicy checks the break-glass policy of an
+// organization before it is saved
+func ValidateBreakGlassPolicy(policy *systemv3.BreakGlassPolicy) error {
+	if !policy.GetEnabled() {
+		return nil
+	}
+	if policy.GetRole() == "" {
+		return fmt.Errorf("no role provided for break-glass access")
+	}
+	if BreakGlassDuration(policy) > MaxBreakGlassDuration {
+		return fmt.Errorf("break-glass access may last at most %v", MaxBreakGlassDuration)
+	}
+	if len(policy.GetEligibleGroups()) == 0 {
+		return fmt.Errorf("no groups provided which are eligible for break-glass access")
+	}
+	if policy.GetWebhookUrl() != "" {
+		u, err := url.Parse(policy.GetWebhookUrl())
+		if err != nil || u.Scheme != "https" || u.Host == "" {
+			return fmt.Errorf("break-glass webhook url should be a https url")
+		}
+	}
+	return nil
+}
+
+// getBreakGlassRole returns the elevated role of a break-glass policy,
+// emergency accesses are to whole clusters so the role must be an
+// organization or project role granting cluster wide kubectl access
+func getBreakGlassRole(ctx context.Context, db bun.IDB, name string) (*models.Role, error) {
+	entity, err := dao.GetByName(ctx, db, name, &models.Role{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to find break-glass role '%v'", name)
+	}
+	role := entity.(*models.Role)
+	if !strings.EqualFold(role.Scope, organizationScope) && !strings.EqualFold(role.Scope, projectScope) {
+		return nil, fmt.Errorf("break-glass role '%v' should be a cluster scoped role", name)
+	}
+	rps, err := dao.GetRolePermissions(ctx, db, role.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, rp := range rps {
+		switch rp.Name {
+		case sentry.KubectlFullAccessPermission, sentry.KubectlClusterReadPermission, sentry.KubectlClusterWritePermission:
+			return role, nil
+		}
+	}
+	return nil, fmt.Errorf("break-glass role '%v' should grant cluster wide kubectl access", name)
+}
+
+// isEligible returns whether the account is a member of one of the
+// groups the break-glass policy allows to break the glass
+func (s *breakGlassService) isEligible(ctx context.Context, sd *commonv3.SessionData, accountId, organizationId uuid.UUID, policy *systemv3.BreakGlassPolicy) (bool, error) {
+	groups, err := dao.GetGroups(ctx, s.db, accountId)
+	if err != nil {
+		return false, err
+	}
+	// groups of sso users are those of their identity provider
+	names := append([]string{}, sd.GetGroups()...)
+	for _, g := range groups {
+		if g.OrganizationId == organizationId {
+			names = append(names, g.Name)
+		}
+	}
+	for _, g := range policy.GetEligibleGroups() {
+		if utils.Contains(names, g) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *breakGlassService) getSession(ctx context.Context, partner, org string) (*commonv3.SessionData, uuid.UUID, uuid.UUID, error) {
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok || sd.GetUsername() == "" {
+		return nil, uuid.Nil, uuid.Nil, fmt.Errorf("unable to get session of break-glass access")
+	}
+	partnerId, organizationId, err := getPartnerOrganization(ctx, s.db, partner, org)
+	if err != nil {
+		return nil, uuid.Nil, uuid.Nil, fmt.Errorf("unable to get partner and org id")
+	}
+	if sd.GetOrganization() != organizationId.String() || sd.GetPartner() != partnerId.String() {
+		return nil, uuid.Nil, uuid.Nil, fmt.Errorf("not authorized to break the glass in organization '%v'", org)
+	}
+	return sd, partnerId, organizationId, nil
+}
+
+func toV3BreakGlass(bg *models.BreakGlass, partner, org string) *userv3.BreakGlass {
+	now := time.Now()
+	return &userv3.BreakGlass{
+		ApiVersion: apiVersion,
+		Kind:       breakGlassKind,
+		Metadata: &commonv3.Metadata{
+			Name:         bg.Name,
+			Description:  bg.Description,
+			Id:           bg.ID.String(),
+			Organization: org,
+			Partner:      partner,
+			ModifiedAt:   timestamppb.New(bg.ModifiedAt),
+			CreatedAt:    timestamppb.New(bg.CreatedAt),
+		},
+		Spec: &userv3.BreakGlassSpec{
+			Cluster:       bg.Cluster,
+			Justification: bg.Justification,
+			Incident:      bg.Incident,
+		},
+		Status: &userv3.BreakGlassStatus{
+			User:      bg.Username,
+			Role:      bg.Role,
+			StartsAt:  timestamppb.New(bg.StartsAt),
+			ExpiresAt: timestamppb.New(bg.ExpiresAt),
+			Active:    !now.Before(bg.StartsAt) && now.Before(bg.ExpiresAt),
+		},
+	}
+}
+
+func (s *breakGlassService) Create(ctx context.Context, req *userv3.BreakGlass) (*userv3.BreakGlass, error) {
+	sd, partnerId, organizationId, err := s.getSession(ctx, req.GetMetadata().GetPartner(), req.GetMetadata().GetOrganization())
+	if err != nil {
+		return nil, err
+	}
+	if sd.GetIsServiceAccount() {
+		return nil, fmt.Errorf("service accounts cannot break the glass")
+	}
+	spec := req.GetSpec()
+	if strings.TrimSpace(spec.GetJustification()) == "" {
+		return nil, fmt.Errorf("justification is required to break the glass")
+	}
+	if strings.TrimSpace(spec.GetIncident()) == "" {
+		return nil, fmt.Errorf("incident reference is required to break the glass")
+	}
+	accountId, err := uuid.Parse(sd.GetAccount())
+	if err != nil {
+		return nil, fmt.Errorf("unable to get account of break-glass access")
+	}
+
+	settings, err := GetOrganizationSettings(ctx, s.db, organizationId)
+	if err != nil {
+		return nil, err
+	}
+	policy := settings.GetBreakGlass()
+	if !policy.GetEnabled() || policy.GetRole() == "" {
+		return nil, fmt.Errorf("break-glass access is not enabled in organization '%v'", req.GetMetadata().GetOrganization())
+	}
+	eligible, err := s.isEligible(ctx, sd, accountId, organizationId, policy)
+	if err != nil {
+		return nil, err
+	}
+	if !eligible {
+		return nil, fmt.Errorf("not eligible to break the glass in organization '%v'", req.GetMetadata().GetOrganization())
+	}
+	role, err := getBreakGlassRole(ctx, s.db, policy.GetRole())
+	if err != nil {
+		return nil, err
+	}
+
+	entity, err := dao.GetByNamePartnerOrg(ctx, s.db, spec.GetCluster(), uuid.NullUUID{UUID: partnerId, Valid: true}, uuid.NullUUID{UUID: organizationId, Valid: true}, &models.Cluster{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to find cluster '%v'", spec.GetCluster())
+	}
+	cluster := entity.(*models.Cluster)
+
+	active, err := dao.GetActiveBreakGlasses(ctx, s.db, organizationId, accountId)
+	if err != nil {
+		return nil, err
+	}
+	for _, bg := range active {
+		if bg.ClusterId == cluster.ID {
+			return nil, fmt.Errorf("break-glass access to cluster '%v' is already granted until %v", cluster.Name, bg.ExpiresAt.Format(time.RFC3339))
+		}
+	}
+
+	now := time.Now()
+	bg := models.BreakGlass{
+		Name:           "breakglass-" + strings.Split(uuid.New().String(), "-")[0],
+		Description:    req.GetMetadata().GetDescription(),
+		CreatedAt:      now,
+		ModifiedAt:     now,
+		Trash:          false,
+		OrganizationId: organizationId,
+		PartnerId:      partnerId,
+		AccountId:      accountId,
+		Username:       sd.GetUsername(),
+		ClusterId:      cluster.ID,
+		Cluster:        cluster.Name,
+		RoleId:         role.ID,
+		Role:           role.Name,
+		Justification:  spec.GetJustification(),
+		Incident:       spec.GetIncident(),
+		StartsAt:       now,
+		ExpiresAt:      now.Add(BreakGlassDuration(policy)),
+	}
+	if _, err := dao.Create(ctx, s.db, &bg); err != nil {
+		return nil, err
+	}
+
+	CreateBreakGlassAuditEvent(ctx, s.al, &bg)
+	if policy.GetWebhookUrl() != "" {
+		// the emergency access does not wait on the notification
+		go s.notify(policy.GetWebhookUrl(), req.GetMetadata().GetOrganization(), bg)
+	}
+	return toV3BreakGlass(&bg, req.GetMetadata().GetPartner(), req.GetMetadata().GetOrganization()), nil
+}
+
+func (s *breakGlassService) GetByName(ctx context.Context, req *userv3.BreakGlass) (*userv3.BreakGlass, error) {
+	_, partnerId, organizationId, err := s.getSession(ctx, req.GetMetadata().GetPartner(), req.GetMetadata().GetOrganization())
+	if err != nil {
+		return &userv3.BreakGlass{}, err
+	}
+	entity, err := dao.GetByNamePartnerOrg(ctx, s.db, req.GetMetadata().GetName(), uuid.NullUUID{UUID: partnerId, Valid: true}, uuid.NullUUID{UUID: organizationId, Valid: true}, &models.BreakGlass{})
+	if err != nil {
+		return &userv3.BreakGlass{}, fmt.Errorf("no break-glass access found with name '%v'", req.GetMetadata().GetName())
+	}
+	return toV3BreakGlass(entity.(*models.BreakGlass), req.GetMetadata().GetPartner(), req.GetMetadata().GetOrganization()), nil
+}
+
+func (s *breakGlassService) List(ctx context.Context, q *userv3.BreakGlassQuery) (*userv3.BreakGlassList, error) {
+	bgList := &userv3.BreakGlassList{
+		ApiVersion: apiVersion,
+		Kind:       breakGlassListKind,
+		Metadata: &commonv3.ListMetadata{
+			Count: 0,
+		},
+	}
+	_, partnerId, organizationId, err := s.getSession(ctx, q.GetPartner(), q.GetOrganization())
+	if err != nil {
+		return bgList, err
+	}
+	bgs, err := dao.ListBreakGlasses(ctx, s.db, partnerId, organizationId, q.GetActive())
+	if err != nil {
+		return bgList, err
+	}
+	for i := range bgs {
+		bgList.Items = append(bgList.Items, toV3BreakGlass(&bgs[i], q.GetPartner(), q.GetOrganization()))
+	}
+	bgList.Metadata = &commonv3.ListMetadata{
+		Count: int64(len(bgs)),
+	}
+	return bgList, nil
+}
+
+// breakGlassNotification is posted to the webhook of the organization
+// when a user breaks the glass
+type breakGlassNotification struct {
+	Event         string    `json:"event"`
+	Name          string    `json:"name"`
+	Organization  string    `json:"organization"`
+	User          string    `json:"user"`
+	Cluster       string    `json:"cluster"`
+	Role          string    `json:"role"`
+	Justification string    `json:"justification"`
+	Incident      string    `json:"incident"`
+	StartsAt      time.Time `json:"startsAt"`
+	ExpiresAt     time.Time `json:"expiresAt"`
+	Admins        []string  `json:"admins"`
+}
+
+// notify notifies the admins of the organization of the emergency
+// access, failures are logged as the access is already granted
+func (s *breakGlassService) notify(webhook, org string, bg models.BreakGlass) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	admins, err := organizationAdmins(ctx, s.db, bg.OrganizationId)
+	if err != nil {
+		_log.Warnw("unable to get organization admins of break-glass access", "breakglass", bg.Name, "error", err)
+	}
+	n := &breakGlassNotification{
+		Event:         "breakglass.create",
+		Name:          bg.Name,
+		Organization:  org,
+		User:          bg.Username,
+		Cluster:       bg.Cluster,
+		Role:          bg.Role,
+		Justification: bg.Justification,
+		Incident:      bg.Incident,
+		StartsAt:      bg.StartsAt,
+		ExpiresAt:     bg.ExpiresAt,
+		Admins:        admins,
+	}
+	if err := postBreakGlassNotification(ctx, s.client, webhook, n); err != nil {
+		_log.Warnw("unable to notify break-glass access", "breakglass", bg.Name, "error", err)
+	}
+}
+
+func postBreakGlassNotification(ctx context.Context, client *http.Client, webhook string, n *breakGlassNotification) error {
+	body, err := json.Marshal(n)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook responded %v", resp.Status)
+	}
+	return nil
+}
+
+// organizationAdmins returns the users holding the admin role of the
+// organization, directly or through their groups
+func organizationAdmins(ctx context.Context, db bun.IDB, organizationId uuid.UUID) ([]string, error) {
+	bindings, err := dao.GetOrganizationRoleBindings(ctx, db, organizationId)
+	if err != nil {
+		return nil, err
+	}
+	members, err := dao.GetOrganizationGroupMembers(ctx, db, organizationId)
+	if err != nil {
+		return nil, err
+	}
+	admins := []string{}
+	for _, rb := range bindings {
+		if rb.Role != breakGlassAdminRole || rb.Project != "" {
+			continue
+		}
+		switch {
+		case strings.HasPrefix(rb.Subject, "u:"):
+			admins = append(admins, strings.TrimPrefix(rb.Subject, "u:"))
+		case strings.HasPrefix(rb.Subject, "g:"):
+			for _, m := range members {
+				if m.GroupName == strings.TrimPrefix(rb.Subject, "g:") {
+					admins = append(admins, m.Username)
+				}
+			}
+		}
+	}
+	admins = utils.Unique(admins)
+	sort.Strings(admins)
+	return admins, nil
+}
+
+// getBreakGlassGrants returns the roles held by the account through its
+// emergency accesses which have not yet expired
+func getBreakGlassGrants(ctx context.Context, db bun.IDB, orgID, accountID uuid.UUID) ([]models.BreakGlassGrant, error) {
+	bgs, err := dao.GetActiveBreakGlasses(ctx, db, orgID, accountID)
+	if err != nil || len(bgs) == 0 {
+		return nil, err
+	}
+	var roleIds []uuid.UUID
+	for _, bg := range bgs {
+		roleIds = append(roleIds, bg.RoleId)
+	}
+	roles, err := dao.GetRoles(ctx, db, roleIds)
+	if err != nil {
+		return nil, err
+	}
+	rps, err := dao.GetRolesPermissions(ctx, db, roleIds)
+	if err != nil {
+		return nil, err
+	}
+
+	var grants []models.BreakGlassGrant
+	for _, bg := range bgs {
+		grant := models.BreakGlassGrant{Name: bg.Name, Incident: bg.Incident, ExpiresAt: bg.ExpiresAt}
+		grant.ClusterId = bg.ClusterId
+		grant.RoleName = bg.Role
+		for _, role := range roles {
+			if role.ID == bg.RoleId {
+				grant.KubernetesRules = role.KubernetesRules
+			}
+		}
+		for _, rp := range rps {
+			if rp.RoleId == bg.RoleId {
+				grant.Permissions = append(grant.Permissions, rp.Permission)
+			}
+		}
+		grants = append(grants, grant)
+	}
+	return grants, nil
+}
+
+// relay audit timestamps are RFC3339 when read from elasticsearch and
+// in the format of time.Time.String when read from the database
+var relayAuditTimeLayouts = []string{time.RFC3339Nano, "2006-01-02 15:04:05.999999999 -0700 MST"}
+
+func parseRelayAuditTime(ts string) (time.Time, bool) {
+	// monotonic clock reading of time.Time.String
+	if i := strings.Index(ts, " m="); i > 0 {
+		ts = ts[:i]
+	}
+	for _, layout := range relayAuditTimeLayouts {
+		if t, err := time.Parse(layout, ts); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// breakGlassAt returns the emergency access of the user to the cluster
+// at t, if any
+func breakGlassAt(bgs []models.BreakGlass, user, cluster string, t time.Time) *models.BreakGlass {
+	for i := range bgs {
+		bg := &bgs[i]
+		if bg.Username == user && bg.Cluster == cluster && !t.Before(bg.StartsAt) && t.Before(bg.ExpiresAt) {
+			return bg
+		}
+	}
+	return nil
+}
+
+// relayAuditRecords returns the records of the hits of a relay audit
+// search result
+func relayAuditRecords(result map[string]interface{}) []map[string]interface{} {
+	var records []map[string]interface{}
+	hits, _ := result["hits"].(map[string]interface{})
+	items, _ := hits["hits"].([]interface{})
+	for _, item := range items {
+		hit, _ := item.(map[string]interface{})
+		source, _ := hit["_source"].(map[string]interface{})
+		if record, ok := source["json"].(map[string]interface{}); ok {
+			records = append(records, record)
+		}
+	}
+	return records
+}
+
+// flagBreakGlassRecords flags the relay audit records made by users
+// during their emergency access to the cluster with the name of the
+// access and its incident. The relay writes the access in the records
+// of sessions authorized by it, older records are matched by the time
+// of the emergency access
+func flagBreakGlassRecords(ctx context.Context, db bun.IDB, result map[string]interface{}) {
+	records := relayAuditRecords(result)
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok || len(records) == 0 {
+		return
+	}
+	organizationId, err := uuid.Parse(sd.GetOrganization())
+	if err != nil {
+		return
+	}
+	var users []string
+	for _, r := range records {
+		if un, ok := r["un"].(string); ok && un != "" {
+			users = append(users, un)
+		}
+	}
+	bgs, err := dao.GetUsersBreakGlasses(ctx, db, organizationId, utils.Unique(users))
+	if err != nil {
+		_log.Warnw("unable to get break-glass accesses of relay audit", "error", err)
+		return
+	}
+	if len(bgs) == 0 {
+		return
+	}
+	for _, r := range records {
+		if name, ok := r["breakGlass"].(string); ok && name != "" {
+			for _, bg := range bgs {
+				if bg.Name == name {
+					r["incident"] = bg.Incident
+				}
+			}
+			continue
+		}
+		un, _ := r["un"].(string)
+		cn, _ := r["cn"].(string)
+		ts, _ := r["ts"].(string)
+		t, ok := parseRelayAuditTime(ts)
+		if !ok {
+			continue
+		}
+		if bg := breakGlassAt(bgs, un, cn, t); bg != nil {
+			r["breakGlass"] = bg.Name
+			r["incident"] = bg.Incident
+		}
+	}
+}
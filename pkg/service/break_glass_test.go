@@ -0,0 +1,250 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/models"
+	v3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	systemv3 "github.com/paralus/paralus/proto/types/systempb/v3"
+	userv3 "github.com/paralus/paralus/proto/types/userpb/v3"
+)
+
+const testBreakGlassSettings = `{"breakGlass":{"enabled":true,"role":"CLUSTER_ADMIN","durationMin":30,"eligibleGroups":["oncall"]}}`
+
+func addAccountGroupsFetchExpectation(mock sqlmock.Sqlmock, accuuid, ouuid string, groups ...string) {
+	rows := sqlmock.NewRows([]string{"id", "name", "organization_id"})
+	for _, g := range groups {
+		rows.AddRow(uuid.NewString(), g, ouuid)
+	}
+	mock.ExpectQuery(`SELECT "group"."id", .* FROM "authsrv_group" AS "group" JOIN authsrv_groupaccount .* WHERE .authsrv_groupaccount.account_id = '` + accuuid + `'.`).
+		WillReturnRows(rows)
+}
+
+func addBreakGlassRoleFetchExpectation(mock sqlmock.Sqlmock, scope string, permissions ...string) {
+	ruuid := uuid.NewString()
+	mock.ExpectQuery(`SELECT "resourcerole"."id", .* FROM "authsrv_resourcerole" AS "resourcerole" WHERE .name = 'CLUSTER_ADMIN'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "scope"}).AddRow(ruuid, "CLUSTER_ADMIN", scope))
+	if scope == "NAMESPACE" {
+		return
+	}
+	rows := sqlmock.NewRows([]string{"name"})
+	for _, p := range permissions {
+		rows.AddRow(p)
+	}
+	mock.ExpectQuery(`SELECT authsrv_resourcepermission.name as name FROM "authsrv_resourcepermission" JOIN authsrv_resourcerolepermission .* WHERE .authsrv_resourcerolepermission.resource_role_id = '` + ruuid + `'.`).
+		WillReturnRows(rows)
+}
+
+func breakGlassRequest(puuid, ouuid, incident string) *userv3.BreakGlass {
+	return &userv3.BreakGlass{
+		Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid},
+		Spec:     &userv3.BreakGlassSpec{Cluster: "prod", Justification: "api server down", Incident: incident},
+	}
+}
+
+func TestCreateBreakGlass(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	bgs := NewBreakGlassService(db, getLogger())
+	accuuid := uuid.NewString()
+	cuuid := uuid.NewString()
+
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+	addOrgSettingsFetchExpectation(mock, ouuid, testBreakGlassSettings)
+	addAccountGroupsFetchExpectation(mock, accuuid, ouuid, "staff", "oncall")
+	addBreakGlassRoleFetchExpectation(mock, "ORGANIZATION", "kubectl.fullaccess")
+	mock.ExpectQuery(`SELECT "cluster"."id", .* FROM "cluster_clusters" AS "cluster" WHERE .* AND .name = 'prod'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(cuuid, "prod"))
+	mock.ExpectQuery(`SELECT "breakglass"."id", .* FROM "authsrv_break_glass" AS "breakglass" WHERE .organization_id = '` + ouuid + `'. AND .account_id = '` + accuuid + `'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery(`INSERT INTO "authsrv_break_glass"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.NewString()))
+
+	ctx := accessRequestContext("oncall@example.com", accuuid, puuid, ouuid)
+	bg, err := bgs.Create(ctx, breakGlassRequest(puuid, ouuid, "INC-42"))
+	if err != nil {
+		t.Fatal("unable to break the glass:", err)
+	}
+	if bg.GetStatus().GetRole() != "CLUSTER_ADMIN" || bg.GetStatus().GetUser() != "oncall@example.com" || !bg.GetStatus().GetActive() {
+		t.Errorf("unexpected break-glass status %v", bg.GetStatus())
+	}
+	duration := bg.GetStatus().GetExpiresAt().AsTime().Sub(bg.GetStatus().GetStartsAt().AsTime())
+	if duration != 30*time.Minute {
+		t.Errorf("expected emergency access of 30m; got %v", duration)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCreateBreakGlassRefused(t *testing.T) {
+	tt := []struct {
+		name     string
+		settings string
+		incident string
+	}{
+		{"policy disabled", `{"breakGlass":{"enabled":false,"role":"CLUSTER_ADMIN"}}`, "INC-42"},
+		{"no policy", `{}`, "INC-42"},
+		{"no incident", testBreakGlassSettings, " "},
+		{"not eligible", testBreakGlassSettings, "INC-42"},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock := getDB(t)
+			defer db.Close()
+
+			bgs := NewBreakGlassService(db, getLogger())
+			puuid, ouuid := addParterOrgFetchExpectation(mock)
+			addOrgSettingsFetchExpectation(mock, ouuid, tc.settings)
+			accuuid := uuid.NewString()
+			addAccountGroupsFetchExpectation(mock, accuuid, ouuid, "staff")
+
+			ctx := accessRequestContext("oncall@example.com", accuuid, puuid, ouuid)
+			if _, err := bgs.Create(ctx, breakGlassRequest(puuid, ouuid, tc.incident)); err == nil {
+				t.Error("expected break-glass access to be refused")
+			}
+		})
+	}
+}
+
+func TestValidateBreakGlassPolicy(t *testing.T) {
+	tt := []struct {
+		name   string
+		policy *systemv3.BreakGlassPolicy
+		valid  bool
+	}{
+		{"disabled", &systemv3.BreakGlassPolicy{}, true},
+		{"default duration", &systemv3.BreakGlassPolicy{Enabled: true, Role: "CLUSTER_ADMIN", EligibleGroups: []string{"oncall"}}, true},
+		{"no role", &systemv3.BreakGlassPolicy{Enabled: true, EligibleGroups: []string{"oncall"}}, false},
+		{"no eligible groups", &systemv3.BreakGlassPolicy{Enabled: true, Role: "CLUSTER_ADMIN"}, false},
+		{"too long", &systemv3.BreakGlassPolicy{Enabled: true, Role: "CLUSTER_ADMIN", EligibleGroups: []string{"oncall"}, DurationMin: 48 * 60}, false},
+		{"webhook", &systemv3.BreakGlassPolicy{Enabled: true, Role: "CLUSTER_ADMIN", EligibleGroups: []string{"oncall"}, WebhookUrl: "https://hooks.example.com/paralus"}, true},
+		{"invalid webhook", &systemv3.BreakGlassPolicy{Enabled: true, Role: "CLUSTER_ADMIN", EligibleGroups: []string{"oncall"}, WebhookUrl: "ftp://hooks.example.com"}, false},
+		{"http webhook", &systemv3.BreakGlassPolicy{Enabled: true, Role: "CLUSTER_ADMIN", EligibleGroups: []string{"oncall"}, WebhookUrl: "http://hooks.example.com/paralus"}, false},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := ValidateBreakGlassPolicy(tc.policy); (err == nil) != tc.valid {
+				t.Errorf("expected valid %v; got %v", tc.valid, err)
+			}
+		})
+	}
+}
+
+func TestGetBreakGlassRole(t *testing.T) {
+	tt := []struct {
+		name        string
+		scope       string
+		permissions []string
+		valid       bool
+	}{
+		{"cluster admin", "ORGANIZATION", []string{"kubectl.fullaccess", "cluster.read"}, true},
+		{"project cluster reader", "project", []string{"kubectl.cluster.read"}, true},
+		{"namespace role", "NAMESPACE", []string{"kubectl.namespace.write"}, false},
+		{"no kubectl access", "ORGANIZATION", []string{"cluster.read", "cluster.write"}, false},
+		{"namespace kubectl access", "PROJECT", []string{"kubectl.namespace.write"}, false},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock := getDB(t)
+			defer db.Close()
+
+			addBreakGlassRoleFetchExpectation(mock, tc.scope, tc.permissions...)
+			if _, err := getBreakGlassRole(context.Background(), db, "CLUSTER_ADMIN"); (err == nil) != tc.valid {
+				t.Errorf("expected valid %v; got %v", tc.valid, err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestPostBreakGlassNotification(t *testing.T) {
+	var received breakGlassNotification
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer srv.Close()
+
+	n := &breakGlassNotification{Name: "breakglass-1", User: "oncall@example.com", Incident: "INC-42", Admins: []string{"admin@example.com"}}
+	if err := postBreakGlassNotification(context.Background(), srv.Client(), srv.URL, n); err != nil {
+		t.Fatal("unable to notify break-glass access:", err)
+	}
+	if received.Incident != "INC-42" || len(received.Admins) != 1 {
+		t.Errorf("unexpected notification %v", received)
+	}
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failing.Close()
+	if err := postBreakGlassNotification(context.Background(), failing.Client(), failing.URL, n); err == nil {
+		t.Error("expected error when webhook fails")
+	}
+}
+
+func TestFlagBreakGlassRecords(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	ouuid := uuid.NewString()
+	start := time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(`SELECT "breakglass"."id", .* FROM "authsrv_break_glass" AS "breakglass" WHERE .organization_id = '` + ouuid + `'. AND .username IN .'oncall@example.com'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "username", "cluster", "incident", "starts_at", "expires_at"}).
+			AddRow(uuid.NewString(), "breakglass-1", "oncall@example.com", "prod", "INC-42", start, start.Add(time.Hour)))
+
+	record := func(cluster string, ts time.Time, layout string) map[string]interface{} {
+		return map[string]interface{}{"_source": map[string]interface{}{"json": map[string]interface{}{
+			"un": "oncall@example.com", "cn": cluster, "ts": ts.Format(layout),
+		}}}
+	}
+	// records of sessions authorized by the access are flagged by the
+	// relay when it writes them
+	written := record("prod", start.Add(2*time.Hour), time.RFC3339Nano)
+	written["_source"].(map[string]interface{})["json"].(map[string]interface{})["breakGlass"] = "breakglass-1"
+	result := map[string]interface{}{"hits": map[string]interface{}{"hits": []interface{}{
+		record("prod", start.Add(time.Minute), time.RFC3339Nano),
+		record("prod", start.Add(time.Minute).Add(time.Second), "2006-01-02 15:04:05.999999999 -0700 MST"),
+		record("dev", start.Add(time.Minute), time.RFC3339Nano),
+		record("prod", start.Add(2*time.Hour), time.RFC3339Nano),
+		written,
+	}}}
+
+	ctx := accessRequestContext("admin@example.com", uuid.NewString(), uuid.NewString(), ouuid)
+	flagBreakGlassRecords(ctx, db, result)
+
+	records := relayAuditRecords(result)
+	for i, flagged := range []bool{true, true, false, false, true} {
+		if (records[i]["breakGlass"] == "breakglass-1") != flagged {
+			t.Errorf("expected record %d flagged %v; got %v", i, flagged, records[i])
+		}
+	}
+	if records[0]["incident"] != "INC-42" || records[4]["incident"] != "INC-42" {
+		t.Errorf("expected incident of records; got %v", records)
+	}
+}
+
+func TestBreakGlassAt(t *testing.T) {
+	start := time.Now()
+	bgs := []models.BreakGlass{{Name: "breakglass-1", Username: "oncall@example.com", Cluster: "prod", StartsAt: start, ExpiresAt: start.Add(time.Hour)}}
+	if bg := breakGlassAt(bgs, "oncall@example.com", "prod", start); bg == nil {
+		t.Error("expected emergency access at its start")
+	}
+	if bg := breakGlassAt(bgs, "oncall@example.com", "prod", start.Add(time.Hour)); bg != nil {
+		t.Error("expected no emergency access at its expiry")
+	}
+	if bg := breakGlassAt(bgs, "other@example.com", "prod", start); bg != nil {
+		t.Error("expected no emergency access of other user")
+	}
+}
@@ -0,0 +1,379 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/dao"
+	"github.com/paralus/paralus/internal/models"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	systemv3 "github.com/paralus/paralus/proto/types/systempb/v3"
+	userv3 "github.com/paralus/paralus/proto/types/userpb/v3"
+	bun "github.com/uptrace/bun"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// claimMatcher is a claim mapping rule ready to be matched, re is set
+// for glob and regex rules
+type claimMatcher struct {
+	rule *systemv3.ClaimMappingRule
+	re   *regexp.Regexp
+}
+
+// globToRegexp converts a glob where * matches any sequence of
+// characters and ? a single character to an anchored regexp
+func globToRegexp(glob string) string {
+	var b strings.Builder
+	b.WriteString("^")
+	for _, r := range glob {
+		switch r {
+		case '*':
+			b.WriteString(".*")
+		case '?':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	b.WriteString("$")
+	return b.String()
+}
+
+// compileClaimMappings validates claim mapping rules and prepares them
+// for matching
+func compileClaimMappings(rules []*systemv3.ClaimMappingRule) ([]*claimMatcher, error) {
+	matchers := make([]*claimMatcher, 0, len(rules))
+	for i, rule := range rules {
+		if strings.TrimSpace(rule.GetClaim()) == "" {
+			return nil, fmt.Errorf("claim mapping rule %d: empty claim", i)
+		}
+		if rule.GetPattern() == "" {
+			return nil, fmt.Errorf("claim mapping rule %d: empty pattern", i)
+		}
+		for _, pnr := range rule.GetProjectNamespaceRoles() {
+			if pnr.GetRole() == "" {
+				return nil, fmt.Errorf("claim mapping rule %d: empty role", i)
+			}
+		}
+		m := &claimMatcher{rule: rule}
+		switch rule.GetMatch() {
+		case systemv3.ClaimMatchType_ClaimMatchExact:
+		case systemv3.ClaimMatchType_ClaimMatchGlob:
+			m.re = regexp.MustCompile(globToRegexp(rule.GetPattern()))
+		case systemv3.ClaimMatchType_ClaimMatchRegex:
+			// patterns match the whole value, submatch indexes are
+			// kept by the non-capturing group
+			re, err := regexp.Compile("^(?:" + rule.GetPattern() + ")$")
+			if err != nil {
+				return nil, fmt.Errorf("claim mapping rule %d: invalid pattern: %v", i, err)
+			}
+			m.re = re
+		default:
+			return nil, fmt.Errorf("claim mapping rule %d: unknown match type %v", i, rule.GetMatch())
+		}
+		matchers = append(matchers, m)
+	}
+	return matchers, nil
+}
+
+// group returns the group value maps to, ok is false when the value
+// does not match the rule
+func (m *claimMatcher) group(value string) (string, bool) {
+	target := m.rule.GetGroup()
+	switch {
+	case m.re == nil:
+		if value != m.rule.GetPattern() {
+			return "", false
+		}
+	case m.rule.GetMatch() == systemv3.ClaimMatchType_ClaimMatchRegex:
+		submatches := m.re.FindStringSubmatchIndex(value)
+		if submatches == nil {
+			return "", false
+		}
+		if target != "" {
+			target = string(m.re.ExpandString(nil, target, value, submatches))
+		}
+	default:
+		if !m.re.MatchString(value) {
+			return "", false
+		}
+	}
+	if target == "" {
+		target = value
+	}
+	return strings.TrimSpace(target), true
+}
+
+// claimValues returns the values of claim as strings, claims of nested
+// objects are referenced with dots, e.g. name.first
+func claimValues(claims map[string]interface{}, claim string) []string {
+	var v interface{} = claims
+	for _, key := range strings.Split(claim, ".") {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		v = m[key]
+	}
+	var values []string
+	switch t := v.(type) {
+	case nil:
+	case string:
+		values = append(values, t)
+	case []string:
+		values = append(values, t...)
+	case []interface{}:
+		for _, e := range t {
+			if s, ok := e.(string); ok {
+				values = append(values, s)
+			} else if e != nil {
+				values = append(values, fmt.Sprint(e))
+			}
+		}
+	default:
+		values = append(values, fmt.Sprint(t))
+	}
+	return values
+}
+
+// claimMappingResult is what a set of claims resolves to
+type claimMappingResult struct {
+	matches []*systemv3.ClaimMappingMatch
+	// groups in order of the first rule mapping to them
+	groups []string
+	// create has the groups a rule allows to create
+	create map[string]bool
+	// roles granted by the rules to each group
+	roles map[string][]*userv3.ProjectNamespaceRole
+}
+
+// resolveClaimMappings evaluates the rules in order against claims
+func resolveClaimMappings(matchers []*claimMatcher, claims map[string]interface{}) *claimMappingResult {
+	res := &claimMappingResult{
+		create: map[string]bool{},
+		roles:  map[string][]*userv3.ProjectNamespaceRole{},
+	}
+	seen := map[string]bool{}
+	for i, m := range matchers {
+		for _, value := range claimValues(claims, m.rule.GetClaim()) {
+			group, ok := m.group(value)
+			if !ok || group == "" {
+				continue
+			}
+			res.matches = append(res.matches, &systemv3.ClaimMappingMatch{
+				Rule:  int32(i),
+				Claim: m.rule.GetClaim(),
+				Value: value,
+				Group: group,
+			})
+			if !seen[group] {
+				seen[group] = true
+				res.groups = append(res.groups, group)
+			}
+			if m.rule.GetCreateGroup() {
+				res.create[group] = true
+			}
+			for _, pnr := range m.rule.GetProjectNamespaceRoles() {
+				res.roles[group] = appendRole(res.roles[group], pnr)
+			}
+		}
+	}
+	return res
+}
+
+func sameRole(a, b *userv3.ProjectNamespaceRole) bool {
+	return a.GetRole() == b.GetRole() && a.GetProject() == b.GetProject() && a.GetNamespace() == b.GetNamespace()
+}
+
+// appendRole appends role to roles unless it is already granted
+func appendRole(roles []*userv3.ProjectNamespaceRole, role *userv3.ProjectNamespaceRole) []*userv3.ProjectNamespaceRole {
+	for _, r := range roles {
+		if sameRole(r, role) {
+			return roles
+		}
+	}
+	return append(roles, &userv3.ProjectNamespaceRole{
+		Project:   role.Project,
+		Namespace: role.Namespace,
+		Role:      role.GetRole(),
+	})
+}
+
+// claimMappingsToJSON returns the rules as stored with the provider
+func claimMappingsToJSON(rules []*systemv3.ClaimMappingRule) (json.RawMessage, error) {
+	if len(rules) == 0 {
+		return nil, nil
+	}
+	items := make([]json.RawMessage, 0, len(rules))
+	for _, rule := range rules {
+		b, err := protojson.Marshal(rule)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, b)
+	}
+	return json.Marshal(items)
+}
+
+// claimMappingsFromJSON returns the rules stored with a provider
+func claimMappingsFromJSON(data json.RawMessage) ([]*systemv3.ClaimMappingRule, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, err
+	}
+	rules := make([]*systemv3.ClaimMappingRule, 0, len(items))
+	for _, item := range items {
+		rule := &systemv3.ClaimMappingRule{}
+		if err := protojson.Unmarshal(item, rule); err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// providerClaimMappings returns the claim mapping rules of a provider,
+// invalid rules are logged and skipped
+func providerClaimMappings(provider *models.OIDCProvider) []*systemv3.ClaimMappingRule {
+	rules, err := claimMappingsFromJSON(provider.ClaimMappings)
+	if err != nil {
+		_log.Warnw("unable to read claim mappings of provider", "provider", provider.Name, "error", err)
+		return nil
+	}
+	return rules
+}
+
+// evaluateClaimMappings resolves claims with the rules of provider and
+// checks which of the resulting groups exist
+func evaluateClaimMappings(ctx context.Context, db bun.IDB, provider *models.OIDCProvider, rules []*systemv3.ClaimMappingRule, claims map[string]interface{}) (*claimMappingResult, *systemv3.ClaimMappingDryRunStatus, error) {
+	matchers, err := compileClaimMappings(rules)
+	if err != nil {
+		return nil, nil, err
+	}
+	res := resolveClaimMappings(matchers, claims)
+	status := &systemv3.ClaimMappingDryRunStatus{Matches: res.matches}
+	partner := uuid.NullUUID{UUID: provider.PartnerId, Valid: true}
+	org := uuid.NullUUID{UUID: provider.OrganizationId, Valid: true}
+	for _, group := range res.groups {
+		exists, _ := dao.GetIdByNamePartnerOrg(ctx, db, group, partner, org, &models.Group{})
+		switch {
+		case exists != nil:
+		case res.create[group]:
+			status.CreatedGroups = append(status.CreatedGroups, group)
+		default:
+			status.IgnoredGroups = append(status.IgnoredGroups, group)
+			continue
+		}
+		status.Groups = append(status.Groups, group)
+		for _, pnr := range res.roles[group] {
+			role := proto.Clone(pnr).(*userv3.ProjectNamespaceRole)
+			role.Group = group
+			status.ProjectNamespaceRoles = append(status.ProjectNamespaceRoles, role)
+		}
+	}
+	return res, status, nil
+}
+
+// syncClaimMappedGroups creates the groups the claims of a user of
+// provider map to and grants them the roles of the rules. It returns
+// the groups the user is to be a member of.
+func (s *userService) syncClaimMappedGroups(ctx context.Context, provider *models.OIDCProvider, rules []*systemv3.ClaimMappingRule, claims map[string]interface{}) (*systemv3.ClaimMappingDryRunStatus, error) {
+	res, status, err := evaluateClaimMappings(ctx, s.db, provider, rules, claims)
+	if err != nil {
+		return nil, err
+	}
+	if len(status.Groups) == 0 {
+		return status, nil
+	}
+
+	org := &models.Organization{}
+	if _, err := dao.GetNameById(ctx, s.db, provider.OrganizationId, org); err != nil {
+		return nil, fmt.Errorf("unable to find organization of provider %s: %v", provider.Name, err)
+	}
+	partner := &models.Partner{}
+	if _, err := dao.GetNameById(ctx, s.db, provider.PartnerId, partner); err != nil {
+		return nil, fmt.Errorf("unable to find partner of provider %s: %v", provider.Name, err)
+	}
+
+	gs := &groupService{db: s.db, azc: s.azc, al: s.al}
+	created := map[string]bool{}
+	for _, group := range status.CreatedGroups {
+		created[group] = true
+	}
+	for _, name := range status.Groups {
+		metadata := &commonv3.Metadata{Name: name, Organization: org.Name, Partner: partner.Name}
+		if created[name] {
+			_, err := gs.Create(ctx, &userv3.Group{
+				Metadata: &commonv3.Metadata{
+					Name:         name,
+					Description:  fmt.Sprintf("Created for claims of users of %s", provider.Name),
+					Organization: org.Name,
+					Partner:      partner.Name,
+				},
+				Spec: &userv3.GroupSpec{ProjectNamespaceRoles: res.roles[name]},
+			})
+			if err != nil {
+				return nil, fmt.Errorf("unable to create group %s: %v", name, err)
+			}
+			continue
+		}
+		if len(res.roles[name]) == 0 {
+			continue
+		}
+
+		// roles granted to the group by others are kept, roles of
+		// the rules are added when missing
+		group, err := gs.GetByName(ctx, &userv3.Group{Metadata: metadata})
+		if err != nil {
+			return nil, fmt.Errorf("unable to get group %s: %v", name, err)
+		}
+		roles := group.GetSpec().GetProjectNamespaceRoles()
+		missing := false
+		for _, pnr := range res.roles[name] {
+			if updated := appendRole(roles, pnr); len(updated) != len(roles) {
+				roles = updated
+				missing = true
+			}
+		}
+		if !missing {
+			continue
+		}
+		group.Spec.ProjectNamespaceRoles = roles
+		if _, err := gs.Update(ctx, group); err != nil {
+			return nil, fmt.Errorf("unable to grant roles to group %s: %v", name, err)
+		}
+	}
+	return status, nil
+}
+
+// claimMappingSyncDetail returns the audit meta of the result of a sync
+func claimMappingSyncDetail(provider string, status *systemv3.ClaimMappingDryRunStatus) map[string]string {
+	roles := []string{}
+	for _, pnr := range status.GetProjectNamespaceRoles() {
+		role := pnr.GetGroup() + "=" + pnr.GetRole()
+		if pnr.GetProject() != "" {
+			role += "@" + pnr.GetProject()
+		}
+		if pnr.GetNamespace() != "" {
+			role += "/" + pnr.GetNamespace()
+		}
+		roles = append(roles, role)
+	}
+	sort.Strings(roles)
+	return map[string]string{
+		"provider":       provider,
+		"groups":         strings.Join(status.GetGroups(), ","),
+		"created_groups": strings.Join(status.GetCreatedGroups(), ","),
+		"ignored_groups": strings.Join(status.GetIgnoredGroups(), ","),
+		"roles":          strings.Join(roles, ","),
+	}
+}
@@ -0,0 +1,210 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/pkg/common"
+	v3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	systemv3 "github.com/paralus/paralus/proto/types/systempb/v3"
+	userv3 "github.com/paralus/paralus/proto/types/userpb/v3"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestCompileClaimMappingsInvalid(t *testing.T) {
+	tt := []struct {
+		name string
+		rule *systemv3.ClaimMappingRule
+	}{
+		{"empty claim", &systemv3.ClaimMappingRule{Pattern: "admins"}},
+		{"empty pattern", &systemv3.ClaimMappingRule{Claim: "idp_groups"}},
+		{"invalid regex", &systemv3.ClaimMappingRule{Claim: "idp_groups", Match: systemv3.ClaimMatchType_ClaimMatchRegex, Pattern: "team-(.*"}},
+		{"empty role", &systemv3.ClaimMappingRule{Claim: "idp_groups", Pattern: "admins", ProjectNamespaceRoles: []*userv3.ProjectNamespaceRole{{Project: "default"}}}},
+	}
+	for _, tc := range tt {
+		if _, err := compileClaimMappings([]*systemv3.ClaimMappingRule{tc.rule}); err == nil {
+			t.Errorf("%s: invalid rule accepted", tc.name)
+		}
+	}
+}
+
+func TestResolveClaimMappings(t *testing.T) {
+	project := "payments"
+	rules := []*systemv3.ClaimMappingRule{
+		{Claim: "idp_groups", Pattern: "admins", Group: "platform-admins"},
+		{Claim: "idp_groups", Match: systemv3.ClaimMatchType_ClaimMatchGlob, Pattern: "dev-*", CreateGroup: true},
+		{
+			Claim: "idp_groups", Match: systemv3.ClaimMatchType_ClaimMatchRegex, Pattern: `team-(?P<team>[a-z]+)-(ro|rw)`, Group: "${team}-$2", CreateGroup: true,
+			ProjectNamespaceRoles: []*userv3.ProjectNamespaceRole{{Project: project, Role: "PROJECT_READ_ONLY"}},
+		},
+		{
+			Claim: "email", Match: systemv3.ClaimMatchType_ClaimMatchGlob, Pattern: "*@example.com", Group: "employees",
+			ProjectNamespaceRoles: []*userv3.ProjectNamespaceRole{{Project: project, Role: "PROJECT_READ_ONLY"}, {Project: project, Role: "PROJECT_READ_ONLY"}},
+		},
+		{Claim: "name.first", Pattern: "Jane", Group: "janes"},
+	}
+	matchers, err := compileClaimMappings(rules)
+	if err != nil {
+		t.Fatal(err)
+	}
+	claims := map[string]interface{}{
+		"email":      "jane@example.com",
+		"name":       map[string]interface{}{"first": "Jane"},
+		"idp_groups": []interface{}{"admins", "dev-frontend", "team-payments-ro", "team-Payments-rw", "other"},
+	}
+	res := resolveClaimMappings(matchers, claims)
+
+	expected := []string{"platform-admins", "dev-frontend", "payments-ro", "employees", "janes"}
+	if !reflect.DeepEqual(res.groups, expected) {
+		t.Errorf("expected groups %v; got %v", expected, res.groups)
+	}
+	if len(res.matches) != len(expected) {
+		t.Errorf("expected %d matches; got %d", len(expected), len(res.matches))
+	}
+	if res.create["platform-admins"] || !res.create["dev-frontend"] || !res.create["payments-ro"] {
+		t.Errorf("unexpected groups to create %v", res.create)
+	}
+	if len(res.roles["employees"]) != 1 || res.roles["employees"][0].GetRole() != "PROJECT_READ_ONLY" {
+		t.Errorf("expected deduplicated role for employees; got %v", res.roles["employees"])
+	}
+	if len(res.roles["platform-admins"]) != 0 {
+		t.Errorf("expected no roles for platform-admins; got %v", res.roles["platform-admins"])
+	}
+}
+
+func TestClaimMappingsJSON(t *testing.T) {
+	rules := []*systemv3.ClaimMappingRule{
+		{Claim: "idp_groups", Match: systemv3.ClaimMatchType_ClaimMatchRegex, Pattern: "team-(.*)", Group: "$1", CreateGroup: true},
+	}
+	data, err := claimMappingsToJSON(rules)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := claimMappingsFromJSON(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0].GetMatch() != systemv3.ClaimMatchType_ClaimMatchRegex || got[0].GetGroup() != "$1" || !got[0].GetCreateGroup() {
+		t.Errorf("rules not stored as expected: %s", data)
+	}
+	if data, _ := claimMappingsToJSON(nil); data != nil {
+		t.Errorf("expected no rules to be stored as null; got %s", data)
+	}
+}
+
+func addClaimMappingProviderExpectation(mock sqlmock.Sqlmock, name, puuid, ouuid, rules string) {
+	mock.ExpectQuery(`SELECT "oidcprovider"."id", .* FROM "authsrv_oidc_provider" AS "oidcprovider" WHERE .*name.* = '` + name + `'`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "partner_id", "organization_id", "claim_mappings"}).
+			AddRow(uuid.NewString(), name, puuid, ouuid, []byte(rules)))
+}
+
+func TestDryRunClaimMappings(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	ops := NewOIDCProviderService(db, "", getLogger())
+	puuid, ouuid := uuid.NewString(), uuid.NewString()
+	gid := uuid.NewString()
+
+	addClaimMappingProviderExpectation(mock, "okta", puuid, ouuid, `[
+		{"claim": "idp_groups", "match": "ClaimMatchGlob", "pattern": "dev-*", "createGroup": true,
+		 "projectNamespaceRoles": [{"project": "payments", "role": "PROJECT_READ_ONLY"}]},
+		{"claim": "idp_groups", "pattern": "admins"},
+		{"claim": "idp_groups", "pattern": "ops"}
+	]`)
+	mock.ExpectQuery(`SELECT "group"."id" FROM "authsrv_group" AS "group" WHERE .*name = 'dev-frontend'`).
+		WillReturnError(fmt.Errorf("no data available"))
+	mock.ExpectQuery(`SELECT "group"."id" FROM "authsrv_group" AS "group" WHERE .*name = 'admins'`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(gid))
+	mock.ExpectQuery(`SELECT "group"."id" FROM "authsrv_group" AS "group" WHERE .*name = 'ops'`).
+		WillReturnError(fmt.Errorf("no data available"))
+
+	claims, _ := structpb.NewStruct(map[string]interface{}{
+		"idp_groups": []interface{}{"dev-frontend", "admins", "ops"},
+	})
+	dr, err := ops.DryRunClaimMappings(context.Background(), &systemv3.ClaimMappingDryRun{
+		Metadata: &v3.Metadata{Name: "okta"},
+		Spec:     &systemv3.ClaimMappingDryRunSpec{Claims: claims},
+	})
+	if err != nil {
+		t.Fatal("unable to dry run claim mappings:", err)
+	}
+	st := dr.GetStatus()
+	if !reflect.DeepEqual(st.GetGroups(), []string{"dev-frontend", "admins"}) {
+		t.Errorf("unexpected groups %v", st.GetGroups())
+	}
+	if !reflect.DeepEqual(st.GetCreatedGroups(), []string{"dev-frontend"}) {
+		t.Errorf("unexpected created groups %v", st.GetCreatedGroups())
+	}
+	if !reflect.DeepEqual(st.GetIgnoredGroups(), []string{"ops"}) {
+		t.Errorf("unexpected ignored groups %v", st.GetIgnoredGroups())
+	}
+	if len(st.GetProjectNamespaceRoles()) != 1 || st.GetProjectNamespaceRoles()[0].GetGroup() != "dev-frontend" {
+		t.Errorf("unexpected roles %v", st.GetProjectNamespaceRoles())
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDryRunClaimMappingsInvalidRules(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	ops := NewOIDCProviderService(db, "", getLogger())
+	addClaimMappingProviderExpectation(mock, "okta", uuid.NewString(), uuid.NewString(), `[]`)
+
+	_, err := ops.DryRunClaimMappings(context.Background(), &systemv3.ClaimMappingDryRun{
+		Metadata: &v3.Metadata{Name: "okta"},
+		Spec: &systemv3.ClaimMappingDryRunSpec{ClaimMappings: []*systemv3.ClaimMappingRule{
+			{Claim: "idp_groups", Match: systemv3.ClaimMatchType_ClaimMatchRegex, Pattern: "("},
+		}},
+	})
+	if err == nil {
+		t.Error("dry run with invalid rules succeeded")
+	}
+}
+
+func TestUpdateIdpUserGroupPolicyClaimMappings(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	mazc := mockAuthzClient{}
+	us := NewUserService(&mockAuthProvider{}, db, &mazc, nil, common.CliConfigDownloadData{}, getLogger(), true)
+	uuuid := uuid.NewString()
+	puuid, ouuid := uuid.NewString(), uuid.NewString()
+	gid := uuid.NewString()
+
+	mock.ExpectQuery(`SELECT ici.identifier FROM identity_credential_identifiers AS ici JOIN identity_credentials AS ic .* WHERE .ic.identity_id = '` + uuuid + `'. AND .ict.name = 'oidc'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"identifier"}).AddRow("okta:00u1abc"))
+	mock.ExpectQuery(`SELECT "oidcprovider"."id", .* FROM "authsrv_oidc_provider" AS "oidcprovider" WHERE .name IN .'okta'.. AND .trash = FALSE. LIMIT 1`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "partner_id", "organization_id", "claim_mappings"}).
+			AddRow(uuid.NewString(), "okta", puuid, ouuid, []byte(`[{"claim": "idp_groups", "match": "ClaimMatchRegex", "pattern": "okta-(.*)", "group": "$1"}]`)))
+	mock.ExpectQuery(`SELECT "group"."id" FROM "authsrv_group" AS "group" WHERE .*name = 'developers'`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(gid))
+	mock.ExpectQuery(`SELECT "organization"."name" FROM "authsrv_organization"`).
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("org"))
+	mock.ExpectQuery(`SELECT "partner"."name" FROM "authsrv_partner"`).
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("partner"))
+	mock.ExpectQuery(`SELECT "group"."id", .* FROM "authsrv_group" AS "group" JOIN authsrv_groupaccount`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}))
+	mock.ExpectQuery(`SELECT "group"."id", .* FROM "authsrv_group" AS "group" WHERE .name = 'developers'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(gid, "developers"))
+	mock.ExpectQuery(`INSERT INTO "authsrv_groupaccount"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.NewString()))
+
+	traits := `{"email": "jane@example.com", "first_name": "Jane", "idp_groups": ["okta-developers", "unmapped"]}`
+	if err := us.UpdateIdpUserGroupPolicy(context.Background(), "INSERT", uuuid, traits); err != nil {
+		t.Fatal("unable to sync idp groups:", err)
+	}
+	if len(mazc.cug) != 1 || len(mazc.cug[0].UserGroups) != 1 || mazc.cug[0].UserGroups[0].Grp != "g:developers" {
+		t.Errorf("expected user to be added to mapped group developers; got %v", mazc.cug)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
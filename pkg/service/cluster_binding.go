@@ -0,0 +1,404 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/dao"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/paralus/paralus/pkg/match"
+	"github.com/paralus/paralus/pkg/query"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	rolev3 "github.com/paralus/paralus/proto/types/rolepb/v3"
+	bun "github.com/uptrace/bun"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	clusterBindingKind     = "ClusterBinding"
+	clusterBindingListKind = "ClusterBindingList"
+)
+
+// ClusterBindingService is the interface for cluster binding operations
+type ClusterBindingService interface {
+	// create cluster binding
+	Create(context.Context, *rolev3.ClusterBinding) (*rolev3.ClusterBinding, error)
+	// get cluster binding by name
+	GetByName(context.Context, *rolev3.ClusterBinding) (*rolev3.ClusterBinding, error)
+	// update cluster binding
+	Update(context.Context, *rolev3.ClusterBinding) (*rolev3.ClusterBinding, error)
+	// delete cluster binding
+	Delete(context.Context, *rolev3.ClusterBinding) (*rolev3.ClusterBinding, error)
+	// list cluster bindings
+	List(context.Context, *rolev3.ClusterBinding) (*rolev3.ClusterBindingList, error)
+}
+
+// clusterBindingService implements ClusterBindingService
+type clusterBindingService struct {
+	db *bun.DB
+	al *zap.Logger
+}
+
+// NewClusterBindingService return new cluster binding service
+func NewClusterBindingService(db *bun.DB, al *zap.Logger) ClusterBindingService {
+	return &clusterBindingService{db: db, al: al}
+}
+
+// ClusterBindingApplies returns whether the cluster binding applies to
+// the user or one of its groups, user is the name of the service
+// account for service accounts
+func ClusterBindingApplies(cb models.ClusterBinding, user string, groups []string, serviceAccount bool) bool {
+	subjects := cb.Users
+	if serviceAccount {
+		subjects = cb.ServiceAccounts
+	}
+	for _, s := range subjects {
+		if strings.EqualFold(s, user) {
+			return true
+		}
+	}
+	for _, g := range cb.Groups {
+		for _, grp := range groups {
+			if g == grp {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// getClusterBindingGrants returns the roles the cluster bindings of the
+// organization applying to the user or its groups grant in the clusters
+// whose labels currently match their selectors
+func getClusterBindingGrants(ctx context.Context, db bun.IDB, orgID, partnerID uuid.UUID, user string, groups []string, serviceAccount bool) ([]models.ClusterBindingGrant, error) {
+	bindings, err := dao.GetClusterBindings(ctx, db, orgID, partnerID)
+	if err != nil {
+		return nil, err
+	}
+	var applying []models.ClusterBinding
+	var roleIds []uuid.UUID
+	for _, cb := range bindings {
+		if ClusterBindingApplies(cb, user, groups, serviceAccount) {
+			applying = append(applying, cb)
+			roleIds = append(roleIds, cb.RoleId)
+		}
+	}
+	if len(applying) == 0 {
+		return nil, nil
+	}
+
+	roles, err := dao.GetRoles(ctx, db, roleIds)
+	if err != nil {
+		return nil, err
+	}
+	rps, err := dao.GetRolesPermissions(ctx, db, roleIds)
+	if err != nil {
+		return nil, err
+	}
+	clusters, err := dao.GetOrganizationClusters(ctx, db, orgID, partnerID)
+	if err != nil {
+		return nil, err
+	}
+	clusterLabels := make([]map[string]string, len(clusters))
+	for i, c := range clusters {
+		if len(c.Labels) > 0 {
+			if err := json.Unmarshal(c.Labels, &clusterLabels[i]); err != nil {
+				_log.Warnw("unable to parse cluster labels", "cluster", c.Name, "error", err)
+			}
+		}
+	}
+
+	var grants []models.ClusterBindingGrant
+	for _, cb := range applying {
+		m, err := match.New(query.WithSelector(cb.ClusterSelector))
+		if err != nil {
+			_log.Warnw("invalid cluster selector", "clusterbinding", cb.Name, "selector", cb.ClusterSelector, "error", err)
+			continue
+		}
+		grant := models.ClusterBindingGrant{}
+		for _, role := range roles {
+			if role.ID == cb.RoleId {
+				grant.RoleName = role.Name
+				grant.KubernetesRules = role.KubernetesRules
+			}
+		}
+		for _, rp := range rps {
+			if rp.RoleId == cb.RoleId {
+				grant.Permissions = append(grant.Permissions, rp.Permission)
+			}
+		}
+		for i, c := range clusters {
+			if m.Match(commonv3.Metadata{Labels: clusterLabels[i]}) {
+				grant.ClusterId = c.ID
+				grants = append(grants, grant)
+			}
+		}
+	}
+	return grants, nil
+}
+
+func (s *clusterBindingService) getPartnerOrganization(ctx context.Context, meta *commonv3.Metadata) (uuid.UUID, uuid.UUID, error) {
+	partnerId, err := dao.GetPartnerId(ctx, s.db, meta.GetPartner())
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	organizationId, err := dao.GetOrganizationId(ctx, s.db, meta.GetOrganization())
+	if err != nil {
+		return partnerId, uuid.Nil, err
+	}
+	return partnerId, organizationId, nil
+}
+
+func (s *clusterBindingService) getClusterBinding(ctx context.Context, meta *commonv3.Metadata) (*models.ClusterBinding, error) {
+	partnerId, organizationId, err := s.getPartnerOrganization(ctx, meta)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get partner and org id")
+	}
+	entity, err := dao.GetByNamePartnerOrg(ctx, s.db, meta.GetName(), uuid.NullUUID{UUID: partnerId, Valid: true}, uuid.NullUUID{UUID: organizationId, Valid: true}, &models.ClusterBinding{})
+	if err != nil {
+		return nil, fmt.Errorf("no cluster binding found with name '%v'", meta.GetName())
+	}
+	if cb, ok := entity.(*models.ClusterBinding); ok {
+		return cb, nil
+	}
+	return nil, fmt.Errorf("no cluster binding found with name '%v'", meta.GetName())
+}
+
+// setClusterBindingSpec validates the spec and sets it on the cluster
+// binding, users are set to their emails as known to paralus
+func (s *clusterBindingService) setClusterBindingSpec(ctx context.Context, cb *models.ClusterBinding, spec *rolev3.ClusterBindingSpec) error {
+	if strings.TrimSpace(spec.GetClusterSelector()) == "" {
+		return fmt.Errorf("cluster binding should have a cluster selector")
+	}
+	if _, err := match.New(query.WithSelector(spec.GetClusterSelector())); err != nil {
+		return fmt.Errorf("invalid cluster selector '%v'; %v", spec.GetClusterSelector(), err)
+	}
+	if len(spec.GetUsers()) == 0 && len(spec.GetGroups()) == 0 && len(spec.GetServiceAccounts()) == 0 {
+		return fmt.Errorf("cluster binding should have at least one user, group or service account")
+	}
+	entity, err := dao.GetByName(ctx, s.db, spec.GetRole(), &models.Role{})
+	if err != nil {
+		return fmt.Errorf("unable to find role '%v'", spec.GetRole())
+	}
+	role, ok := entity.(*models.Role)
+	if !ok {
+		return fmt.Errorf("unable to find role '%v'", spec.GetRole())
+	}
+	if strings.ToLower(role.Scope) != "project" {
+		return fmt.Errorf("only project roles can be bound to clusters")
+	}
+
+	partner := uuid.NullUUID{UUID: cb.PartnerId, Valid: true}
+	org := uuid.NullUUID{UUID: cb.OrganizationId, Valid: true}
+	for _, g := range spec.GetGroups() {
+		if _, err := dao.GetIdByNamePartnerOrg(ctx, s.db, g, partner, org, &models.Group{}); err != nil {
+			return fmt.Errorf("unable to find group '%v'", g)
+		}
+	}
+	for _, sa := range spec.GetServiceAccounts() {
+		if _, err := dao.GetIdByNamePartnerOrg(ctx, s.db, sa, partner, org, &models.ServiceAccount{}); err != nil {
+			return fmt.Errorf("unable to find service account '%v'", sa)
+		}
+	}
+	emails, err := dao.GetUserEmails(ctx, s.db, spec.GetUsers())
+	if err != nil {
+		return err
+	}
+	users := []string{}
+	for _, u := range spec.GetUsers() {
+		email, ok := emails[strings.ToLower(u)]
+		if !ok {
+			return fmt.Errorf("unable to find user '%v'", u)
+		}
+		users = append(users, email)
+	}
+
+	cb.ClusterSelector = spec.GetClusterSelector()
+	cb.RoleId = role.ID
+	cb.Users = users
+	cb.Groups = spec.GetGroups()
+	cb.ServiceAccounts = spec.GetServiceAccounts()
+	return nil
+}
+
+func (s *clusterBindingService) toV3ClusterBinding(ctx context.Context, binding *rolev3.ClusterBinding, cb *models.ClusterBinding) (*rolev3.ClusterBinding, error) {
+	labels := make(map[string]string)
+	labels["organization"] = binding.GetMetadata().GetOrganization()
+	labels["partner"] = binding.GetMetadata().GetPartner()
+
+	role := ""
+	entity, err := dao.GetNameById(ctx, s.db, cb.RoleId, &models.Role{})
+	if err != nil {
+		return nil, err
+	}
+	if r, ok := entity.(*models.Role); ok {
+		role = r.Name
+	}
+
+	binding.ApiVersion = apiVersion
+	binding.Kind = clusterBindingKind
+	binding.Metadata = &commonv3.Metadata{
+		Name:         cb.Name,
+		Description:  cb.Description,
+		Id:           cb.ID.String(),
+		Organization: binding.GetMetadata().GetOrganization(),
+		Partner:      binding.GetMetadata().GetPartner(),
+		Labels:       labels,
+		ModifiedAt:   timestamppb.New(cb.ModifiedAt),
+		CreatedAt:    timestamppb.New(cb.CreatedAt),
+	}
+	binding.Spec = &rolev3.ClusterBindingSpec{
+		ClusterSelector: cb.ClusterSelector,
+		Role:            role,
+		Users:           cb.Users,
+		Groups:          cb.Groups,
+		ServiceAccounts: cb.ServiceAccounts,
+	}
+	return binding, nil
+}
+
+func (s *clusterBindingService) Create(ctx context.Context, binding *rolev3.ClusterBinding) (*rolev3.ClusterBinding, error) {
+	partnerId, organizationId, err := s.getPartnerOrganization(ctx, binding.GetMetadata())
+	if err != nil {
+		return nil, fmt.Errorf("unable to get partner and org id")
+	}
+	e, _ := dao.GetIdByNamePartnerOrg(ctx, s.db, binding.GetMetadata().GetName(), uuid.NullUUID{UUID: partnerId, Valid: true}, uuid.NullUUID{UUID: organizationId, Valid: true}, &models.ClusterBinding{})
+	if e != nil {
+		return nil, fmt.Errorf("cluster binding '%v' already exists", binding.GetMetadata().GetName())
+	}
+
+	cb := models.ClusterBinding{
+		Name:           binding.GetMetadata().GetName(),
+		Description:    binding.GetMetadata().GetDescription(),
+		CreatedAt:      time.Now(),
+		ModifiedAt:     time.Now(),
+		Trash:          false,
+		OrganizationId: organizationId,
+		PartnerId:      partnerId,
+	}
+	if err := s.setClusterBindingSpec(ctx, &cb, binding.GetSpec()); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return &rolev3.ClusterBinding{}, err
+	}
+	if _, err := dao.Create(ctx, tx, &cb); err != nil {
+		tx.Rollback()
+		return &rolev3.ClusterBinding{}, err
+	}
+	if err := tx.Commit(); err != nil {
+		tx.Rollback()
+		_log.Warn("unable to commit changes", err)
+	}
+
+	CreateClusterBindingAuditEvent(ctx, s.al, AuditActionCreate, cb.Name, cb.ClusterSelector, binding.GetSpec().GetRole())
+	return s.toV3ClusterBinding(ctx, binding, &cb)
+}
+
+func (s *clusterBindingService) GetByName(ctx context.Context, binding *rolev3.ClusterBinding) (*rolev3.ClusterBinding, error) {
+	cb, err := s.getClusterBinding(ctx, binding.GetMetadata())
+	if err != nil {
+		return &rolev3.ClusterBinding{}, err
+	}
+	return s.toV3ClusterBinding(ctx, binding, cb)
+}
+
+func (s *clusterBindingService) Update(ctx context.Context, binding *rolev3.ClusterBinding) (*rolev3.ClusterBinding, error) {
+	cb, err := s.getClusterBinding(ctx, binding.GetMetadata())
+	if err != nil {
+		return &rolev3.ClusterBinding{}, err
+	}
+	cb.Description = binding.GetMetadata().GetDescription()
+	cb.ModifiedAt = time.Now()
+	if err := s.setClusterBindingSpec(ctx, cb, binding.GetSpec()); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return &rolev3.ClusterBinding{}, err
+	}
+	if _, err := dao.Update(ctx, tx, cb.ID, cb); err != nil {
+		tx.Rollback()
+		return &rolev3.ClusterBinding{}, err
+	}
+	if err := tx.Commit(); err != nil {
+		tx.Rollback()
+		_log.Warn("unable to commit changes", err)
+	}
+
+	CreateClusterBindingAuditEvent(ctx, s.al, AuditActionUpdate, cb.Name, cb.ClusterSelector, binding.GetSpec().GetRole())
+	return s.toV3ClusterBinding(ctx, binding, cb)
+}
+
+func (s *clusterBindingService) Delete(ctx context.Context, binding *rolev3.ClusterBinding) (*rolev3.ClusterBinding, error) {
+	cb, err := s.getClusterBinding(ctx, binding.GetMetadata())
+	if err != nil {
+		return &rolev3.ClusterBinding{}, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return &rolev3.ClusterBinding{}, err
+	}
+	if err := dao.Delete(ctx, tx, cb.ID, cb); err != nil {
+		tx.Rollback()
+		return &rolev3.ClusterBinding{}, err
+	}
+	if err := tx.Commit(); err != nil {
+		tx.Rollback()
+		_log.Warn("unable to commit changes", err)
+	}
+
+	CreateClusterBindingAuditEvent(ctx, s.al, AuditActionDelete, cb.Name, cb.ClusterSelector, "")
+	return binding, nil
+}
+
+func (s *clusterBindingService) List(ctx context.Context, binding *rolev3.ClusterBinding) (*rolev3.ClusterBindingList, error) {
+	var items []*rolev3.ClusterBinding
+	cbList := &rolev3.ClusterBindingList{
+		ApiVersion: apiVersion,
+		Kind:       clusterBindingListKind,
+		Metadata: &commonv3.ListMetadata{
+			Count: 0,
+		},
+	}
+
+	partnerId, organizationId, err := s.getPartnerOrganization(ctx, binding.GetMetadata())
+	if err != nil {
+		return cbList, err
+	}
+	var cbs []models.ClusterBinding
+	entities, err := dao.List(ctx, s.db, uuid.NullUUID{UUID: partnerId, Valid: true}, uuid.NullUUID{UUID: organizationId, Valid: true}, &cbs)
+	if err != nil {
+		return cbList, err
+	}
+	if cbs, ok := entities.(*[]models.ClusterBinding); ok {
+		for _, cb := range *cbs {
+			entry := &rolev3.ClusterBinding{Metadata: &commonv3.Metadata{
+				Organization: binding.GetMetadata().GetOrganization(),
+				Partner:      binding.GetMetadata().GetPartner(),
+			}}
+			entry, err = s.toV3ClusterBinding(ctx, entry, &cb)
+			if err != nil {
+				return cbList, err
+			}
+			items = append(items, entry)
+		}
+
+		cbList.Metadata = &commonv3.ListMetadata{
+			Count: int64(len(items)),
+		}
+		cbList.Items = items
+	}
+
+	return cbList, nil
+}
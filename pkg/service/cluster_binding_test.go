@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/models"
+	v3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	rolev3 "github.com/paralus/paralus/proto/types/rolepb/v3"
+)
+
+func TestCreateClusterBinding(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	cbs := NewClusterBindingService(db, getLogger())
+
+	cbuuid := uuid.New().String()
+	ruuid := uuid.New().String()
+
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+	mock.ExpectQuery(`SELECT "clusterbinding"."id" FROM "authsrv_cluster_binding" AS "clusterbinding" WHERE .organization_id = '` + ouuid + `'. AND .partner_id = '` + puuid + `'. AND .name = 'clusterbinding-` + cbuuid + `'.`).
+		WillReturnError(fmt.Errorf("no data available"))
+	mock.ExpectQuery(`SELECT "resourcerole"."id", .* FROM "authsrv_resourcerole" AS "resourcerole" WHERE .name = 'PROJECT_READ_ONLY'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "scope"}).AddRow(ruuid, "PROJECT_READ_ONLY", "PROJECT"))
+	mock.ExpectQuery(`SELECT "group"."id" FROM "authsrv_group" AS "group" WHERE .organization_id = '` + ouuid + `'. AND .partner_id = '` + puuid + `'. AND .name = 'sre'.`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.New().String()))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO "authsrv_cluster_binding" .* VALUES .*'env=prod,region=eu', '` + ruuid + `'`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(cbuuid))
+	mock.ExpectCommit()
+	mock.ExpectQuery(`SELECT "resourcerole"."name" FROM "authsrv_resourcerole" AS "resourcerole" WHERE .id = '` + ruuid + `'.`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("PROJECT_READ_ONLY"))
+
+	binding := &rolev3.ClusterBinding{
+		Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Name: "clusterbinding-" + cbuuid},
+		Spec:     &rolev3.ClusterBindingSpec{ClusterSelector: "env=prod,region=eu", Role: "PROJECT_READ_ONLY", Groups: []string{"sre"}},
+	}
+	binding, err := cbs.Create(context.Background(), binding)
+	if err != nil {
+		t.Fatal("could not create cluster binding:", err)
+	}
+	if binding.GetSpec().GetRole() != "PROJECT_READ_ONLY" {
+		t.Errorf("expected role 'PROJECT_READ_ONLY'; got '%v'", binding.GetSpec().GetRole())
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCreateClusterBindingInvalidSelector(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	cbs := NewClusterBindingService(db, getLogger())
+
+	cbuuid := uuid.New().String()
+
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+	mock.ExpectQuery(`SELECT "clusterbinding"."id" FROM "authsrv_cluster_binding" AS "clusterbinding"`).
+		WillReturnError(fmt.Errorf("no data available"))
+
+	binding := &rolev3.ClusterBinding{
+		Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Name: "clusterbinding-" + cbuuid},
+		Spec:     &rolev3.ClusterBindingSpec{ClusterSelector: "env in (prod", Role: "PROJECT_READ_ONLY", Groups: []string{"sre"}},
+	}
+	if _, err := cbs.Create(context.Background(), binding); err == nil {
+		t.Error("expected error for invalid cluster selector")
+	}
+}
+
+func TestGetClusterBindingGrants(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	ouuid := uuid.New()
+	puuid := uuid.New()
+	ruuid := uuid.New().String()
+	prod := uuid.New()
+
+	mock.ExpectQuery(`SELECT .* FROM "authsrv_cluster_binding" AS "clusterbinding" WHERE .organization_id = '` + ouuid.String() + `'. AND .partner_id = '` + puuid.String() + `'. AND .trash = FALSE.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "cluster_selector", "role_id", "users", "groups", "service_accounts"}).
+			AddRow(uuid.NewString(), "prod-readers", "env=prod", ruuid, "{}", "{sre}", "{}").
+			AddRow(uuid.NewString(), "dev-readers", "env=dev", ruuid, "{user@example.com}", "{}", "{}"))
+	mock.ExpectQuery(`SELECT .* FROM "authsrv_resourcerole" AS "resourcerole" WHERE .id IN .'` + ruuid + `'.. AND .trash = FALSE.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(ruuid, "PROJECT_READ_ONLY"))
+	mock.ExpectQuery(`SELECT rrp.resource_role_id AS role_id, rp.name AS permission FROM authsrv_resourcerolepermission AS rrp`).
+		WillReturnRows(sqlmock.NewRows([]string{"role_id", "permission"}).
+			AddRow(ruuid, "kubeconfig.read").
+			AddRow(ruuid, "kubectl.cluster.read"))
+	mock.ExpectQuery(`SELECT "cluster"."id", "cluster"."name", "cluster"."labels" FROM "cluster_clusters" AS "cluster" WHERE .organization_id = '` + ouuid.String() + `'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "labels"}).
+			AddRow(prod.String(), "prod-eu", `{"env": "prod", "region": "eu"}`).
+			AddRow(uuid.NewString(), "dev-eu", `{"env": "dev", "region": "eu"}`))
+
+	grants, err := getClusterBindingGrants(context.Background(), db, ouuid, puuid, "other@example.com", []string{"sre"}, false)
+	if err != nil {
+		t.Fatal("could not get cluster binding grants:", err)
+	}
+	if len(grants) != 1 || grants[0].ClusterId != prod {
+		t.Fatalf("expected a grant on the prod cluster only; got %v", grants)
+	}
+	if grants[0].RoleName != "PROJECT_READ_ONLY" || len(grants[0].Permissions) != 2 {
+		t.Errorf("unexpected grant %v", grants[0])
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestClusterBindingApplies(t *testing.T) {
+	cb := models.ClusterBinding{
+		Users:           []string{"user@example.com"},
+		Groups:          []string{"sre"},
+		ServiceAccounts: []string{"ci"},
+	}
+	if !ClusterBindingApplies(cb, "User@example.com", nil, false) {
+		t.Error("expected cluster binding to apply to user")
+	}
+	if !ClusterBindingApplies(cb, "other@example.com", []string{"sre"}, false) {
+		t.Error("expected cluster binding to apply to group member")
+	}
+	if !ClusterBindingApplies(cb, "ci", nil, true) {
+		t.Error("expected cluster binding to apply to service account")
+	}
+	if ClusterBindingApplies(cb, "user@example.com", nil, true) {
+		t.Error("expected cluster binding to not apply to service account named as a user")
+	}
+}
@@ -0,0 +1,1090 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/constants"
+	"github.com/paralus/paralus/internal/dao"
+	providers "github.com/paralus/paralus/internal/provider/kratos"
+	"github.com/paralus/paralus/pkg/gateway"
+	"github.com/paralus/paralus/pkg/query"
+	authzv1 "github.com/paralus/paralus/proto/types/authz"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	rolev3 "github.com/paralus/paralus/proto/types/rolepb/v3"
+	"github.com/paralus/paralus/proto/types/sentry"
+	systemv3 "github.com/paralus/paralus/proto/types/systempb/v3"
+	userv3 "github.com/paralus/paralus/proto/types/userpb/v3"
+	"github.com/uptrace/bun"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	configBundleKind      = "ConfigBundle"
+	configBundleVersion   = "v1"
+	kubeconfigSettingKind = "KubeconfigSetting"
+	oidcProviderKind      = "OIDCProvider"
+	configSecretMask      = "********"
+
+	configActionCreate = "create"
+	configActionUpdate = "update"
+	configActionDelete = "delete"
+)
+
+// ConfigBundleService is the interface for the export and apply of the
+// configuration of an organization as a multi document yaml bundle of
+// its roles, projects, local users, groups, kubeconfig setting and oidc
+// providers. Role bindings are part of the users and groups, and group
+// memberships part of the groups.
+type ConfigBundleService interface {
+	// export the configuration of the organization
+	Export(context.Context, *systemv3.ConfigBundleExportRequest) ([]byte, error)
+	// apply a bundle to the organization
+	Apply(context.Context, *systemv3.ConfigBundleApplyRequest) (*systemv3.ConfigBundleApplyResponse, error)
+}
+
+type configBundleService struct {
+	db        *bun.DB
+	ap        providers.AuthProvider
+	azc       AuthzService
+	kratosUrl string
+	al        *zap.Logger
+}
+
+// NewConfigBundleService return new config bundle service
+func NewConfigBundleService(db *bun.DB, ap providers.AuthProvider, azc AuthzService, kratosUrl string, al *zap.Logger) ConfigBundleService {
+	return &configBundleService{db: db, ap: ap, azc: azc, kratosUrl: kratosUrl, al: al}
+}
+
+// configBundleHeader is the first document of a bundle
+type configBundleHeader struct {
+	APIVersion string             `json:"apiVersion"`
+	Kind       string             `json:"kind"`
+	Metadata   *commonv3.Metadata `json:"metadata,omitempty"`
+	Spec       configBundleSpec   `json:"spec"`
+}
+
+type configBundleSpec struct {
+	Version    string `json:"version"`
+	ExportedAt string `json:"exportedAt,omitempty"`
+}
+
+// configKubeconfigSetting is the kubeconfig setting of the organization
+// in a bundle
+type configKubeconfigSetting struct {
+	APIVersion string                       `json:"apiVersion"`
+	Kind       string                       `json:"kind"`
+	Metadata   *commonv3.Metadata           `json:"metadata"`
+	Spec       *configKubeconfigSettingSpec `json:"spec"`
+}
+
+type configKubeconfigSettingSpec struct {
+	ValiditySeconds             int64 `json:"validitySeconds,omitempty"`
+	SaValiditySeconds           int64 `json:"saValiditySeconds,omitempty"`
+	EnableSessionCheck          bool  `json:"enableSessionCheck,omitempty"`
+	EnablePrivateRelay          bool  `json:"enablePrivateRelay,omitempty"`
+	EnforceOrgAdminSecretAccess bool  `json:"enforceOrgAdminSecretAccess,omitempty"`
+	DisableWebKubectl           bool  `json:"disableWebKubectl,omitempty"`
+	DisableCLIKubectl           bool  `json:"disableCLIKubectl,omitempty"`
+}
+
+// configOIDCProvider is an oidc provider in a bundle, the requested
+// claims are a plain object rather than a struct
+type configOIDCProvider struct {
+	APIVersion string                  `json:"apiVersion"`
+	Kind       string                  `json:"kind"`
+	Metadata   *commonv3.Metadata      `json:"metadata"`
+	Spec       *configOIDCProviderSpec `json:"spec"`
+}
+
+type configOIDCProviderSpec struct {
+	systemv3.OIDCProviderSpec
+	RequestedClaims map[string]interface{} `json:"requestedClaims,omitempty"`
+}
+
+// configDocument is a document of a bundle
+type configDocument struct {
+	kind string
+	data []byte
+}
+
+// configObject is an object of the organization or of a bundle, doc is
+// the object as written in a bundle and obj the object applied
+type configObject struct {
+	name string
+	doc  interface{}
+	obj  interface{}
+	// protected objects are not pruned
+	protected bool
+}
+
+// configKind is a kind of object of a bundle, kinds are applied in
+// order and pruned in the reverse order
+type configKind struct {
+	kind   string
+	list   func(context.Context) ([]configObject, error)
+	decode func([]byte) (configObject, error)
+	// prepare carries over to the object applied what the bundle
+	// leaves to the organization
+	prepare func(desired *configObject, current configObject)
+	create  func(context.Context, configObject) error
+	update  func(context.Context, configObject) error
+	// kinds without delete are not pruned
+	delete func(context.Context, configObject) error
+	// encode returns what is marshalled for the doc of an object
+	encode func(interface{}) interface{}
+}
+
+// configSession is the organization an export or apply works on with
+// the services bound to the database or the transaction of the apply
+type configSession struct {
+	partner        string
+	organization   string
+	organizationID uuid.UUID
+	partnerID      uuid.UUID
+	username       string
+	rs             *roleService
+	ps             *projectService
+	us             *userService
+	gs             *groupService
+	kss            *kubeconfigSettingService
+	ops            *oidcProvider
+}
+
+func (s *configBundleService) session(ctx context.Context, db bun.IDB, ap providers.AuthProvider, azc AuthzService, al *zap.Logger, partner, organization string) (*configSession, error) {
+	partnerID, organizationID, err := getPartnerOrganization(ctx, db, partner, organization)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find partner '%v' and organization '%v'", partner, organization)
+	}
+	username := ""
+	if sd, ok := GetSessionDataFromContext(ctx); ok {
+		username = sd.Username
+	}
+	return &configSession{
+		partner:        partner,
+		organization:   organization,
+		organizationID: organizationID,
+		partnerID:      partnerID,
+		username:       username,
+		rs:             &roleService{db: db, azc: azc, al: al},
+		// all projects of the organization are listed in dev mode
+		ps:  &projectService{db: db, azc: azc, al: al, dev: true},
+		us:  &userService{ap: ap, db: db, azc: azc, al: al},
+		gs:  &groupService{db: db, azc: azc, al: al},
+		kss: &kubeconfigSettingService{db: db},
+		ops: &oidcProvider{db: db, kratosUrl: s.kratosUrl, al: al},
+	}, nil
+}
+
+func (s *configBundleService) Export(ctx context.Context, req *systemv3.ConfigBundleExportRequest) ([]byte, error) {
+	sess, err := s.session(ctx, s.db, s.ap, s.azc, s.al, req.GetMetadata().GetPartner(), req.GetMetadata().GetOrganization())
+	if err != nil {
+		return nil, err
+	}
+
+	m := gateway.NewParalusYAML()
+	header, err := m.Marshal(&configBundleHeader{
+		APIVersion: apiVersion,
+		Kind:       configBundleKind,
+		Metadata:   &commonv3.Metadata{Partner: sess.partner, Organization: sess.organization},
+		Spec:       configBundleSpec{Version: configBundleVersion, ExportedAt: time.Now().UTC().Format(time.RFC3339)},
+	})
+	if err != nil {
+		return nil, err
+	}
+	docs := [][]byte{header}
+	for _, k := range sess.kinds() {
+		objs, err := k.list(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list %s objects: %w", k.kind, err)
+		}
+		for _, o := range objs {
+			yb, err := k.marshal(o)
+			if err != nil {
+				return nil, err
+			}
+			docs = append(docs, yb)
+		}
+	}
+	return bytes.Join(docs, []byte("---\n")), nil
+}
+
+func (s *configBundleService) Apply(ctx context.Context, req *systemv3.ConfigBundleApplyRequest) (*systemv3.ConfigBundleApplyResponse, error) {
+	docs, err := decodeConfigBundle([]byte(req.GetBundle()))
+	if err != nil {
+		return nil, err
+	}
+	partner, organization := req.GetMetadata().GetPartner(), req.GetMetadata().GetOrganization()
+
+	if req.GetDryRun() {
+		sess, err := s.session(ctx, s.db, s.ap, s.azc, s.al, partner, organization)
+		if err != nil {
+			return nil, err
+		}
+		changes, err := sess.apply(ctx, docs, req.GetPrune(), true)
+		if err != nil {
+			return nil, err
+		}
+		return &systemv3.ConfigBundleApplyResponse{DryRun: true, Changes: changes}, nil
+	}
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	// the changes made outside of the database wait on the transaction,
+	// the services log no audit event of their own
+	azc := &deferredAuthzService{AuthzService: s.azc}
+	ap := &deferredAuthProvider{AuthProvider: s.ap}
+	sess, err := s.session(ctx, tx, ap, azc, zap.NewNop(), partner, organization)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	changes, err := sess.apply(ctx, docs, req.GetPrune(), false)
+	if err != nil {
+		tx.Rollback()
+		ap.rollback(ctx)
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		ap.rollback(ctx)
+		return nil, fmt.Errorf("unable to commit changes: %w", err)
+	}
+	if err := ap.commit(ctx); err != nil {
+		_log.Warnw("unable to update identities of config bundle", "error", err)
+		return nil, err
+	}
+	if err := azc.commit(ctx); err != nil {
+		_log.Warnw("unable to update policies of config bundle", "error", err)
+		return nil, err
+	}
+
+	CreateConfigBundleAuditEvent(ctx, s.al, organization, changes)
+	return &systemv3.ConfigBundleApplyResponse{Changes: changes}, nil
+}
+
+// apply makes the changes the documents call for, only computing them
+// on a dry run
+func (sess *configSession) apply(ctx context.Context, docs []configDocument, prune, dryRun bool) ([]*systemv3.ConfigBundleChange, error) {
+	kinds := sess.kinds()
+	desired := map[string][]configObject{}
+	for _, d := range docs {
+		k, ok := findConfigKind(kinds, d.kind)
+		if !ok {
+			return nil, fmt.Errorf("unknown kind '%v' in config bundle", d.kind)
+		}
+		o, err := k.decode(d.data)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode %s: %w", d.kind, err)
+		}
+		if o.name == "" {
+			return nil, fmt.Errorf("%s without a name in config bundle", d.kind)
+		}
+		for _, e := range desired[d.kind] {
+			if e.name == o.name {
+				return nil, fmt.Errorf("duplicate %s '%v' in config bundle", d.kind, o.name)
+			}
+		}
+		desired[d.kind] = append(desired[d.kind], o)
+	}
+
+	var changes []*systemv3.ConfigBundleChange
+	for _, k := range kinds {
+		current, err := k.list(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list %s objects: %w", k.kind, err)
+		}
+		for _, o := range desired[k.kind] {
+			action, from := configActionCreate, ""
+			for _, c := range current {
+				if c.name != o.name {
+					continue
+				}
+				if k.prepare != nil {
+					k.prepare(&o, c)
+				}
+				action = configActionUpdate
+				yb, err := k.marshal(c)
+				if err != nil {
+					return nil, err
+				}
+				from = string(yb)
+			}
+			yb, err := k.marshal(o)
+			if err != nil {
+				return nil, err
+			}
+			if action == configActionUpdate && from == string(yb) {
+				continue
+			}
+			changes = append(changes, &systemv3.ConfigBundleChange{Kind: k.kind, Name: o.name, Action: action, Diff: diffLines(from, string(yb))})
+			if dryRun {
+				continue
+			}
+			apply := k.create
+			if action == configActionUpdate {
+				apply = k.update
+			}
+			if err := apply(ctx, o); err != nil {
+				return nil, fmt.Errorf("unable to %s %s '%v': %w", action, k.kind, o.name, err)
+			}
+		}
+	}
+	if !prune {
+		return changes, nil
+	}
+
+	for i := len(kinds) - 1; i >= 0; i-- {
+		k := kinds[i]
+		if k.delete == nil {
+			continue
+		}
+		current, err := k.list(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list %s objects: %w", k.kind, err)
+		}
+		for _, c := range current {
+			if c.protected || hasConfigObject(desired[k.kind], c.name) {
+				continue
+			}
+			yb, err := k.marshal(c)
+			if err != nil {
+				return nil, err
+			}
+			changes = append(changes, &systemv3.ConfigBundleChange{Kind: k.kind, Name: c.name, Action: configActionDelete, Diff: diffLines(string(yb), "")})
+			if dryRun {
+				continue
+			}
+			if err := k.delete(ctx, c); err != nil {
+				return nil, fmt.Errorf("unable to delete %s '%v': %w", k.kind, c.name, err)
+			}
+		}
+	}
+	return changes, nil
+}
+
+func (k configKind) marshal(o configObject) ([]byte, error) {
+	doc := o.doc
+	if k.encode != nil {
+		doc = k.encode(doc)
+	}
+	return gateway.NewParalusYAML().Marshal(doc)
+}
+
+func findConfigKind(kinds []configKind, kind string) (configKind, bool) {
+	for _, k := range kinds {
+		if k.kind == kind {
+			return k, true
+		}
+	}
+	return configKind{}, false
+}
+
+func hasConfigObject(objs []configObject, name string) bool {
+	for _, o := range objs {
+		if o.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// decodeConfigBundle splits a bundle into its documents, the first one
+// is the header of the bundle
+func decodeConfigBundle(b []byte) ([]configDocument, error) {
+	m := gateway.NewParalusYAML()
+	var docs []configDocument
+	header := false
+	for _, data := range splitYAMLDocuments(b) {
+		var d struct {
+			APIVersion string `json:"apiVersion"`
+			Kind       string `json:"kind"`
+		}
+		if err := m.Unmarshal(data, &d); err != nil {
+			return nil, fmt.Errorf("invalid config bundle: %w", err)
+		}
+		if !header {
+			if d.Kind != configBundleKind {
+				return nil, fmt.Errorf("config bundle should start with a %s document", configBundleKind)
+			}
+			var h configBundleHeader
+			if err := m.Unmarshal(data, &h); err != nil {
+				return nil, fmt.Errorf("invalid config bundle: %w", err)
+			}
+			if h.Spec.Version != configBundleVersion {
+				return nil, fmt.Errorf("unsupported config bundle version '%v'", h.Spec.Version)
+			}
+			header = true
+			continue
+		}
+		if d.APIVersion != apiVersion {
+			return nil, fmt.Errorf("unsupported apiVersion '%v' of %s in config bundle", d.APIVersion, d.Kind)
+		}
+		docs = append(docs, configDocument{kind: d.Kind, data: data})
+	}
+	if !header {
+		return nil, fmt.Errorf("empty config bundle")
+	}
+	return docs, nil
+}
+
+// splitYAMLDocuments returns the documents of a multi document yaml
+// which are not empty
+func splitYAMLDocuments(b []byte) [][]byte {
+	var docs [][]byte
+	var doc []byte
+	add := func() {
+		if len(bytes.TrimSpace(doc)) != 0 {
+			docs = append(docs, doc)
+		}
+		doc = nil
+	}
+	for _, line := range bytes.SplitAfter(b, []byte("\n")) {
+		if bytes.Equal(bytes.TrimRight(line, " \r\n"), []byte("---")) {
+			add()
+			continue
+		}
+		if bytes.HasPrefix(line, []byte("#")) {
+			continue
+		}
+		doc = append(doc, line...)
+	}
+	add()
+	return docs
+}
+
+// diffLines returns the lines of the two yamls, the lines removed are
+// prefixed with - and the lines added with +
+func diffLines(from, to string) string {
+	a := strings.Split(strings.TrimSuffix(from, "\n"), "\n")
+	b := strings.Split(strings.TrimSuffix(to, "\n"), "\n")
+	if from == "" {
+		a = nil
+	}
+	if to == "" {
+		b = nil
+	}
+	// length of the longest common subsequence of the remaining lines
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+	var sb strings.Builder
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			sb.WriteString("  " + a[i] + "\n")
+			i++
+			j++
+		case j < len(b) && (i == len(a) || lcs[i][j+1] >= lcs[i+1][j]):
+			sb.WriteString("+ " + b[j] + "\n")
+			j++
+		default:
+			sb.WriteString("- " + a[i] + "\n")
+			i++
+		}
+	}
+	return sb.String()
+}
+
+// configMetadata returns the metadata of an object in a bundle
+func configMetadata(md *commonv3.Metadata) *commonv3.Metadata {
+	return &commonv3.Metadata{Name: md.GetName(), Description: md.GetDescription()}
+}
+
+// withOrganization returns the metadata of an object applied to the
+// organization
+func (sess *configSession) withOrganization(md *commonv3.Metadata) *commonv3.Metadata {
+	md = proto.Clone(md).(*commonv3.Metadata)
+	md.Partner = sess.partner
+	md.Organization = sess.organization
+	return md
+}
+
+func (sess *configSession) queryOptions(utype string) query.Option {
+	return func(opts *commonv3.QueryOptions) {
+		opts.Partner = sess.partner
+		opts.Organization = sess.organization
+		opts.Type = utype
+	}
+}
+
+// configBindings returns the role bindings as written in a bundle
+func configBindings(pnrs []*userv3.ProjectNamespaceRole) []*userv3.ProjectNamespaceRole {
+	var bindings []*userv3.ProjectNamespaceRole
+	for _, pnr := range pnrs {
+		b := proto.Clone(pnr).(*userv3.ProjectNamespaceRole)
+		b.Group = ""
+		b.ExpiresIn = ""
+		bindings = append(bindings, b)
+	}
+	sort.SliceStable(bindings, func(i, j int) bool {
+		bi, bj := bindings[i], bindings[j]
+		if bi.Project != bj.Project {
+			return bi.Project < bj.Project
+		}
+		if bi.Namespace != bj.Namespace {
+			return bi.Namespace < bj.Namespace
+		}
+		if bi.NamespaceSelector != bj.NamespaceSelector {
+			return bi.NamespaceSelector < bj.NamespaceSelector
+		}
+		return bi.Role < bj.Role
+	})
+	return bindings
+}
+
+func sortedStrings(s []string) []string {
+	s = append([]string(nil), s...)
+	sort.Strings(s)
+	return s
+}
+
+func (sess *configSession) kinds() []configKind {
+	m := gateway.NewParalusYAML()
+	return []configKind{
+		{
+			kind: roleKind,
+			list: func(ctx context.Context) ([]configObject, error) {
+				rl, err := sess.rs.List(ctx, &rolev3.Role{Metadata: &commonv3.Metadata{Partner: sess.partner, Organization: sess.organization}})
+				if err != nil {
+					return nil, err
+				}
+				var objs []configObject
+				for _, r := range rl.GetItems() {
+					if r.GetSpec().GetBuiltin() {
+						continue
+					}
+					objs = append(objs, configRole(r))
+				}
+				return objs, nil
+			},
+			decode: func(data []byte) (configObject, error) {
+				r := &rolev3.Role{}
+				if err := m.Unmarshal(data, r); err != nil {
+					return configObject{}, err
+				}
+				return configRole(r), nil
+			},
+			create: func(ctx context.Context, o configObject) error {
+				r := proto.Clone(o.obj.(*rolev3.Role)).(*rolev3.Role)
+				r.Metadata = sess.withOrganization(r.Metadata)
+				_, err := sess.rs.Create(ctx, r)
+				return err
+			},
+			update: func(ctx context.Context, o configObject) error {
+				r := proto.Clone(o.obj.(*rolev3.Role)).(*rolev3.Role)
+				r.Metadata = sess.withOrganization(r.Metadata)
+				_, err := sess.rs.Update(ctx, r)
+				return err
+			},
+			delete: func(ctx context.Context, o configObject) error {
+				_, err := sess.rs.Delete(ctx, &rolev3.Role{Metadata: sess.withOrganization(&commonv3.Metadata{Name: o.name})})
+				return err
+			},
+		},
+		{
+			kind: projectKind,
+			list: func(ctx context.Context) ([]configObject, error) {
+				pl, err := sess.ps.List(ctx, &systemv3.Project{Metadata: &commonv3.Metadata{Partner: sess.partner, Organization: sess.organization}})
+				if err != nil {
+					return nil, err
+				}
+				var objs []configObject
+				for _, p := range pl.GetItems() {
+					o := configProject(p)
+					// bindings are kept on update
+					o.obj = p
+					objs = append(objs, o)
+				}
+				return objs, nil
+			},
+			decode: func(data []byte) (configObject, error) {
+				p := &systemv3.Project{}
+				if err := m.Unmarshal(data, p); err != nil {
+					return configObject{}, err
+				}
+				return configProject(p), nil
+			},
+			prepare: func(desired *configObject, current configObject) {
+				p := proto.Clone(desired.obj.(*systemv3.Project)).(*systemv3.Project)
+				p.Spec.ProjectNamespaceRoles = current.obj.(*systemv3.Project).GetSpec().GetProjectNamespaceRoles()
+				p.Spec.UserRoles = current.obj.(*systemv3.Project).GetSpec().GetUserRoles()
+				desired.obj = p
+			},
+			create: func(ctx context.Context, o configObject) error {
+				p := proto.Clone(o.obj.(*systemv3.Project)).(*systemv3.Project)
+				p.Metadata = sess.withOrganization(p.Metadata)
+				_, err := sess.ps.Create(ctx, p)
+				return err
+			},
+			update: func(ctx context.Context, o configObject) error {
+				p := proto.Clone(o.obj.(*systemv3.Project)).(*systemv3.Project)
+				p.Metadata = sess.withOrganization(p.Metadata)
+				_, err := sess.ps.Update(ctx, p)
+				return err
+			},
+			delete: func(ctx context.Context, o configObject) error {
+				_, err := sess.ps.Delete(ctx, &systemv3.Project{Metadata: sess.withOrganization(&commonv3.Metadata{Name: o.name})})
+				return err
+			},
+		},
+		{
+			kind: userKind,
+			list: func(ctx context.Context) ([]configObject, error) {
+				// users of identity providers are managed by the providers
+				ul, err := sess.us.List(ctx, sess.queryOptions(dao.KratosPasswordType))
+				if err != nil {
+					return nil, err
+				}
+				var objs []configObject
+				for _, u := range ul.GetItems() {
+					o := configUser(u)
+					// group memberships are kept on update
+					o.obj = u
+					// the user applying the bundle cannot delete itself
+					o.protected = u.GetMetadata().GetName() == sess.username
+					objs = append(objs, o)
+				}
+				return objs, nil
+			},
+			decode: func(data []byte) (configObject, error) {
+				u := &userv3.User{}
+				if err := m.Unmarshal(data, u); err != nil {
+					return configObject{}, err
+				}
+				o := configUser(u)
+				// the password of a new user
+				o.obj.(*userv3.User).Spec.Password = u.GetSpec().GetPassword()
+				return o, nil
+			},
+			prepare: func(desired *configObject, current configObject) {
+				u := proto.Clone(desired.obj.(*userv3.User)).(*userv3.User)
+				u.Spec.Groups = current.obj.(*userv3.User).GetSpec().GetGroups()
+				desired.obj = u
+			},
+			create: func(ctx context.Context, o configObject) error {
+				u := proto.Clone(o.obj.(*userv3.User)).(*userv3.User)
+				u.Metadata = sess.withOrganization(u.Metadata)
+				_, err := sess.us.Create(ctx, u)
+				return err
+			},
+			update: func(ctx context.Context, o configObject) error {
+				u := proto.Clone(o.obj.(*userv3.User)).(*userv3.User)
+				u.Metadata = sess.withOrganization(u.Metadata)
+				_, err := sess.us.Update(ctx, u)
+				return err
+			},
+			delete: func(ctx context.Context, o configObject) error {
+				_, err := sess.us.Delete(ctx, &userv3.User{Metadata: sess.withOrganization(&commonv3.Metadata{Name: o.name})})
+				return err
+			},
+		},
+		{
+			kind: groupKind,
+			list: func(ctx context.Context) ([]configObject, error) {
+				gl, err := sess.gs.List(ctx, sess.queryOptions(""))
+				if err != nil {
+					return nil, err
+				}
+				var objs []configObject
+				for _, g := range gl.GetItems() {
+					o := configGroup(g)
+					// the default groups of the organization
+					o.protected = strings.HasPrefix(g.GetSpec().GetType(), "DEFAULT_")
+					objs = append(objs, o)
+				}
+				return objs, nil
+			},
+			decode: func(data []byte) (configObject, error) {
+				g := &userv3.Group{}
+				if err := m.Unmarshal(data, g); err != nil {
+					return configObject{}, err
+				}
+				return configGroup(g), nil
+			},
+			create: func(ctx context.Context, o configObject) error {
+				g := proto.Clone(o.obj.(*userv3.Group)).(*userv3.Group)
+				g.Metadata = sess.withOrganization(g.Metadata)
+				_, err := sess.gs.Create(ctx, g)
+				return err
+			},
+			update: func(ctx context.Context, o configObject) error {
+				g := proto.Clone(o.obj.(*userv3.Group)).(*userv3.Group)
+				g.Metadata = sess.withOrganization(g.Metadata)
+				_, err := sess.gs.Update(ctx, g)
+				return err
+			},
+			delete: func(ctx context.Context, o configObject) error {
+				_, err := sess.gs.Delete(ctx, &userv3.Group{Metadata: sess.withOrganization(&commonv3.Metadata{Name: o.name})})
+				return err
+			},
+		},
+		{
+			kind: kubeconfigSettingKind,
+			list: func(ctx context.Context) ([]configObject, error) {
+				ks, err := sess.kss.Get(ctx, sess.organizationID.String(), "", false)
+				if err == constants.ErrNotFound {
+					return nil, nil
+				} else if err != nil {
+					return nil, err
+				}
+				return []configObject{configKubeconfigSettingObject(sess.organization, &configKubeconfigSettingSpec{
+					ValiditySeconds:             ks.ValiditySeconds,
+					SaValiditySeconds:           ks.SaValiditySeconds,
+					EnableSessionCheck:          ks.EnableSessionCheck,
+					EnablePrivateRelay:          ks.EnablePrivateRelay,
+					EnforceOrgAdminSecretAccess: ks.EnforceOrgAdminSecretAccess,
+					DisableWebKubectl:           ks.DisableWebKubectl,
+					DisableCLIKubectl:           ks.DisableCLIKubectl,
+				})}, nil
+			},
+			decode: func(data []byte) (configObject, error) {
+				ks := &configKubeconfigSetting{}
+				if err := m.Unmarshal(data, ks); err != nil {
+					return configObject{}, err
+				}
+				if ks.Spec == nil {
+					ks.Spec = &configKubeconfigSettingSpec{}
+				}
+				// the setting of the organization the bundle is applied to
+				return configKubeconfigSettingObject(sess.organization, ks.Spec), nil
+			},
+			create: sess.patchKubeconfigSetting,
+			update: sess.patchKubeconfigSetting,
+		},
+		{
+			kind: oidcProviderKind,
+			list: func(ctx context.Context) ([]configObject, error) {
+				pl, err := sess.ops.List(ctx)
+				if err != nil {
+					return nil, err
+				}
+				var objs []configObject
+				for _, p := range pl.GetItems() {
+					o := configOIDCProviderObject(p)
+					// the secret is kept on update
+					o.obj = p
+					objs = append(objs, o)
+				}
+				return objs, nil
+			},
+			decode: func(data []byte) (configObject, error) {
+				cp := &configOIDCProvider{}
+				if err := m.Unmarshal(data, cp); err != nil {
+					return configObject{}, err
+				}
+				p := &systemv3.OIDCProvider{ApiVersion: cp.APIVersion, Kind: cp.Kind, Metadata: cp.Metadata}
+				if cp.Spec != nil {
+					p.Spec = &cp.Spec.OIDCProviderSpec
+					claims, err := structpb.NewStruct(cp.Spec.RequestedClaims)
+					if err != nil {
+						return configObject{}, err
+					}
+					p.Spec.RequestedClaims = claims
+				}
+				o := configOIDCProviderObject(p)
+				if p.GetSpec().GetClientSecret() != "" {
+					o.doc.(*systemv3.OIDCProvider).Spec.ClientSecret = configSecretMask
+					o.obj.(*systemv3.OIDCProvider).Spec.ClientSecret = p.GetSpec().GetClientSecret()
+				}
+				return o, nil
+			},
+			prepare: func(desired *configObject, current configObject) {
+				p := proto.Clone(desired.obj.(*systemv3.OIDCProvider)).(*systemv3.OIDCProvider)
+				secret := current.obj.(*systemv3.OIDCProvider).GetSpec().GetClientSecret()
+				if p.Spec.ClientSecret == "" || p.Spec.ClientSecret == secret {
+					p.Spec.ClientSecret = secret
+					desired.doc.(*systemv3.OIDCProvider).Spec.ClientSecret = ""
+				}
+				desired.obj = p
+			},
+			create: func(ctx context.Context, o configObject) error {
+				p := proto.Clone(o.obj.(*systemv3.OIDCProvider)).(*systemv3.OIDCProvider)
+				p.Metadata = sess.withOrganization(p.Metadata)
+				_, err := sess.ops.Create(ctx, p)
+				return err
+			},
+			update: func(ctx context.Context, o configObject) error {
+				p := proto.Clone(o.obj.(*systemv3.OIDCProvider)).(*systemv3.OIDCProvider)
+				p.Metadata = sess.withOrganization(p.Metadata)
+				_, err := sess.ops.Update(ctx, p)
+				return err
+			},
+			delete: func(ctx context.Context, o configObject) error {
+				return sess.ops.Delete(ctx, &systemv3.OIDCProvider{Metadata: sess.withOrganization(&commonv3.Metadata{Name: o.name})})
+			},
+			encode: func(doc interface{}) interface{} {
+				p := doc.(*systemv3.OIDCProvider)
+				cp := &configOIDCProvider{APIVersion: p.ApiVersion, Kind: p.Kind, Metadata: p.Metadata, Spec: &configOIDCProviderSpec{}}
+				proto.Merge(&cp.Spec.OIDCProviderSpec, p.GetSpec())
+				cp.Spec.OIDCProviderSpec.RequestedClaims = nil
+				if p.GetSpec().GetRequestedClaims() != nil {
+					cp.Spec.RequestedClaims = p.GetSpec().GetRequestedClaims().AsMap()
+				}
+				return cp
+			},
+		},
+	}
+}
+
+func (sess *configSession) patchKubeconfigSetting(ctx context.Context, o configObject) error {
+	spec := o.obj.(*configKubeconfigSetting).Spec
+	return sess.kss.Patch(ctx, &sentry.KubeconfigSetting{
+		OrganizationID:              sess.organizationID.String(),
+		PartnerID:                   sess.partnerID.String(),
+		ValiditySeconds:             spec.ValiditySeconds,
+		SaValiditySeconds:           spec.SaValiditySeconds,
+		EnableSessionCheck:          spec.EnableSessionCheck,
+		EnablePrivateRelay:          spec.EnablePrivateRelay,
+		EnforceOrgAdminSecretAccess: spec.EnforceOrgAdminSecretAccess,
+		DisableWebKubectl:           spec.DisableWebKubectl,
+		DisableCLIKubectl:           spec.DisableCLIKubectl,
+	})
+}
+
+func configRole(r *rolev3.Role) configObject {
+	doc := &rolev3.Role{
+		ApiVersion: apiVersion,
+		Kind:       roleKind,
+		Metadata:   configMetadata(r.GetMetadata()),
+		Spec: &rolev3.RoleSpec{
+			Rolepermissions: sortedStrings(r.GetSpec().GetRolepermissions()),
+			IsGlobal:        r.GetSpec().GetIsGlobal(),
+			Scope:           r.GetSpec().GetScope(),
+			RequireMfa:      r.GetSpec().GetRequireMfa(),
+			KubernetesRules: r.GetSpec().GetKubernetesRules(),
+		},
+	}
+	return configObject{name: doc.Metadata.Name, doc: doc, obj: proto.Clone(doc)}
+}
+
+func configProject(p *systemv3.Project) configObject {
+	doc := &systemv3.Project{
+		ApiVersion: apiVersion,
+		Kind:       projectKind,
+		Metadata:   configMetadata(p.GetMetadata()),
+		Spec:       &systemv3.ProjectSpec{Default: p.GetSpec().GetDefault()},
+	}
+	// the default project of the organization
+	return configObject{name: doc.Metadata.Name, doc: doc, obj: proto.Clone(doc), protected: doc.Spec.Default}
+}
+
+func configUser(u *userv3.User) configObject {
+	// the roles of the groups of the user are part of the groups
+	var roles []*userv3.ProjectNamespaceRole
+	for _, pnr := range u.GetSpec().GetProjectNamespaceRoles() {
+		if pnr.GetGroup() == "" {
+			roles = append(roles, pnr)
+		}
+	}
+	doc := &userv3.User{
+		ApiVersion: apiVersion,
+		Kind:       userKind,
+		Metadata:   configMetadata(u.GetMetadata()),
+		Spec: &userv3.UserSpec{
+			FirstName:             u.GetSpec().GetFirstName(),
+			LastName:              u.GetSpec().GetLastName(),
+			Phone:                 u.GetSpec().GetPhone(),
+			ProjectNamespaceRoles: configBindings(roles),
+		},
+	}
+	return configObject{name: doc.Metadata.Name, doc: doc, obj: proto.Clone(doc)}
+}
+
+func configGroup(g *userv3.Group) configObject {
+	doc := &userv3.Group{
+		ApiVersion: apiVersion,
+		Kind:       groupKind,
+		Metadata:   configMetadata(g.GetMetadata()),
+		Spec: &userv3.GroupSpec{
+			Type:                  g.GetSpec().GetType(),
+			Users:                 sortedStrings(g.GetSpec().GetUsers()),
+			ProjectNamespaceRoles: configBindings(g.GetSpec().GetProjectNamespaceRoles()),
+		},
+	}
+	return configObject{name: doc.Metadata.Name, doc: doc, obj: proto.Clone(doc)}
+}
+
+func configKubeconfigSettingObject(organization string, spec *configKubeconfigSettingSpec) configObject {
+	doc := &configKubeconfigSetting{
+		APIVersion: apiVersion,
+		Kind:       kubeconfigSettingKind,
+		Metadata:   &commonv3.Metadata{Name: organization},
+		Spec:       spec,
+	}
+	return configObject{name: organization, doc: doc, obj: doc}
+}
+
+func configOIDCProviderObject(p *systemv3.OIDCProvider) configObject {
+	spec := p.GetSpec()
+	doc := &systemv3.OIDCProvider{
+		ApiVersion: apiVersion,
+		Kind:       oidcProviderKind,
+		Metadata:   configMetadata(p.GetMetadata()),
+		Spec: &systemv3.OIDCProviderSpec{
+			ProviderName:    spec.GetProviderName(),
+			MapperUrl:       spec.GetMapperUrl(),
+			MapperFilename:  spec.GetMapperFilename(),
+			ClientId:        spec.GetClientId(),
+			Scopes:          spec.GetScopes(),
+			IssuerUrl:       spec.GetIssuerUrl(),
+			AuthUrl:         spec.GetAuthUrl(),
+			TokenUrl:        spec.GetTokenUrl(),
+			RequestedClaims: spec.GetRequestedClaims(),
+			Predefined:      spec.GetPredefined(),
+			ClaimMappings:   spec.GetClaimMappings(),
+		},
+	}
+	return configObject{name: doc.Metadata.Name, doc: doc, obj: proto.Clone(doc)}
+}
+
+// deferredAuthzService records the policy changes of an apply, they are
+// made once the transaction of the apply is committed
+type deferredAuthzService struct {
+	AuthzService
+	changes []func(context.Context) error
+}
+
+func (s *deferredAuthzService) record(change func(context.Context) error) (*authzv1.BoolReply, error) {
+	s.changes = append(s.changes, change)
+	return &authzv1.BoolReply{Res: true}, nil
+}
+
+func (s *deferredAuthzService) CreatePolicies(ctx context.Context, p *authzv1.Policies) (*authzv1.BoolReply, error) {
+	return s.record(func(ctx context.Context) error {
+		_, err := s.AuthzService.CreatePolicies(ctx, p)
+		return err
+	})
+}
+
+func (s *deferredAuthzService) DeletePolicies(ctx context.Context, p *authzv1.Policy) (*authzv1.BoolReply, error) {
+	return s.record(func(ctx context.Context) error {
+		_, err := s.AuthzService.DeletePolicies(ctx, p)
+		return err
+	})
+}
+
+func (s *deferredAuthzService) CreateDenyPolicies(ctx context.Context, p *authzv1.Policies) (*authzv1.BoolReply, error) {
+	return s.record(func(ctx context.Context) error {
+		_, err := s.AuthzService.CreateDenyPolicies(ctx, p)
+		return err
+	})
+}
+
+func (s *deferredAuthzService) DeleteDenyPolicies(ctx context.Context, p *authzv1.Policy) (*authzv1.BoolReply, error) {
+	return s.record(func(ctx context.Context) error {
+		_, err := s.AuthzService.DeleteDenyPolicies(ctx, p)
+		return err
+	})
+}
+
+func (s *deferredAuthzService) CreateUserGroups(ctx context.Context, p *authzv1.UserGroups) (*authzv1.BoolReply, error) {
+	return s.record(func(ctx context.Context) error {
+		_, err := s.AuthzService.CreateUserGroups(ctx, p)
+		return err
+	})
+}
+
+func (s *deferredAuthzService) DeleteUserGroups(ctx context.Context, p *authzv1.UserGroup) (*authzv1.BoolReply, error) {
+	return s.record(func(ctx context.Context) error {
+		_, err := s.AuthzService.DeleteUserGroups(ctx, p)
+		return err
+	})
+}
+
+func (s *deferredAuthzService) CreateRolePermissionMappings(ctx context.Context, p *authzv1.RolePermissionMappingList) (*authzv1.BoolReply, error) {
+	return s.record(func(ctx context.Context) error {
+		_, err := s.AuthzService.CreateRolePermissionMappings(ctx, p)
+		return err
+	})
+}
+
+func (s *deferredAuthzService) DeleteRolePermissionMappings(ctx context.Context, p *authzv1.FilteredRolePermissionMapping) (*authzv1.BoolReply, error) {
+	return s.record(func(ctx context.Context) error {
+		_, err := s.AuthzService.DeleteRolePermissionMappings(ctx, p)
+		return err
+	})
+}
+
+// commit makes the recorded policy changes in order
+func (s *deferredAuthzService) commit(ctx context.Context) error {
+	for _, change := range s.changes {
+		if err := change(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deferredAuthProvider creates the identities of an apply right away,
+// they are deleted again if the apply fails, and makes the other
+// identity changes once the transaction of the apply is committed
+type deferredAuthProvider struct {
+	providers.AuthProvider
+	created []string
+	changes []func(context.Context) error
+}
+
+func (p *deferredAuthProvider) Create(ctx context.Context, password string, traits map[string]interface{}, md providers.IdentityPublicMetadata) (string, error) {
+	id, err := p.AuthProvider.Create(ctx, password, traits, md)
+	if err == nil {
+		p.created = append(p.created, id)
+	}
+	return id, err
+}
+
+func (p *deferredAuthProvider) Update(ctx context.Context, id string, traits map[string]interface{}, md providers.IdentityPublicMetadata) error {
+	p.changes = append(p.changes, func(ctx context.Context) error {
+		return p.AuthProvider.Update(ctx, id, traits, md)
+	})
+	return nil
+}
+
+func (p *deferredAuthProvider) Delete(ctx context.Context, id string) error {
+	p.changes = append(p.changes, func(ctx context.Context) error {
+		return p.AuthProvider.Delete(ctx, id)
+	})
+	return nil
+}
+
+// commit makes the recorded identity changes in order
+func (p *deferredAuthProvider) commit(ctx context.Context) error {
+	for _, change := range p.changes {
+		if err := change(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rollback deletes the identities created
+func (p *deferredAuthProvider) rollback(ctx context.Context) {
+	for _, id := range p.created {
+		if err := p.AuthProvider.Delete(ctx, id); err != nil {
+			_log.Warnw("unable to delete identity of failed config bundle", "id", id, "error", err)
+		}
+	}
+}
@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/paralus/paralus/pkg/gateway"
+	authzpbv1 "github.com/paralus/paralus/proto/types/authz"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	systemv3 "github.com/paralus/paralus/proto/types/systempb/v3"
+	userv3 "github.com/paralus/paralus/proto/types/userpb/v3"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const testConfigBundle = `apiVersion: system.k8smgmt.io/v3
+kind: ConfigBundle
+metadata:
+  organization: org
+  partner: partner
+spec:
+  version: v1
+---
+# the admins of the organization
+apiVersion: system.k8smgmt.io/v3
+kind: Group
+metadata:
+  name: admins
+spec:
+  users:
+  - user@example.com
+---
+apiVersion: system.k8smgmt.io/v3
+kind: Project
+metadata:
+  name: project-a
+`
+
+func TestDecodeConfigBundle(t *testing.T) {
+	docs, err := decodeConfigBundle([]byte(testConfigBundle))
+	if err != nil {
+		t.Fatal("unable to decode config bundle:", err)
+	}
+	if len(docs) != 2 || docs[0].kind != groupKind || docs[1].kind != projectKind {
+		t.Fatalf("expected a group and a project; got %v", docs)
+	}
+
+	tt := []struct {
+		name   string
+		bundle string
+	}{
+		{"empty", "---\n"},
+		{"no header", strings.SplitN(testConfigBundle, "---\n", 2)[1]},
+		{"unsupported version", strings.Replace(testConfigBundle, "version: v1", "version: v2", 1)},
+		{"unsupported api version", strings.Replace(testConfigBundle, "apiVersion: system.k8smgmt.io/v3\nkind: Project", "apiVersion: v1\nkind: Project", 1)},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := decodeConfigBundle([]byte(tc.bundle)); err == nil {
+				t.Error("expected error decoding config bundle")
+			}
+		})
+	}
+}
+
+func TestDiffLines(t *testing.T) {
+	diff := diffLines("a\nb\nc\n", "a\nc\nd\n")
+	if diff != "  a\n- b\n  c\n+ d\n" {
+		t.Errorf("unexpected diff %q", diff)
+	}
+	if diff := diffLines("", "a\n"); diff != "+ a\n" {
+		t.Errorf("unexpected diff of created object %q", diff)
+	}
+	if diff := diffLines("a\n", ""); diff != "- a\n" {
+		t.Errorf("unexpected diff of deleted object %q", diff)
+	}
+}
+
+func TestConfigGroupRoundTrip(t *testing.T) {
+	g := &userv3.Group{
+		Metadata: &commonv3.Metadata{Name: "admins", Partner: "partner", Organization: "org", Id: "id"},
+		Spec: &userv3.GroupSpec{
+			Users: []string{"b@example.com", "a@example.com"},
+			ProjectNamespaceRoles: []*userv3.ProjectNamespaceRole{
+				{Project: "project-b", Role: "PROJECT_ADMIN", Group: "admins"},
+				{Project: "project-a", Role: "PROJECT_ADMIN", Group: "admins"},
+			},
+		},
+	}
+	o := configGroup(g)
+	k := configKind{kind: groupKind}
+	yb, err := k.marshal(o)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(yb), "partner") || strings.Contains(string(yb), "group:") {
+		t.Errorf("expected organization details and binding groups to be left out; got %s", yb)
+	}
+	if strings.Index(string(yb), "a@example.com") > strings.Index(string(yb), "b@example.com") ||
+		strings.Index(string(yb), "project-a") > strings.Index(string(yb), "project-b") {
+		t.Errorf("expected users and bindings to be sorted; got %s", yb)
+	}
+
+	decoded := &userv3.Group{}
+	if err := gateway.NewParalusYAML().Unmarshal(yb, decoded); err != nil {
+		t.Fatal(err)
+	}
+	again, err := k.marshal(configGroup(decoded))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(again) != string(yb) {
+		t.Errorf("expected round trip to keep the group; got %s and %s", yb, again)
+	}
+}
+
+func TestConfigOIDCProviderEncode(t *testing.T) {
+	claims, _ := structpb.NewStruct(map[string]interface{}{"email": map[string]interface{}{"essential": true}})
+	o := configOIDCProviderObject(&systemv3.OIDCProvider{
+		Metadata: &commonv3.Metadata{Name: "github"},
+		Spec: &systemv3.OIDCProviderSpec{
+			ProviderName:    "github",
+			ClientId:        "client",
+			ClientSecret:    "secret",
+			Scopes:          []string{"email"},
+			IssuerUrl:       "https://github.com",
+			CallbackUrl:     "https://paralus/callback",
+			RequestedClaims: claims,
+		},
+	})
+	for _, k := range (&configSession{}).kinds() {
+		if k.kind != oidcProviderKind {
+			continue
+		}
+		yb, err := k.marshal(o)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Contains(string(yb), "secret") || strings.Contains(string(yb), "callback") {
+			t.Errorf("expected secret and callback url to be left out; got %s", yb)
+		}
+		if !strings.Contains(string(yb), "essential: true") {
+			t.Errorf("expected requested claims; got %s", yb)
+		}
+		decoded, err := k.decode(yb)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if decoded.obj.(*systemv3.OIDCProvider).GetSpec().GetRequestedClaims().AsMap()["email"] == nil {
+			t.Error("expected requested claims to be decoded")
+		}
+	}
+}
+
+func TestDeferredAuthzService(t *testing.T) {
+	as := NewAuthzService(nil, getEnforcer(t))
+	azc := &deferredAuthzService{AuthzService: as}
+	ctx := context.Background()
+
+	ug := &authzpbv1.UserGroups{UserGroups: []*authzpbv1.UserGroup{{User: "u:other@example.com", Grp: "g:admins"}}}
+	res, err := azc.CreateUserGroups(ctx, ug)
+	if err != nil || !res.Res {
+		t.Fatal("expected user groups to be recorded:", err)
+	}
+	groups, err := azc.ListUserGroups(ctx, &authzpbv1.UserGroup{User: "u:other@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(groups.UserGroups) != 0 {
+		t.Errorf("expected no user groups before commit; got %v", groups.UserGroups)
+	}
+
+	if err := azc.commit(ctx); err != nil {
+		t.Fatal("unable to commit policies:", err)
+	}
+	groups, err = as.ListUserGroups(ctx, &authzpbv1.UserGroup{User: "u:other@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(groups.UserGroups) != 1 {
+		t.Errorf("expected user group after commit; got %v", groups.UserGroups)
+	}
+}
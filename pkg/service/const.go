@@ -6,12 +6,13 @@ const (
 )
 
 const (
-	namespaceScope = "namespace"
-	projectScope   = "project"
-	namespaceR     = "kubectl.namespace.read"
-	namespaceW     = "kubectl.namespace.write"
-	partnerR       = "partner.read"
-	organizationR  = "organization.read"
-	opsAll         = "ops_star.all"
-	cliConfigR     = "cli.config.read"
+	namespaceScope    = "namespace"
+	organizationScope = "organization"
+	projectScope      = "project"
+	namespaceR        = "kubectl.namespace.read"
+	namespaceW        = "kubectl.namespace.write"
+	partnerR          = "partner.read"
+	organizationR     = "organization.read"
+	opsAll            = "ops_star.all"
+	cliConfigR        = "cli.config.read"
 )
@@ -0,0 +1,419 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/dao"
+	"github.com/paralus/paralus/internal/models"
+	authzv1 "github.com/paralus/paralus/proto/types/authz"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	rolev3 "github.com/paralus/paralus/proto/types/rolepb/v3"
+	bun "github.com/uptrace/bun"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	denyPolicyKind     = "DenyPolicy"
+	denyPolicyListKind = "DenyPolicyList"
+)
+
+// DenyPolicyService is the interface for deny policy operations
+type DenyPolicyService interface {
+	// create deny policy
+	Create(context.Context, *rolev3.DenyPolicy) (*rolev3.DenyPolicy, error)
+	// get deny policy by name
+	GetByName(context.Context, *rolev3.DenyPolicy) (*rolev3.DenyPolicy, error)
+	// update deny policy
+	Update(context.Context, *rolev3.DenyPolicy) (*rolev3.DenyPolicy, error)
+	// delete deny policy
+	Delete(context.Context, *rolev3.DenyPolicy) (*rolev3.DenyPolicy, error)
+	// list deny policies
+	List(context.Context, *rolev3.DenyPolicy) (*rolev3.DenyPolicyList, error)
+}
+
+// denyPolicyService implements DenyPolicyService
+type denyPolicyService struct {
+	db  *bun.DB
+	azc AuthzService
+	al  *zap.Logger
+}
+
+// NewDenyPolicyService return new deny policy service
+func NewDenyPolicyService(db *bun.DB, azc AuthzService, al *zap.Logger) DenyPolicyService {
+	return &denyPolicyService{db: db, azc: azc, al: al}
+}
+
+// denyPolicyRole is the object of the casbin deny policies of a deny
+// policy, the urls of its permissions are mapped to it
+func denyPolicyRole(org, name string) string {
+	return "deny:" + org + ":" + name
+}
+
+// DenyPolicyApplies returns whether the deny policy applies to the user
+// or one of its groups
+func DenyPolicyApplies(dp models.DenyPolicy, user string, groups []string) bool {
+	for _, u := range dp.Users {
+		if strings.EqualFold(u, user) {
+			return true
+		}
+	}
+	for _, g := range dp.Groups {
+		for _, grp := range groups {
+			if g == grp {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// DeniedPermissions returns the permissions the deny policies applying
+// to the user or its groups deny in the whole of the project and
+// namespace, uuid.Nil and "" being the organization and all namespaces
+func DeniedPermissions(policies []models.DenyPolicy, user string, groups []string, project uuid.UUID, namespace string) map[string]bool {
+	denied := map[string]bool{}
+	for _, dp := range policies {
+		if !DenyPolicyApplies(dp, user, groups) {
+			continue
+		}
+		if dp.ProjectId != uuid.Nil && dp.ProjectId != project {
+			continue
+		}
+		if dp.Namespace != "" && dp.Namespace != namespace {
+			continue
+		}
+		for _, p := range dp.Permissions {
+			denied[p] = true
+		}
+	}
+	return denied
+}
+
+func (s *denyPolicyService) getPartnerOrganization(ctx context.Context, meta *commonv3.Metadata) (uuid.UUID, uuid.UUID, error) {
+	partnerId, err := dao.GetPartnerId(ctx, s.db, meta.GetPartner())
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	organizationId, err := dao.GetOrganizationId(ctx, s.db, meta.GetOrganization())
+	if err != nil {
+		return partnerId, uuid.Nil, err
+	}
+	return partnerId, organizationId, nil
+}
+
+func (s *denyPolicyService) getDenyPolicy(ctx context.Context, meta *commonv3.Metadata) (*models.DenyPolicy, error) {
+	partnerId, organizationId, err := s.getPartnerOrganization(ctx, meta)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get partner and org id")
+	}
+	entity, err := dao.GetByNamePartnerOrg(ctx, s.db, meta.GetName(), uuid.NullUUID{UUID: partnerId, Valid: true}, uuid.NullUUID{UUID: organizationId, Valid: true}, &models.DenyPolicy{})
+	if err != nil {
+		return nil, fmt.Errorf("no deny policy found with name '%v'", meta.GetName())
+	}
+	if dp, ok := entity.(*models.DenyPolicy); ok {
+		return dp, nil
+	}
+	return nil, fmt.Errorf("no deny policy found with name '%v'", meta.GetName())
+}
+
+// setDenyPolicySpec validates the spec and sets it on the deny policy,
+// users are set to their emails as known to paralus
+func (s *denyPolicyService) setDenyPolicySpec(ctx context.Context, dp *models.DenyPolicy, spec *rolev3.DenyPolicySpec) error {
+	if len(spec.GetPermissions()) == 0 {
+		return fmt.Errorf("deny policy should have at least one permission")
+	}
+	if len(spec.GetUsers()) == 0 && len(spec.GetGroups()) == 0 {
+		return fmt.Errorf("deny policy should have at least one user or group")
+	}
+	if spec.GetNamespace() != "" && spec.GetProject() == "" {
+		return fmt.Errorf("project is required for namespace '%v'", spec.GetNamespace())
+	}
+	for _, p := range spec.GetPermissions() {
+		if _, err := dao.GetIdByName(ctx, s.db, p, &models.ResourcePermission{}); err != nil {
+			return fmt.Errorf("unable to find role permission '%v'", p)
+		}
+	}
+
+	partner := uuid.NullUUID{UUID: dp.PartnerId, Valid: true}
+	org := uuid.NullUUID{UUID: dp.OrganizationId, Valid: true}
+	dp.ProjectId = uuid.Nil
+	if spec.GetProject() != "" {
+		entity, err := dao.GetIdByNamePartnerOrg(ctx, s.db, spec.GetProject(), partner, org, &models.Project{})
+		if err != nil {
+			return fmt.Errorf("unable to find project '%v'", spec.GetProject())
+		}
+		if proj, ok := entity.(*models.Project); ok {
+			dp.ProjectId = proj.ID
+		}
+	}
+	for _, g := range spec.GetGroups() {
+		if _, err := dao.GetIdByNamePartnerOrg(ctx, s.db, g, partner, org, &models.Group{}); err != nil {
+			return fmt.Errorf("unable to find group '%v'", g)
+		}
+	}
+	emails, err := dao.GetUserEmails(ctx, s.db, spec.GetUsers())
+	if err != nil {
+		return err
+	}
+	users := []string{}
+	for _, u := range spec.GetUsers() {
+		email, ok := emails[strings.ToLower(u)]
+		if !ok {
+			return fmt.Errorf("unable to find user '%v'", u)
+		}
+		users = append(users, email)
+	}
+
+	dp.Namespace = spec.GetNamespace()
+	dp.Users = users
+	dp.Groups = spec.GetGroups()
+	dp.Permissions = spec.GetPermissions()
+	return nil
+}
+
+// createAuthzPolicies adds the casbin deny policies of the deny policy
+// and maps the urls of its permissions to it
+func (s *denyPolicyService) createAuthzPolicies(ctx context.Context, dp *models.DenyPolicy, org, project string) error {
+	role := denyPolicyRole(org, dp.Name)
+	ns := dp.Namespace
+	if ns == "" {
+		ns = "*"
+	}
+	if project == "" {
+		project = "*"
+	}
+	var ps []*authzv1.Policy
+	for _, u := range dp.Users {
+		ps = append(ps, &authzv1.Policy{Sub: "u:" + u, Ns: ns, Proj: project, Org: org, Obj: role})
+	}
+	for _, g := range dp.Groups {
+		ps = append(ps, &authzv1.Policy{Sub: "g:" + g, Ns: ns, Proj: project, Org: org, Obj: role})
+	}
+	if _, err := s.azc.CreateDenyPolicies(ctx, &authzv1.Policies{Policies: ps}); err != nil {
+		return fmt.Errorf("unable to create deny policies in authz; %v", err)
+	}
+	// kubectl permissions have no urls, they are denied when the
+	// kubernetes rbac of the user is generated
+	crpm := authzv1.RolePermissionMappingList{
+		RolePermissionMappingList: []*authzv1.RolePermissionMapping{{
+			Role:       role,
+			Permission: dp.Permissions,
+		}},
+	}
+	if _, err := s.azc.CreateRolePermissionMappings(ctx, &crpm); err != nil {
+		return fmt.Errorf("unable to create mapping in authz; %v", err)
+	}
+	return nil
+}
+
+func (s *denyPolicyService) deleteAuthzPolicies(ctx context.Context, dp *models.DenyPolicy, org string) error {
+	role := denyPolicyRole(org, dp.Name)
+	if _, err := s.azc.DeleteDenyPolicies(ctx, &authzv1.Policy{Obj: role}); err != nil {
+		return fmt.Errorf("unable to delete deny policies from authz; %v", err)
+	}
+	if _, err := s.azc.DeleteRolePermissionMappings(ctx, &authzv1.FilteredRolePermissionMapping{Role: role}); err != nil {
+		return fmt.Errorf("unable to delete mapping from authz; %v", err)
+	}
+	return nil
+}
+
+func (s *denyPolicyService) toV3DenyPolicy(ctx context.Context, policy *rolev3.DenyPolicy, dp *models.DenyPolicy) (*rolev3.DenyPolicy, error) {
+	labels := make(map[string]string)
+	labels["organization"] = policy.GetMetadata().GetOrganization()
+	labels["partner"] = policy.GetMetadata().GetPartner()
+
+	project := ""
+	if dp.ProjectId != uuid.Nil {
+		entity, err := dao.GetNameById(ctx, s.db, dp.ProjectId, &models.Project{})
+		if err != nil {
+			return nil, err
+		}
+		if proj, ok := entity.(*models.Project); ok {
+			project = proj.Name
+		}
+	}
+
+	policy.ApiVersion = apiVersion
+	policy.Kind = denyPolicyKind
+	policy.Metadata = &commonv3.Metadata{
+		Name:         dp.Name,
+		Description:  dp.Description,
+		Id:           dp.ID.String(),
+		Organization: policy.GetMetadata().GetOrganization(),
+		Partner:      policy.GetMetadata().GetPartner(),
+		Labels:       labels,
+		ModifiedAt:   timestamppb.New(dp.ModifiedAt),
+		CreatedAt:    timestamppb.New(dp.CreatedAt),
+	}
+	policy.Spec = &rolev3.DenyPolicySpec{
+		Project:     project,
+		Namespace:   dp.Namespace,
+		Users:       dp.Users,
+		Groups:      dp.Groups,
+		Permissions: dp.Permissions,
+	}
+	return policy, nil
+}
+
+func (s *denyPolicyService) Create(ctx context.Context, policy *rolev3.DenyPolicy) (*rolev3.DenyPolicy, error) {
+	partnerId, organizationId, err := s.getPartnerOrganization(ctx, policy.GetMetadata())
+	if err != nil {
+		return nil, fmt.Errorf("unable to get partner and org id")
+	}
+	e, _ := dao.GetIdByNamePartnerOrg(ctx, s.db, policy.GetMetadata().GetName(), uuid.NullUUID{UUID: partnerId, Valid: true}, uuid.NullUUID{UUID: organizationId, Valid: true}, &models.DenyPolicy{})
+	if e != nil {
+		return nil, fmt.Errorf("deny policy '%v' already exists", policy.GetMetadata().GetName())
+	}
+
+	dp := models.DenyPolicy{
+		Name:           policy.GetMetadata().GetName(),
+		Description:    policy.GetMetadata().GetDescription(),
+		CreatedAt:      time.Now(),
+		ModifiedAt:     time.Now(),
+		Trash:          false,
+		OrganizationId: organizationId,
+		PartnerId:      partnerId,
+	}
+	if err := s.setDenyPolicySpec(ctx, &dp, policy.GetSpec()); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return &rolev3.DenyPolicy{}, err
+	}
+	if _, err := dao.Create(ctx, tx, &dp); err != nil {
+		tx.Rollback()
+		return &rolev3.DenyPolicy{}, err
+	}
+	if err := s.createAuthzPolicies(ctx, &dp, policy.GetMetadata().GetOrganization(), policy.GetSpec().GetProject()); err != nil {
+		tx.Rollback()
+		return &rolev3.DenyPolicy{}, err
+	}
+	if err := tx.Commit(); err != nil {
+		tx.Rollback()
+		_log.Warn("unable to commit changes", err)
+	}
+
+	CreateDenyPolicyAuditEvent(ctx, s.al, AuditActionCreate, dp.Name, dp.ID, dp.Permissions)
+	return s.toV3DenyPolicy(ctx, policy, &dp)
+}
+
+func (s *denyPolicyService) GetByName(ctx context.Context, policy *rolev3.DenyPolicy) (*rolev3.DenyPolicy, error) {
+	dp, err := s.getDenyPolicy(ctx, policy.GetMetadata())
+	if err != nil {
+		return &rolev3.DenyPolicy{}, err
+	}
+	return s.toV3DenyPolicy(ctx, policy, dp)
+}
+
+func (s *denyPolicyService) Update(ctx context.Context, policy *rolev3.DenyPolicy) (*rolev3.DenyPolicy, error) {
+	dp, err := s.getDenyPolicy(ctx, policy.GetMetadata())
+	if err != nil {
+		return &rolev3.DenyPolicy{}, err
+	}
+	dp.Description = policy.GetMetadata().GetDescription()
+	dp.ModifiedAt = time.Now()
+	if err := s.setDenyPolicySpec(ctx, dp, policy.GetSpec()); err != nil {
+		return nil, err
+	}
+
+	org := policy.GetMetadata().GetOrganization()
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return &rolev3.DenyPolicy{}, err
+	}
+	if _, err := dao.Update(ctx, tx, dp.ID, dp); err != nil {
+		tx.Rollback()
+		return &rolev3.DenyPolicy{}, err
+	}
+	if err := s.deleteAuthzPolicies(ctx, dp, org); err != nil {
+		tx.Rollback()
+		return &rolev3.DenyPolicy{}, err
+	}
+	if err := s.createAuthzPolicies(ctx, dp, org, policy.GetSpec().GetProject()); err != nil {
+		tx.Rollback()
+		return &rolev3.DenyPolicy{}, err
+	}
+	if err := tx.Commit(); err != nil {
+		tx.Rollback()
+		_log.Warn("unable to commit changes", err)
+	}
+
+	CreateDenyPolicyAuditEvent(ctx, s.al, AuditActionUpdate, dp.Name, dp.ID, dp.Permissions)
+	return s.toV3DenyPolicy(ctx, policy, dp)
+}
+
+func (s *denyPolicyService) Delete(ctx context.Context, policy *rolev3.DenyPolicy) (*rolev3.DenyPolicy, error) {
+	dp, err := s.getDenyPolicy(ctx, policy.GetMetadata())
+	if err != nil {
+		return &rolev3.DenyPolicy{}, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return &rolev3.DenyPolicy{}, err
+	}
+	if err := dao.Delete(ctx, tx, dp.ID, dp); err != nil {
+		tx.Rollback()
+		return &rolev3.DenyPolicy{}, err
+	}
+	if err := s.deleteAuthzPolicies(ctx, dp, policy.GetMetadata().GetOrganization()); err != nil {
+		tx.Rollback()
+		return &rolev3.DenyPolicy{}, err
+	}
+	if err := tx.Commit(); err != nil {
+		tx.Rollback()
+		_log.Warn("unable to commit changes", err)
+	}
+
+	CreateDenyPolicyAuditEvent(ctx, s.al, AuditActionDelete, dp.Name, dp.ID, dp.Permissions)
+	return policy, nil
+}
+
+func (s *denyPolicyService) List(ctx context.Context, policy *rolev3.DenyPolicy) (*rolev3.DenyPolicyList, error) {
+	var items []*rolev3.DenyPolicy
+	dpList := &rolev3.DenyPolicyList{
+		ApiVersion: apiVersion,
+		Kind:       denyPolicyListKind,
+		Metadata: &commonv3.ListMetadata{
+			Count: 0,
+		},
+	}
+
+	partnerId, organizationId, err := s.getPartnerOrganization(ctx, policy.GetMetadata())
+	if err != nil {
+		return dpList, err
+	}
+	var dps []models.DenyPolicy
+	entities, err := dao.List(ctx, s.db, uuid.NullUUID{UUID: partnerId, Valid: true}, uuid.NullUUID{UUID: organizationId, Valid: true}, &dps)
+	if err != nil {
+		return dpList, err
+	}
+	if dps, ok := entities.(*[]models.DenyPolicy); ok {
+		for _, dp := range *dps {
+			entry := &rolev3.DenyPolicy{Metadata: &commonv3.Metadata{
+				Organization: policy.GetMetadata().GetOrganization(),
+				Partner:      policy.GetMetadata().GetPartner(),
+			}}
+			entry, err = s.toV3DenyPolicy(ctx, entry, &dp)
+			if err != nil {
+				return dpList, err
+			}
+			items = append(items, entry)
+		}
+
+		dpList.Metadata = &commonv3.ListMetadata{
+			Count: int64(len(items)),
+		}
+		dpList.Items = items
+	}
+
+	return dpList, nil
+}
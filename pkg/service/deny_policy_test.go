@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/models"
+	v3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	rolev3 "github.com/paralus/paralus/proto/types/rolepb/v3"
+)
+
+func TestCreateDenyPolicy(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	mazc := mockAuthzClient{}
+	dps := NewDenyPolicyService(db, &mazc, getLogger())
+
+	duuid := uuid.New().String()
+	pruuid := uuid.New().String()
+
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+	mock.ExpectQuery(`SELECT "denypolicy"."id" FROM "authsrv_deny_policy" AS "denypolicy" WHERE .organization_id = '` + ouuid + `'. AND .partner_id = '` + puuid + `'. AND .name = 'denypolicy-` + duuid + `'.`).
+		WillReturnError(fmt.Errorf("no data available"))
+	mock.ExpectQuery(`SELECT "resourcepermission"."id" FROM "authsrv_resourcepermission" AS "resourcepermission" WHERE .name = 'kubectl.fullaccess'.`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.New().String()))
+	mock.ExpectQuery(`SELECT "project"."id" FROM "authsrv_project" AS "project" WHERE .organization_id = '` + ouuid + `'. AND .partner_id = '` + puuid + `'. AND .name = 'prod'.`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(pruuid))
+	mock.ExpectQuery(`SELECT "group"."id" FROM "authsrv_group" AS "group" WHERE .organization_id = '` + ouuid + `'. AND .partner_id = '` + puuid + `'. AND .name = 'contractors'.`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.New().String()))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO "authsrv_deny_policy"`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(duuid))
+	mock.ExpectCommit()
+	mock.ExpectQuery(`SELECT "project"."name" FROM "authsrv_project" AS "project" WHERE .id = '` + pruuid + `'.`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("prod"))
+
+	policy := &rolev3.DenyPolicy{
+		Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Name: "denypolicy-" + duuid},
+		Spec:     &rolev3.DenyPolicySpec{Project: "prod", Groups: []string{"contractors"}, Permissions: []string{"kubectl.fullaccess"}},
+	}
+	policy, err := dps.Create(context.Background(), policy)
+	if err != nil {
+		t.Fatal("could not create deny policy:", err)
+	}
+	if policy.GetSpec().GetProject() != "prod" {
+		t.Errorf("expected project 'prod'; got '%v'", policy.GetSpec().GetProject())
+	}
+
+	if len(mazc.cdp) != 1 || len(mazc.cdp[0].Policies) != 1 {
+		t.Fatalf("expected one deny policy in authz; got %v", mazc.cdp)
+	}
+	p := mazc.cdp[0].Policies[0]
+	if p.Sub != "g:contractors" || p.Proj != "prod" || p.Ns != "*" || p.Obj != denyPolicyRole("org-"+ouuid, "denypolicy-"+duuid) {
+		t.Errorf("unexpected deny policy in authz %v", p)
+	}
+	if len(mazc.crpm) != 1 || mazc.crpm[0].RolePermissionMappingList[0].Role != p.Obj {
+		t.Errorf("expected permissions mapped to deny policy; got %v", mazc.crpm)
+	}
+}
+
+func TestCreateDenyPolicyNamespaceWithoutProject(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	mazc := mockAuthzClient{}
+	dps := NewDenyPolicyService(db, &mazc, getLogger())
+
+	duuid := uuid.New().String()
+
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+	mock.ExpectQuery(`SELECT "denypolicy"."id" FROM "authsrv_deny_policy" AS "denypolicy"`).
+		WillReturnError(fmt.Errorf("no data available"))
+
+	policy := &rolev3.DenyPolicy{
+		Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Name: "denypolicy-" + duuid},
+		Spec:     &rolev3.DenyPolicySpec{Namespace: "payments", Groups: []string{"contractors"}, Permissions: []string{"kubectl.namespace.write"}},
+	}
+	if _, err := dps.Create(context.Background(), policy); err == nil {
+		t.Error("expected error for namespace without project")
+	}
+	if len(mazc.cdp) != 0 {
+		t.Errorf("expected no deny policies in authz; got %v", mazc.cdp)
+	}
+}
+
+func TestDeniedPermissions(t *testing.T) {
+	prod := uuid.New()
+	policies := []models.DenyPolicy{
+		{Groups: []string{"contractors"}, Permissions: []string{"ops_star.all"}},
+		{ProjectId: prod, Groups: []string{"contractors"}, Permissions: []string{"kubectl.fullaccess"}},
+		{ProjectId: prod, Namespace: "payments", Users: []string{"user@example.com"}, Permissions: []string{"kubectl.namespace.write"}},
+	}
+
+	denied := DeniedPermissions(policies, "other@example.com", []string{"contractors"}, uuid.Nil, "")
+	if len(denied) != 1 || !denied["ops_star.all"] {
+		t.Errorf("expected only organization deny policies to apply; got %v", denied)
+	}
+	denied = DeniedPermissions(policies, "other@example.com", []string{"contractors"}, prod, "")
+	if len(denied) != 2 || !denied["kubectl.fullaccess"] {
+		t.Errorf("expected organization and project deny policies to apply; got %v", denied)
+	}
+	denied = DeniedPermissions(policies, "User@example.com", nil, prod, "payments")
+	if len(denied) != 1 || !denied["kubectl.namespace.write"] {
+		t.Errorf("expected namespace deny policy to apply; got %v", denied)
+	}
+	if denied = DeniedPermissions(policies, "user@example.com", nil, prod, "web"); len(denied) != 0 {
+		t.Errorf("expected no deny policies to apply in other namespace; got %v", denied)
+	}
+}
@@ -0,0 +1,383 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/dao"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/paralus/paralus/pkg/utils"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	"github.com/uptrace/bun"
+	"google.golang.org/protobuf/proto"
+)
+
+// organizationAdminRoles are the roles which allow everything in the
+// organization they are held in
+var organizationAdminRoles = []string{"ADMIN", "SUPER_ADMIN"}
+
+// accessSubject is the user, service account or group whose effective
+// access is resolved, name is the user or service account name deny
+// policies are matched against
+type accessSubject struct {
+	subject   string
+	name      string
+	accountId uuid.UUID
+	groups    []models.Group
+	key       *models.ApiKey
+}
+
+// accessGrant is a grant together with the id of its project deny
+// policies are matched against
+type accessGrant struct {
+	*commonv3.AccessGrant
+	projectId uuid.UUID
+}
+
+func getAccessPartnerOrganization(ctx context.Context, db bun.IDB, meta *commonv3.Metadata) (uuid.UUID, uuid.UUID, error) {
+	partnerId, err := dao.GetPartnerId(ctx, db, meta.GetPartner())
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("unable to find partner '%v'", meta.GetPartner())
+	}
+	organizationId, err := dao.GetOrganizationId(ctx, db, meta.GetOrganization())
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("unable to find organization '%v'", meta.GetOrganization())
+	}
+	return partnerId, organizationId, nil
+}
+
+func getAccessSubject(ctx context.Context, db bun.IDB, partnerId, organizationId uuid.UUID, req *commonv3.EffectiveAccessRequest) (*accessSubject, error) {
+	set := 0
+	for _, v := range []string{req.GetUsername(), req.GetGroup(), req.GetApiKey()} {
+		if v != "" {
+			set++
+		}
+	}
+	if set != 1 {
+		return nil, fmt.Errorf("exactly one of username, group and api key is required")
+	}
+	pid := uuid.NullUUID{UUID: partnerId, Valid: true}
+	oid := uuid.NullUUID{UUID: organizationId, Valid: true}
+
+	sub := &accessSubject{}
+	switch {
+	case req.GetGroup() != "":
+		entity, err := dao.GetIdByNamePartnerOrg(ctx, db, req.GetGroup(), pid, oid, &models.Group{})
+		if err != nil {
+			return nil, fmt.Errorf("unable to find group '%v'", req.GetGroup())
+		}
+		sub.subject = "g:" + req.GetGroup()
+		sub.groups = []models.Group{{ID: entity.(*models.Group).ID, Name: req.GetGroup()}}
+		return sub, nil
+	case req.GetApiKey() != "":
+		var key models.ApiKey
+		_, err := dao.GetX(ctx, db, "key", req.GetApiKey(), &key)
+		if err != nil || key.Trash || key.OrganizationID != organizationId {
+			return nil, fmt.Errorf("unable to find api key '%v'", req.GetApiKey())
+		}
+		sub.key = &key
+		sub.accountId = key.AccountID
+		if key.IsServiceAccount {
+			entity, err := dao.GetNameById(ctx, db, key.AccountID, &models.ServiceAccount{})
+			if err != nil {
+				return nil, fmt.Errorf("unable to find service account of api key '%v'", req.GetApiKey())
+			}
+			sub.name = entity.(*models.ServiceAccount).Name
+			sub.subject = ServiceAccountSubject(key.AccountID.String())
+			return sub, nil
+		}
+		names, err := dao.GetUserNamesByIds(ctx, db, []uuid.UUID{key.AccountID}, &models.KratosIdentities{})
+		if err != nil || len(names) == 0 {
+			return nil, fmt.Errorf("unable to find user of api key '%v'", req.GetApiKey())
+		}
+		sub.name = names[0]
+		sub.subject = "u:" + sub.name
+	case req.GetServiceAccount():
+		entity, err := dao.GetIdByNamePartnerOrg(ctx, db, req.GetUsername(), pid, oid, &models.ServiceAccount{})
+		if err != nil {
+			return nil, fmt.Errorf("unable to find service account '%v'", req.GetUsername())
+		}
+		sub.accountId = entity.(*models.ServiceAccount).ID
+		sub.name = req.GetUsername()
+		sub.subject = ServiceAccountSubject(sub.accountId.String())
+		return sub, nil
+	default:
+		entity, err := dao.GetUserIdByEmail(ctx, db, req.GetUsername(), &models.KratosIdentities{})
+		if err != nil {
+			return nil, fmt.Errorf("unable to find user '%v'", req.GetUsername())
+		}
+		sub.accountId = entity.(*models.KratosIdentities).ID
+		sub.name = req.GetUsername()
+		sub.subject = "u:" + sub.name
+	}
+
+	groups, err := dao.GetGroups(ctx, db, sub.accountId)
+	if err != nil {
+		return nil, err
+	}
+	for _, g := range groups {
+		if g.OrganizationId == organizationId {
+			sub.groups = append(sub.groups, g)
+		}
+	}
+	return sub, nil
+}
+
+// getAccessProjects returns the projects with the names, unknown
+// projects are skipped unless required
+func getAccessProjects(ctx context.Context, db bun.IDB, partnerId, organizationId uuid.UUID, names []string, required string) ([]models.Project, error) {
+	projects := []models.Project{}
+	for _, name := range names {
+		entity, err := dao.GetIdByNamePartnerOrg(ctx, db, name,
+			uuid.NullUUID{UUID: partnerId, Valid: true}, uuid.NullUUID{UUID: organizationId, Valid: true}, &models.Project{})
+		if err == sql.ErrNoRows && name != required {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to find project '%v'", name)
+		}
+		projects = append(projects, models.Project{ID: entity.(*models.Project).ID, Name: name})
+	}
+	return projects, nil
+}
+
+// expandRoleBindings turns the role bindings into a grant per
+// permission. Organization wide bindings are reported on each of the
+// projects when projects are given and bindings of other projects are
+// left out.
+func expandRoleBindings(ctx context.Context, db bun.IDB, bindings []models.RoleBinding, projects []models.Project, permissions func(models.RoleBinding) []string) ([]accessGrant, error) {
+	projectIds := []uuid.UUID{}
+	for _, p := range projects {
+		projectIds = append(projectIds, p.ID)
+	}
+	for _, rb := range bindings {
+		if rb.ProjectId != uuid.Nil && !utils.ContainsU(projectIds, rb.ProjectId) {
+			projectIds = append(projectIds, rb.ProjectId)
+		}
+	}
+	pcs, err := dao.GetProjectsClusters(ctx, db, projectIds)
+	if err != nil {
+		return nil, err
+	}
+	clusters := map[uuid.UUID][]string{}
+	for _, pc := range pcs {
+		clusters[pc.ProjectId] = append(clusters[pc.ProjectId], pc.Cluster)
+	}
+
+	grants := []accessGrant{}
+	for _, rb := range bindings {
+		targets := []models.Project{{ID: rb.ProjectId, Name: rb.Project}}
+		if projects != nil {
+			if rb.ProjectId == uuid.Nil {
+				targets = projects
+			} else if !containsProject(projects, rb.ProjectId) {
+				continue
+			}
+		}
+		for _, p := range targets {
+			for _, perm := range permissions(rb) {
+				grants = append(grants, accessGrant{
+					AccessGrant: &commonv3.AccessGrant{
+						Subject:      rb.Subject,
+						Organization: rb.Organization,
+						Project:      p.Name,
+						Clusters:     clusters[p.ID],
+						Namespace:    rb.Namespace,
+						Permission:   perm,
+						Role:         rb.Role,
+						Scope:        rb.Scope,
+					},
+					projectId: p.ID,
+				})
+			}
+		}
+	}
+	return grants, nil
+}
+
+func containsProject(projects []models.Project, id uuid.UUID) bool {
+	for _, p := range projects {
+		if p.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+func sortAccessGrants(grants []*commonv3.AccessGrant) {
+	sort.SliceStable(grants, func(i, j int) bool {
+		a, b := grants[i], grants[j]
+		for _, c := range [][2]string{
+			{a.Project, b.Project}, {a.Namespace, b.Namespace}, {a.Subject, b.Subject},
+			{a.Permission, b.Permission}, {a.Role, b.Role}, {a.Group, b.Group},
+		} {
+			if c[0] != c[1] {
+				return c[0] < c[1]
+			}
+		}
+		return false
+	})
+}
+
+// EffectiveAccess resolves the permissions a user, service account,
+// group or api key holds in the organization, its projects and
+// namespaces. Grants of the groups of an account name the group they
+// came from, grants overridden by deny policies are marked denied and
+// api keys are restricted to their permissions and projects.
+func EffectiveAccess(ctx context.Context, db bun.IDB, req *commonv3.EffectiveAccessRequest) (*commonv3.EffectiveAccessResponse, error) {
+	partnerId, organizationId, err := getAccessPartnerOrganization(ctx, db, req.GetMetadata())
+	if err != nil {
+		return nil, err
+	}
+	sub, err := getAccessSubject(ctx, db, partnerId, organizationId, req)
+	if err != nil {
+		return nil, err
+	}
+	groupIds := []uuid.UUID{}
+	groupNames := []string{}
+	for _, g := range sub.groups {
+		groupIds = append(groupIds, g.ID)
+		groupNames = append(groupNames, g.Name)
+	}
+	bindings, err := dao.GetSubjectRoleBindings(ctx, db, organizationId, sub.accountId, groupIds)
+	if err != nil {
+		return nil, err
+	}
+
+	// grants are reported on the projects of the request or api key
+	var scope []string
+	if sub.key != nil && len(sub.key.Projects) > 0 {
+		scope = sub.key.Projects
+	}
+	if p := req.GetProject(); p != "" {
+		if scope == nil || utils.Contains(scope, p) {
+			scope = []string{p}
+		} else {
+			scope = []string{}
+		}
+	}
+	var projects []models.Project
+	if scope != nil {
+		projects, err = getAccessProjects(ctx, db, partnerId, organizationId, scope, req.GetProject())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	roleIds := []uuid.UUID{}
+	for _, rb := range bindings {
+		if !utils.ContainsU(roleIds, rb.RoleId) {
+			roleIds = append(roleIds, rb.RoleId)
+		}
+	}
+	rps, err := dao.GetRolesPermissions(ctx, db, roleIds)
+	if err != nil {
+		return nil, err
+	}
+	rolePermissions := map[uuid.UUID][]string{}
+	for _, rp := range rps {
+		if sub.key != nil && len(sub.key.Permissions) > 0 && !utils.Contains(sub.key.Permissions, rp.Permission) {
+			continue
+		}
+		rolePermissions[rp.RoleId] = append(rolePermissions[rp.RoleId], rp.Permission)
+	}
+	grants, err := expandRoleBindings(ctx, db, bindings, projects, func(rb models.RoleBinding) []string {
+		return rolePermissions[rb.RoleId]
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	policies, err := dao.GetDenyPolicies(ctx, db, organizationId, partnerId)
+	if err != nil {
+		return nil, err
+	}
+	resp := &commonv3.EffectiveAccessResponse{
+		Subject: sub.subject,
+		Groups:  groupNames,
+	}
+	if sub.key == nil || (len(sub.key.Projects) == 0 && len(sub.key.Permissions) == 0) {
+		for _, rb := range bindings {
+			if rb.ProjectId == uuid.Nil && utils.Contains(organizationAdminRoles, rb.Role) {
+				resp.OrganizationAdmin = true
+			}
+		}
+	}
+	for _, g := range grants {
+		if g.Subject != sub.subject {
+			g.Group = strings.TrimPrefix(g.Subject, "g:")
+			g.Subject = sub.subject
+		}
+		g.Denied = DeniedPermissions(policies, sub.name, groupNames, g.projectId, g.Namespace)[g.Permission]
+		resp.Grants = append(resp.Grants, g.AccessGrant)
+	}
+	sortAccessGrants(resp.Grants)
+	return resp, nil
+}
+
+// PermissionHolders lists everyone holding the permission in the
+// project, through a role on the project, one of its namespaces or the
+// whole organization. Group grants are listed for the group and for
+// each of its users.
+func PermissionHolders(ctx context.Context, db bun.IDB, req *commonv3.PermissionHoldersRequest) (*commonv3.PermissionHoldersResponse, error) {
+	if req.GetProject() == "" || req.GetPermission() == "" {
+		return nil, fmt.Errorf("project and permission are required")
+	}
+	partnerId, organizationId, err := getAccessPartnerOrganization(ctx, db, req.GetMetadata())
+	if err != nil {
+		return nil, err
+	}
+	projects, err := getAccessProjects(ctx, db, partnerId, organizationId, []string{req.GetProject()}, req.GetProject())
+	if err != nil {
+		return nil, err
+	}
+	bindings, err := dao.GetPermissionRoleBindings(ctx, db, organizationId, projects[0].ID, []string{req.GetPermission(), opsAll})
+	if err != nil {
+		return nil, err
+	}
+	grants, err := expandRoleBindings(ctx, db, bindings, projects, func(models.RoleBinding) []string {
+		return []string{req.GetPermission()}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := dao.GetOrganizationGroupMembers(ctx, db, organizationId)
+	if err != nil {
+		return nil, err
+	}
+	groupUsers := map[string][]string{}
+	userGroups := map[string][]string{}
+	for _, m := range members {
+		groupUsers[m.GroupName] = append(groupUsers[m.GroupName], m.Username)
+		userGroups[m.Username] = append(userGroups[m.Username], m.GroupName)
+	}
+	policies, err := dao.GetDenyPolicies(ctx, db, organizationId, partnerId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &commonv3.PermissionHoldersResponse{}
+	for _, g := range grants {
+		kind, name, _ := strings.Cut(g.Subject, ":")
+		if kind == "g" {
+			g.Denied = DeniedPermissions(policies, "", []string{name}, g.projectId, g.Namespace)[g.Permission]
+			for _, user := range groupUsers[name] {
+				ug := proto.Clone(g.AccessGrant).(*commonv3.AccessGrant)
+				ug.Subject = "u:" + user
+				ug.Group = name
+				ug.Denied = DeniedPermissions(policies, user, userGroups[user], g.projectId, g.Namespace)[g.Permission]
+				resp.Grants = append(resp.Grants, ug)
+			}
+		} else {
+			g.Denied = DeniedPermissions(policies, name, userGroups[name], g.projectId, g.Namespace)[g.Permission]
+		}
+		resp.Grants = append(resp.Grants, g.AccessGrant)
+	}
+	sortAccessGrants(resp.Grants)
+	return resp, nil
+}
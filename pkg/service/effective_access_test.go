@@ -0,0 +1,230 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	v3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+)
+
+var roleBindingColumns = []string{"id", "organization_id", "partner_id", "role_id", "role", "scope", "organization", "project_id", "project", "namespace", "subject"}
+
+func expectRoleBindings(mock sqlmock.Sqlmock, table string, rows *sqlmock.Rows) {
+	if rows == nil {
+		rows = sqlmock.NewRows(roleBindingColumns)
+	}
+	mock.ExpectQuery(`SELECT rb.id, .* FROM ` + table + ` AS rb `).WillReturnRows(rows)
+}
+
+func TestEffectiveAccessUser(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	uuuid := uuid.NewString()
+	guuid := uuid.NewString()
+	pruuid := uuid.NewString()
+	readOnly := uuid.NewString()
+	clusterAdmin := uuid.NewString()
+
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+	mock.ExpectQuery(`SELECT "identities"."id" FROM "identities" WHERE .*traits ->> 'email' = 'alice@example.com'`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuuid))
+	mock.ExpectQuery(`SELECT .* FROM "authsrv_group" AS "group" JOIN authsrv_groupaccount .* WHERE \(authsrv_groupaccount.account_id = '` + uuuid + `'\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "organization_id"}).
+			AddRow(guuid, "sre", ouuid).
+			AddRow(uuid.NewString(), "elsewhere", uuid.NewString()))
+	expectRoleBindings(mock, "authsrv_accountresourcerole", nil)
+	mock.ExpectQuery(`FROM authsrv_grouprole AS rb .* WHERE \(rb.trash = FALSE\) AND \(rb.group_id IN \('` + guuid + `'\)\) AND \(rb.organization_id = '` + ouuid + `'\) AND \(rb.starts_at IS NULL OR rb.starts_at <= now\(\)\)`).
+		WillReturnRows(sqlmock.NewRows(roleBindingColumns))
+	expectRoleBindings(mock, "authsrv_projectaccountresourcerole", sqlmock.NewRows(roleBindingColumns).
+		AddRow(uuid.NewString(), ouuid, puuid, readOnly, "PROJECT_READ_ONLY", "project", "acme", pruuid, "prod", "", "u:alice@example.com"))
+	expectRoleBindings(mock, "authsrv_projectaccountnamespacerole", nil)
+	expectRoleBindings(mock, "authsrv_projectgrouprole", sqlmock.NewRows(roleBindingColumns).
+		AddRow(uuid.NewString(), ouuid, puuid, clusterAdmin, "CLUSTER_ADMIN", "project", "acme", pruuid, "prod", "", "g:sre"))
+	expectRoleBindings(mock, "authsrv_projectgroupnamespacerole", nil)
+	mock.ExpectQuery(`SELECT rrp.resource_role_id AS role_id, rp.name AS permission FROM authsrv_resourcerolepermission AS rrp .* WHERE \(rrp.resource_role_id IN \('` + readOnly + `', '` + clusterAdmin + `'\)\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"role_id", "permission"}).
+			AddRow(readOnly, "cluster.read").
+			AddRow(clusterAdmin, "cluster.read").
+			AddRow(clusterAdmin, "kubectl.fullaccess").
+			AddRow(readOnly, "project.read"))
+	mock.ExpectQuery(`SELECT pc.project_id, c.name AS cluster FROM cluster_project_cluster AS pc .* WHERE \(pc.project_id IN \('` + pruuid + `'\)\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"project_id", "cluster"}).AddRow(pruuid, "east").AddRow(pruuid, "west"))
+	mock.ExpectQuery(`SELECT .* FROM "authsrv_deny_policy" AS "denypolicy" WHERE \(organization_id = '` + ouuid + `'\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "project_id", "groups", "permissions"}).
+			AddRow(uuid.NewString(), pruuid, "{sre}", "{kubectl.fullaccess}"))
+
+	resp, err := EffectiveAccess(context.Background(), db, &v3.EffectiveAccessRequest{
+		Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid},
+		Username: "alice@example.com",
+	})
+	if err != nil {
+		t.Fatal("could not resolve effective access:", err)
+	}
+	if resp.Subject != "u:alice@example.com" || len(resp.Groups) != 1 || resp.Groups[0] != "sre" || resp.OrganizationAdmin {
+		t.Errorf("unexpected subject of effective access %v", resp)
+	}
+	expected := []struct {
+		permission, role, group string
+		denied                  bool
+	}{
+		{"cluster.read", "CLUSTER_ADMIN", "sre", false},
+		{"cluster.read", "PROJECT_READ_ONLY", "", false},
+		{"kubectl.fullaccess", "CLUSTER_ADMIN", "sre", true},
+		{"project.read", "PROJECT_READ_ONLY", "", false},
+	}
+	if len(resp.Grants) != len(expected) {
+		t.Fatalf("expected %d grants; got %v", len(expected), resp.Grants)
+	}
+	for i, e := range expected {
+		g := resp.Grants[i]
+		if g.Subject != "u:alice@example.com" || g.Project != "prod" || g.Permission != e.permission || g.Role != e.role || g.Group != e.group || g.Denied != e.denied {
+			t.Errorf("expected grant %v; got %v", e, g)
+		}
+		if len(g.Clusters) != 2 || g.Clusters[0] != "east" || g.Clusters[1] != "west" {
+			t.Errorf("expected clusters of prod in grant; got %v", g.Clusters)
+		}
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error("unfulfilled expectations:", err)
+	}
+}
+
+func TestEffectiveAccessApiKey(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	uuuid := uuid.NewString()
+	pruuid := uuid.NewString()
+	admin := uuid.NewString()
+
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+	mock.ExpectQuery(`SELECT .* FROM "authsrv_apikey" AS "apikey" WHERE \(key = 'ci-key'\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "key", "account_id", "organization_id", "projects", "permissions"}).
+			AddRow(uuid.NewString(), "ci-key", uuuid, ouuid, "{prod}", "{cluster.read}"))
+	mock.ExpectQuery(`SELECT traits ->> 'email' as name FROM "identities"`).
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("ci@example.com"))
+	mock.ExpectQuery(`SELECT .* FROM "authsrv_group" AS "group"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "organization_id"}))
+	// group bindings are not queried for accounts without groups
+	expectRoleBindings(mock, "authsrv_accountresourcerole", sqlmock.NewRows(roleBindingColumns).
+		AddRow(uuid.NewString(), ouuid, puuid, admin, "ADMIN", "organization", "acme", nil, nil, nil, "u:ci@example.com"))
+	expectRoleBindings(mock, "authsrv_projectaccountresourcerole", nil)
+	expectRoleBindings(mock, "authsrv_projectaccountnamespacerole", nil)
+	mock.ExpectQuery(`SELECT "project"."id" FROM "authsrv_project" AS "project" WHERE .*name = 'prod'`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(pruuid))
+	mock.ExpectQuery(`FROM authsrv_resourcerolepermission AS rrp`).
+		WillReturnRows(sqlmock.NewRows([]string{"role_id", "permission"}).
+			AddRow(admin, "cluster.read").
+			AddRow(admin, "user.write"))
+	mock.ExpectQuery(`FROM cluster_project_cluster AS pc`).
+		WillReturnRows(sqlmock.NewRows([]string{"project_id", "cluster"}).AddRow(pruuid, "east"))
+	mock.ExpectQuery(`FROM "authsrv_deny_policy" AS "denypolicy"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	resp, err := EffectiveAccess(context.Background(), db, &v3.EffectiveAccessRequest{
+		Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid},
+		ApiKey:   "ci-key",
+	})
+	if err != nil {
+		t.Fatal("could not resolve effective access:", err)
+	}
+	if resp.OrganizationAdmin {
+		t.Error("expected restricted api key not to be organization admin")
+	}
+	if len(resp.Grants) != 1 {
+		t.Fatalf("expected grant of api key permission only; got %v", resp.Grants)
+	}
+	g := resp.Grants[0]
+	if g.Subject != "u:ci@example.com" || g.Project != "prod" || g.Permission != "cluster.read" || g.Role != "ADMIN" || g.Scope != "organization" || len(g.Clusters) != 1 {
+		t.Errorf("expected organization grant reported on api key project; got %v", g)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error("unfulfilled expectations:", err)
+	}
+}
+
+func TestEffectiveAccessSubjectRequired(t *testing.T) {
+	tt := []struct {
+		name string
+		req  *v3.EffectiveAccessRequest
+	}{
+		{"none", &v3.EffectiveAccessRequest{}},
+		{"user and group", &v3.EffectiveAccessRequest{Username: "alice@example.com", Group: "sre"}},
+		{"group and api key", &v3.EffectiveAccessRequest{Group: "sre", ApiKey: "ci-key"}},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock := getDB(t)
+			defer db.Close()
+
+			puuid, ouuid := addParterOrgFetchExpectation(mock)
+			tc.req.Metadata = &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid}
+			if _, err := EffectiveAccess(context.Background(), db, tc.req); err == nil {
+				t.Error("expected effective access without a single subject to fail")
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error("unfulfilled expectations:", err)
+			}
+		})
+	}
+}
+
+func TestPermissionHolders(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	pruuid := uuid.NewString()
+
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+	mock.ExpectQuery(`SELECT "project"."id" FROM "authsrv_project" AS "project" WHERE .*name = 'prod'`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(pruuid))
+	expectRoleBindings(mock, "authsrv_accountresourcerole", nil)
+	mock.ExpectQuery(`FROM authsrv_grouprole AS rb .* WHERE \(rb.trash = FALSE\) AND \(rb.organization_id = '` + ouuid + `'\) AND \(rb.role_id IN \(SELECT rrp.resource_role_id .* WHERE \(rp.name IN \('kubectl.namespace.write', 'ops_star.all'\)\)`).
+		WillReturnRows(sqlmock.NewRows(roleBindingColumns).
+			AddRow(uuid.NewString(), ouuid, puuid, uuid.NewString(), "ADMIN", "organization", "acme", nil, nil, nil, "g:sre"))
+	expectRoleBindings(mock, "authsrv_projectaccountresourcerole", nil)
+	mock.ExpectQuery(`FROM authsrv_projectaccountnamespacerole AS rb .* AND \(rb.project_id = '` + pruuid + `'\)`).
+		WillReturnRows(sqlmock.NewRows(roleBindingColumns).
+			AddRow(uuid.NewString(), ouuid, puuid, uuid.NewString(), "NAMESPACE_ADMIN", "namespace", "acme", pruuid, "prod", "payments", "u:bob@example.com"))
+	expectRoleBindings(mock, "authsrv_projectgrouprole", nil)
+	expectRoleBindings(mock, "authsrv_projectgroupnamespacerole", nil)
+	mock.ExpectQuery(`FROM cluster_project_cluster AS pc`).
+		WillReturnRows(sqlmock.NewRows([]string{"project_id", "cluster"}).AddRow(pruuid, "east"))
+	mock.ExpectQuery(`SELECT g.name AS group_name, i.traits ->> 'email' AS username FROM authsrv_groupaccount AS ga .* WHERE \(g.organization_id = '` + ouuid + `'\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"group_name", "username"}).AddRow("sre", "alice@example.com"))
+	mock.ExpectQuery(`FROM "authsrv_deny_policy" AS "denypolicy"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "namespace", "users", "permissions"}).
+			AddRow(uuid.NewString(), "payments", "{bob@example.com}", "{kubectl.namespace.write}"))
+
+	resp, err := PermissionHolders(context.Background(), db, &v3.PermissionHoldersRequest{
+		Metadata:   &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid},
+		Project:    "prod",
+		Permission: "kubectl.namespace.write",
+	})
+	if err != nil {
+		t.Fatal("could not list permission holders:", err)
+	}
+	expected := []struct {
+		namespace, subject, role, group string
+		denied                          bool
+	}{
+		{"", "g:sre", "ADMIN", "", false},
+		{"", "u:alice@example.com", "ADMIN", "sre", false},
+		{"payments", "u:bob@example.com", "NAMESPACE_ADMIN", "", true},
+	}
+	if len(resp.Grants) != len(expected) {
+		t.Fatalf("expected %d grants; got %v", len(expected), resp.Grants)
+	}
+	for i, e := range expected {
+		g := resp.Grants[i]
+		if g.Project != "prod" || g.Namespace != e.namespace || g.Subject != e.subject || g.Role != e.role || g.Group != e.group || g.Denied != e.denied || g.Permission != "kubectl.namespace.write" {
+			t.Errorf("expected grant %v; got %v", e, g)
+		}
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error("unfulfilled expectations:", err)
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
-	"regexp"
 	"strings"
 	"time"
 
@@ -45,7 +44,7 @@ type GroupService interface {
 
 // groupService implements GroupService
 type groupService struct {
-	db  *bun.DB
+	db  bun.IDB
 	azc AuthzService
 	al  *zap.Logger
 }
@@ -103,9 +102,12 @@ func (s *groupService) createGroupRoleRelations(ctx context.Context, db bun.IDB,
 	var grs []models.GroupRole
 	var ps []*authzv1.Policy
 	var rids []uuid.UUID
-	regexc := regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
 
 	for _, pnr := range projectNamespaceRoles {
+		w, err := getBindingWindow(pnr)
+		if err != nil {
+			return &userv3.Group{}, nil, err
+		}
 		role := pnr.GetRole()
 		entity, err := dao.GetByName(ctx, db, role, &models.Role{})
 		if err != nil {
@@ -134,10 +136,12 @@ func (s *groupService) createGroupRoleRelations(ctx context.Context, db bun.IDB,
 				PartnerId:      ids.Partner,
 				OrganizationId: ids.Organization,
 				GroupId:        ids.Id,
-				Active:         true,
+				StartsAt:       w.StartsAt,
+				ExpiresAt:      w.ExpiresAt,
+				Active:         w.active(time.Now()),
 			}
 			grs = append(grs, gr)
-			ps = append(ps, &authzv1.Policy{
+			ps = w.appendPolicy(ps, &authzv1.Policy{
 				Sub:  "g:" + group.GetMetadata().GetName(),
 				Ns:   "*",
 				Proj: "*",
@@ -154,10 +158,12 @@ func (s *groupService) createGroupRoleRelations(ctx context.Context, db bun.IDB,
 				PartnerId:      ids.Partner,
 				OrganizationId: ids.Organization,
 				GroupId:        ids.Id,
-				Active:         true,
+				StartsAt:       w.StartsAt,
+				ExpiresAt:      w.ExpiresAt,
+				Active:         w.active(time.Now()),
 			}
 			grs = append(grs, gr)
-			ps = append(ps, &authzv1.Policy{
+			ps = w.appendPolicy(ps, &authzv1.Policy{
 				Sub:  "g:" + group.GetMetadata().GetName(),
 				Ns:   "*",
 				Proj: "*",
@@ -183,11 +189,13 @@ func (s *groupService) createGroupRoleRelations(ctx context.Context, db bun.IDB,
 				OrganizationId: ids.Organization,
 				GroupId:        ids.Id,
 				ProjectId:      projectId,
-				Active:         true,
+				StartsAt:       w.StartsAt,
+				ExpiresAt:      w.ExpiresAt,
+				Active:         w.active(time.Now()),
 			}
 			pgrs = append(pgrs, pgr)
 
-			ps = append(ps, &authzv1.Policy{
+			ps = w.appendPolicy(ps, &authzv1.Policy{
 				Sub:  "g:" + group.GetMetadata().GetName(),
 				Ns:   "*",
 				Proj: project,
@@ -207,31 +215,30 @@ func (s *groupService) createGroupRoleRelations(ctx context.Context, db bun.IDB,
 			}
 
 			namespace := pnr.GetNamespace()
-			match := regexc.MatchString(namespace)
-			if !match {
-				return &userv3.Group{}, nil, fmt.Errorf("namespace %q is invalid", namespace)
-			}
-			if len(namespace) < 1 || len(namespace) > 63 {
-				return &userv3.Group{}, nil, fmt.Errorf("namespace %q is invalid. must be no more than 63 characters", namespace)
+			if err := validateBindingNamespace(namespace, pnr.GetNamespaceSelector()); err != nil {
+				return &userv3.Group{}, nil, err
 			}
 
 			pgnrObj := models.ProjectGroupNamespaceRole{
-				CreatedAt:      time.Now(),
-				ModifiedAt:     time.Now(),
-				Trash:          false,
-				PartnerId:      ids.Partner,
-				OrganizationId: ids.Organization,
-				RoleId:         roleId,
-				GroupId:        ids.Id,
-				ProjectId:      projectId,
-				Namespace:      namespace,
-				Active:         true,
+				CreatedAt:         time.Now(),
+				ModifiedAt:        time.Now(),
+				Trash:             false,
+				PartnerId:         ids.Partner,
+				OrganizationId:    ids.Organization,
+				RoleId:            roleId,
+				GroupId:           ids.Id,
+				ProjectId:         projectId,
+				Namespace:         namespace,
+				NamespaceSelector: pnr.GetNamespaceSelector(),
+				StartsAt:          w.StartsAt,
+				ExpiresAt:         w.ExpiresAt,
+				Active:            w.active(time.Now()),
 			}
 			pgnr = append(pgnr, pgnrObj)
 
-			ps = append(ps, &authzv1.Policy{
+			ps = w.appendPolicy(ps, &authzv1.Policy{
 				Sub:  "g:" + group.GetMetadata().GetName(),
-				Ns:   namespace,
+				Ns:   policyNamespace(namespace),
 				Proj: project,
 				Org:  org,
 				Obj:  role,
@@ -419,6 +426,7 @@ func (s *groupService) toV3Group(ctx context.Context, db bun.IDB, group *userv3.
 	group.Metadata = &v3.Metadata{
 		Name:         grp.Name,
 		Description:  grp.Description,
+		Id:           grp.ID.String(),
 		Organization: group.GetMetadata().GetOrganization(),
 		Partner:      group.GetMetadata().GetPartner(),
 		Labels:       labels,
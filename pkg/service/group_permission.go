@@ -17,6 +17,7 @@ type GroupPermissionService interface {
 	GetGroupProjectsByPermission(ctx context.Context, groupNames []string, orgID, partnerID string, permission string) ([]sentry.GroupPermission, error)
 	GetGroupPermissionsByProjectIDPermissions(ctx context.Context, groupNames []string, orgID, partnerID string, projects []string, permissions []string) ([]sentry.GroupPermission, error)
 	GetProjectByGroup(ctx context.Context, groupNames []string, orgID, partnerID string) ([]sentry.GroupPermission, error)
+	GetGroupKubernetesRoleGrants(ctx context.Context, groupNames []string, orgID, partnerID string, projects []string) ([]models.KubernetesRoleGrant, error)
 }
 
 // groupPermissionService implements GroupPermissionService
@@ -81,6 +82,10 @@ func (s *groupPermissionService) GetProjectByGroup(ctx context.Context, groupNam
 	return groupPermissions, nil
 }
 
+func (s *groupPermissionService) GetGroupKubernetesRoleGrants(ctx context.Context, groupNames []string, orgID, partnerID string, projects []string) ([]models.KubernetesRoleGrant, error) {
+	return dao.GetGroupKubernetesRoleGrants(ctx, s.db, groupNames, uuid.MustParse(orgID), uuid.MustParse(partnerID), projects)
+}
+
 func prepareGroupPermissionResponse(gps models.GroupPermission) sentry.GroupPermission {
 	var urls []*sentry.PermissionURL
 	if gps.Urls != nil {
@@ -69,3 +69,28 @@ func TestGetGroupPermissionsByProjectIDPermissions(t *testing.T) {
 		t.Fatal("could not get GetGroupProjectsByPermission:", err)
 	}
 }
+
+func TestGetGroupKubernetesRoleGrants(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	ps := NewGroupPermissionService(db)
+
+	groupNames := []string{"mygroup"}
+	oid := uuid.New().String()
+	pid := uuid.New().String()
+	projid := uuid.New().String()
+
+	mock.ExpectQuery(`SELECT rr.name AS role_name, '' AS namespace, '' AS namespace_selector, rr.kubernetes_rules FROM sentry_group_permission AS sgp .* UNION .*FROM authsrv_projectgroupnamespacerole AS pgnr`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"role_name", "namespace", "kubernetes_rules"}).
+		AddRow("pod-reader", "", `[{"apiGroups":[""],"resources":["pods"],"verbs":["get"]}]`).
+		AddRow("cm-editor", "web", `[{"apiGroups":[""],"resources":["configmaps"],"verbs":["update"]}]`))
+
+	grants, err := ps.GetGroupKubernetesRoleGrants(context.Background(), groupNames, oid, pid, []string{projid})
+	if err != nil {
+		t.Fatal("could not get GetGroupKubernetesRoleGrants:", err)
+	}
+	if len(grants) != 2 || grants[1].Namespace != "web" || grants[1].KubernetesRules[0].Verbs[0] != "update" {
+		t.Errorf("unexpected grants %+v", grants)
+	}
+}
@@ -44,7 +44,9 @@ func NewIdpService(db *bun.DB, hostUrl string, al *zap.Logger) IdpService {
 	return &idpService{db: db, appHost: hostUrl, al: al}
 }
 
-func generateAcsURL(id string, hostUrl string) string {
+// GenerateAcsURL returns the assertion consumer service url of an
+// idp, it is also the entity id of paralus as service provider
+func GenerateAcsURL(id string, hostUrl string) string {
 	b, _ := url.Parse(hostUrl)
 	return fmt.Sprintf("%s/auth/v3/sso/acs/%s", b.String(), id)
 }
@@ -166,24 +168,24 @@ func (s *idpService) Create(ctx context.Context, idp *systemv3.Idp) (*systemv3.I
 		GroupAttributeName: idp.Spec.GetGroupAttributeName(),
 		SaeEnabled:         idp.Spec.GetSaeEnabled(),
 	}
-	if entity.SaeEnabled {
-		baseURL, err := url.Parse(s.appHost)
-		if err != nil {
-			return &systemv3.Idp{}, err
-		}
-		spcert, spkey, err := generateSpCert(baseURL.Host)
-		if err != nil {
-			return &systemv3.Idp{}, err
-		}
-		entity.SpCert = spcert
-		entity.SpKey = spkey
+	// the sp certificate signs saml requests, it is needed even
+	// when assertions are not encrypted
+	baseURL, err := url.Parse(s.appHost)
+	if err != nil {
+		return &systemv3.Idp{}, err
+	}
+	spcert, spkey, err := generateSpCert(baseURL.Host)
+	if err != nil {
+		return &systemv3.Idp{}, err
 	}
+	entity.SpCert = spcert
+	entity.SpKey = spkey
 	_, err = dao.Create(ctx, s.db, entity)
 	if err != nil {
 		return &systemv3.Idp{}, err
 	}
 
-	acsURL := generateAcsURL(entity.Id.String(), s.appHost)
+	acsURL := GenerateAcsURL(entity.Id.String(), s.appHost)
 	rv := &systemv3.Idp{
 		ApiVersion: apiVersion,
 		Kind:       "Idp",
@@ -225,7 +227,7 @@ func (s *idpService) GetByID(ctx context.Context, idp *systemv3.Idp) (*systemv3.
 		return &systemv3.Idp{}, err
 	}
 
-	acsURL := generateAcsURL(entity.Id.String(), s.appHost)
+	acsURL := GenerateAcsURL(entity.Id.String(), s.appHost)
 	rv := &systemv3.Idp{
 		ApiVersion: apiVersion,
 		Kind:       "Idp",
@@ -266,7 +268,7 @@ func (s *idpService) GetByName(ctx context.Context, idp *systemv3.Idp) (*systemv
 		return &systemv3.Idp{}, err
 	}
 
-	acsURL := generateAcsURL(entity.Id.String(), s.appHost)
+	acsURL := GenerateAcsURL(entity.Id.String(), s.appHost)
 	rv := &systemv3.Idp{
 		ApiVersion: apiVersion,
 		Kind:       "Idp",
@@ -343,8 +345,10 @@ func (s *idpService) Update(ctx context.Context, idp *systemv3.Idp) (*systemv3.I
 		MetadataFilename:   idp.Spec.GetMetadataFilename(),
 		GroupAttributeName: idp.Spec.GetGroupAttributeName(),
 		SaeEnabled:         idp.Spec.GetSaeEnabled(),
+		SpCert:             existingIdp.SpCert,
+		SpKey:              existingIdp.SpKey,
 	}
-	if entity.SaeEnabled {
+	if entity.SaeEnabled || entity.SpKey == "" {
 		baseURL, err := url.Parse(s.appHost)
 		if err != nil {
 			return &systemv3.Idp{}, err
@@ -362,7 +366,7 @@ func (s *idpService) Update(ctx context.Context, idp *systemv3.Idp) (*systemv3.I
 		return &systemv3.Idp{}, err
 	}
 
-	acsURL := generateAcsURL(idp.GetMetadata().GetId(), s.appHost)
+	acsURL := GenerateAcsURL(idp.GetMetadata().GetId(), s.appHost)
 	rv := &systemv3.Idp{
 		ApiVersion: apiVersion,
 		Kind:       "Idp",
@@ -405,7 +409,7 @@ func (s *idpService) List(ctx context.Context) (*systemv3.IdpList, error) {
 	// Get idps only till limit
 	var result []*systemv3.Idp
 	for _, entity := range entities {
-		acsURL := generateAcsURL(entity.Id.String(), s.appHost)
+		acsURL := GenerateAcsURL(entity.Id.String(), s.appHost)
 		e := &systemv3.Idp{
 			ApiVersion: apiVersion,
 			Kind:       "Idp",
@@ -23,7 +23,7 @@ type KubeconfigSettingService interface {
 
 // kubeconfigSettingService implements KubeconfigSettingService
 type kubeconfigSettingService struct {
-	db *bun.DB
+	db bun.IDB
 }
 
 // NewKubeconfigSettingService return new kubeconfig setting service
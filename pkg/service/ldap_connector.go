@@ -0,0 +1,625 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/dao"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/paralus/paralus/pkg/common"
+	"github.com/paralus/paralus/pkg/ldap"
+	"github.com/paralus/paralus/pkg/query"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	userv3 "github.com/paralus/paralus/proto/types/userpb/v3"
+	bun "github.com/uptrace/bun"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	ldapConnectorKind     = "LdapConnector"
+	ldapConnectorListKind = "LdapConnectorList"
+)
+
+// LdapConnectorService is the interface for LDAP connector operations
+type LdapConnectorService interface {
+	// create ldap connector
+	Create(context.Context, *userv3.LdapConnector) (*userv3.LdapConnector, error)
+	// get ldap connector by name
+	GetByName(context.Context, *userv3.LdapConnector) (*userv3.LdapConnector, error)
+	// update ldap connector
+	Update(context.Context, *userv3.LdapConnector) (*userv3.LdapConnector, error)
+	// delete ldap connector, groups it synchronized are kept
+	Delete(context.Context, *userv3.LdapConnector) (*userv3.LdapConnector, error)
+	// list ldap connectors
+	List(context.Context, ...query.Option) (*userv3.LdapConnectorList, error)
+	// Sync synchronizes the directory groups of a connector into groups
+	Sync(context.Context, *userv3.LdapConnectorSync) (*userv3.LdapConnectorSync, error)
+	// SyncDue synchronizes the connectors whose sync interval elapsed
+	SyncDue(context.Context) error
+}
+
+// ldapConnectorService implements LdapConnectorService
+type ldapConnectorService struct {
+	db *bun.DB
+	gs GroupService
+	al *zap.Logger
+	// groups reads the groups of a directory
+	groups func(context.Context, ldap.Config) ([]ldap.Group, error)
+}
+
+// NewLdapConnectorService return new ldap connector service
+func NewLdapConnectorService(db *bun.DB, gs GroupService, al *zap.Logger) LdapConnectorService {
+	return &ldapConnectorService{db: db, gs: gs, al: al, groups: ldap.Groups}
+}
+
+func ldapConfig(conn *models.LdapConnector) ldap.Config {
+	return ldap.Config{
+		URL:                conn.URL,
+		BindDN:             conn.BindDN,
+		BindPassword:       conn.BindPassword,
+		StartTLS:           conn.StartTLS,
+		InsecureSkipVerify: conn.InsecureSkipVerify,
+		GroupSearchBases:   conn.GroupSearchBases,
+		GroupFilter:        conn.GroupFilter,
+		GroupNameAttribute: conn.GroupNameAttribute,
+		GroupIDAttribute:   conn.GroupIDAttribute,
+		MemberAttribute:    conn.MemberAttribute,
+		UserEmailAttribute: conn.UserEmailAttribute,
+		NestedGroups:       conn.NestedGroups,
+	}
+}
+
+// setLdapConnectorSpec sets the spec of conn to spec, the bind password
+// is kept when spec has none
+func setLdapConnectorSpec(conn *models.LdapConnector, spec *userv3.LdapConnectorSpec) error {
+	conn.URL = spec.GetUrl()
+	conn.BindDN = spec.GetBindDn()
+	if spec.GetBindPassword() != "" || spec.GetBindDn() == "" {
+		conn.BindPassword = spec.GetBindPassword()
+	}
+	conn.StartTLS = spec.GetStartTls()
+	conn.InsecureSkipVerify = spec.GetInsecureSkipVerify()
+	conn.GroupSearchBases = spec.GetGroupSearchBases()
+	conn.GroupFilter = spec.GetGroupFilter()
+	conn.GroupNameAttribute = spec.GetGroupNameAttribute()
+	conn.GroupIDAttribute = spec.GetGroupIdAttribute()
+	conn.MemberAttribute = spec.GetMemberAttribute()
+	conn.UserEmailAttribute = spec.GetUserEmailAttribute()
+	conn.NestedGroups = spec.GetNestedGroups()
+	conn.SyncIntervalMinutes = spec.GetSyncIntervalMinutes()
+	if conn.SyncIntervalMinutes < 0 {
+		return fmt.Errorf("syncIntervalMinutes should not be negative")
+	}
+	return ldap.Validate(ldapConfig(conn))
+}
+
+func (s *ldapConnectorService) getPartnerOrganization(ctx context.Context, meta *commonv3.Metadata) (uuid.UUID, uuid.UUID, error) {
+	partnerId, err := dao.GetPartnerId(ctx, s.db, meta.GetPartner())
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	organizationId, err := dao.GetOrganizationId(ctx, s.db, meta.GetOrganization())
+	if err != nil {
+		return partnerId, uuid.Nil, err
+	}
+	return partnerId, organizationId, nil
+}
+
+func (s *ldapConnectorService) getLdapConnector(ctx context.Context, meta *commonv3.Metadata) (*models.LdapConnector, error) {
+	partnerId, organizationId, err := s.getPartnerOrganization(ctx, meta)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get partner and org id")
+	}
+	entity, err := dao.GetByNamePartnerOrg(ctx, s.db, meta.GetName(), uuid.NullUUID{UUID: partnerId, Valid: true}, uuid.NullUUID{UUID: organizationId, Valid: true}, &models.LdapConnector{})
+	if err != nil {
+		return nil, fmt.Errorf("no ldap connector found with name '%v'", meta.GetName())
+	}
+	if conn, ok := entity.(*models.LdapConnector); ok {
+		return conn, nil
+	}
+	return nil, fmt.Errorf("no ldap connector found with name '%v'", meta.GetName())
+}
+
+func (s *ldapConnectorService) toV3LdapConnector(lc *userv3.LdapConnector, conn *models.LdapConnector) *userv3.LdapConnector {
+	labels := make(map[string]string)
+	labels["organization"] = lc.GetMetadata().GetOrganization()
+	labels["partner"] = lc.GetMetadata().GetPartner()
+
+	lc.ApiVersion = apiVersion
+	lc.Kind = ldapConnectorKind
+	lc.Metadata = &commonv3.Metadata{
+		Name:         conn.Name,
+		Description:  conn.Description,
+		Id:           conn.ID.String(),
+		Organization: lc.GetMetadata().GetOrganization(),
+		Partner:      lc.GetMetadata().GetPartner(),
+		Labels:       labels,
+		ModifiedAt:   timestamppb.New(conn.ModifiedAt),
+		CreatedAt:    timestamppb.New(conn.CreatedAt),
+	}
+	spec := &userv3.LdapConnectorSpec{
+		Url:                 conn.URL,
+		BindDn:              conn.BindDN,
+		StartTls:            conn.StartTLS,
+		InsecureSkipVerify:  conn.InsecureSkipVerify,
+		GroupSearchBases:    conn.GroupSearchBases,
+		GroupFilter:         conn.GroupFilter,
+		GroupNameAttribute:  conn.GroupNameAttribute,
+		GroupIdAttribute:    conn.GroupIDAttribute,
+		MemberAttribute:     conn.MemberAttribute,
+		UserEmailAttribute:  conn.UserEmailAttribute,
+		NestedGroups:        conn.NestedGroups,
+		SyncIntervalMinutes: conn.SyncIntervalMinutes,
+		LastSyncError:       conn.LastSyncError,
+	}
+	if !conn.LastSyncAt.IsZero() {
+		spec.LastSyncAt = timestamppb.New(conn.LastSyncAt)
+	}
+	if len(conn.LastSyncDiff) > 0 {
+		diff := &userv3.LdapSyncDiff{}
+		if err := protojson.Unmarshal(conn.LastSyncDiff, diff); err != nil {
+			_log.Warnw("unable to read last sync diff of ldap connector", "name", conn.Name, "error", err)
+		} else {
+			spec.LastSyncDiff = diff
+		}
+	}
+	lc.Spec = spec
+	return lc
+}
+
+func (s *ldapConnectorService) Create(ctx context.Context, lc *userv3.LdapConnector) (*userv3.LdapConnector, error) {
+	partnerId, organizationId, err := s.getPartnerOrganization(ctx, lc.GetMetadata())
+	if err != nil {
+		return nil, fmt.Errorf("unable to get partner and org id")
+	}
+	e, _ := dao.GetIdByNamePartnerOrg(ctx, s.db, lc.GetMetadata().GetName(), uuid.NullUUID{UUID: partnerId, Valid: true}, uuid.NullUUID{UUID: organizationId, Valid: true}, &models.LdapConnector{})
+	if e != nil {
+		return nil, fmt.Errorf("ldap connector '%v' already exists", lc.GetMetadata().GetName())
+	}
+
+	conn := models.LdapConnector{
+		Name:           lc.GetMetadata().GetName(),
+		Description:    lc.GetMetadata().GetDescription(),
+		CreatedAt:      time.Now(),
+		ModifiedAt:     time.Now(),
+		Trash:          false,
+		OrganizationId: organizationId,
+		PartnerId:      partnerId,
+	}
+	if err := setLdapConnectorSpec(&conn, lc.GetSpec()); err != nil {
+		return nil, err
+	}
+
+	_, err = dao.Create(ctx, s.db, &conn)
+	if err != nil {
+		return &userv3.LdapConnector{}, err
+	}
+
+	CreateLdapConnectorAuditEvent(ctx, s.al, AuditActionCreate, conn.Name)
+	return s.toV3LdapConnector(lc, &conn), nil
+}
+
+func (s *ldapConnectorService) GetByName(ctx context.Context, lc *userv3.LdapConnector) (*userv3.LdapConnector, error) {
+	conn, err := s.getLdapConnector(ctx, lc.GetMetadata())
+	if err != nil {
+		return &userv3.LdapConnector{}, err
+	}
+	return s.toV3LdapConnector(lc, conn), nil
+}
+
+func (s *ldapConnectorService) Update(ctx context.Context, lc *userv3.LdapConnector) (*userv3.LdapConnector, error) {
+	conn, err := s.getLdapConnector(ctx, lc.GetMetadata())
+	if err != nil {
+		return &userv3.LdapConnector{}, err
+	}
+	conn.Description = lc.GetMetadata().GetDescription()
+	conn.ModifiedAt = time.Now()
+	if err := setLdapConnectorSpec(conn, lc.GetSpec()); err != nil {
+		return nil, err
+	}
+
+	_, err = dao.Update(ctx, s.db, conn.ID, conn)
+	if err != nil {
+		return &userv3.LdapConnector{}, err
+	}
+
+	CreateLdapConnectorAuditEvent(ctx, s.al, AuditActionUpdate, conn.Name)
+	return s.toV3LdapConnector(lc, conn), nil
+}
+
+func (s *ldapConnectorService) Delete(ctx context.Context, lc *userv3.LdapConnector) (*userv3.LdapConnector, error) {
+	conn, err := s.getLdapConnector(ctx, lc.GetMetadata())
+	if err != nil {
+		return &userv3.LdapConnector{}, err
+	}
+
+	// groups of the connector become regular groups
+	err = s.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewDelete().Model((*models.LdapGroup)(nil)).Where("connector_id = ?", conn.ID).Exec(ctx); err != nil {
+			return err
+		}
+		return dao.Delete(ctx, tx, conn.ID, conn)
+	})
+	if err != nil {
+		return &userv3.LdapConnector{}, err
+	}
+
+	CreateLdapConnectorAuditEvent(ctx, s.al, AuditActionDelete, conn.Name)
+	return lc, nil
+}
+
+func (s *ldapConnectorService) List(ctx context.Context, opts ...query.Option) (*userv3.LdapConnectorList, error) {
+	var items []*userv3.LdapConnector
+	lcList := &userv3.LdapConnectorList{
+		ApiVersion: apiVersion,
+		Kind:       ldapConnectorListKind,
+		Metadata: &commonv3.ListMetadata{
+			Count: 0,
+		},
+	}
+
+	queryOptions := commonv3.QueryOptions{}
+	for _, opt := range opts {
+		opt(&queryOptions)
+	}
+
+	orgId, err := dao.GetOrganizationId(ctx, s.db, queryOptions.Organization)
+	if err != nil {
+		return lcList, err
+	}
+	partId, err := dao.GetPartnerId(ctx, s.db, queryOptions.Partner)
+	if err != nil {
+		return lcList, err
+	}
+	var connectors []models.LdapConnector
+	entities, err := dao.ListFiltered(ctx, s.db,
+		uuid.NullUUID{UUID: partId, Valid: true}, uuid.NullUUID{UUID: orgId, Valid: true},
+		uuid.NullUUID{Valid: false},
+		&connectors,
+		queryOptions.Q,
+		queryOptions.OrderBy,
+		queryOptions.Order,
+		int(queryOptions.Limit),
+		int(queryOptions.Offset),
+	)
+	if err != nil {
+		return lcList, err
+	}
+	if connectors, ok := entities.(*[]models.LdapConnector); ok {
+		for _, conn := range *connectors {
+			entry := &userv3.LdapConnector{Metadata: &commonv3.Metadata{
+				Organization: queryOptions.Organization,
+				Partner:      queryOptions.Partner,
+			}}
+			items = append(items, s.toV3LdapConnector(entry, &conn))
+		}
+
+		lcList.Metadata = &commonv3.ListMetadata{
+			Count: int64(len(items)),
+		}
+		lcList.Items = items
+	}
+
+	return lcList, nil
+}
+
+func (s *ldapConnectorService) Sync(ctx context.Context, req *userv3.LdapConnectorSync) (*userv3.LdapConnectorSync, error) {
+	conn, err := s.getLdapConnector(ctx, req.GetMetadata())
+	if err != nil {
+		return &userv3.LdapConnectorSync{}, err
+	}
+	diff, err := s.sync(ctx, conn, req.GetDryRun())
+	if err != nil {
+		return &userv3.LdapConnectorSync{}, err
+	}
+	req.Diff = diff
+	return req, nil
+}
+
+func (s *ldapConnectorService) SyncDue(ctx context.Context) error {
+	var connectors []models.LdapConnector
+	err := s.db.NewSelect().Model(&connectors).
+		Where("trash = ?", false).
+		Where("sync_interval_minutes > 0").
+		Scan(ctx)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	for i := range connectors {
+		conn := &connectors[i]
+		interval := time.Duration(conn.SyncIntervalMinutes) * time.Minute
+		if !conn.LastSyncAt.IsZero() && now.Before(conn.LastSyncAt.Add(interval)) {
+			continue
+		}
+		// every replica runs the worker, the sync is done by the one
+		// claiming it
+		claimed, err := dao.ClaimLdapConnectorSync(ctx, s.db, conn.ID, conn.LastSyncAt, now)
+		if err != nil {
+			return err
+		}
+		if !claimed {
+			continue
+		}
+		sctx := context.WithValue(ctx, common.SessionDataKey, &commonv3.SessionData{Username: "ldapconnector/" + conn.Name})
+		if _, err := s.sync(sctx, conn, false); err != nil {
+			_log.Warnw("unable to sync ldap connector", "name", conn.Name, "error", err)
+		}
+	}
+	return nil
+}
+
+// sync reconciles the groups of the directory of conn into groups, the
+// result is recorded on the connector unless it is a dry run
+func (s *ldapConnectorService) sync(ctx context.Context, conn *models.LdapConnector, dryRun bool) (*userv3.LdapSyncDiff, error) {
+	groups, err := s.groups(ctx, ldapConfig(conn))
+	var diff *userv3.LdapSyncDiff
+	if err == nil {
+		diff, err = s.reconcile(ctx, conn, groups, dryRun)
+	}
+	if dryRun {
+		return diff, err
+	}
+
+	conn.LastSyncAt = time.Now()
+	conn.LastSyncError = ""
+	if err != nil {
+		conn.LastSyncError = err.Error()
+	}
+	conn.LastSyncDiff = nil
+	if diff != nil {
+		if b, merr := protojson.Marshal(diff); merr == nil {
+			conn.LastSyncDiff = b
+		}
+	}
+	_, uerr := s.db.NewUpdate().Model(conn).
+		Column("last_sync_at", "last_sync_error", "last_sync_diff").
+		WherePK().
+		Exec(ctx)
+	if uerr != nil {
+		_log.Warnw("unable to record sync of ldap connector", "name", conn.Name, "error", uerr)
+	}
+	if conn.LastSyncError != "" {
+		return diff, errors.New(conn.LastSyncError)
+	}
+
+	LdapConnectorSyncAuditEvent(ctx, s.al, conn.Name, map[string]string{
+		"groups_added":    strconv.Itoa(len(diff.GroupsAdded)),
+		"groups_removed":  strconv.Itoa(len(diff.GroupsRemoved)),
+		"members_added":   strconv.Itoa(len(diff.MembersAdded)),
+		"members_removed": strconv.Itoa(len(diff.MembersRemoved)),
+		"skipped_members": strconv.Itoa(len(diff.SkippedMembers)),
+		"conflicts":       strconv.Itoa(len(diff.Conflicts)),
+	})
+	return diff, nil
+}
+
+// reconcile makes the groups synchronized by conn match the directory
+// groups. Directory groups are tracked by their id, a group renamed in
+// the directory keeps its name. Only groups created by the connector
+// are changed or deleted.
+func (s *ldapConnectorService) reconcile(ctx context.Context, conn *models.LdapConnector, groups []ldap.Group, dryRun bool) (*userv3.LdapSyncDiff, error) {
+	partner, err := dao.GetPartnerName(ctx, s.db, conn.PartnerId)
+	if err != nil {
+		return nil, err
+	}
+	org, err := dao.GetOrganizationName(ctx, s.db, conn.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+	mappings, err := dao.GetLdapGroups(ctx, s.db, conn.ID)
+	if err != nil {
+		return nil, err
+	}
+	mapped := map[string]*models.LdapGroup{}
+	for i := range mappings {
+		mapped[mappings[i].ExternalId] = &mappings[i]
+	}
+	var emails []string
+	for _, g := range groups {
+		emails = append(emails, g.Members...)
+	}
+	users, err := dao.GetUserEmails(ctx, s.db, emails)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &userv3.LdapSyncDiff{}
+	seen := map[string]bool{}
+	names := map[string]bool{}
+	for _, g := range groups {
+		seen[g.ID] = true
+		var members []string
+		for _, m := range g.Members {
+			if email, ok := users[strings.ToLower(m)]; ok {
+				members = append(members, email)
+				continue
+			}
+			diff.SkippedMembers = append(diff.SkippedMembers, &userv3.LdapGroupMember{Group: g.Name, User: m})
+		}
+		if names[g.Name] {
+			// directory groups of the same name in different subtrees
+			diff.Conflicts = append(diff.Conflicts, g.Name)
+			continue
+		}
+		names[g.Name] = true
+
+		mapping := mapped[g.ID]
+		var grp *models.Group
+		if mapping != nil {
+			grp = &models.Group{}
+			if _, err := dao.GetByID(ctx, s.db, mapping.GroupId, grp); err != nil {
+				if !errors.Is(err, sql.ErrNoRows) {
+					return diff, err
+				}
+				// deleted outside of the connector, it is created again
+				grp = nil
+			}
+		}
+
+		if grp == nil {
+			if e, _ := dao.GetIdByNamePartnerOrg(ctx, s.db, g.Name, uuid.NullUUID{UUID: conn.PartnerId, Valid: true}, uuid.NullUUID{UUID: conn.OrganizationId, Valid: true}, &models.Group{}); e != nil {
+				diff.Conflicts = append(diff.Conflicts, g.Name)
+				continue
+			}
+			diff.GroupsAdded = append(diff.GroupsAdded, g.Name)
+			for _, m := range members {
+				diff.MembersAdded = append(diff.MembersAdded, &userv3.LdapGroupMember{Group: g.Name, User: m})
+			}
+			if dryRun {
+				continue
+			}
+			if err := s.createGroup(ctx, conn, mapping, g, members, partner, org); err != nil {
+				return diff, err
+			}
+			continue
+		}
+
+		current, err := dao.GetUsers(ctx, s.db, grp.ID)
+		if err != nil {
+			return diff, err
+		}
+		var currentEmails []string
+		for _, u := range current {
+			if email, ok := u.Traits["email"].(string); ok {
+				currentEmails = append(currentEmails, email)
+			}
+		}
+		added, removed := diffMembers(currentEmails, members)
+		for _, m := range added {
+			diff.MembersAdded = append(diff.MembersAdded, &userv3.LdapGroupMember{Group: grp.Name, User: m})
+		}
+		for _, m := range removed {
+			diff.MembersRemoved = append(diff.MembersRemoved, &userv3.LdapGroupMember{Group: grp.Name, User: m})
+		}
+		if dryRun {
+			continue
+		}
+		if mapping.DN != g.DN {
+			mapping.DN = g.DN
+			if _, err := s.db.NewUpdate().Model(mapping).Column("dn").WherePK().Exec(ctx); err != nil {
+				return diff, err
+			}
+		}
+		if len(added) == 0 && len(removed) == 0 {
+			continue
+		}
+		group, err := s.gs.GetByName(ctx, &userv3.Group{Metadata: &commonv3.Metadata{
+			Name:         grp.Name,
+			Organization: org,
+			Partner:      partner,
+		}})
+		if err != nil {
+			return diff, err
+		}
+		group.Spec.Users = members
+		if _, err := s.gs.Update(ctx, group); err != nil {
+			return diff, err
+		}
+	}
+
+	for i := range mappings {
+		mapping := &mappings[i]
+		if seen[mapping.ExternalId] {
+			continue
+		}
+		grp := &models.Group{}
+		_, err := dao.GetByID(ctx, s.db, mapping.GroupId, grp)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return diff, err
+		}
+		if err == nil {
+			diff.GroupsRemoved = append(diff.GroupsRemoved, grp.Name)
+		}
+		if dryRun {
+			continue
+		}
+		if err == nil {
+			_, err = s.gs.Delete(ctx, &userv3.Group{Metadata: &commonv3.Metadata{
+				Name:         grp.Name,
+				Organization: org,
+				Partner:      partner,
+			}})
+			if err != nil {
+				return diff, err
+			}
+		}
+		if _, err := s.db.NewDelete().Model(mapping).WherePK().Exec(ctx); err != nil {
+			return diff, err
+		}
+	}
+	return diff, nil
+}
+
+// createGroup creates the group of the directory group g and records
+// it as synchronized by conn, mapping is the previous record of g when
+// its group was deleted
+func (s *ldapConnectorService) createGroup(ctx context.Context, conn *models.LdapConnector, mapping *models.LdapGroup, g ldap.Group, members []string, partner, org string) error {
+	_, err := s.gs.Create(ctx, &userv3.Group{
+		Metadata: &commonv3.Metadata{
+			Name:         g.Name,
+			Description:  fmt.Sprintf("Synchronized from LDAP connector %s", conn.Name),
+			Organization: org,
+			Partner:      partner,
+		},
+		Spec: &userv3.GroupSpec{Users: members},
+	})
+	if err != nil {
+		return err
+	}
+	grp := &models.Group{}
+	_, err = dao.GetIdByNamePartnerOrg(ctx, s.db, g.Name, uuid.NullUUID{UUID: conn.PartnerId, Valid: true}, uuid.NullUUID{UUID: conn.OrganizationId, Valid: true}, grp)
+	if err != nil {
+		return err
+	}
+	if mapping != nil {
+		mapping.GroupId = grp.ID
+		mapping.DN = g.DN
+		_, err = s.db.NewUpdate().Model(mapping).Column("group_id", "dn").WherePK().Exec(ctx)
+		return err
+	}
+	_, err = dao.Create(ctx, s.db, &models.LdapGroup{
+		ConnectorId: conn.ID,
+		ExternalId:  g.ID,
+		GroupId:     grp.ID,
+		DN:          g.DN,
+		CreatedAt:   time.Now(),
+	})
+	return err
+}
+
+// diffMembers returns the members of desired not in current and the
+// members of current not in desired, sorted
+func diffMembers(current, desired []string) ([]string, []string) {
+	in := func(values []string, v string) bool {
+		for _, value := range values {
+			if value == v {
+				return true
+			}
+		}
+		return false
+	}
+	var added, removed []string
+	for _, m := range desired {
+		if !in(current, m) {
+			added = append(added, m)
+		}
+	}
+	for _, m := range current {
+		if !in(desired, m) {
+			removed = append(removed, m)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+	return added, removed
+}
@@ -0,0 +1,365 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/pkg/ldap"
+	v3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	userv3 "github.com/paralus/paralus/proto/types/userpb/v3"
+)
+
+// fakeGroupService records the groups changed through it
+type fakeGroupService struct {
+	GroupService
+	created []*userv3.Group
+	updated []*userv3.Group
+	deleted []string
+}
+
+func (f *fakeGroupService) Create(ctx context.Context, group *userv3.Group) (*userv3.Group, error) {
+	f.created = append(f.created, group)
+	return group, nil
+}
+
+func (f *fakeGroupService) GetByName(ctx context.Context, group *userv3.Group) (*userv3.Group, error) {
+	group.Spec = &userv3.GroupSpec{ProjectNamespaceRoles: []*userv3.ProjectNamespaceRole{{Role: "PROJECT_READ_ONLY", Project: "default"}}}
+	return group, nil
+}
+
+func (f *fakeGroupService) Update(ctx context.Context, group *userv3.Group) (*userv3.Group, error) {
+	f.updated = append(f.updated, group)
+	return group, nil
+}
+
+func (f *fakeGroupService) Delete(ctx context.Context, group *userv3.Group) (*userv3.Group, error) {
+	f.deleted = append(f.deleted, group.GetMetadata().GetName())
+	return group, nil
+}
+
+func ldapConnectorRows(id, name string) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"id", "name", "url", "group_search_bases"}).
+		AddRow(id, name, "ldap://ldap.example.com", "{ou=groups,dc=example,dc=com}")
+}
+
+func TestCreateLdapConnector(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	lcs := NewLdapConnectorService(db, &fakeGroupService{}, getLogger())
+	luuid := uuid.NewString()
+
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+	mock.ExpectQuery(`SELECT "ldapconnector"."id" FROM "authsrv_ldap_connector" AS "ldapconnector" WHERE .organization_id = '` + ouuid + `'. AND .partner_id = '` + puuid + `'. AND .name = 'ldap-` + luuid + `'.`).
+		WillReturnError(fmt.Errorf("no data available"))
+	mock.ExpectQuery(`INSERT INTO "authsrv_ldap_connector" .* VALUES .*'ldaps://ldap.example.com', 'cn=admin,dc=example,dc=com', 'secret'`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(luuid))
+
+	lc := &userv3.LdapConnector{
+		Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Name: "ldap-" + luuid},
+		Spec: &userv3.LdapConnectorSpec{
+			Url:              "ldaps://ldap.example.com",
+			BindDn:           "cn=admin,dc=example,dc=com",
+			BindPassword:     "secret",
+			GroupSearchBases: []string{"ou=groups,dc=example,dc=com"},
+		},
+	}
+	lc, err := lcs.Create(context.Background(), lc)
+	if err != nil {
+		t.Fatal("could not create ldap connector:", err)
+	}
+	if lc.GetSpec().GetBindPassword() != "" {
+		t.Error("bind password returned")
+	}
+	if lc.GetMetadata().GetId() != luuid {
+		t.Errorf("invalid id, expected '%v'; got '%v'", luuid, lc.GetMetadata().GetId())
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCreateLdapConnectorInvalid(t *testing.T) {
+	tt := []struct {
+		name string
+		spec *userv3.LdapConnectorSpec
+	}{
+		{"no url", &userv3.LdapConnectorSpec{GroupSearchBases: []string{"dc=example,dc=com"}}},
+		{"no search base", &userv3.LdapConnectorSpec{Url: "ldap://ldap.example.com"}},
+		{"invalid filter", &userv3.LdapConnectorSpec{Url: "ldap://ldap.example.com", GroupSearchBases: []string{"dc=example,dc=com"}, GroupFilter: "objectClass="}},
+		{"negative interval", &userv3.LdapConnectorSpec{Url: "ldap://ldap.example.com", GroupSearchBases: []string{"dc=example,dc=com"}, SyncIntervalMinutes: -1}},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock := getDB(t)
+			defer db.Close()
+
+			lcs := NewLdapConnectorService(db, &fakeGroupService{}, getLogger())
+			puuid, ouuid := addParterOrgFetchExpectation(mock)
+			mock.ExpectQuery(`SELECT "ldapconnector"."id" FROM "authsrv_ldap_connector"`).
+				WillReturnError(fmt.Errorf("no data available"))
+
+			lc := &userv3.LdapConnector{
+				Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Name: "ldap"},
+				Spec:     tc.spec,
+			}
+			if _, err := lcs.Create(context.Background(), lc); err == nil {
+				t.Error("created invalid ldap connector")
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestUpdateLdapConnectorKeepsBindPassword(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	lcs := NewLdapConnectorService(db, &fakeGroupService{}, getLogger())
+	luuid := uuid.NewString()
+
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+	mock.ExpectQuery(`SELECT "ldapconnector"."id", "ldapconnector"."name", .* FROM "authsrv_ldap_connector" AS "ldapconnector" WHERE .*name = 'ldap-` + luuid + `'`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "bind_dn", "bind_password"}).AddRow(luuid, "ldap-"+luuid, "cn=admin,dc=example,dc=com", "secret"))
+	mock.ExpectExec(`UPDATE "authsrv_ldap_connector" AS "ldapconnector" SET .*"bind_dn" = 'cn=admin,dc=example,dc=com', "bind_password" = 'secret'.* WHERE .id = '` + luuid + `'`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	lc := &userv3.LdapConnector{
+		Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Name: "ldap-" + luuid},
+		Spec: &userv3.LdapConnectorSpec{
+			Url:                 "ldap://ldap.example.com",
+			BindDn:              "cn=admin,dc=example,dc=com",
+			GroupSearchBases:    []string{"ou=groups,dc=example,dc=com"},
+			SyncIntervalMinutes: 30,
+		},
+	}
+	if _, err := lcs.Update(context.Background(), lc); err != nil {
+		t.Fatal("could not update ldap connector:", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSyncLdapConnector(t *testing.T) {
+	tt := []struct {
+		name   string
+		dryRun bool
+	}{
+		{"sync", false},
+		{"dry run", true},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock := getDB(t)
+			defer db.Close()
+
+			gs := &fakeGroupService{}
+			lcs := NewLdapConnectorService(db, gs, getLogger())
+			lcs.(*ldapConnectorService).groups = func(ctx context.Context, c ldap.Config) ([]ldap.Group, error) {
+				if c.URL != "ldap://ldap.example.com" {
+					t.Errorf("unexpected url %q", c.URL)
+				}
+				return []ldap.Group{
+					{ID: "eng-id", DN: "cn=engineering,ou=groups,dc=example,dc=com", Name: "engineering", Members: []string{"Alice@example.com", "bob@example.com"}},
+					{ID: "ops-id", DN: "cn=ops,ou=groups,dc=example,dc=com", Name: "ops", Members: []string{"carol@example.com"}},
+					{ID: "platform-id", DN: "cn=platform,ou=groups,dc=example,dc=com", Name: "platform", Members: []string{"carol@example.com", "unknown@example.com"}},
+				}, nil
+			}
+			luuid := uuid.NewString()
+			enguuid := uuid.NewString()
+			legacyuuid := uuid.NewString()
+			platformuuid := uuid.NewString()
+			engmapping := uuid.NewString()
+			legacymapping := uuid.NewString()
+
+			puuid, ouuid := addParterOrgFetchExpectation(mock)
+			mock.ExpectQuery(`SELECT "ldapconnector"."id", .* FROM "authsrv_ldap_connector" AS "ldapconnector" WHERE .*name = 'ldap-` + luuid + `'`).
+				WillReturnRows(ldapConnectorRows(luuid, "ldap-"+luuid))
+			mock.ExpectQuery(`SELECT "partner"."name" FROM "authsrv_partner"`).
+				WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("partner-" + puuid))
+			mock.ExpectQuery(`SELECT "organization"."name" FROM "authsrv_organization"`).
+				WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("org-" + ouuid))
+			mock.ExpectQuery(`SELECT .* FROM "authsrv_ldap_group" AS "ldapgroup" WHERE .connector_id = '` + luuid + `'.`).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "connector_id", "external_id", "group_id", "dn"}).
+					AddRow(engmapping, luuid, "eng-id", enguuid, "cn=engineering,ou=groups,dc=example,dc=com").
+					AddRow(legacymapping, luuid, "legacy-id", legacyuuid, "cn=legacy,ou=groups,dc=example,dc=com"))
+			mock.ExpectQuery(`SELECT traits ->> 'email' FROM "identities" WHERE .lower.traits ->> 'email'. IN .'alice@example.com', 'bob@example.com', 'carol@example.com', 'unknown@example.com'..`).
+				WillReturnRows(sqlmock.NewRows([]string{"email"}).AddRow("alice@example.com").AddRow("bob@example.com").AddRow("carol@example.com"))
+
+			// engineering is synchronized, carol was removed and bob added
+			mock.ExpectQuery(`SELECT .* FROM "authsrv_group" AS "group" WHERE .id = '` + enguuid + `'.`).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(enguuid, "engineering"))
+			mock.ExpectQuery(`SELECT .* FROM "identities" JOIN authsrv_groupaccount .* WHERE .authsrv_groupaccount.group_id = '` + enguuid + `'.`).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "traits"}).
+					AddRow(uuid.NewString(), `{"email": "alice@example.com"}`).
+					AddRow(uuid.NewString(), `{"email": "carol@example.com"}`))
+
+			// ops is a group not managed by the connector
+			mock.ExpectQuery(`SELECT "group"."id" FROM "authsrv_group" AS "group" WHERE .*name = 'ops'`).
+				WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.NewString()))
+
+			// platform is new
+			mock.ExpectQuery(`SELECT "group"."id" FROM "authsrv_group" AS "group" WHERE .*name = 'platform'`).
+				WillReturnError(fmt.Errorf("no data available"))
+			if !tc.dryRun {
+				mock.ExpectQuery(`SELECT "group"."id" FROM "authsrv_group" AS "group" WHERE .*name = 'platform'`).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(platformuuid))
+				mock.ExpectQuery(`INSERT INTO "authsrv_ldap_group" .* VALUES .*'` + luuid + `', 'platform-id', '` + platformuuid + `', 'cn=platform,ou=groups,dc=example,dc=com'`).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.NewString()))
+			}
+
+			// legacy no longer exists in the directory
+			mock.ExpectQuery(`SELECT .* FROM "authsrv_group" AS "group" WHERE .id = '` + legacyuuid + `'.`).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(legacyuuid, "legacy"))
+			if !tc.dryRun {
+				mock.ExpectExec(`DELETE FROM "authsrv_ldap_group" AS "ldapgroup" WHERE .*"ldapgroup"."id" = '` + legacymapping + `'`).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec(`UPDATE "authsrv_ldap_connector" AS "ldapconnector" SET "last_sync_at" = .*, "last_sync_error" = '', "last_sync_diff" = '{.*"groupsAdded":\["platform"\].*' WHERE ."ldapconnector"."id" = '` + luuid + `'`).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+			}
+
+			res, err := lcs.Sync(context.Background(), &userv3.LdapConnectorSync{
+				Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Name: "ldap-" + luuid},
+				DryRun:   tc.dryRun,
+			})
+			if err != nil {
+				t.Fatal("could not sync ldap connector:", err)
+			}
+
+			diff := res.GetDiff()
+			if !reflect.DeepEqual(diff.GetGroupsAdded(), []string{"platform"}) {
+				t.Errorf("unexpected groups added %v", diff.GetGroupsAdded())
+			}
+			if !reflect.DeepEqual(diff.GetGroupsRemoved(), []string{"legacy"}) {
+				t.Errorf("unexpected groups removed %v", diff.GetGroupsRemoved())
+			}
+			if !reflect.DeepEqual(diff.GetConflicts(), []string{"ops"}) {
+				t.Errorf("unexpected conflicts %v", diff.GetConflicts())
+			}
+			members := func(ms []*userv3.LdapGroupMember) []string {
+				var r []string
+				for _, m := range ms {
+					r = append(r, m.Group+"/"+m.User)
+				}
+				return r
+			}
+			if got := members(diff.GetMembersAdded()); !reflect.DeepEqual(got, []string{"engineering/bob@example.com", "platform/carol@example.com"}) {
+				t.Errorf("unexpected members added %v", got)
+			}
+			if got := members(diff.GetMembersRemoved()); !reflect.DeepEqual(got, []string{"engineering/carol@example.com"}) {
+				t.Errorf("unexpected members removed %v", got)
+			}
+			if got := members(diff.GetSkippedMembers()); !reflect.DeepEqual(got, []string{"platform/unknown@example.com"}) {
+				t.Errorf("unexpected skipped members %v", got)
+			}
+
+			if tc.dryRun {
+				if len(gs.created)+len(gs.updated)+len(gs.deleted) > 0 {
+					t.Error("groups changed by dry run")
+				}
+			} else {
+				if len(gs.created) != 1 || !reflect.DeepEqual(gs.created[0].GetSpec().GetUsers(), []string{"carol@example.com"}) {
+					t.Errorf("unexpected groups created %v", gs.created)
+				}
+				if len(gs.updated) != 1 || !reflect.DeepEqual(gs.updated[0].GetSpec().GetUsers(), []string{"alice@example.com", "bob@example.com"}) {
+					t.Errorf("unexpected groups updated %v", gs.updated)
+				}
+				if len(gs.updated) == 1 && len(gs.updated[0].GetSpec().GetProjectNamespaceRoles()) != 1 {
+					t.Error("roles of synchronized group not kept")
+				}
+				if !reflect.DeepEqual(gs.deleted, []string{"legacy"}) {
+					t.Errorf("unexpected groups deleted %v", gs.deleted)
+				}
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestSyncLdapConnectorDirectoryError(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	lcs := NewLdapConnectorService(db, &fakeGroupService{}, getLogger())
+	lcs.(*ldapConnectorService).groups = func(ctx context.Context, c ldap.Config) ([]ldap.Group, error) {
+		return nil, fmt.Errorf("unable to bind")
+	}
+	luuid := uuid.NewString()
+
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+	mock.ExpectQuery(`SELECT "ldapconnector"."id", .* FROM "authsrv_ldap_connector" AS "ldapconnector"`).
+		WillReturnRows(ldapConnectorRows(luuid, "ldap-"+luuid))
+	mock.ExpectExec(`UPDATE "authsrv_ldap_connector" AS "ldapconnector" SET "last_sync_at" = .*, "last_sync_error" = 'unable to bind', "last_sync_diff" = NULL WHERE ."ldapconnector"."id" = '` + luuid + `'`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_, err := lcs.Sync(context.Background(), &userv3.LdapConnectorSync{
+		Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Name: "ldap-" + luuid},
+	})
+	if err == nil {
+		t.Error("expected sync error")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSyncDueLdapConnectors(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	synced := 0
+	lcs := NewLdapConnectorService(db, &fakeGroupService{}, getLogger())
+	lcs.(*ldapConnectorService).groups = func(ctx context.Context, c ldap.Config) ([]ldap.Group, error) {
+		synced++
+		if _, ok := GetSessionDataFromContext(ctx); !ok {
+			t.Error("background sync without session data")
+		}
+		return nil, fmt.Errorf("unable to connect")
+	}
+	due := uuid.NewString()
+	claimed := uuid.NewString()
+	recent := uuid.NewString()
+
+	mock.ExpectQuery(`SELECT .* FROM "authsrv_ldap_connector" AS "ldapconnector" WHERE .trash = FALSE. AND .sync_interval_minutes > 0.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "sync_interval_minutes", "last_sync_at"}).
+			AddRow(due, "due", 60, nil).
+			AddRow(claimed, "claimed", 60, nil).
+			AddRow(recent, "recent", 60, time.Now().Add(-time.Minute)))
+	mock.ExpectExec(`UPDATE "authsrv_ldap_connector" AS "ldapconnector" SET last_sync_at = .* WHERE .id = '` + due + `'. AND .trash = FALSE. AND .last_sync_at IS NULL.`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE "authsrv_ldap_connector" AS "ldapconnector" SET "last_sync_at" = .* WHERE ."ldapconnector"."id" = '` + due + `'`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	// another replica synchronizes the connector
+	mock.ExpectExec(`UPDATE "authsrv_ldap_connector" AS "ldapconnector" SET last_sync_at = .* WHERE .id = '` + claimed + `'`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	if err := lcs.SyncDue(context.Background()); err != nil {
+		t.Fatal("could not sync due ldap connectors:", err)
+	}
+	if synced != 1 {
+		t.Errorf("expected 1 connector synced; got %v", synced)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDiffMembers(t *testing.T) {
+	added, removed := diffMembers([]string{"a", "c", "d"}, []string{"d", "b", "a"})
+	if !reflect.DeepEqual(added, []string{"b"}) {
+		t.Errorf("unexpected added members %v", added)
+	}
+	if !reflect.DeepEqual(removed, []string{"c"}) {
+		t.Errorf("unexpected removed members %v", removed)
+	}
+}
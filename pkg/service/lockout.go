@@ -0,0 +1,368 @@
+package service
+
+import (
+	"context"
+	"crypto/subtle"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/dao"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/paralus/paralus/pkg/common"
+	"github.com/paralus/paralus/pkg/gateway"
+	"github.com/paralus/paralus/pkg/query"
+	userrpcv3 "github.com/paralus/paralus/proto/rpc/user"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	userv3 "github.com/paralus/paralus/proto/types/userpb/v3"
+	bun "github.com/uptrace/bun"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	lockedAccountListKind = "LockedAccountList"
+
+	// sessionActivityInterval is how often the last activity of a
+	// session is written, requests in between are not recorded
+	sessionActivityInterval = time.Minute
+)
+
+// ErrAccountLocked is returned for logins of locked accounts
+var ErrAccountLocked = errors.New("account locked")
+
+// CheckLoginWebhook authenticates calls of the login webhook with the
+// shared secret of kratos. The webhook is not authenticated otherwise,
+// so failed logins, which lock accounts out, are only recorded from
+// authenticated calls.
+func CheckLoginWebhook(ctx context.Context, secret string, req *userrpcv3.UserLoginAuditRequest) error {
+	var got string
+	if md, ok := metadata.FromIncomingContext(ctx); ok && len(md.Get(gateway.WebhookSecret)) > 0 {
+		got = md.Get(gateway.WebhookSecret)[0]
+	}
+	if secret == "" {
+		if req.GetFailed() {
+			return status.Error(codes.FailedPrecondition, "failed logins are only recorded when the webhook secret is configured")
+		}
+		return nil
+	}
+	if subtle.ConstantTimeCompare([]byte(got), []byte(secret)) != 1 {
+		return status.Error(codes.Unauthenticated, "invalid webhook secret")
+	}
+	return nil
+}
+
+// AccountLockoutService enforces the lockout and idle logout settings
+// of organizations
+type AccountLockoutService interface {
+	// IsLocked checks if the account has been locked
+	IsLocked(ctx context.Context, accountID uuid.UUID) (bool, error)
+	// RecordFailure counts a failed login attempt of the account and
+	// locks it once the lockout attempts of its organization are
+	// reached within the lockout period. Returns true if the account
+	// is locked.
+	RecordFailure(ctx context.Context, accountID, orgID uuid.UUID, name string) (bool, error)
+	// RecordSuccess resets the failed login attempts of an account
+	// which is not locked
+	RecordSuccess(ctx context.Context, accountID uuid.UUID) error
+	// RecordLoginFailures counts the failed console logins kratos
+	// reported since the last call towards the lockout of the users
+	RecordLoginFailures(ctx context.Context) error
+	// IsSessionIdle records the activity of a session and checks if
+	// it has been idle for longer than the idle logout time of its
+	// organization
+	IsSessionIdle(ctx context.Context, sessionID string, accountID, orgID uuid.UUID, expiresAt time.Time) (bool, error)
+	// list locked accounts
+	List(context.Context, ...query.Option) (*userv3.LockedAccountList, error)
+	// unlock account
+	Unlock(ctx context.Context, org string, accountID uuid.UUID) (*userv3.LockedAccount, error)
+}
+
+// accountLockoutService implements AccountLockoutService
+type accountLockoutService struct {
+	db *bun.DB
+	al *zap.Logger
+}
+
+// NewAccountLockoutService return new account lockout service
+func NewAccountLockoutService(db *bun.DB, al *zap.Logger) AccountLockoutService {
+	return &accountLockoutService{db: db, al: al}
+}
+
+func (s *accountLockoutService) IsLocked(ctx context.Context, accountID uuid.UUID) (bool, error) {
+	return s.db.NewSelect().Model((*models.AccountLockout)(nil)).
+		Where("account_id = ?", accountID).
+		Where("locked_at IS NOT NULL").
+		Exists(ctx)
+}
+
+func (s *accountLockoutService) RecordFailure(ctx context.Context, accountID, orgID uuid.UUID, name string) (bool, error) {
+	if orgID == uuid.Nil {
+		ap, err := dao.GetDefaultAccountProject(ctx, s.db, accountID)
+		if err != nil {
+			return false, err
+		}
+		orgID = ap.OrganizationId
+	}
+	settings, err := GetOrganizationSettings(ctx, s.db, orgID)
+	if err != nil {
+		return false, err
+	}
+	lockout := settings.GetLockout()
+	if !lockout.GetEnabled() || lockout.GetAttempts() <= 0 {
+		return false, nil
+	}
+
+	// attempts older than the lockout period start a new count
+	now := time.Now()
+	periodStart := now.Add(-time.Duration(lockout.GetPeriodMin()) * time.Minute)
+	l := &models.AccountLockout{
+		AccountId:      accountID,
+		OrganizationId: orgID,
+		FailedAttempts: 1,
+		FirstFailedAt:  now,
+		LastFailedAt:   now,
+	}
+	_, err = s.db.NewInsert().Model(l).
+		On("CONFLICT (account_id) DO UPDATE").
+		Set("failed_attempts = CASE WHEN lockout.first_failed_at < ? THEN 1 ELSE lockout.failed_attempts + 1 END", periodStart).
+		Set("first_failed_at = CASE WHEN lockout.first_failed_at < ? THEN EXCLUDED.first_failed_at ELSE lockout.first_failed_at END", periodStart).
+		Set("last_failed_at = EXCLUDED.last_failed_at").
+		Returning("failed_attempts, locked_at").
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+	if !l.LockedAt.IsZero() {
+		return true, nil
+	}
+	if l.FailedAttempts < lockout.GetAttempts() {
+		return false, nil
+	}
+
+	_, err = s.db.NewUpdate().Model((*models.AccountLockout)(nil)).
+		Set("locked_at = ?", now).
+		Where("account_id = ?", accountID).
+		Where("locked_at IS NULL").
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+	_log.Infow("account locked", "account", accountID, "attempts", l.FailedAttempts)
+	LockAccountAuditEvent(s.al, &commonv3.SessionData{
+		Account:      accountID.String(),
+		Username:     name,
+		Organization: orgID.String(),
+	}, name, l.FailedAttempts)
+	return true, nil
+}
+
+func (s *accountLockoutService) RecordSuccess(ctx context.Context, accountID uuid.UUID) error {
+	_, err := s.db.NewDelete().Model((*models.AccountLockout)(nil)).
+		Where("account_id = ?", accountID).
+		Where("locked_at IS NULL").
+		Exec(ctx)
+	return err
+}
+
+func (s *accountLockoutService) RecordLoginFailures(ctx context.Context) error {
+	// failed logins are queued by the trigger of the kratos login
+	// flows, every replica records them, each is counted by the one
+	// deleting it
+	var failures []models.LoginFailure
+	_, err := s.db.NewDelete().Model(&failures).
+		Where("TRUE").
+		Returning("identifier").
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	// failures are claimed once deleted, one which can't be recorded
+	// does not hold up the others
+	for _, f := range failures {
+		entity, err := dao.GetUserIdByEmail(ctx, s.db, f.Identifier, &models.KratosIdentities{})
+		if err == sql.ErrNoRows {
+			continue
+		}
+		if err != nil {
+			_log.Warnw("unable to find user of failed login", "user", f.Identifier, "error", err)
+			continue
+		}
+		uid := entity.(*models.KratosIdentities).ID
+		ctx := context.WithValue(ctx, common.SessionDataKey, &commonv3.SessionData{Account: uid.String(), Username: f.Identifier})
+		FailedUserLoginAuditEvent(ctx, s.al, f.Identifier, "invalid credentials")
+		if _, err := s.RecordFailure(ctx, uid, uuid.Nil, f.Identifier); err != nil {
+			_log.Warnw("unable to record failed login", "user", f.Identifier, "error", err)
+		}
+	}
+	return nil
+}
+
+func (s *accountLockoutService) IsSessionIdle(ctx context.Context, sessionID string, accountID, orgID uuid.UUID, expiresAt time.Time) (bool, error) {
+	settings, err := GetOrganizationSettings(ctx, s.db, orgID)
+	if err != nil {
+		return false, err
+	}
+	// activity is recorded even without idle logout, it is the last
+	// seen time of session listings
+	idleLogout := time.Duration(settings.GetIdleLogoutMin()) * time.Minute
+
+	now := time.Now()
+	var activity models.SessionActivity
+	err = s.db.NewSelect().Model(&activity).Where("session_id = ?", sessionID).Scan(ctx)
+	if err == sql.ErrNoRows {
+		// activity of expired sessions is no longer needed
+		_, err := s.db.NewDelete().Model((*models.SessionActivity)(nil)).
+			Where("expires_at < ?", now).
+			Exec(ctx)
+		if err != nil {
+			return false, err
+		}
+		_, err = s.db.NewInsert().Model(&models.SessionActivity{
+			SessionId:    sessionID,
+			AccountId:    accountID,
+			LastActiveAt: now,
+			ExpiresAt:    expiresAt,
+		}).On("CONFLICT (session_id) DO NOTHING").Exec(ctx)
+		return false, err
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if idleLogout > 0 && now.Sub(activity.LastActiveAt) > idleLogout {
+		return true, nil
+	}
+	if now.Sub(activity.LastActiveAt) > sessionActivityInterval {
+		_, err = s.db.NewUpdate().Model((*models.SessionActivity)(nil)).
+			Set("last_active_at = ?", now).
+			Where("session_id = ?", sessionID).
+			Exec(ctx)
+		if err != nil {
+			return false, err
+		}
+	}
+	return false, nil
+}
+
+// accountName returns the name and type of a user or service account
+func (s *accountLockoutService) accountName(ctx context.Context, accountID uuid.UUID) (string, string, error) {
+	names, err := dao.GetUserNamesByIds(ctx, s.db, []uuid.UUID{accountID}, &models.KratosIdentities{})
+	if err != nil {
+		return "", "", err
+	}
+	if len(names) > 0 {
+		return names[0], "USER", nil
+	}
+	var sa models.ServiceAccount
+	_, err = dao.GetNameById(ctx, s.db, accountID, &sa)
+	if err == sql.ErrNoRows {
+		return "", "", nil
+	}
+	if err != nil {
+		return "", "", err
+	}
+	return sa.Name, "SERVICE_ACCOUNT", nil
+}
+
+func (s *accountLockoutService) toV3LockedAccount(ctx context.Context, l *models.AccountLockout) (*userv3.LockedAccount, error) {
+	name, typ, err := s.accountName(ctx, l.AccountId)
+	if err != nil {
+		return nil, err
+	}
+	account := &userv3.LockedAccount{
+		Id:             l.AccountId.String(),
+		Name:           name,
+		Type:           typ,
+		FailedAttempts: l.FailedAttempts,
+		LastFailedAt:   timestamppb.New(l.LastFailedAt),
+	}
+	if !l.LockedAt.IsZero() {
+		account.LockedAt = timestamppb.New(l.LockedAt)
+	}
+	return account, nil
+}
+
+func (s *accountLockoutService) List(ctx context.Context, opts ...query.Option) (*userv3.LockedAccountList, error) {
+	laList := &userv3.LockedAccountList{
+		ApiVersion: apiVersion,
+		Kind:       lockedAccountListKind,
+		Metadata: &commonv3.ListMetadata{
+			Count: 0,
+		},
+	}
+
+	queryOptions := commonv3.QueryOptions{}
+	for _, opt := range opts {
+		opt(&queryOptions)
+	}
+
+	orgId, err := dao.GetOrganizationId(ctx, s.db, queryOptions.Organization)
+	if err != nil {
+		return laList, err
+	}
+	var lockouts []models.AccountLockout
+	q := s.db.NewSelect().Model(&lockouts).
+		Where("organization_id = ?", orgId).
+		Where("locked_at IS NOT NULL").
+		Order("locked_at DESC")
+	if queryOptions.Limit > 0 {
+		q = q.Limit(int(queryOptions.Limit))
+	}
+	if queryOptions.Offset > 0 {
+		q = q.Offset(int(queryOptions.Offset))
+	}
+	if err := q.Scan(ctx); err != nil {
+		return laList, err
+	}
+
+	var items []*userv3.LockedAccount
+	for i := range lockouts {
+		account, err := s.toV3LockedAccount(ctx, &lockouts[i])
+		if err != nil {
+			return laList, err
+		}
+		items = append(items, account)
+	}
+	laList.Metadata.Count = int64(len(items))
+	laList.Items = items
+	return laList, nil
+}
+
+func (s *accountLockoutService) Unlock(ctx context.Context, org string, accountID uuid.UUID) (*userv3.LockedAccount, error) {
+	orgId, err := dao.GetOrganizationId(ctx, s.db, org)
+	if err != nil {
+		return nil, err
+	}
+	var l models.AccountLockout
+	err = s.db.NewSelect().Model(&l).
+		Where("account_id = ?", accountID).
+		Where("organization_id = ?", orgId).
+		Where("locked_at IS NOT NULL").
+		Scan(ctx)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("account '%v' is not locked", accountID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := s.toV3LockedAccount(ctx, &l)
+	if err != nil {
+		return nil, err
+	}
+	_, err = s.db.NewDelete().Model((*models.AccountLockout)(nil)).
+		Where("account_id = ?", accountID).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	CreateAccountLockoutAuditEvent(ctx, s.al, "unlock", account.Name)
+	return account, nil
+}
@@ -0,0 +1,270 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/pkg/common"
+	"github.com/paralus/paralus/pkg/gateway"
+	userrpcv3 "github.com/paralus/paralus/proto/rpc/user"
+	"google.golang.org/grpc/metadata"
+)
+
+func addOrgSettingsFetchExpectation(mock sqlmock.Sqlmock, ouuid string, settings string) {
+	mock.ExpectQuery(`SELECT "organization"."id", .* FROM "authsrv_organization" AS "organization" WHERE .id = '` + ouuid + `'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "settings"}).AddRow(ouuid, "org-"+ouuid, []byte(settings)))
+}
+
+const testLockoutSettings = `{"lockout":{"enabled":true,"periodMin":15,"attempts":3},"idleLogoutMin":30}`
+
+func TestRecordFailure(t *testing.T) {
+	tt := []struct {
+		name     string
+		settings string
+		attempts int
+		locked   bool
+	}{
+		{"below attempts", testLockoutSettings, 1, false},
+		{"reaching attempts", testLockoutSettings, 3, true},
+		{"lockout disabled", `{"lockout":{"enabled":false,"periodMin":15,"attempts":3}}`, 0, false},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock := getDB(t)
+			defer db.Close()
+
+			ls := NewAccountLockoutService(db, getLogger())
+			auuid := uuid.NewString()
+			ouuid := uuid.NewString()
+
+			addOrgSettingsFetchExpectation(mock, ouuid, tc.settings)
+			if tc.attempts > 0 {
+				mock.ExpectQuery(`INSERT INTO "authsrv_account_lockout" AS "lockout" .* ON CONFLICT \(account_id\) DO UPDATE SET failed_attempts = CASE WHEN lockout.first_failed_at < .* RETURNING failed_attempts, locked_at`).
+					WillReturnRows(sqlmock.NewRows([]string{"failed_attempts", "locked_at"}).AddRow(tc.attempts, nil))
+			}
+			if tc.locked {
+				mock.ExpectExec(`UPDATE "authsrv_account_lockout" AS "lockout" SET locked_at = .* WHERE .account_id = '` + auuid + `'. AND .locked_at IS NULL.`).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+			}
+
+			locked, err := ls.RecordFailure(context.Background(), uuid.MustParse(auuid), uuid.MustParse(ouuid), "user@example.com")
+			if err != nil {
+				t.Fatal("unable to record failure:", err)
+			}
+			if locked != tc.locked {
+				t.Errorf("expected locked %v; got %v", tc.locked, locked)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestIsSessionIdle(t *testing.T) {
+	tt := []struct {
+		name       string
+		lastActive time.Time
+		newSession bool
+		update     bool
+		idle       bool
+	}{
+		{"new session", time.Time{}, true, false, false},
+		{"recently active", time.Now().Add(-10 * time.Second), false, false, false},
+		{"active", time.Now().Add(-10 * time.Minute), false, true, false},
+		{"idle", time.Now().Add(-time.Hour), false, false, true},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock := getDB(t)
+			defer db.Close()
+
+			ls := NewAccountLockoutService(db, getLogger())
+			auuid := uuid.New()
+			ouuid := uuid.NewString()
+
+			addOrgSettingsFetchExpectation(mock, ouuid, testLockoutSettings)
+			q := mock.ExpectQuery(`SELECT "sessionactivity"."session_id", .* FROM "authsrv_session_activity" AS "sessionactivity" WHERE .session_id = 'session-1'.`)
+			if tc.newSession {
+				q.WillReturnRows(sqlmock.NewRows([]string{"session_id"}))
+				mock.ExpectExec(`DELETE FROM "authsrv_session_activity" AS "sessionactivity" WHERE .expires_at < `).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(`INSERT INTO "authsrv_session_activity" .* ON CONFLICT \(session_id\) DO NOTHING`).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+			} else {
+				q.WillReturnRows(sqlmock.NewRows([]string{"session_id", "account_id", "last_active_at"}).
+					AddRow("session-1", auuid.String(), tc.lastActive))
+			}
+			if tc.update {
+				mock.ExpectExec(`UPDATE "authsrv_session_activity" AS "sessionactivity" SET last_active_at = .* WHERE .session_id = 'session-1'.`).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+			}
+
+			idle, err := ls.IsSessionIdle(context.Background(), "session-1", auuid, uuid.MustParse(ouuid), time.Now().Add(time.Hour))
+			if err != nil {
+				t.Fatal("unable to check session:", err)
+			}
+			if idle != tc.idle {
+				t.Errorf("expected idle %v; got %v", tc.idle, idle)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestUnlockAccount(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	ls := NewAccountLockoutService(db, getLogger())
+	auuid := uuid.NewString()
+	ouuid := uuid.NewString()
+
+	mock.ExpectQuery(`SELECT "organization"."id" FROM "authsrv_organization" AS "organization" WHERE .name = 'org-` + ouuid + `'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(ouuid))
+	mock.ExpectQuery(`SELECT "lockout"."account_id", .* FROM "authsrv_account_lockout" AS "lockout" WHERE .account_id = '` + auuid + `'. AND .organization_id = '` + ouuid + `'. AND .locked_at IS NOT NULL.`).
+		WillReturnRows(sqlmock.NewRows([]string{"account_id", "organization_id", "failed_attempts", "locked_at"}).
+			AddRow(auuid, ouuid, 3, time.Now()))
+	mock.ExpectQuery(`SELECT traits ->> 'email' as name FROM "identities" WHERE .id = .'` + auuid + `'..`).
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("user@example.com"))
+	mock.ExpectExec(`DELETE FROM "authsrv_account_lockout" AS "lockout" WHERE .account_id = '` + auuid + `'.`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	account, err := ls.Unlock(context.Background(), "org-"+ouuid, uuid.MustParse(auuid))
+	if err != nil {
+		t.Fatal("unable to unlock account:", err)
+	}
+	if account.Name != "user@example.com" || account.Type != "USER" || account.FailedAttempts != 3 {
+		t.Errorf("invalid unlocked account %v", account)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUnlockAccountNotLocked(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	ls := NewAccountLockoutService(db, getLogger())
+	auuid := uuid.NewString()
+	ouuid := uuid.NewString()
+
+	mock.ExpectQuery(`SELECT "organization"."id" FROM "authsrv_organization" AS "organization" WHERE .name = 'org-` + ouuid + `'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(ouuid))
+	mock.ExpectQuery(`SELECT "lockout"."account_id", .* FROM "authsrv_account_lockout" AS "lockout" WHERE .account_id = '` + auuid + `'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"account_id"}))
+
+	if _, err := ls.Unlock(context.Background(), "org-"+ouuid, uuid.MustParse(auuid)); err == nil {
+		t.Error("unlocked account which is not locked")
+	}
+}
+
+func TestCreateLoginAuditLogLocked(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	us := NewUserService(&mockAuthProvider{}, db, &mockAuthzClient{}, nil, common.CliConfigDownloadData{}, getLogger(), true)
+	uid := uuid.NewString()
+
+	mock.ExpectQuery(`SELECT traits ->> 'email' as name FROM "identities" WHERE .id = .'` + uid + `'..`).
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("user@example.com"))
+	mock.ExpectQuery(`SELECT EXISTS .SELECT "lockout"."account_id", .* FROM "authsrv_account_lockout" AS "lockout" WHERE .account_id = '` + uid + `'. AND .locked_at IS NOT NULL.`).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+	_, err := us.CreateLoginAuditLog(context.Background(), &userrpcv3.UserLoginAuditRequest{UserId: uid})
+	if err != ErrAccountLocked {
+		t.Errorf("expected locked account; got '%v'", err)
+	}
+}
+
+func TestCreateLoginAuditLogFailed(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	us := NewUserService(&mockAuthProvider{}, db, &mockAuthzClient{}, nil, common.CliConfigDownloadData{}, getLogger(), true)
+	uid := uuid.NewString()
+	ouuid := uuid.NewString()
+
+	mock.ExpectQuery(`SELECT "identities"."id" FROM "identities" WHERE .traits ->> 'email' = 'user@example.com'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uid))
+	mock.ExpectQuery(`SELECT traits ->> 'email' as name FROM "identities" WHERE .id = .'` + uid + `'..`).
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("user@example.com"))
+	mock.ExpectQuery(`SELECT sap.* FROM "sentry_account_permission" AS "sap" JOIN authsrv_project as proj .* WHERE .account_id = '` + uid + `'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"account_id", "organization_id"}).AddRow(uid, ouuid))
+	addOrgSettingsFetchExpectation(mock, ouuid, testLockoutSettings)
+	mock.ExpectQuery(`INSERT INTO "authsrv_account_lockout" AS "lockout" .*'` + uid + `', '` + ouuid + `', 1`).
+		WillReturnRows(sqlmock.NewRows([]string{"failed_attempts", "locked_at"}).AddRow(1, nil))
+
+	req := &userrpcv3.UserLoginAuditRequest{Username: "user@example.com", Failed: true}
+	if _, err := us.CreateLoginAuditLog(context.Background(), req); err != nil {
+		t.Fatal("unable to record failed login:", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRecordLoginFailures(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	ls := NewAccountLockoutService(db, getLogger())
+	uid := uuid.NewString()
+	ouuid := uuid.NewString()
+
+	// the third failed login of the user locks the account, failed
+	// logins of unknown users are dropped
+	mock.ExpectQuery(`DELETE FROM "authsrv_login_failure" AS "loginfailure" WHERE .TRUE. RETURNING identifier`).
+		WillReturnRows(sqlmock.NewRows([]string{"identifier"}).AddRow("user@example.com").AddRow("nobody@example.com"))
+	mock.ExpectQuery(`SELECT "identities"."id" FROM "identities" WHERE .traits ->> 'email' = 'user@example.com'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uid))
+	mock.ExpectQuery(`SELECT sap.* FROM "sentry_account_permission" AS "sap" JOIN authsrv_project as proj .* WHERE .account_id = '` + uid + `'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"account_id", "organization_id"}).AddRow(uid, ouuid))
+	addOrgSettingsFetchExpectation(mock, ouuid, testLockoutSettings)
+	mock.ExpectQuery(`INSERT INTO "authsrv_account_lockout" AS "lockout" .*'` + uid + `', '` + ouuid + `', 1`).
+		WillReturnRows(sqlmock.NewRows([]string{"failed_attempts", "locked_at"}).AddRow(3, nil))
+	mock.ExpectExec(`UPDATE "authsrv_account_lockout" AS "lockout" SET locked_at = .* WHERE .account_id = '` + uid + `'. AND .locked_at IS NULL.`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery(`SELECT "identities"."id" FROM "identities" WHERE .traits ->> 'email' = 'nobody@example.com'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	if err := ls.RecordLoginFailures(context.Background()); err != nil {
+		t.Fatal("unable to record failed logins:", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCheckLoginWebhook(t *testing.T) {
+	withSecret := func(secret string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(gateway.WebhookSecret, secret))
+	}
+	tt := []struct {
+		name   string
+		ctx    context.Context
+		secret string
+		failed bool
+		valid  bool
+	}{
+		{"matching secret", withSecret("s3cret"), "s3cret", true, true},
+		{"wrong secret", withSecret("guess"), "s3cret", false, false},
+		{"missing secret", context.Background(), "s3cret", true, false},
+		{"login without configured secret", context.Background(), "", false, true},
+		{"failed login without configured secret", withSecret("guess"), "", true, false},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			err := CheckLoginWebhook(tc.ctx, tc.secret, &userrpcv3.UserLoginAuditRequest{UserId: uuid.NewString(), Failed: tc.failed})
+			if (err == nil) != tc.valid {
+				t.Errorf("expected valid %v; got %v", tc.valid, err)
+			}
+		})
+	}
+}
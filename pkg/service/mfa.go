@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/dao"
+	"github.com/paralus/paralus/internal/models"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	systemv3 "github.com/paralus/paralus/proto/types/systempb/v3"
+	bun "github.com/uptrace/bun"
+)
+
+// authenticator assurance levels reported for sessions
+const (
+	AAL1 = "aal1"
+	AAL2 = "aal2"
+	AAL3 = "aal3"
+)
+
+// ErrStepUpRequired is returned when an action needs a session which
+// recently reached a higher authenticator assurance level
+var ErrStepUpRequired = errors.New("step-up authentication required")
+
+// GetOrganizationSettings returns the settings of the organization
+func GetOrganizationSettings(ctx context.Context, db bun.IDB, orgID uuid.UUID) (*systemv3.OrganizationSettings, error) {
+	var org models.Organization
+	_, err := dao.GetByID(ctx, db, orgID, &org)
+	if err != nil {
+		return nil, err
+	}
+	settings := &systemv3.OrganizationSettings{}
+	if org.Settings != nil {
+		_ = json.Unmarshal(org.Settings, settings) // ignore any unmarshelling issues
+	}
+	return settings, nil
+}
+
+// HasAAL2 checks if the session reached at least aal2
+func HasAAL2(sd *commonv3.SessionData) bool {
+	return sd.GetAal() == AAL2 || sd.GetAal() == AAL3
+}
+
+// IsConsoleSession checks if the request was made with the login
+// session of a user, the mfa policy only applies to those
+func IsConsoleSession(sd *commonv3.SessionData) bool {
+	return sd.GetAuthType() == commonv3.AuthType_SessionLogin || sd.GetAuthType() == commonv3.AuthType_SAMLLogin
+}
+
+// HasRecentStepUp checks if the session reached aal2 within maxAge
+func HasRecentStepUp(sd *commonv3.SessionData, maxAge time.Duration) bool {
+	if !HasAAL2(sd) || sd.GetStepUpAt() == nil {
+		return false
+	}
+	return time.Since(sd.GetStepUpAt().AsTime()) <= maxAge
+}
+
+// MfaRequiredRoles returns which of the roles only grant access to
+// aal2 sessions in the organization. Roles of ORGANIZATION scope are
+// included when the mfa policy of the organization asks for it.
+func MfaRequiredRoles(ctx context.Context, db bun.IDB, orgID uuid.UUID, roles []string, policy *systemv3.MfaPolicy) ([]string, error) {
+	names := []string{}
+	if len(roles) == 0 {
+		return names, nil
+	}
+	q := db.NewSelect().Model((*models.Role)(nil)).Column("name").
+		Where("name IN (?)", bun.In(roles)).
+		Where("organization_id = ? OR organization_id IS NULL", orgID).
+		Where("trash = ?", false)
+	if policy.GetEnabled() && policy.GetOrganizationRoles() {
+		q = q.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Where("require_mfa = ?", true).WhereOr("scope = ?", "organization")
+		})
+	} else {
+		q = q.Where("require_mfa = ?", true)
+	}
+	err := q.Scan(ctx, &names)
+	return names, err
+}
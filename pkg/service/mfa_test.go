@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	systemv3 "github.com/paralus/paralus/proto/types/systempb/v3"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestHasRecentStepUp(t *testing.T) {
+	tt := []struct {
+		name   string
+		sd     *commonv3.SessionData
+		recent bool
+	}{
+		{"aal1 session", &commonv3.SessionData{Aal: AAL1}, false},
+		{"aal2 without step-up time", &commonv3.SessionData{Aal: AAL2}, false},
+		{"recent step-up", &commonv3.SessionData{Aal: AAL2, StepUpAt: timestamppb.New(time.Now().Add(-time.Minute))}, true},
+		{"aal3 step-up", &commonv3.SessionData{Aal: AAL3, StepUpAt: timestamppb.New(time.Now().Add(-time.Minute))}, true},
+		{"old step-up", &commonv3.SessionData{Aal: AAL2, StepUpAt: timestamppb.New(time.Now().Add(-time.Hour))}, false},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			if recent := HasRecentStepUp(tc.sd, 15*time.Minute); recent != tc.recent {
+				t.Errorf("expected recent step-up %v; got %v", tc.recent, recent)
+			}
+		})
+	}
+}
+
+func TestMfaRequiredRoles(t *testing.T) {
+	tt := []struct {
+		name   string
+		policy *systemv3.MfaPolicy
+		where  string
+	}{
+		{"roles requiring mfa", nil, `AND .require_mfa = TRUE.$`},
+		{"organization roles", &systemv3.MfaPolicy{Enabled: true, OrganizationRoles: true}, `AND ..require_mfa = TRUE. OR .scope = 'organization'..$`},
+		{"policy disabled", &systemv3.MfaPolicy{OrganizationRoles: true}, `AND .require_mfa = TRUE.$`},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock := getDB(t)
+			defer db.Close()
+
+			ouuid := uuid.NewString()
+			mock.ExpectQuery(`SELECT "resourcerole"."name" FROM "authsrv_resourcerole" AS "resourcerole" WHERE .name IN .'ADMIN', 'NAMESPACE_ADMIN'.. AND .organization_id = '` + ouuid + `' OR organization_id IS NULL. AND .trash = FALSE. ` + tc.where).
+				WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("ADMIN"))
+
+			roles, err := MfaRequiredRoles(context.Background(), db, uuid.MustParse(ouuid), []string{"ADMIN", "NAMESPACE_ADMIN"}, tc.policy)
+			if err != nil {
+				t.Fatal("unable to get roles requiring mfa:", err)
+			}
+			if len(roles) != 1 || roles[0] != "ADMIN" {
+				t.Errorf("expected roles [ADMIN]; got %v", roles)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	providers "github.com/paralus/paralus/internal/provider/kratos"
 	types "github.com/paralus/paralus/proto/types/authz"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
 )
 
 type ApUpdate struct {
@@ -17,6 +18,11 @@ type mockAuthProvider struct {
 	u []ApUpdate
 	r []string
 	d []string
+	// sessions by identity id and revoked session ids
+	s  map[string][]providers.Session
+	rs []string
+	// active state set by identity id
+	a map[string]bool
 }
 
 func (m *mockAuthProvider) Create(ctx context.Context, pass string, traits map[string]interface{}, metadata providers.IdentityPublicMetadata) (string, error) {
@@ -40,9 +46,35 @@ func (m *mockAuthProvider) GetPublicMetadata(context.Context, string) (*provider
 	return &providers.IdentityPublicMetadata{}, nil
 }
 
+func (m *mockAuthProvider) ListSessions(ctx context.Context, id string) ([]providers.Session, error) {
+	return m.s[id], nil
+}
+
+func (m *mockAuthProvider) RevokeSession(ctx context.Context, sessionID string) error {
+	m.rs = append(m.rs, sessionID)
+	return nil
+}
+
+func (m *mockAuthProvider) RevokeSessions(ctx context.Context, id string) error {
+	for _, s := range m.s[id] {
+		m.rs = append(m.rs, s.ID)
+	}
+	return nil
+}
+
+func (m *mockAuthProvider) SetActive(ctx context.Context, id string, active bool) error {
+	if m.a == nil {
+		m.a = map[string]bool{}
+	}
+	m.a[id] = active
+	return nil
+}
+
 type mockAuthzClient struct {
 	cp   []*types.Policies
 	dp   []*types.Policy
+	cdp  []*types.Policies
+	ddp  []*types.Policy
 	cug  []*types.UserGroups
 	dug  []*types.UserGroup
 	crpm []*types.RolePermissionMappingList
@@ -52,6 +84,15 @@ type mockAuthzClient struct {
 func (c *mockAuthzClient) Enforce(ctx context.Context, in *types.EnforceRequest) (*types.BoolReply, error) {
 	return &types.BoolReply{Res: true}, nil
 }
+func (c *mockAuthzClient) GrantingRoles(ctx context.Context, in *types.EnforceRequest) ([]string, error) {
+	return []string{}, nil
+}
+func (c *mockAuthzClient) MatchPermissions(ctx context.Context, url, method string, permissions []string) ([]string, error) {
+	return []string{}, nil
+}
+func (c *mockAuthzClient) Explain(ctx context.Context, in *types.EnforceRequest) (*commonv3.ExplainRequestResponse, error) {
+	return &commonv3.ExplainRequestResponse{Params: in.Params}, nil
+}
 func (c *mockAuthzClient) ListPolicies(ctx context.Context, in *types.Policy) (*types.Policies, error) {
 	return &types.Policies{}, nil
 }
@@ -63,6 +104,14 @@ func (c *mockAuthzClient) DeletePolicies(ctx context.Context, in *types.Policy)
 	c.dp = append(c.dp, in)
 	return &types.BoolReply{Res: true}, nil
 }
+func (c *mockAuthzClient) CreateDenyPolicies(ctx context.Context, in *types.Policies) (*types.BoolReply, error) {
+	c.cdp = append(c.cdp, in)
+	return &types.BoolReply{Res: true}, nil
+}
+func (c *mockAuthzClient) DeleteDenyPolicies(ctx context.Context, in *types.Policy) (*types.BoolReply, error) {
+	c.ddp = append(c.ddp, in)
+	return &types.BoolReply{Res: true}, nil
+}
 func (c *mockAuthzClient) ListUserGroups(ctx context.Context, in *types.UserGroup) (*types.UserGroups, error) {
 	return &types.UserGroups{}, nil
 }
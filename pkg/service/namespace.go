@@ -2,19 +2,37 @@ package service
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/paralus/paralus/internal/dao"
+	"github.com/paralus/paralus/pkg/match"
+	"github.com/paralus/paralus/pkg/query"
 	"github.com/paralus/paralus/pkg/utils"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
 	"github.com/uptrace/bun"
 )
 
 // NamespaceService is the interface for namespace operations
 type NamespaceService interface {
-	// GetProjectNamespaces
-	GetProjectNamespaces(ctx context.Context, projectID uuid.UUID) ([]string, error)
-	GetAccountProjectNamespaces(ctx context.Context, projectID uuid.UUID, accountID uuid.UUID) ([]string, error)
-	GetGroupProjectNamespaces(ctx context.Context, projectID uuid.UUID, accountID uuid.UUID) ([]string, error)
+	// GetProjectNamespaces returns the namespaces of the cluster roles
+	// of the project may be bound in, every namespace of the cluster
+	// when a role is bound by namespace selector as the labels of the
+	// namespaces may no longer match
+	GetProjectNamespaces(ctx context.Context, projectID uuid.UUID, clusterID uuid.UUID) ([]string, error)
+	// GetAccountProjectNamespaces returns the namespaces of the cluster
+	// in which the account is bound a role of the project
+	GetAccountProjectNamespaces(ctx context.Context, projectID uuid.UUID, accountID uuid.UUID, clusterID uuid.UUID) ([]string, error)
+	// GetGroupProjectNamespaces returns the namespaces of the cluster
+	// in which the groups of the account are bound a role of the project
+	GetGroupProjectNamespaces(ctx context.Context, projectID uuid.UUID, accountID uuid.UUID, clusterID uuid.UUID) ([]string, error)
+	// MatchClusterNamespaces returns the namespaces of the cluster the
+	// namespace, namespace pattern or namespace selector matches
+	MatchClusterNamespaces(ctx context.Context, clusterID uuid.UUID, namespace string, namespaceSelector string) ([]string, error)
 }
 
 // namespaceService implements NamespaceService
@@ -27,30 +45,163 @@ func NewNamespaceService(db *bun.DB) NamespaceService {
 	return &namespaceService{db}
 }
 
-func (s *namespaceService) GetProjectNamespaces(ctx context.Context, projectID uuid.UUID) ([]string, error) {
+// clusterNamespace is a namespace synced from a cluster
+type clusterNamespace struct {
+	name   string
+	labels map[string]string
+}
+
+var (
+	namespaceRegex        = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+	namespacePatternRegex = regexp.MustCompile(`^[-a-z0-9*?\[\]^]+$`)
+)
+
+// IsNamespacePattern returns true when the namespace of a role binding
+// is a pattern matching the namespaces of the clusters
+func IsNamespacePattern(namespace string) bool {
+	return strings.ContainsAny(namespace, "*?[")
+}
+
+// validateBindingNamespace validates the namespace, or namespace
+// pattern, or the namespace selector of a role binding
+func validateBindingNamespace(namespace, namespaceSelector string) error {
+	if namespaceSelector != "" {
+		if namespace != "" {
+			return fmt.Errorf("namespace %q and namespace selector %q are exclusive", namespace, namespaceSelector)
+		}
+		if _, err := match.New(query.WithSelector(namespaceSelector)); err != nil {
+			return fmt.Errorf("namespace selector %q is invalid; %v", namespaceSelector, err)
+		}
+		return nil
+	}
+	if len(namespace) < 1 || len(namespace) > 63 {
+		return fmt.Errorf("namespace %q is invalid. must be no more than 63 characters", namespace)
+	}
+	if IsNamespacePattern(namespace) {
+		if _, err := path.Match(namespace, ""); err != nil || !namespacePatternRegex.MatchString(namespace) {
+			return fmt.Errorf("namespace pattern %q is invalid", namespace)
+		}
+		return nil
+	}
+	if !namespaceRegex.MatchString(namespace) {
+		return fmt.Errorf("namespace %q is invalid", namespace)
+	}
+	return nil
+}
+
+// policyNamespace returns the namespace of the authz policy of a role
+// binding in namespaces, bindings by namespace selector apply in any
+// namespace
+func policyNamespace(namespace string) string {
+	if namespace == "" {
+		return "*"
+	}
+	return namespace
+}
+
+// matchNamespaces returns the namespaces matched by the namespace
+// pattern or the namespace selector, literal namespaces match as is
+func matchNamespaces(pn dao.ProjectNamespace, cns []clusterNamespace) []string {
+	var namespaces []string
+	if pn.NamespaceSelector != "" {
+		m, err := match.New(query.WithSelector(pn.NamespaceSelector))
+		if err != nil {
+			_log.Warnw("invalid namespace selector", "selector", pn.NamespaceSelector, "error", err)
+			return nil
+		}
+		for _, cn := range cns {
+			if m.Match(commonv3.Metadata{Labels: cn.labels}) {
+				namespaces = append(namespaces, cn.name)
+			}
+		}
+		return namespaces
+	}
+	if !IsNamespacePattern(pn.Namespace) {
+		return []string{pn.Namespace}
+	}
+	for _, cn := range cns {
+		if ok, _ := path.Match(pn.Namespace, cn.name); ok {
+			namespaces = append(namespaces, cn.name)
+		}
+	}
+	return namespaces
+}
+
+// getClusterNamespaces returns the namespaces synced from the cluster
+func (s *namespaceService) getClusterNamespaces(ctx context.Context, clusterID uuid.UUID) ([]clusterNamespace, error) {
+	cnl, err := dao.GetClusterNamespaceLabels(ctx, s.db, clusterID)
+	if err != nil {
+		return nil, err
+	}
+	cns := make([]clusterNamespace, len(cnl))
+	for i, cn := range cnl {
+		cns[i].name = cn.Name
+		if len(cn.Labels) > 0 {
+			if err := json.Unmarshal(cn.Labels, &cns[i].labels); err != nil {
+				_log.Warnw("unable to parse namespace labels", "namespace", cn.Name, "error", err)
+			}
+		}
+	}
+	return cns, nil
+}
+
+// expandNamespaces returns the namespaces of the cluster the role
+// bindings are in, the namespaces of the cluster are only read when a
+// binding has a namespace pattern or selector
+func (s *namespaceService) expandNamespaces(ctx context.Context, clusterID uuid.UUID, pns []dao.ProjectNamespace) ([]string, error) {
+	var namespaces []string
+	var cns []clusterNamespace
+	synced := false
+	for _, pn := range pns {
+		if pn.NamespaceSelector != "" || IsNamespacePattern(pn.Namespace) {
+			if !synced {
+				var err error
+				cns, err = s.getClusterNamespaces(ctx, clusterID)
+				if err != nil {
+					return nil, err
+				}
+				synced = true
+			}
+		}
+		namespaces = append(namespaces, matchNamespaces(pn, cns)...)
+	}
+	return utils.Unique(namespaces), nil
+}
 
-	cns, err := dao.GetProjectNamespaces(ctx, s.db, projectID)
+func (s *namespaceService) GetProjectNamespaces(ctx context.Context, projectID, clusterID uuid.UUID) ([]string, error) {
+
+	pns, err := dao.GetProjectNamespaces(ctx, s.db, projectID)
 	if err != nil {
 		return nil, err
 	}
 
-	return utils.Unique(cns), nil
+	for i, pn := range pns {
+		if pn.NamespaceSelector != "" {
+			// any namespace of the cluster
+			pns[i] = dao.ProjectNamespace{Namespace: "*"}
+		}
+	}
+	return s.expandNamespaces(ctx, clusterID, pns)
 }
 
-func (s *namespaceService) GetAccountProjectNamespaces(ctx context.Context, projectID, accountID uuid.UUID) ([]string, error) {
-	cns, err := dao.GetAccountProjectNamespaces(ctx, s.db, projectID, accountID)
+func (s *namespaceService) GetAccountProjectNamespaces(ctx context.Context, projectID, accountID, clusterID uuid.UUID) ([]string, error) {
+	pns, err := dao.GetAccountProjectNamespaces(ctx, s.db, projectID, accountID)
 	if err != nil {
 		return nil, err
 	}
 
-	return utils.Unique(cns), nil
+	return s.expandNamespaces(ctx, clusterID, pns)
 }
 
-func (s *namespaceService) GetGroupProjectNamespaces(ctx context.Context, projectID, accountID uuid.UUID) ([]string, error) {
-	cns, err := dao.GetGroupProjectNamespaces(ctx, s.db, projectID, accountID)
+func (s *namespaceService) GetGroupProjectNamespaces(ctx context.Context, projectID, accountID, clusterID uuid.UUID) ([]string, error) {
+	pns, err := dao.GetGroupProjectNamespaces(ctx, s.db, projectID, accountID)
 	if err != nil {
 		return nil, err
 	}
 
-	return utils.Unique(cns), nil
+	return s.expandNamespaces(ctx, clusterID, pns)
+}
+
+func (s *namespaceService) MatchClusterNamespaces(ctx context.Context, clusterID uuid.UUID, namespace, namespaceSelector string) ([]string, error) {
+	return s.expandNamespaces(ctx, clusterID, []dao.ProjectNamespace{{Namespace: namespace, NamespaceSelector: namespaceSelector}})
 }
@@ -13,13 +13,13 @@ func TestGetProjectNamespaces(t *testing.T) {
 	defer db.Close()
 
 	puuid := uuid.New()
-	mock.ExpectQuery(`SELECT "projectaccountnamespacerole"."id", "projectaccountnamespacerole"."name", "projectaccountnamespacerole"."description", "projectaccountnamespacerole"."created_at", "projectaccountnamespacerole"."modified_at", "projectaccountnamespacerole"."trash", "projectaccountnamespacerole"."organization_id", "projectaccountnamespacerole"."partner_id", "projectaccountnamespacerole"."role_id", "projectaccountnamespacerole"."account_id", "projectaccountnamespacerole"."project_id", "projectaccountnamespacerole"."namespace", "projectaccountnamespacerole"."active" FROM "authsrv_projectaccountnamespacerole" AS "projectaccountnamespacerole" WHERE \(project_id = '` + puuid.String() + `'\) AND \(trash = FALSE\)`).
+	mock.ExpectQuery(`SELECT "projectaccountnamespacerole"."id", "projectaccountnamespacerole"."name", "projectaccountnamespacerole"."description", "projectaccountnamespacerole"."created_at", "projectaccountnamespacerole"."modified_at", "projectaccountnamespacerole"."trash", "projectaccountnamespacerole"."organization_id", "projectaccountnamespacerole"."partner_id", "projectaccountnamespacerole"."role_id", "projectaccountnamespacerole"."account_id", "projectaccountnamespacerole"."project_id", "projectaccountnamespacerole"."namespace", "projectaccountnamespacerole"."namespace_selector", "projectaccountnamespacerole"."active", "projectaccountnamespacerole"."starts_at", "projectaccountnamespacerole"."expires_at" FROM "authsrv_projectaccountnamespacerole" AS "projectaccountnamespacerole" WHERE \(project_id = '` + puuid.String() + `'\) AND \(trash = FALSE\)`).
 		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"namespace"}).AddRow("namespace1"))
-	mock.ExpectQuery(`SELECT "projectgroupnamespacerole"."id", "projectgroupnamespacerole"."name", "projectgroupnamespacerole"."description", "projectgroupnamespacerole"."created_at", "projectgroupnamespacerole"."modified_at", "projectgroupnamespacerole"."trash", "projectgroupnamespacerole"."organization_id", "projectgroupnamespacerole"."partner_id", "projectgroupnamespacerole"."role_id", "projectgroupnamespacerole"."group_id", "projectgroupnamespacerole"."project_id", "projectgroupnamespacerole"."namespace", "projectgroupnamespacerole"."active" FROM "authsrv_projectgroupnamespacerole" AS "projectgroupnamespacerole" WHERE \(project_id = '` + puuid.String() + `'\) AND \(trash = FALSE\)`).
+	mock.ExpectQuery(`SELECT "projectgroupnamespacerole"."id", "projectgroupnamespacerole"."name", "projectgroupnamespacerole"."description", "projectgroupnamespacerole"."created_at", "projectgroupnamespacerole"."modified_at", "projectgroupnamespacerole"."trash", "projectgroupnamespacerole"."organization_id", "projectgroupnamespacerole"."partner_id", "projectgroupnamespacerole"."role_id", "projectgroupnamespacerole"."group_id", "projectgroupnamespacerole"."project_id", "projectgroupnamespacerole"."namespace", "projectgroupnamespacerole"."namespace_selector", "projectgroupnamespacerole"."active", "projectgroupnamespacerole"."starts_at", "projectgroupnamespacerole"."expires_at" FROM "authsrv_projectgroupnamespacerole" AS "projectgroupnamespacerole" WHERE \(project_id = '` + puuid.String() + `'\) AND \(trash = FALSE\)`).
 		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"namespace"}).AddRow("namespace2"))
 
 	ns := NewNamespaceService(db)
-	nl, err := ns.GetProjectNamespaces(context.Background(), puuid)
+	nl, err := ns.GetProjectNamespaces(context.Background(), puuid, uuid.New())
 	if err != nil {
 		t.Fatal("unable to get namespaces", err)
 	}
@@ -40,11 +40,11 @@ func TestGetAccountProjectNamespaces(t *testing.T) {
 
 	puuid := uuid.New()
 	uuuid := uuid.New()
-	mock.ExpectQuery(`SELECT "projectaccountnamespacerole"."id", "projectaccountnamespacerole"."name", "projectaccountnamespacerole"."description", "projectaccountnamespacerole"."created_at", "projectaccountnamespacerole"."modified_at", "projectaccountnamespacerole"."trash", "projectaccountnamespacerole"."organization_id", "projectaccountnamespacerole"."partner_id", "projectaccountnamespacerole"."role_id", "projectaccountnamespacerole"."account_id", "projectaccountnamespacerole"."project_id", "projectaccountnamespacerole"."namespace", "projectaccountnamespacerole"."active" FROM "authsrv_projectaccountnamespacerole" AS "projectaccountnamespacerole" WHERE \(project_id = '` + puuid.String() + `'\) AND \(account_id = '` + uuuid.String() + `'\)`).
+	mock.ExpectQuery(`SELECT "projectaccountnamespacerole"."id", "projectaccountnamespacerole"."name", "projectaccountnamespacerole"."description", "projectaccountnamespacerole"."created_at", "projectaccountnamespacerole"."modified_at", "projectaccountnamespacerole"."trash", "projectaccountnamespacerole"."organization_id", "projectaccountnamespacerole"."partner_id", "projectaccountnamespacerole"."role_id", "projectaccountnamespacerole"."account_id", "projectaccountnamespacerole"."project_id", "projectaccountnamespacerole"."namespace", "projectaccountnamespacerole"."namespace_selector", "projectaccountnamespacerole"."active", "projectaccountnamespacerole"."starts_at", "projectaccountnamespacerole"."expires_at" FROM "authsrv_projectaccountnamespacerole" AS "projectaccountnamespacerole" WHERE \(project_id = '` + puuid.String() + `'\) AND \(account_id = '` + uuuid.String() + `'\)`).
 		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"namespace"}).AddRow("namespace1"))
 
 	ns := NewNamespaceService(db)
-	nl, err := ns.GetAccountProjectNamespaces(context.Background(), puuid, uuuid)
+	nl, err := ns.GetAccountProjectNamespaces(context.Background(), puuid, uuuid, uuid.New())
 	if err != nil {
 		t.Fatal("unable to get namespaces", err)
 	}
@@ -62,11 +62,11 @@ func TestGetGroupProjectNamespaces(t *testing.T) {
 
 	puuid := uuid.New()
 	uuuid := uuid.New()
-	mock.ExpectQuery(`SELECT "projectgroupnamespacerole"."id", "projectgroupnamespacerole"."name", "projectgroupnamespacerole"."description", "projectgroupnamespacerole"."created_at", "projectgroupnamespacerole"."modified_at", "projectgroupnamespacerole"."trash", "projectgroupnamespacerole"."organization_id", "projectgroupnamespacerole"."partner_id", "projectgroupnamespacerole"."role_id", "projectgroupnamespacerole"."group_id", "projectgroupnamespacerole"."project_id", "projectgroupnamespacerole"."namespace", "projectgroupnamespacerole"."active" FROM "authsrv_projectgroupnamespacerole" AS "projectgroupnamespacerole" JOIN authsrv_groupaccount ON projectgroupnamespacerole.group_id=authsrv_groupaccount.group_id WHERE \(project_id = '+` + puuid.String() + `+'\) AND \(authsrv_groupaccount.account_id = '` + uuuid.String() + `'\) AND \(projectgroupnamespacerole.trash = FALSE\) AND \(authsrv_groupaccount.trash = FALSE\)`).
+	mock.ExpectQuery(`SELECT "projectgroupnamespacerole"."id", "projectgroupnamespacerole"."name", "projectgroupnamespacerole"."description", "projectgroupnamespacerole"."created_at", "projectgroupnamespacerole"."modified_at", "projectgroupnamespacerole"."trash", "projectgroupnamespacerole"."organization_id", "projectgroupnamespacerole"."partner_id", "projectgroupnamespacerole"."role_id", "projectgroupnamespacerole"."group_id", "projectgroupnamespacerole"."project_id", "projectgroupnamespacerole"."namespace", "projectgroupnamespacerole"."namespace_selector", "projectgroupnamespacerole"."active", "projectgroupnamespacerole"."starts_at", "projectgroupnamespacerole"."expires_at" FROM "authsrv_projectgroupnamespacerole" AS "projectgroupnamespacerole" JOIN authsrv_groupaccount ON projectgroupnamespacerole.group_id=authsrv_groupaccount.group_id WHERE \(project_id = '+` + puuid.String() + `+'\) AND \(authsrv_groupaccount.account_id = '` + uuuid.String() + `'\) AND \(projectgroupnamespacerole.trash = FALSE\) AND \(authsrv_groupaccount.trash = FALSE\)`).
 		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"namespace"}).AddRow("namespace1"))
 
 	ns := NewNamespaceService(db)
-	nl, err := ns.GetGroupProjectNamespaces(context.Background(), puuid, uuuid)
+	nl, err := ns.GetGroupProjectNamespaces(context.Background(), puuid, uuuid, uuid.New())
 	if err != nil {
 		t.Fatal("unable to get namespaces", err)
 	}
@@ -77,3 +77,71 @@ func TestGetGroupProjectNamespaces(t *testing.T) {
 		t.Errorf("incorrect namespace name; expected '%v', got '%v'", "namespace1", nl[0])
 	}
 }
+
+func TestGetAccountProjectNamespacesExpanded(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	puuid := uuid.New()
+	uuuid := uuid.New()
+	cuuid := uuid.New()
+	mock.ExpectQuery(`SELECT .* FROM "authsrv_projectaccountnamespacerole" AS "projectaccountnamespacerole" WHERE \(project_id = '` + puuid.String() + `'\) AND \(account_id = '` + uuuid.String() + `'\)`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"namespace", "namespace_selector"}).
+		AddRow("team-a-*", "").
+		AddRow("", "tier=backend").
+		AddRow("payments", ""))
+	mock.ExpectQuery(`SELECT cns.name, cns.namespace->'objectMeta'->'labels' AS labels FROM "cluster_namespaces" AS "cns" WHERE \(cns.cluster_id = '` + cuuid.String() + `'\) AND \(cns.deleted_at IS NULL\)`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"name", "labels"}).
+		AddRow("team-a-api", `{"tier": "frontend"}`).
+		AddRow("team-a-db", `{"tier": "backend"}`).
+		AddRow("team-b-db", `{"tier": "backend"}`).
+		AddRow("team-b-web", nil))
+
+	ns := NewNamespaceService(db)
+	nl, err := ns.GetAccountProjectNamespaces(context.Background(), puuid, uuuid, cuuid)
+	if err != nil {
+		t.Fatal("unable to get namespaces", err)
+	}
+	expected := []string{"team-a-api", "team-a-db", "team-b-db", "payments"}
+	if len(nl) != len(expected) {
+		t.Fatalf("incorrect namespaces; expected '%v', got '%v'", expected, nl)
+	}
+	for i := range expected {
+		if nl[i] != expected[i] {
+			t.Errorf("incorrect namespace name; expected '%v', got '%v'", expected[i], nl[i])
+		}
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestValidateBindingNamespace(t *testing.T) {
+	tt := []struct {
+		name      string
+		namespace string
+		selector  string
+		valid     bool
+	}{
+		{"namespace", "payments", "", true},
+		{"invalid namespace", "Payments", "", false},
+		{"empty namespace", "", "", false},
+		{"pattern", "team-a-*", "", true},
+		{"character class pattern", "team-[ab]-db", "", true},
+		{"invalid pattern", "team-[a", "", false},
+		{"selector", "", "tier in (backend,db)", true},
+		{"invalid selector", "", "tier in (backend", false},
+		{"namespace and selector", "payments", "tier=backend", false},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateBindingNamespace(tc.namespace, tc.selector)
+			if tc.valid && err != nil {
+				t.Errorf("expected namespace to be valid; got %v", err)
+			}
+			if !tc.valid && err == nil {
+				t.Error("expected namespace to be invalid")
+			}
+		})
+	}
+}
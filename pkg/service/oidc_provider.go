@@ -28,10 +28,11 @@ type OIDCProviderService interface {
 	List(context.Context) (*systemv3.OIDCProviderList, error)
 	Update(context.Context, *systemv3.OIDCProvider) (*systemv3.OIDCProvider, error)
 	Delete(context.Context, *systemv3.OIDCProvider) error
+	DryRunClaimMappings(context.Context, *systemv3.ClaimMappingDryRun) (*systemv3.ClaimMappingDryRun, error)
 }
 
 type oidcProvider struct {
-	db        *bun.DB
+	db        bun.IDB
 	kratosUrl string
 	al        *zap.Logger
 }
@@ -136,6 +137,13 @@ func (s *oidcProvider) Create(ctx context.Context, provider *systemv3.OIDCProvid
 	if len(tknUrl) != 0 && !validateURL(tknUrl) {
 		return &systemv3.OIDCProvider{}, fmt.Errorf("invalid token url")
 	}
+	if _, err := compileClaimMappings(provider.Spec.GetClaimMappings()); err != nil {
+		return &systemv3.OIDCProvider{}, err
+	}
+	claimMappings, err := claimMappingsToJSON(provider.Spec.GetClaimMappings())
+	if err != nil {
+		return &systemv3.OIDCProvider{}, err
+	}
 
 	entity := &models.OIDCProvider{
 		Name:            name,
@@ -154,6 +162,7 @@ func (s *oidcProvider) Create(ctx context.Context, provider *systemv3.OIDCProvid
 		AuthURL:         authUrl,
 		TokenURL:        tknUrl,
 		RequestedClaims: provider.Spec.GetRequestedClaims().AsMap(),
+		ClaimMappings:   claimMappings,
 		Predefined:      provider.Spec.GetPredefined(),
 	}
 	_, err = dao.Create(ctx, s.db, entity)
@@ -180,6 +189,7 @@ func (s *oidcProvider) Create(ctx context.Context, provider *systemv3.OIDCProvid
 			AuthUrl:         entity.AuthURL,
 			TokenUrl:        entity.TokenURL,
 			RequestedClaims: rclaims,
+			ClaimMappings:   providerClaimMappings(entity),
 			Predefined:      entity.Predefined,
 			CallbackUrl:     generateCallbackUrl(entity.Name, s.kratosUrl),
 		},
@@ -222,6 +232,7 @@ func (s *oidcProvider) GetByID(ctx context.Context, provider *systemv3.OIDCProvi
 			AuthUrl:         entity.AuthURL,
 			TokenUrl:        entity.TokenURL,
 			RequestedClaims: rclaims,
+			ClaimMappings:   providerClaimMappings(entity),
 			Predefined:      entity.Predefined,
 			CallbackUrl:     generateCallbackUrl(entity.Name, s.kratosUrl),
 		},
@@ -269,6 +280,7 @@ func (s *oidcProvider) GetByName(ctx context.Context, provider *systemv3.OIDCPro
 			AuthUrl:         entity.AuthURL,
 			TokenUrl:        entity.TokenURL,
 			RequestedClaims: rclaims,
+			ClaimMappings:   providerClaimMappings(entity),
 			Predefined:      entity.Predefined,
 			CallbackUrl:     generateCallbackUrl(entity.Name, s.kratosUrl),
 		},
@@ -308,6 +320,7 @@ func (s *oidcProvider) List(ctx context.Context) (*systemv3.OIDCProviderList, er
 				AuthUrl:         entity.AuthURL,
 				TokenUrl:        entity.TokenURL,
 				RequestedClaims: rclaims,
+				ClaimMappings:   providerClaimMappings(&entity),
 				Predefined:      entity.Predefined,
 				CallbackUrl:     generateCallbackUrl(entity.Name, s.kratosUrl),
 			},
@@ -368,6 +381,13 @@ func (s *oidcProvider) Update(ctx context.Context, provider *systemv3.OIDCProvid
 	if len(tknUrl) != 0 && !validateURL(tknUrl) {
 		return &systemv3.OIDCProvider{}, fmt.Errorf("invalid token url")
 	}
+	if _, err := compileClaimMappings(provider.Spec.GetClaimMappings()); err != nil {
+		return &systemv3.OIDCProvider{}, err
+	}
+	claimMappings, err := claimMappingsToJSON(provider.Spec.GetClaimMappings())
+	if err != nil {
+		return &systemv3.OIDCProvider{}, err
+	}
 
 	entity := &models.OIDCProvider{
 		Name:            provider.Metadata.GetName(),
@@ -385,6 +405,7 @@ func (s *oidcProvider) Update(ctx context.Context, provider *systemv3.OIDCProvid
 		AuthURL:         authUrl,
 		TokenURL:        tknUrl,
 		RequestedClaims: provider.Spec.GetRequestedClaims().AsMap(),
+		ClaimMappings:   claimMappings,
 		Predefined:      provider.Spec.GetPredefined(),
 	}
 	_, err = dao.Update(ctx, s.db, existingP.Id, entity)
@@ -413,6 +434,7 @@ func (s *oidcProvider) Update(ctx context.Context, provider *systemv3.OIDCProvid
 			AuthUrl:         entity.AuthURL,
 			TokenUrl:        entity.TokenURL,
 			RequestedClaims: rclaims,
+			ClaimMappings:   providerClaimMappings(entity),
 			Predefined:      entity.Predefined,
 			CallbackUrl:     generateCallbackUrl(provider.GetMetadata().GetName(), s.kratosUrl),
 		},
@@ -441,3 +463,32 @@ func (s *oidcProvider) Delete(ctx context.Context, provider *systemv3.OIDCProvid
 	CreateOidcAuditEvent(ctx, s.al, AuditActionDelete, name, entity.Id)
 	return nil
 }
+
+// DryRunClaimMappings shows what the claims of the request resolve to
+// with the claim mapping rules of a provider, or the rules of the
+// request when set, without changing anything.
+func (s *oidcProvider) DryRunClaimMappings(ctx context.Context, dr *systemv3.ClaimMappingDryRun) (*systemv3.ClaimMappingDryRun, error) {
+	name := dr.GetMetadata().GetName()
+	if len(name) == 0 {
+		return &systemv3.ClaimMappingDryRun{}, status.Error(codes.InvalidArgument, "empty name")
+	}
+	entity := &models.OIDCProvider{}
+	_, err := dao.GetByName(ctx, s.db, name, entity)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return &systemv3.ClaimMappingDryRun{}, status.Errorf(codes.InvalidArgument, "oidc provider %q not exist", name)
+		}
+		return &systemv3.ClaimMappingDryRun{}, status.Error(codes.Internal, codes.Internal.String())
+	}
+
+	rules := dr.GetSpec().GetClaimMappings()
+	if len(rules) == 0 {
+		rules = providerClaimMappings(entity)
+	}
+	_, st, err := evaluateClaimMappings(ctx, s.db, entity, rules, dr.GetSpec().GetClaims().AsMap())
+	if err != nil {
+		return &systemv3.ClaimMappingDryRun{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	dr.Status = st
+	return dr, nil
+}
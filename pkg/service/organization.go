@@ -203,6 +203,14 @@ func (s *organizationService) Update(ctx context.Context, organization *systemv3
 
 	if org, ok := entity.(*models.Organization); ok {
 		settingsAfter := organization.GetSpec().GetSettings()
+		if err := ValidateBreakGlassPolicy(settingsAfter.GetBreakGlass()); err != nil {
+			return &systemv3.Organization{}, err
+		}
+		if settingsAfter.GetBreakGlass().GetEnabled() {
+			if _, err := getBreakGlassRole(ctx, s.db, settingsAfter.GetBreakGlass().GetRole()); err != nil {
+				return &systemv3.Organization{}, err
+			}
+		}
 		settingsBefore := systemv3.OrganizationSettings{}
 		_ = json.Unmarshal(org.Settings, &settingsBefore) // ignore any unmarshelling issues
 
@@ -45,7 +45,7 @@ type ProjectService interface {
 
 // projectService implements ProjectService
 type projectService struct {
-	db  *bun.DB
+	db  bun.IDB
 	azc AuthzService
 	al  *zap.Logger
 	dev bool
@@ -471,6 +471,10 @@ func (s *projectService) createGroupRoleRelations(ctx context.Context, db bun.ID
 	var pgnr []models.ProjectGroupNamespaceRole
 	var ps []*authzv1.Policy
 	for _, pnr := range projectNamespaceRoles {
+		w, err := getBindingWindow(pnr)
+		if err != nil {
+			return &systemv3.Project{}, err
+		}
 		role := pnr.GetRole()
 		entity, err := dao.GetByName(ctx, db, role, &models.Role{})
 		if err != nil {
@@ -514,11 +518,13 @@ func (s *projectService) createGroupRoleRelations(ctx context.Context, db bun.ID
 				OrganizationId: ids.Organization,
 				GroupId:        grpId,
 				ProjectId:      ids.Id,
-				Active:         true,
+				StartsAt:       w.StartsAt,
+				ExpiresAt:      w.ExpiresAt,
+				Active:         w.active(time.Now()),
 			}
 			pgrs = append(pgrs, pgr)
 
-			ps = append(ps, &authzv1.Policy{
+			ps = w.appendPolicy(ps, &authzv1.Policy{
 				Sub:  "g:" + grpName,
 				Ns:   "*",
 				Proj: project.Metadata.Name,
@@ -531,23 +537,31 @@ func (s *projectService) createGroupRoleRelations(ctx context.Context, db bun.ID
 			}
 
 			namespace := pnr.GetNamespace()
+			if IsNamespacePattern(namespace) || pnr.GetNamespaceSelector() != "" {
+				if err := validateBindingNamespace(namespace, pnr.GetNamespaceSelector()); err != nil {
+					return &systemv3.Project{}, err
+				}
+			}
 			pgnrObj := models.ProjectGroupNamespaceRole{
-				CreatedAt:      time.Now(),
-				ModifiedAt:     time.Now(),
-				Trash:          false,
-				PartnerId:      ids.Partner,
-				OrganizationId: ids.Organization,
-				RoleId:         roleId,
-				GroupId:        grpId,
-				ProjectId:      ids.Id,
-				Namespace:      namespace,
-				Active:         true,
+				CreatedAt:         time.Now(),
+				ModifiedAt:        time.Now(),
+				Trash:             false,
+				PartnerId:         ids.Partner,
+				OrganizationId:    ids.Organization,
+				RoleId:            roleId,
+				GroupId:           grpId,
+				ProjectId:         ids.Id,
+				Namespace:         namespace,
+				NamespaceSelector: pnr.GetNamespaceSelector(),
+				StartsAt:          w.StartsAt,
+				ExpiresAt:         w.ExpiresAt,
+				Active:            w.active(time.Now()),
 			}
 			pgnr = append(pgnr, pgnrObj)
 
-			ps = append(ps, &authzv1.Policy{
+			ps = w.appendPolicy(ps, &authzv1.Policy{
 				Sub:  "g:" + grpName,
-				Ns:   namespace,
+				Ns:   policyNamespace(namespace),
 				Proj: project.Metadata.Name,
 				Org:  org,
 				Obj:  role,
@@ -628,6 +642,10 @@ func (s *projectService) createProjectAccountRelations(ctx context.Context, db b
 	var ugs []*authzv1.Policy
 
 	for _, ur := range project.GetSpec().GetUserRoles() {
+		w, err := getBindingWindow(ur)
+		if err != nil {
+			return &systemv3.Project{}, err
+		}
 		// FIXME: do combined lookup
 		entity, err := dao.GetUserIdByEmail(ctx, db, ur.User, &models.KratosIdentities{})
 		if err != nil {
@@ -651,10 +669,12 @@ func (s *projectService) createProjectAccountRelations(ctx context.Context, db b
 						RoleId:         role.ID,
 						OrganizationId: role.OrganizationId,
 						PartnerId:      role.PartnerId,
-						Active:         true,
+						StartsAt:       w.StartsAt,
+						ExpiresAt:      w.ExpiresAt,
+						Active:         w.active(time.Now()),
 					}
 					parrs = append(parrs, parr)
-					ugs = append(ugs, &authzv1.Policy{
+					ugs = w.appendPolicy(ugs, &authzv1.Policy{
 						Sub:  "u:" + ur.User,
 						Proj: project.Metadata.Name,
 						Org:  project.Metadata.Organization,
@@ -662,25 +682,33 @@ func (s *projectService) createProjectAccountRelations(ctx context.Context, db b
 						Obj:  role.Name,
 					})
 				case "namespace":
+					if IsNamespacePattern(ur.GetNamespace()) || ur.GetNamespaceSelector() != "" {
+						if err := validateBindingNamespace(ur.GetNamespace(), ur.GetNamespaceSelector()); err != nil {
+							return &systemv3.Project{}, err
+						}
+					}
 					panrObj := models.ProjectAccountNamespaceRole{
-						CreatedAt:      time.Now(),
-						ModifiedAt:     time.Now(),
-						Trash:          false,
-						AccountId:      acc.ID,
-						PartnerId:      role.PartnerId,
-						OrganizationId: role.OrganizationId,
-						RoleId:         role.ID,
-						ProjectId:      projectId,
-						Namespace:      ur.GetNamespace(),
-						Active:         true,
+						CreatedAt:         time.Now(),
+						ModifiedAt:        time.Now(),
+						Trash:             false,
+						AccountId:         acc.ID,
+						PartnerId:         role.PartnerId,
+						OrganizationId:    role.OrganizationId,
+						RoleId:            role.ID,
+						ProjectId:         projectId,
+						Namespace:         ur.GetNamespace(),
+						NamespaceSelector: ur.GetNamespaceSelector(),
+						StartsAt:          w.StartsAt,
+						ExpiresAt:         w.ExpiresAt,
+						Active:            w.active(time.Now()),
 					}
 					panrs = append(panrs, panrObj)
 
-					ugs = append(ugs, &authzv1.Policy{
+					ugs = w.appendPolicy(ugs, &authzv1.Policy{
 						Sub:  "u:" + ur.User,
 						Proj: project.Metadata.Name,
 						Org:  project.Metadata.Organization,
-						Ns:   ur.GetNamespace(),
+						Ns:   policyNamespace(ur.GetNamespace()),
 						Obj:  role.Name,
 					})
 				default:
@@ -107,6 +107,7 @@ func (ra *relayAuditDatabaseService) GetRelayAuditByProjects(ctx context.Context
 		return nil, err
 	}
 	json.Unmarshal(data, &resMap)
+	flagBreakGlassRecords(ctx, ra.db, resMap)
 
 	result, _ := structpb.NewStruct(resMap)
 	res = &v1.RelayAuditResponse{
@@ -235,6 +235,7 @@ func (ra *relayAuditElasticSearchService) GetRelayAuditByProjects(ctx context.Co
 	if r == nil {
 		return res, nil
 	}
+	flagBreakGlassRecords(ctx, ra.db, r)
 	raw, err := structpb.NewStruct(r)
 	if err != nil {
 		return res, err
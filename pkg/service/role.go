@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"regexp"
 	"strings"
 	"time"
 
@@ -25,6 +26,21 @@ const (
 	roleListKind = "RoleList"
 )
 
+var (
+	// verbs kubernetes rules can allow
+	kubernetesVerbs = []string{
+		"get", "list", "watch", "create", "update", "patch", "delete", "deletecollection",
+		"use", "bind", "escalate", "impersonate", "approve", "sign", "*",
+	}
+	// verbs whose requests do not name a resource, resource names
+	// cannot restrict them
+	kubernetesCollectionVerbs = []string{"list", "watch", "create", "deletecollection"}
+
+	kubernetesAPIGroupRegexp    = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+	kubernetesResourceRegexp    = regexp.MustCompile(`^[a-z0-9]([-a-z0-9.]*[a-z0-9])?$`)
+	kubernetesSubresourceRegexp = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+)
+
 // RoleService is the interface for role operations
 type RoleService interface {
 	// create role
@@ -45,7 +61,7 @@ type RoleService interface {
 
 // roleService implements RoleService
 type roleService struct {
-	db  *bun.DB
+	db  bun.IDB
 	azc AuthzService
 	al  *zap.Logger
 }
@@ -126,6 +142,86 @@ func (s *roleService) createRolePermissionMapping(ctx context.Context, db bun.ID
 	return role, nil
 }
 
+// validateKubernetesRules returns an error when a rule would not be
+// accepted by kubernetes or would not grant what it describes
+func validateKubernetesRules(rules []*rolev3.KubernetesRule) error {
+	for i, rule := range rules {
+		if len(rule.GetVerbs()) == 0 {
+			return fmt.Errorf("invalid kubernetes rule %d: no verbs", i)
+		}
+		for _, verb := range rule.GetVerbs() {
+			if !utils.Contains(kubernetesVerbs, verb) {
+				return fmt.Errorf("invalid kubernetes rule %d: unknown verb '%v'", i, verb)
+			}
+		}
+		if len(rule.GetApiGroups()) == 0 {
+			return fmt.Errorf("invalid kubernetes rule %d: no apiGroups, \"\" is the core group", i)
+		}
+		for _, group := range rule.GetApiGroups() {
+			if group != "" && group != "*" && !kubernetesAPIGroupRegexp.MatchString(group) {
+				return fmt.Errorf("invalid kubernetes rule %d: invalid apiGroup '%v'", i, group)
+			}
+		}
+		if len(rule.GetResources()) == 0 {
+			return fmt.Errorf("invalid kubernetes rule %d: no resources", i)
+		}
+		for _, resource := range rule.GetResources() {
+			if strings.Contains(resource, "/") {
+				return fmt.Errorf("invalid kubernetes rule %d: resource '%v' should be split into resources and subresources", i, resource)
+			}
+			if resource != "*" && !kubernetesResourceRegexp.MatchString(resource) {
+				return fmt.Errorf("invalid kubernetes rule %d: invalid resource '%v'", i, resource)
+			}
+		}
+		for _, subresource := range rule.GetSubresources() {
+			if subresource != "*" && !kubernetesSubresourceRegexp.MatchString(subresource) {
+				return fmt.Errorf("invalid kubernetes rule %d: invalid subresource '%v'", i, subresource)
+			}
+		}
+		for _, name := range rule.GetResourceNames() {
+			if name == "" || name == "*" || strings.ContainsAny(name, "/ \t\n") {
+				return fmt.Errorf("invalid kubernetes rule %d: invalid resource name '%v'", i, name)
+			}
+		}
+		if len(rule.GetResourceNames()) > 0 {
+			for _, verb := range rule.GetVerbs() {
+				if utils.Contains(kubernetesCollectionVerbs, verb) {
+					return fmt.Errorf("invalid kubernetes rule %d: resource names cannot restrict verb '%v'", i, verb)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func toModelKubernetesRules(rules []*rolev3.KubernetesRule) []models.KubernetesRule {
+	var mrules []models.KubernetesRule
+	for _, rule := range rules {
+		mrules = append(mrules, models.KubernetesRule{
+			APIGroups:     rule.GetApiGroups(),
+			Resources:     rule.GetResources(),
+			Subresources:  rule.GetSubresources(),
+			ResourceNames: rule.GetResourceNames(),
+			Verbs:         rule.GetVerbs(),
+		})
+	}
+	return mrules
+}
+
+func toV3KubernetesRules(mrules []models.KubernetesRule) []*rolev3.KubernetesRule {
+	var rules []*rolev3.KubernetesRule
+	for _, rule := range mrules {
+		rules = append(rules, &rolev3.KubernetesRule{
+			ApiGroups:     rule.APIGroups,
+			Resources:     rule.Resources,
+			Subresources:  rule.Subresources,
+			ResourceNames: rule.ResourceNames,
+			Verbs:         rule.Verbs,
+		})
+	}
+	return rules
+}
+
 func (s *roleService) Create(ctx context.Context, role *rolev3.Role) (*rolev3.Role, error) {
 	partnerId, organizationId, err := s.getPartnerOrganization(ctx, s.db, role)
 	if err != nil {
@@ -156,6 +252,10 @@ func (s *roleService) Create(ctx context.Context, role *rolev3.Role) (*rolev3.Ro
 		return nil, fmt.Errorf("invalid role permissions, '%v', '%v' should be present ", partnerR, organizationR)
 	}
 
+	if err := validateKubernetesRules(role.GetSpec().GetKubernetesRules()); err != nil {
+		return nil, err
+	}
+
 	// Only allow internal call (eg: initialize) to set builtin flag
 	builtin := role.GetSpec().GetBuiltin()
 	if builtin {
@@ -164,16 +264,18 @@ func (s *roleService) Create(ctx context.Context, role *rolev3.Role) (*rolev3.Ro
 
 	// convert v3 spec to internal models
 	rle := models.Role{
-		Name:           role.GetMetadata().GetName(),
-		Description:    role.GetMetadata().GetDescription(),
-		CreatedAt:      time.Now(),
-		ModifiedAt:     time.Now(),
-		Trash:          false,
-		OrganizationId: organizationId,
-		PartnerId:      partnerId,
-		IsGlobal:       role.GetSpec().GetIsGlobal(),
-		Builtin:        builtin,
-		Scope:          strings.ToLower(scope),
+		Name:            role.GetMetadata().GetName(),
+		Description:     role.GetMetadata().GetDescription(),
+		CreatedAt:       time.Now(),
+		ModifiedAt:      time.Now(),
+		Trash:           false,
+		OrganizationId:  organizationId,
+		PartnerId:       partnerId,
+		IsGlobal:        role.GetSpec().GetIsGlobal(),
+		Builtin:         builtin,
+		Scope:           strings.ToLower(scope),
+		RequireMfa:      role.GetSpec().GetRequireMfa(),
+		KubernetesRules: toModelKubernetesRules(role.GetSpec().GetKubernetesRules()),
 	}
 
 	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
@@ -201,7 +303,7 @@ func (s *roleService) Create(ctx context.Context, role *rolev3.Role) (*rolev3.Ro
 			_log.Warn("unable to commit changes", err)
 		}
 
-		CreateRoleAuditEvent(ctx, s.al, AuditActionCreate, role.GetMetadata().GetName(), createdRole.ID, role.GetSpec().GetRolepermissions())
+		CreateRoleAuditEvent(ctx, s.al, AuditActionCreate, role.GetMetadata().GetName(), createdRole.ID, role.GetSpec().GetRolepermissions(), role.GetSpec().GetKubernetesRules())
 
 		return role, nil
 	}
@@ -282,6 +384,10 @@ func (s *roleService) Update(ctx context.Context, role *rolev3.Role) (*rolev3.Ro
 		return nil, fmt.Errorf("invalid role permissions, '%v', '%v' should be present ", partnerR, organizationR)
 	}
 
+	if err := validateKubernetesRules(role.GetSpec().GetKubernetesRules()); err != nil {
+		return nil, err
+	}
+
 	if rle, ok := entity.(*models.Role); ok {
 		if rle.Builtin {
 			return role, fmt.Errorf("builtin role '%v' cannot be updated", name)
@@ -291,6 +397,8 @@ func (s *roleService) Update(ctx context.Context, role *rolev3.Role) (*rolev3.Ro
 		rle.Description = role.Metadata.Description
 		rle.Scope = role.Spec.Scope
 		rle.IsGlobal = role.Spec.IsGlobal
+		rle.RequireMfa = role.Spec.RequireMfa
+		rle.KubernetesRules = toModelKubernetesRules(role.Spec.KubernetesRules)
 		rle.ModifiedAt = time.Now()
 
 		tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
@@ -318,8 +426,10 @@ func (s *roleService) Update(ctx context.Context, role *rolev3.Role) (*rolev3.Ro
 
 		//update spec and status
 		role.Spec = &rolev3.RoleSpec{
-			IsGlobal: rle.IsGlobal,
-			Scope:    rle.Scope,
+			IsGlobal:        rle.IsGlobal,
+			Scope:           rle.Scope,
+			RequireMfa:      rle.RequireMfa,
+			KubernetesRules: toV3KubernetesRules(rle.KubernetesRules),
 		}
 
 		err = tx.Commit()
@@ -328,7 +438,7 @@ func (s *roleService) Update(ctx context.Context, role *rolev3.Role) (*rolev3.Ro
 			_log.Warn("unable to commit changes", err)
 		}
 
-		CreateRoleAuditEvent(ctx, s.al, AuditActionUpdate, role.GetMetadata().GetName(), rle.ID, role.GetSpec().GetRolepermissions())
+		CreateRoleAuditEvent(ctx, s.al, AuditActionUpdate, role.GetMetadata().GetName(), rle.ID, role.GetSpec().GetRolepermissions(), role.GetSpec().GetKubernetesRules())
 		return role, nil
 	}
 	return &rolev3.Role{}, fmt.Errorf("unable to update role '%v'", role.GetMetadata().GetName())
@@ -374,7 +484,7 @@ func (s *roleService) Delete(ctx context.Context, role *rolev3.Role) (*rolev3.Ro
 			_log.Warn("unable to commit changes", err)
 		}
 
-		CreateRoleAuditEvent(ctx, s.al, AuditActionDelete, role.GetMetadata().GetName(), rle.ID, []string{})
+		CreateRoleAuditEvent(ctx, s.al, AuditActionDelete, role.GetMetadata().GetName(), rle.ID, []string{}, nil)
 		return role, nil
 	}
 
@@ -410,6 +520,8 @@ func (s *roleService) toV3Role(ctx context.Context, db bun.IDB, role *rolev3.Rol
 		Scope:           rle.Scope,
 		Rolepermissions: permissions,
 		Builtin:         rle.Builtin,
+		RequireMfa:      rle.RequireMfa,
+		KubernetesRules: toV3KubernetesRules(rle.KubernetesRules),
 	}
 	return role, nil
 }
@@ -477,6 +589,7 @@ func (s *roleService) Upsert(ctx context.Context, role *rolev3.Role) (*rolev3.Ro
 		IsGlobal:       role.GetSpec().GetIsGlobal(),
 		Builtin:        role.GetSpec().GetBuiltin(),
 		Scope:          strings.ToLower(role.GetSpec().GetScope()),
+		RequireMfa:     role.GetSpec().GetRequireMfa(),
 	}
 
 	_, err = s.db.NewInsert().
@@ -486,6 +599,7 @@ func (s *roleService) Upsert(ctx context.Context, role *rolev3.Role) (*rolev3.Ro
 		Set("scope = EXCLUDED.scope").
 		Set("is_global = EXCLUDED.is_global").
 		Set("builtin = EXCLUDED.builtin").
+		Set("require_mfa = EXCLUDED.require_mfa").
 		Set("modified_at = EXCLUDED.modified_at").
 		Set("name = EXCLUDED.name").
 		Set("organization_id = EXCLUDED.organization_id").
@@ -506,6 +620,7 @@ func (s *roleService) Upsert(ctx context.Context, role *rolev3.Role) (*rolev3.Ro
 			Scope:           rle.Scope,
 			IsGlobal:        rle.IsGlobal,
 			Builtin:         rle.Builtin,
+			RequireMfa:      rle.RequireMfa,
 			Rolepermissions: role.GetSpec().GetRolepermissions(),
 		},
 	}, nil
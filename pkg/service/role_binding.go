@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/paralus/paralus/internal/dao"
+	"github.com/paralus/paralus/internal/models"
+	authzv1 "github.com/paralus/paralus/proto/types/authz"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	bun "github.com/uptrace/bun"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// roleBindingReconcilerName is the actor of the audit events of the
+// role binding reconciler
+const roleBindingReconcilerName = "rolebinding-reconciler"
+
+// timeBoundRole is a role binding of the api types with an optional
+// start and expiry
+type timeBoundRole interface {
+	GetStartsAt() *timestamppb.Timestamp
+	GetExpiresAt() *timestamppb.Timestamp
+}
+
+// bindingWindow is the time a role binding is in effect, a zero start
+// or expiry leaves the window open on that side
+type bindingWindow struct {
+	StartsAt  time.Time
+	ExpiresAt time.Time
+}
+
+func getBindingWindow(r timeBoundRole) (bindingWindow, error) {
+	var w bindingWindow
+	if r.GetStartsAt() != nil {
+		w.StartsAt = r.GetStartsAt().AsTime()
+	}
+	if r.GetExpiresAt() != nil {
+		w.ExpiresAt = r.GetExpiresAt().AsTime()
+	}
+	if !w.StartsAt.IsZero() && !w.ExpiresAt.IsZero() && !w.ExpiresAt.After(w.StartsAt) {
+		return w, fmt.Errorf("expiry of role must be after its start")
+	}
+	return w, nil
+}
+
+// active returns whether the binding is in effect at t
+func (w bindingWindow) active(t time.Time) bool {
+	if !w.StartsAt.IsZero() && w.StartsAt.After(t) {
+		return false
+	}
+	return w.ExpiresAt.IsZero() || w.ExpiresAt.After(t)
+}
+
+// appendPolicy appends the authz policy of the binding when it is in
+// effect, the policies of bindings starting later are created by the
+// RoleBindingReconciler
+func (w bindingWindow) appendPolicy(ps []*authzv1.Policy, p *authzv1.Policy) []*authzv1.Policy {
+	if !w.active(time.Now()) {
+		return ps
+	}
+	return append(ps, p)
+}
+
+// roleBindingPolicy returns the authz policy of a role binding
+func roleBindingPolicy(rb models.RoleBinding) *authzv1.Policy {
+	p := &authzv1.Policy{Sub: rb.Subject, Ns: "*", Proj: "*", Org: "*", Obj: rb.Role}
+	switch rb.Scope {
+	case "organization":
+		p.Org = rb.Organization
+	case "project":
+		p.Org = rb.Organization
+		p.Proj = rb.Project
+	case "namespace":
+		p.Org = rb.Organization
+		p.Proj = rb.Project
+		p.Ns = policyNamespace(rb.Namespace)
+	}
+	return p
+}
+
+// deleteRoleBindingPolicy deletes the authz policy of a removed role
+// binding, the policy is kept while another binding in effect grants it
+func deleteRoleBindingPolicy(ctx context.Context, db bun.IDB, azc AuthzService, rb models.RoleBinding) error {
+	p := roleBindingPolicy(rb)
+	rbs, err := dao.GetRoleBindingsInEffect(ctx, db, rb.OrganizationId, rb.RoleId)
+	if err != nil {
+		return err
+	}
+	for _, other := range rbs {
+		if other.ID != rb.ID && proto.Equal(roleBindingPolicy(other), p) {
+			return nil
+		}
+	}
+	_, err = azc.DeletePolicies(ctx, p)
+	return err
+}
+
+// RoleBindingReconciler applies the start and expiry of role bindings
+type RoleBindingReconciler interface {
+	// Reconcile removes the role bindings which expired and creates
+	// the authz policies of the ones which started
+	Reconcile(ctx context.Context) error
+}
+
+type roleBindingReconciler struct {
+	db  *bun.DB
+	azc AuthzService
+	al  *zap.Logger
+}
+
+// NewRoleBindingReconciler returns a new role binding reconciler
+func NewRoleBindingReconciler(db *bun.DB, azc AuthzService, al *zap.Logger) RoleBindingReconciler {
+	return &roleBindingReconciler{db: db, azc: azc, al: al}
+}
+
+func (r *roleBindingReconciler) Reconcile(ctx context.Context) error {
+	now := time.Now()
+	expired, err := dao.GetExpiredRoleBindings(ctx, r.db, now)
+	if err != nil {
+		return err
+	}
+	for _, rb := range expired {
+		// every replica runs the reconciler, a binding is removed by
+		// the one claiming it
+		claimed, err := dao.ExpireRoleBinding(ctx, r.db, rb)
+		if err != nil {
+			return err
+		}
+		if !claimed {
+			continue
+		}
+		err = deleteRoleBindingPolicy(ctx, r.db, r.azc, rb)
+		if err != nil {
+			return fmt.Errorf("unable to delete expired role binding from authz; %v", err)
+		}
+		ExpireRoleBindingAuditEvent(r.al, &commonv3.SessionData{
+			Username:     roleBindingReconcilerName,
+			Organization: rb.OrganizationId.String(),
+			Partner:      rb.PartnerId.String(),
+		}, rb)
+	}
+
+	started, err := dao.GetStartedRoleBindings(ctx, r.db, now)
+	if err != nil {
+		return err
+	}
+	var ps []*authzv1.Policy
+	for _, rb := range started {
+		claimed, err := dao.ActivateRoleBinding(ctx, r.db, rb)
+		if err != nil {
+			return err
+		}
+		if claimed {
+			ps = append(ps, roleBindingPolicy(rb))
+		}
+	}
+	if len(ps) > 0 {
+		_, err = r.azc.CreatePolicies(ctx, &authzv1.Policies{Policies: ps})
+		if err != nil {
+			return fmt.Errorf("unable to create started role bindings in authz; %v", err)
+		}
+	}
+	return nil
+}
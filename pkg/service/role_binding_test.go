@@ -0,0 +1,201 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	authzv1 "github.com/paralus/paralus/proto/types/authz"
+	userv3 "github.com/paralus/paralus/proto/types/userpb/v3"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var roleBindingTestTables = []string{
+	"authsrv_accountresourcerole",
+	"authsrv_grouprole",
+	"authsrv_projectaccountresourcerole",
+	"authsrv_projectaccountnamespacerole",
+	"authsrv_projectgrouprole",
+	"authsrv_projectgroupnamespacerole",
+}
+
+func TestBindingWindow(t *testing.T) {
+	now := time.Now()
+
+	w, err := getBindingWindow(&userv3.ProjectNamespaceRole{Role: "PROJECT_ADMIN"})
+	if err != nil {
+		t.Fatal("could not get window of permanent binding:", err)
+	}
+	if !w.active(now) {
+		t.Error("expected binding without start and expiry to be active")
+	}
+
+	w, err = getBindingWindow(&userv3.ProjectNamespaceRole{StartsAt: timestamppb.New(now.Add(time.Hour))})
+	if err != nil {
+		t.Fatal("could not get window of future binding:", err)
+	}
+	if w.active(now) {
+		t.Error("expected binding starting later to be inactive")
+	}
+	if ps := w.appendPolicy(nil, &authzv1.Policy{Sub: "u:user@example.com"}); len(ps) != 0 {
+		t.Errorf("expected no policy for binding starting later; got %v", ps)
+	}
+
+	w, err = getBindingWindow(&userv3.UserRole{ExpiresAt: timestamppb.New(now.Add(-time.Minute))})
+	if err != nil {
+		t.Fatal("could not get window of expired binding:", err)
+	}
+	if w.active(now) {
+		t.Error("expected expired binding to be inactive")
+	}
+
+	_, err = getBindingWindow(&userv3.UserRole{
+		StartsAt:  timestamppb.New(now.Add(time.Hour)),
+		ExpiresAt: timestamppb.New(now),
+	})
+	if err == nil {
+		t.Error("expected error for binding expiring before its start")
+	}
+}
+
+func TestRoleBindingReconcile(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	mazc := mockAuthzClient{}
+	rbr := NewRoleBindingReconciler(db, &mazc, getLogger())
+
+	expired := uuid.New().String()
+	started := uuid.New().String()
+	ouuid := uuid.New().String()
+	puuid := uuid.New().String()
+
+	for _, table := range roleBindingTestTables {
+		rows := sqlmock.NewRows([]string{"id", "organization_id", "partner_id", "role", "scope", "organization", "project", "namespace", "subject", "expires_at"})
+		if table == "authsrv_projectaccountnamespacerole" {
+			rows.AddRow(expired, ouuid, puuid, "NAMESPACE_ADMIN", "namespace", "org-"+ouuid, "prod", "payments", "u:user@example.com", time.Now().Add(-time.Minute))
+		}
+		mock.ExpectQuery(`SELECT rb.id, .* FROM ` + table + ` AS rb .* WHERE \(rb.trash = FALSE\) AND \(rb.expires_at <= `).
+			WillReturnRows(rows)
+	}
+	mock.ExpectExec(`UPDATE "authsrv_projectaccountnamespacerole" SET trash = TRUE, .* WHERE \(id = '` + expired + `'\) AND \(trash = FALSE\)`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	for _, table := range roleBindingTestTables {
+		mock.ExpectQuery(`SELECT rb.id, .* FROM ` + table + ` AS rb .* WHERE \(rb.trash = FALSE\) AND \(rb.organization_id = '` + ouuid + `'\) .* AND \(rb.expires_at IS NULL OR rb.expires_at > now\(\)\)`).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	}
+
+	for _, table := range roleBindingTestTables {
+		rows := sqlmock.NewRows([]string{"id", "organization_id", "partner_id", "role", "scope", "organization", "project", "subject"})
+		if table == "authsrv_projectgrouprole" {
+			rows.AddRow(started, ouuid, puuid, "PROJECT_READ_ONLY", "project", "org-"+ouuid, "prod", "g:contractors")
+		}
+		mock.ExpectQuery(`SELECT rb.id, .* FROM ` + table + ` AS rb .* WHERE \(rb.trash = FALSE\) AND \(rb.active = FALSE\) AND \(rb.starts_at <= `).
+			WillReturnRows(rows)
+	}
+	mock.ExpectExec(`UPDATE "authsrv_projectgrouprole" SET active = TRUE, .* WHERE \(id = '` + started + `'\) AND \(active = FALSE\) AND \(trash = FALSE\)`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	if err := rbr.Reconcile(context.Background()); err != nil {
+		t.Fatal("could not reconcile role bindings:", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error("unfulfilled expectations:", err)
+	}
+
+	if len(mazc.dp) != 1 {
+		t.Fatalf("expected one policy deleted from authz; got %v", mazc.dp)
+	}
+	p := mazc.dp[0]
+	if p.Sub != "u:user@example.com" || p.Ns != "payments" || p.Proj != "prod" || p.Org != "org-"+ouuid || p.Obj != "NAMESPACE_ADMIN" {
+		t.Errorf("unexpected policy deleted from authz %v", p)
+	}
+
+	if len(mazc.cp) != 1 || len(mazc.cp[0].Policies) != 1 {
+		t.Fatalf("expected one policy created in authz; got %v", mazc.cp)
+	}
+	p = mazc.cp[0].Policies[0]
+	if p.Sub != "g:contractors" || p.Ns != "*" || p.Proj != "prod" || p.Org != "org-"+ouuid || p.Obj != "PROJECT_READ_ONLY" {
+		t.Errorf("unexpected policy created in authz %v", p)
+	}
+}
+
+func TestRoleBindingReconcileClaimedElsewhere(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	mazc := mockAuthzClient{}
+	rbr := NewRoleBindingReconciler(db, &mazc, getLogger())
+
+	expired := uuid.New().String()
+	for _, table := range roleBindingTestTables {
+		rows := sqlmock.NewRows([]string{"id", "role", "scope", "subject", "expires_at"})
+		if table == "authsrv_grouprole" {
+			rows.AddRow(expired, "ADMIN", "organization", "g:contractors", time.Now().Add(-time.Minute))
+		}
+		mock.ExpectQuery(`SELECT rb.id, .* FROM ` + table + ` AS rb .* \(rb.expires_at <= `).
+			WillReturnRows(rows)
+	}
+	// another replica expired the binding first
+	mock.ExpectExec(`UPDATE "authsrv_grouprole" SET trash = TRUE`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	for _, table := range roleBindingTestTables {
+		mock.ExpectQuery(`SELECT rb.id, .* FROM ` + table + ` AS rb .* \(rb.active = FALSE\)`).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	}
+
+	if err := rbr.Reconcile(context.Background()); err != nil {
+		t.Fatal("could not reconcile role bindings:", err)
+	}
+	if len(mazc.dp) != 0 || len(mazc.cp) != 0 {
+		t.Errorf("expected no authz changes; got deleted %v, created %v", mazc.dp, mazc.cp)
+	}
+}
+
+func TestRoleBindingReconcileOverlapping(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	mazc := mockAuthzClient{}
+	rbr := NewRoleBindingReconciler(db, &mazc, getLogger())
+
+	expired := uuid.New().String()
+	ouuid := uuid.New().String()
+	ruuid := uuid.New().String()
+	columns := []string{"id", "organization_id", "role_id", "role", "scope", "organization", "project", "subject", "expires_at"}
+	for _, table := range roleBindingTestTables {
+		rows := sqlmock.NewRows(columns)
+		if table == "authsrv_projectaccountresourcerole" {
+			rows.AddRow(expired, ouuid, ruuid, "PROJECT_ADMIN", "project", "org-"+ouuid, "prod", "u:user@example.com", time.Now().Add(-time.Minute))
+		}
+		mock.ExpectQuery(`SELECT rb.id, .* FROM ` + table + ` AS rb .* \(rb.expires_at <= `).
+			WillReturnRows(rows)
+	}
+	mock.ExpectExec(`UPDATE "authsrv_projectaccountresourcerole" SET trash = TRUE`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	// a permanent binding grants the same role in the project
+	for _, table := range roleBindingTestTables {
+		rows := sqlmock.NewRows(columns)
+		if table == "authsrv_projectaccountresourcerole" {
+			rows.AddRow(uuid.New().String(), ouuid, ruuid, "PROJECT_ADMIN", "project", "org-"+ouuid, "prod", "u:user@example.com", nil)
+		}
+		mock.ExpectQuery(`SELECT rb.id, .* FROM ` + table + ` AS rb .* \(rb.role_id = '` + ruuid + `'\)`).
+			WillReturnRows(rows)
+	}
+	for _, table := range roleBindingTestTables {
+		mock.ExpectQuery(`SELECT rb.id, .* FROM ` + table + ` AS rb .* \(rb.active = FALSE\)`).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	}
+
+	if err := rbr.Reconcile(context.Background()); err != nil {
+		t.Fatal("could not reconcile role bindings:", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error("unfulfilled expectations:", err)
+	}
+	if len(mazc.dp) != 0 {
+		t.Errorf("expected policy granted by permanent binding to be kept; got deleted %v", mazc.dp)
+	}
+}
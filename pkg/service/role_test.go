@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
@@ -230,7 +231,7 @@ func TestUpdateRole(t *testing.T) {
 		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "name", "organization_id", "partner_id"}).AddRow(ruuid, "role-"+ruuid, ouuid, puuid))
 
 	mock.ExpectBegin()
-	mock.ExpectExec(`UPDATE "authsrv_resourcerole" AS "resourcerole" SET "name" = 'role-` + ruuid + `', .*"organization_id" = '` + ouuid + `', "partner_id" = '` + puuid + `', "is_global" = TRUE, "builtin" = FALSE, "scope" = 'system' WHERE .id  = '` + ruuid + `'.`).
+	mock.ExpectExec(`UPDATE "authsrv_resourcerole" AS "resourcerole" SET "name" = 'role-` + ruuid + `', .*"organization_id" = '` + ouuid + `', "partner_id" = '` + puuid + `', "is_global" = TRUE, "builtin" = FALSE, "scope" = 'system', "require_mfa" = FALSE, "kubernetes_rules" = NULL WHERE .id  = '` + ruuid + `'.`).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectExec(`UPDATE "authsrv_resourcerolepermission" AS "resourcerolepermission" SET trash = TRUE WHERE ."resource_role_id" = '` + ruuid + `'.`).
 		WillReturnResult(sqlmock.NewResult(1, 1))
@@ -426,3 +427,126 @@ func TestRoleList(t *testing.T) {
 		t.Errorf("incorrect role names returned when listing")
 	}
 }
+
+func TestCreateRoleWithKubernetesRules(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	mazc := mockAuthzClient{}
+	rs := NewRoleService(db, &mazc, getLogger())
+
+	ruuid := uuid.New().String()
+
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+	mock.ExpectQuery(`SELECT "resourcerole"."id" FROM "authsrv_resourcerole" AS "resourcerole" WHERE .organization_id = '` + ouuid + `'. AND .partner_id = '` + puuid + `'. AND .name = 'role-` + ruuid + `'.`).
+		WillReturnError(fmt.Errorf("no data available"))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO "authsrv_resourcerole" .*"kubernetes_rules".* VALUES .*` +
+		regexp.QuoteMeta(`'[{"apiGroups":[""],"resources":["pods"],"subresources":["log"],"verbs":["get"]},{"apiGroups":["apps"],"resources":["deployments"],"resourceNames":["web"],"verbs":["get","patch"]}]'`)).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(ruuid))
+	mock.ExpectQuery(`SELECT "resourcepermission"."id" FROM "authsrv_resourcepermission" AS "resourcepermission" WHERE .name = 'partner.read'.`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.New().String()))
+	mock.ExpectQuery(`SELECT "resourcepermission"."id" FROM "authsrv_resourcepermission" AS "resourcepermission" WHERE .name = 'organization.read'.`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.New().String()))
+	mock.ExpectQuery(`INSERT INTO "authsrv_resourcerolepermission"`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.New().String()))
+	mock.ExpectCommit()
+
+	role := &rolev3.Role{
+		Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Name: "role-" + ruuid},
+		Spec: &rolev3.RoleSpec{
+			Scope:           "project",
+			Rolepermissions: []string{"partner.read", "organization.read"},
+			KubernetesRules: []*rolev3.KubernetesRule{
+				{ApiGroups: []string{""}, Resources: []string{"pods"}, Subresources: []string{"log"}, Verbs: []string{"get"}},
+				{ApiGroups: []string{"apps"}, Resources: []string{"deployments"}, ResourceNames: []string{"web"}, Verbs: []string{"get", "patch"}},
+			},
+		},
+	}
+	role, err := rs.Create(context.Background(), role)
+	if err != nil {
+		t.Fatal("could not create role:", err)
+	}
+	performRoleBasicChecks(t, role, ruuid)
+	if len(role.GetSpec().GetKubernetesRules()) != 2 {
+		t.Errorf("expected 2 kubernetes rules; got %v", len(role.GetSpec().GetKubernetesRules()))
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCreateRoleInvalidKubernetesRules(t *testing.T) {
+	tt := []struct {
+		name string
+		rule *rolev3.KubernetesRule
+	}{
+		{"no verbs", &rolev3.KubernetesRule{ApiGroups: []string{""}, Resources: []string{"pods"}}},
+		{"unknown verb", &rolev3.KubernetesRule{ApiGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"read"}}},
+		{"no api groups", &rolev3.KubernetesRule{Resources: []string{"pods"}, Verbs: []string{"get"}}},
+		{"invalid api group", &rolev3.KubernetesRule{ApiGroups: []string{"Apps/v1"}, Resources: []string{"deployments"}, Verbs: []string{"get"}}},
+		{"no resources", &rolev3.KubernetesRule{ApiGroups: []string{""}, Verbs: []string{"get"}}},
+		{"resource with subresource", &rolev3.KubernetesRule{ApiGroups: []string{""}, Resources: []string{"pods/log"}, Verbs: []string{"get"}}},
+		{"invalid resource", &rolev3.KubernetesRule{ApiGroups: []string{""}, Resources: []string{"Pods"}, Verbs: []string{"get"}}},
+		{"invalid subresource", &rolev3.KubernetesRule{ApiGroups: []string{""}, Resources: []string{"pods"}, Subresources: []string{"log/x"}, Verbs: []string{"get"}}},
+		{"wildcard resource name", &rolev3.KubernetesRule{ApiGroups: []string{""}, Resources: []string{"pods"}, ResourceNames: []string{"*"}, Verbs: []string{"get"}}},
+		{"resource name with list", &rolev3.KubernetesRule{ApiGroups: []string{""}, Resources: []string{"pods"}, ResourceNames: []string{"web"}, Verbs: []string{"get", "list"}}},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock := getDB(t)
+			defer db.Close()
+
+			mazc := mockAuthzClient{}
+			rs := NewRoleService(db, &mazc, getLogger())
+
+			ruuid := uuid.New().String()
+
+			puuid, ouuid := addParterOrgFetchExpectation(mock)
+			mock.ExpectQuery(`SELECT "resourcerole"."id" FROM "authsrv_resourcerole" AS "resourcerole"`).
+				WillReturnError(fmt.Errorf("no data available"))
+
+			role := &rolev3.Role{
+				Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Name: "role-" + ruuid},
+				Spec: &rolev3.RoleSpec{
+					Scope:           "project",
+					Rolepermissions: []string{"partner.read", "organization.read"},
+					KubernetesRules: []*rolev3.KubernetesRule{tc.rule},
+				},
+			}
+			if _, err := rs.Create(context.Background(), role); err == nil {
+				t.Error("expected invalid kubernetes rule to be rejected")
+			}
+		})
+	}
+}
+
+func TestRoleGetByNameKubernetesRules(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	mazc := mockAuthzClient{}
+	rs := NewRoleService(db, &mazc, getLogger())
+
+	ruuid := uuid.New().String()
+
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+	mock.ExpectQuery(`SELECT "resourcerole"."id", "resourcerole"."name", .*"resourcerole"."kubernetes_rules" FROM "authsrv_resourcerole" AS "resourcerole" WHERE .*name = 'role-` + ruuid + `'`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "name", "kubernetes_rules"}).
+		AddRow(ruuid, "role-"+ruuid, `[{"apiGroups":[""],"resources":["configmaps"],"verbs":["get","list"]}]`))
+	mock.ExpectQuery(`SELECT authsrv_resourcepermission.name as name FROM "authsrv_resourcepermission"`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"name"}))
+
+	role := &rolev3.Role{
+		Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Name: "role-" + ruuid},
+	}
+	role, err := rs.GetByName(context.Background(), role)
+	if err != nil {
+		t.Fatal("could not get role:", err)
+	}
+	rules := role.GetSpec().GetKubernetesRules()
+	if len(rules) != 1 || rules[0].GetResources()[0] != "configmaps" || len(rules[0].GetVerbs()) != 2 {
+		t.Errorf("unexpected kubernetes rules %v", rules)
+	}
+}
@@ -0,0 +1,260 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/dao"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/paralus/paralus/pkg/query"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	userv3 "github.com/paralus/paralus/proto/types/userpb/v3"
+	bun "github.com/uptrace/bun"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	scimTokenKind     = "ScimToken"
+	scimTokenListKind = "ScimTokenList"
+)
+
+// ErrInvalidScimToken is returned when a bearer token is not an active
+// SCIM token
+var ErrInvalidScimToken = errors.New("invalid scim token")
+
+// ScimTokenService is the interface for SCIM token operations
+type ScimTokenService interface {
+	// create scim token, the token is only returned here
+	Create(context.Context, *userv3.ScimToken) (*userv3.ScimToken, error)
+	// get scim token by name
+	GetByName(context.Context, *userv3.ScimToken) (*userv3.ScimToken, error)
+	// delete scim token
+	Delete(context.Context, *userv3.ScimToken) (*userv3.ScimToken, error)
+	// list scim tokens
+	List(context.Context, ...query.Option) (*userv3.ScimTokenList, error)
+	// Authenticate returns the scim token of a bearer token
+	Authenticate(ctx context.Context, token string) (*models.ScimToken, error)
+}
+
+// scimTokenService implements ScimTokenService
+type scimTokenService struct {
+	db *bun.DB
+	al *zap.Logger
+}
+
+// NewScimTokenService return new scim token service
+func NewScimTokenService(db *bun.DB, al *zap.Logger) ScimTokenService {
+	return &scimTokenService{db: db, al: al}
+}
+
+func hashScimToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func (s *scimTokenService) getPartnerOrganization(ctx context.Context, meta *commonv3.Metadata) (uuid.UUID, uuid.UUID, error) {
+	partnerId, err := dao.GetPartnerId(ctx, s.db, meta.GetPartner())
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	organizationId, err := dao.GetOrganizationId(ctx, s.db, meta.GetOrganization())
+	if err != nil {
+		return partnerId, uuid.Nil, err
+	}
+	return partnerId, organizationId, nil
+}
+
+func (s *scimTokenService) getScimToken(ctx context.Context, meta *commonv3.Metadata) (*models.ScimToken, error) {
+	partnerId, organizationId, err := s.getPartnerOrganization(ctx, meta)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get partner and org id")
+	}
+	entity, err := dao.GetByNamePartnerOrg(ctx, s.db, meta.GetName(), uuid.NullUUID{UUID: partnerId, Valid: true}, uuid.NullUUID{UUID: organizationId, Valid: true}, &models.ScimToken{})
+	if err != nil {
+		return nil, fmt.Errorf("no scim token found with name '%v'", meta.GetName())
+	}
+	if st, ok := entity.(*models.ScimToken); ok {
+		return st, nil
+	}
+	return nil, fmt.Errorf("no scim token found with name '%v'", meta.GetName())
+}
+
+func (s *scimTokenService) toV3ScimToken(st *userv3.ScimToken, token *models.ScimToken) *userv3.ScimToken {
+	labels := make(map[string]string)
+	labels["organization"] = st.GetMetadata().GetOrganization()
+	labels["partner"] = st.GetMetadata().GetPartner()
+
+	st.ApiVersion = apiVersion
+	st.Kind = scimTokenKind
+	st.Metadata = &commonv3.Metadata{
+		Name:         token.Name,
+		Description:  token.Description,
+		Id:           token.ID.String(),
+		Organization: st.GetMetadata().GetOrganization(),
+		Partner:      st.GetMetadata().GetPartner(),
+		Labels:       labels,
+		ModifiedAt:   timestamppb.New(token.ModifiedAt),
+		CreatedAt:    timestamppb.New(token.CreatedAt),
+	}
+	spec := &userv3.ScimTokenSpec{}
+	if !token.ExpiresAt.IsZero() {
+		spec.ExpiresAt = timestamppb.New(token.ExpiresAt)
+	}
+	if !token.LastUsedAt.IsZero() {
+		spec.LastUsedAt = timestamppb.New(token.LastUsedAt)
+	}
+	st.Spec = spec
+	return st
+}
+
+func (s *scimTokenService) Create(ctx context.Context, st *userv3.ScimToken) (*userv3.ScimToken, error) {
+	partnerId, organizationId, err := s.getPartnerOrganization(ctx, st.GetMetadata())
+	if err != nil {
+		return nil, fmt.Errorf("unable to get partner and org id")
+	}
+	e, _ := dao.GetIdByNamePartnerOrg(ctx, s.db, st.GetMetadata().GetName(), uuid.NullUUID{UUID: partnerId, Valid: true}, uuid.NullUUID{UUID: organizationId, Valid: true}, &models.ScimToken{})
+	if e != nil {
+		return nil, fmt.Errorf("scim token '%v' already exists", st.GetMetadata().GetName())
+	}
+
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	secret := base64.RawURLEncoding.EncodeToString(b)
+
+	token := models.ScimToken{
+		Name:           st.GetMetadata().GetName(),
+		Description:    st.GetMetadata().GetDescription(),
+		CreatedAt:      time.Now(),
+		ModifiedAt:     time.Now(),
+		Trash:          false,
+		OrganizationId: organizationId,
+		PartnerId:      partnerId,
+		TokenHash:      hashScimToken(secret),
+	}
+	if st.GetSpec().GetExpiresAt() != nil {
+		token.ExpiresAt = st.GetSpec().GetExpiresAt().AsTime()
+		if token.ExpiresAt.Before(time.Now()) {
+			return nil, fmt.Errorf("expiresAt should be in the future")
+		}
+	}
+
+	_, err = dao.Create(ctx, s.db, &token)
+	if err != nil {
+		return &userv3.ScimToken{}, err
+	}
+
+	CreateScimTokenAuditEvent(ctx, s.al, AuditActionCreate, token.Name)
+	st = s.toV3ScimToken(st, &token)
+	st.Spec.Token = secret
+	return st, nil
+}
+
+func (s *scimTokenService) GetByName(ctx context.Context, st *userv3.ScimToken) (*userv3.ScimToken, error) {
+	token, err := s.getScimToken(ctx, st.GetMetadata())
+	if err != nil {
+		return &userv3.ScimToken{}, err
+	}
+	return s.toV3ScimToken(st, token), nil
+}
+
+func (s *scimTokenService) Delete(ctx context.Context, st *userv3.ScimToken) (*userv3.ScimToken, error) {
+	token, err := s.getScimToken(ctx, st.GetMetadata())
+	if err != nil {
+		return &userv3.ScimToken{}, err
+	}
+	err = dao.Delete(ctx, s.db, token.ID, token)
+	if err != nil {
+		return &userv3.ScimToken{}, err
+	}
+
+	CreateScimTokenAuditEvent(ctx, s.al, AuditActionDelete, token.Name)
+	return st, nil
+}
+
+func (s *scimTokenService) List(ctx context.Context, opts ...query.Option) (*userv3.ScimTokenList, error) {
+	var items []*userv3.ScimToken
+	stList := &userv3.ScimTokenList{
+		ApiVersion: apiVersion,
+		Kind:       scimTokenListKind,
+		Metadata: &commonv3.ListMetadata{
+			Count: 0,
+		},
+	}
+
+	queryOptions := commonv3.QueryOptions{}
+	for _, opt := range opts {
+		opt(&queryOptions)
+	}
+
+	orgId, err := dao.GetOrganizationId(ctx, s.db, queryOptions.Organization)
+	if err != nil {
+		return stList, err
+	}
+	partId, err := dao.GetPartnerId(ctx, s.db, queryOptions.Partner)
+	if err != nil {
+		return stList, err
+	}
+	var tokens []models.ScimToken
+	entities, err := dao.ListFiltered(ctx, s.db,
+		uuid.NullUUID{UUID: partId, Valid: true}, uuid.NullUUID{UUID: orgId, Valid: true},
+		uuid.NullUUID{Valid: false},
+		&tokens,
+		queryOptions.Q,
+		queryOptions.OrderBy,
+		queryOptions.Order,
+		int(queryOptions.Limit),
+		int(queryOptions.Offset),
+	)
+	if err != nil {
+		return stList, err
+	}
+	if tokens, ok := entities.(*[]models.ScimToken); ok {
+		for _, token := range *tokens {
+			entry := &userv3.ScimToken{Metadata: &commonv3.Metadata{
+				Organization: queryOptions.Organization,
+				Partner:      queryOptions.Partner,
+			}}
+			items = append(items, s.toV3ScimToken(entry, &token))
+		}
+
+		stList.Metadata = &commonv3.ListMetadata{
+			Count: int64(len(items)),
+		}
+		stList.Items = items
+	}
+
+	return stList, nil
+}
+
+func (s *scimTokenService) Authenticate(ctx context.Context, token string) (*models.ScimToken, error) {
+	if token == "" {
+		return nil, ErrInvalidScimToken
+	}
+	st := &models.ScimToken{}
+	_, err := dao.GetX(ctx, s.db, "token_hash", hashScimToken(token), st)
+	if err != nil {
+		return nil, ErrInvalidScimToken
+	}
+	if !st.ExpiresAt.IsZero() && st.ExpiresAt.Before(time.Now()) {
+		return nil, ErrInvalidScimToken
+	}
+
+	// last use is informational, a failed update does not fail the
+	// request
+	st.LastUsedAt = time.Now()
+	_, err = s.db.NewUpdate().Model(st).Column("last_used_at").WherePK().Exec(ctx)
+	if err != nil {
+		_log.Warnw("unable to update last use of scim token", "name", st.Name, "error", err)
+	}
+	return st, nil
+}
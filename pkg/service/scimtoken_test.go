@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	v3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	userv3 "github.com/paralus/paralus/proto/types/userpb/v3"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestCreateScimToken(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	sts := NewScimTokenService(db, getLogger())
+	suuid := uuid.NewString()
+
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+	mock.ExpectQuery(`SELECT "scimtoken"."id" FROM "authsrv_scim_token" AS "scimtoken" WHERE .organization_id = '` + ouuid + `'. AND .partner_id = '` + puuid + `'. AND .name = 'scimtoken-` + suuid + `'.`).
+		WillReturnError(fmt.Errorf("no data available"))
+	mock.ExpectQuery(`INSERT INTO "authsrv_scim_token"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(suuid))
+
+	st := &userv3.ScimToken{
+		Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Name: "scimtoken-" + suuid},
+	}
+	st, err := sts.Create(context.Background(), st)
+	if err != nil {
+		t.Fatal("could not create scim token:", err)
+	}
+	if len(st.GetSpec().GetToken()) < 32 {
+		t.Errorf("expected generated token; got '%v'", st.GetSpec().GetToken())
+	}
+	if st.GetMetadata().GetId() != suuid {
+		t.Errorf("invalid id, expected '%v'; got '%v'", suuid, st.GetMetadata().GetId())
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCreateScimTokenExpired(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	sts := NewScimTokenService(db, getLogger())
+	suuid := uuid.NewString()
+
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+	mock.ExpectQuery(`SELECT "scimtoken"."id" FROM "authsrv_scim_token"`).
+		WillReturnError(fmt.Errorf("no data available"))
+
+	st := &userv3.ScimToken{
+		Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Name: "scimtoken-" + suuid},
+		Spec:     &userv3.ScimTokenSpec{ExpiresAt: timestamppb.New(time.Now().Add(-time.Hour))},
+	}
+	if _, err := sts.Create(context.Background(), st); err == nil {
+		t.Error("created scim token expiring in the past")
+	}
+}
+
+func TestScimTokenAuthenticate(t *testing.T) {
+	tt := []struct {
+		name      string
+		expiresAt interface{}
+		found     bool
+		valid     bool
+	}{
+		{"valid token", nil, true, true},
+		{"token not expired", time.Now().Add(time.Hour), true, true},
+		{"expired token", time.Now().Add(-time.Hour), true, false},
+		{"unknown token", nil, false, false},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock := getDB(t)
+			defer db.Close()
+
+			sts := NewScimTokenService(db, getLogger())
+			suuid := uuid.NewString()
+			ouuid := uuid.NewString()
+
+			q := mock.ExpectQuery(`SELECT "scimtoken"."id", .* FROM "authsrv_scim_token" AS "scimtoken" WHERE .token_hash = '` + hashScimToken("secret") + `'. AND .trash = FALSE.`)
+			if tc.found {
+				q.WillReturnRows(sqlmock.NewRows([]string{"id", "name", "organization_id", "expires_at"}).AddRow(suuid, "scimtoken-idp", ouuid, tc.expiresAt))
+			} else {
+				q.WillReturnRows(sqlmock.NewRows([]string{"id"}))
+			}
+			if tc.valid {
+				mock.ExpectExec(`UPDATE "authsrv_scim_token" AS "scimtoken" SET "last_used_at" = .* WHERE ."scimtoken"."id" = '` + suuid + `'`).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			}
+
+			token, err := sts.Authenticate(context.Background(), "secret")
+			if tc.valid {
+				if err != nil {
+					t.Fatal("valid token not accepted:", err)
+				}
+				if token.OrganizationId.String() != ouuid {
+					t.Errorf("invalid organization, expected '%v'; got '%v'", ouuid, token.OrganizationId)
+				}
+			} else if err != ErrInvalidScimToken {
+				t.Errorf("expected invalid token error; got '%v'", err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestDeleteScimToken(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	sts := NewScimTokenService(db, getLogger())
+	suuid := uuid.NewString()
+
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+	mock.ExpectQuery(`SELECT "scimtoken"."id", "scimtoken"."name", .* FROM "authsrv_scim_token" AS "scimtoken" WHERE .*name = 'scimtoken-` + suuid + `'`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "token_hash"}).AddRow(suuid, "scimtoken-"+suuid, strings.Repeat("0", 64)))
+	mock.ExpectExec(`UPDATE "authsrv_scim_token" AS "scimtoken" SET trash = TRUE WHERE .id = '` + suuid).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	st := &userv3.ScimToken{
+		Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Name: "scimtoken-" + suuid},
+	}
+	if _, err := sts.Delete(context.Background(), st); err != nil {
+		t.Fatal("could not delete scim token:", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,555 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/dao"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/paralus/paralus/pkg/query"
+	rpcv3 "github.com/paralus/paralus/proto/rpc/user"
+	authzv1 "github.com/paralus/paralus/proto/types/authz"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	userv3 "github.com/paralus/paralus/proto/types/userpb/v3"
+	bun "github.com/uptrace/bun"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	serviceAccountKind     = "ServiceAccount"
+	serviceAccountListKind = "ServiceAccountList"
+)
+
+// ServiceAccountSubject returns the authz subject of the service
+// account with the given id. Service account names are only unique
+// within an organization, so the subject is keyed by id.
+func ServiceAccountSubject(id string) string {
+	return "sa:" + id
+}
+
+// ServiceAccountSubjectByName returns the authz subject of the service
+// account with the given name in the partner and organization.
+func ServiceAccountSubjectByName(ctx context.Context, db bun.IDB, partner, org, name string) (string, error) {
+	partnerId, organizationId, err := getPartnerOrganization(ctx, db, partner, org)
+	if err != nil {
+		return "", fmt.Errorf("unable to get partner and org id")
+	}
+	entity, err := dao.GetIdByNamePartnerOrg(ctx, db, name,
+		uuid.NullUUID{UUID: partnerId, Valid: true}, uuid.NullUUID{UUID: organizationId, Valid: true}, &models.ServiceAccount{})
+	if err != nil {
+		return "", fmt.Errorf("unable to find service account '%v'", name)
+	}
+	return ServiceAccountSubject(entity.(*models.ServiceAccount).ID.String()), nil
+}
+
+// ServiceAccountService is the interface for service account operations
+type ServiceAccountService interface {
+	// create service account
+	Create(context.Context, *userv3.ServiceAccount) (*userv3.ServiceAccount, error)
+	// get service account by name
+	GetByName(context.Context, *userv3.ServiceAccount) (*userv3.ServiceAccount, error)
+	// update service account
+	Update(context.Context, *userv3.ServiceAccount) (*userv3.ServiceAccount, error)
+	// delete service account
+	Delete(context.Context, *userv3.ServiceAccount) (*userv3.ServiceAccount, error)
+	// list service accounts
+	List(context.Context, ...query.Option) (*userv3.ServiceAccountList, error)
+	// create api key for service account
+	CreateApiKey(context.Context, *rpcv3.ServiceAccountApiKeyRequest) (*models.ApiKey, error)
+	// list api keys of service account
+	ListApiKeys(context.Context, *rpcv3.ServiceAccountApiKeyRequest) (*rpcv3.UserListApiKeysResponse, error)
+	// delete api key of service account
+	DeleteApiKey(context.Context, *rpcv3.ServiceAccountApiKeyRequest) (*rpcv3.UserDeleteApiKeysResponse, error)
+}
+
+// serviceAccountService implements ServiceAccountService
+type serviceAccountService struct {
+	db  *bun.DB
+	azc AuthzService
+	ks  ApiKeyService
+	al  *zap.Logger
+}
+
+// NewServiceAccountService return new service account service
+func NewServiceAccountService(db *bun.DB, azc AuthzService, ks ApiKeyService, al *zap.Logger) ServiceAccountService {
+	return &serviceAccountService{db: db, azc: azc, ks: ks, al: al}
+}
+
+func (s *serviceAccountService) getPartnerOrganization(ctx context.Context, db bun.IDB, meta *commonv3.Metadata) (uuid.UUID, uuid.UUID, error) {
+	partnerId, err := dao.GetPartnerId(ctx, db, meta.GetPartner())
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	organizationId, err := dao.GetOrganizationId(ctx, db, meta.GetOrganization())
+	if err != nil {
+		return partnerId, uuid.Nil, err
+	}
+	return partnerId, organizationId, nil
+}
+
+func (s *serviceAccountService) getServiceAccount(ctx context.Context, db bun.IDB, meta *commonv3.Metadata) (*models.ServiceAccount, error) {
+	partnerId, organizationId, err := s.getPartnerOrganization(ctx, db, meta)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get partner and org id")
+	}
+	entity, err := dao.GetByNamePartnerOrg(ctx, db, meta.GetName(), uuid.NullUUID{UUID: partnerId, Valid: true}, uuid.NullUUID{UUID: organizationId, Valid: true}, &models.ServiceAccount{})
+	if err != nil {
+		return nil, fmt.Errorf("no service account found with name '%v'", meta.GetName())
+	}
+	if sa, ok := entity.(*models.ServiceAccount); ok {
+		return sa, nil
+	}
+	return nil, fmt.Errorf("no service account found with name '%v'", meta.GetName())
+}
+
+// deleteServiceAccountRoleRelations deletes existing service account-role relations
+func (s *serviceAccountService) deleteServiceAccountRoleRelations(ctx context.Context, db bun.IDB, saId uuid.UUID, sa *userv3.ServiceAccount) ([]uuid.UUID, error) {
+	ids := []uuid.UUID{}
+
+	ar := []models.AccountResourcerole{}
+	err := dao.DeleteXR(ctx, db, "account_id", saId, &ar)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range ar {
+		ids = append(ids, r.RoleId)
+	}
+
+	par := []models.ProjectAccountResourcerole{}
+	err = dao.DeleteXR(ctx, db, "account_id", saId, &par)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range par {
+		ids = append(ids, r.RoleId)
+	}
+
+	panr := []models.ProjectAccountNamespaceRole{}
+	err = dao.DeleteXR(ctx, db, "account_id", saId, &panr)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range panr {
+		ids = append(ids, r.RoleId)
+	}
+
+	_, err = s.azc.DeletePolicies(ctx, &authzv1.Policy{Sub: ServiceAccountSubject(saId.String())})
+	if err != nil {
+		return nil, fmt.Errorf("unable to delete service account-role relations from authz; %v", err)
+	}
+
+	return ids, nil
+}
+
+// Map roles to service accounts
+func (s *serviceAccountService) createServiceAccountRoleRelations(ctx context.Context, db bun.IDB, sa *userv3.ServiceAccount, ids parsedIds) ([]uuid.UUID, error) {
+	var pars []models.ProjectAccountResourcerole
+	var panr []models.ProjectAccountNamespaceRole
+	var ars []models.AccountResourcerole
+	var ps []*authzv1.Policy
+	var rids []uuid.UUID
+
+	sub := ServiceAccountSubject(ids.Id.String())
+	org := sa.GetMetadata().GetOrganization()
+	for _, pnr := range sa.GetSpec().GetProjectNamespaceRoles() {
+		w, err := getBindingWindow(pnr)
+		if err != nil {
+			return nil, err
+		}
+		role := pnr.GetRole()
+		if role == "" {
+			return nil, fmt.Errorf("cannot use empty role")
+		}
+		entity, err := dao.GetByName(ctx, db, role, &models.Role{})
+		if err != nil {
+			return nil, fmt.Errorf("unable to find role '%v'", role)
+		}
+		rle, ok := entity.(*models.Role)
+		if !ok {
+			return nil, fmt.Errorf("unable to find role '%v'", role)
+		}
+		rids = append(rids, rle.ID)
+		project := pnr.GetProject()
+
+		switch strings.ToLower(rle.Scope) {
+		case "system":
+			// service accounts are organization scoped, they never
+			// get access across organizations
+			return nil, fmt.Errorf("system role '%v' cannot be assigned to service account", role)
+		case "organization":
+			ars = append(ars, models.AccountResourcerole{
+				CreatedAt:      time.Now(),
+				ModifiedAt:     time.Now(),
+				Trash:          false,
+				Default:        true,
+				RoleId:         rle.ID,
+				PartnerId:      ids.Partner,
+				OrganizationId: ids.Organization,
+				AccountId:      ids.Id,
+				StartsAt:       w.StartsAt,
+				ExpiresAt:      w.ExpiresAt,
+				Active:         w.active(time.Now()),
+			})
+			ps = w.appendPolicy(ps, &authzv1.Policy{
+				Sub:  sub,
+				Ns:   "*",
+				Proj: "*",
+				Org:  org,
+				Obj:  role,
+			})
+		case "project":
+			if project == "" {
+				return nil, fmt.Errorf("no project name provided for role '%v'", rle.Name)
+			}
+			projectId, err := dao.GetProjectId(ctx, db, project)
+			if err != nil {
+				return nil, fmt.Errorf("unable to find project '%v'", project)
+			}
+			pars = append(pars, models.ProjectAccountResourcerole{
+				CreatedAt:      time.Now(),
+				ModifiedAt:     time.Now(),
+				Trash:          false,
+				Default:        true,
+				RoleId:         rle.ID,
+				PartnerId:      ids.Partner,
+				OrganizationId: ids.Organization,
+				AccountId:      ids.Id,
+				ProjectId:      projectId,
+				StartsAt:       w.StartsAt,
+				ExpiresAt:      w.ExpiresAt,
+				Active:         w.active(time.Now()),
+			})
+			ps = w.appendPolicy(ps, &authzv1.Policy{
+				Sub:  sub,
+				Ns:   "*",
+				Proj: project,
+				Org:  org,
+				Obj:  role,
+			})
+		case "namespace":
+			if project == "" {
+				return nil, fmt.Errorf("no project name provided for role '%v'", rle.Name)
+			}
+			projectId, err := dao.GetProjectId(ctx, db, project)
+			if err != nil {
+				return nil, fmt.Errorf("unable to find project '%v'", project)
+			}
+			namespace := pnr.GetNamespace()
+			if err := validateBindingNamespace(namespace, pnr.GetNamespaceSelector()); err != nil {
+				return nil, err
+			}
+			panr = append(panr, models.ProjectAccountNamespaceRole{
+				CreatedAt:         time.Now(),
+				ModifiedAt:        time.Now(),
+				Trash:             false,
+				PartnerId:         ids.Partner,
+				OrganizationId:    ids.Organization,
+				RoleId:            rle.ID,
+				AccountId:         ids.Id,
+				ProjectId:         projectId,
+				Namespace:         namespace,
+				NamespaceSelector: pnr.GetNamespaceSelector(),
+				StartsAt:          w.StartsAt,
+				ExpiresAt:         w.ExpiresAt,
+				Active:            w.active(time.Now()),
+			})
+			ps = w.appendPolicy(ps, &authzv1.Policy{
+				Sub:  sub,
+				Ns:   policyNamespace(namespace),
+				Proj: project,
+				Org:  org,
+				Obj:  role,
+			})
+		default:
+			return nil, fmt.Errorf("unknown scope for role '%v'", role)
+		}
+	}
+	if len(pars) > 0 {
+		_, err := dao.Create(ctx, db, &pars)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(panr) > 0 {
+		_, err := dao.Create(ctx, db, &panr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(ars) > 0 {
+		_, err := dao.Create(ctx, db, &ars)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(ps) > 0 {
+		success, err := s.azc.CreatePolicies(ctx, &authzv1.Policies{Policies: ps})
+		if err != nil || !success.Res {
+			return nil, fmt.Errorf("unable to create mapping in authz; %v", err)
+		}
+	}
+
+	return rids, nil
+}
+
+func (s *serviceAccountService) toV3ServiceAccount(ctx context.Context, db bun.IDB, sa *userv3.ServiceAccount, svc *models.ServiceAccount) (*userv3.ServiceAccount, error) {
+	labels := make(map[string]string)
+	labels["organization"] = sa.GetMetadata().GetOrganization()
+	labels["partner"] = sa.GetMetadata().GetPartner()
+
+	sa.ApiVersion = apiVersion
+	sa.Kind = serviceAccountKind
+	sa.Metadata = &commonv3.Metadata{
+		Name:         svc.Name,
+		Description:  svc.Description,
+		Id:           svc.ID.String(),
+		Organization: sa.GetMetadata().GetOrganization(),
+		Partner:      sa.GetMetadata().GetPartner(),
+		Labels:       labels,
+		ModifiedAt:   timestamppb.New(svc.ModifiedAt),
+		CreatedAt:    timestamppb.New(svc.CreatedAt),
+	}
+	roles, err := dao.GetUserRoles(ctx, db, svc.ID)
+	if err != nil {
+		return &userv3.ServiceAccount{}, err
+	}
+	sa.Spec = &userv3.ServiceAccountSpec{
+		ProjectNamespaceRoles: roles,
+	}
+	return sa, nil
+}
+
+func (s *serviceAccountService) Create(ctx context.Context, sa *userv3.ServiceAccount) (*userv3.ServiceAccount, error) {
+	partnerId, organizationId, err := s.getPartnerOrganization(ctx, s.db, sa.GetMetadata())
+	if err != nil {
+		return nil, fmt.Errorf("unable to get partner and org id")
+	}
+	e, _ := dao.GetIdByNamePartnerOrg(ctx, s.db, sa.GetMetadata().GetName(), uuid.NullUUID{UUID: partnerId, Valid: true}, uuid.NullUUID{UUID: organizationId, Valid: true}, &models.ServiceAccount{})
+	if e != nil {
+		return nil, fmt.Errorf("service account '%v' already exists", sa.GetMetadata().GetName())
+	}
+
+	svc := models.ServiceAccount{
+		Name:           sa.GetMetadata().GetName(),
+		Description:    sa.GetMetadata().GetDescription(),
+		CreatedAt:      time.Now(),
+		ModifiedAt:     time.Now(),
+		Trash:          false,
+		OrganizationId: organizationId,
+		PartnerId:      partnerId,
+	}
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return &userv3.ServiceAccount{}, err
+	}
+
+	_, err = dao.Create(ctx, tx, &svc)
+	if err != nil {
+		tx.Rollback()
+		return &userv3.ServiceAccount{}, err
+	}
+
+	rolesAfter, err := s.createServiceAccountRoleRelations(ctx, tx, sa, parsedIds{Id: svc.ID, Partner: partnerId, Organization: organizationId})
+	if err != nil {
+		tx.Rollback()
+		return &userv3.ServiceAccount{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		_log.Warn("unable to commit changes", err)
+	}
+
+	CreateServiceAccountAuditEvent(ctx, s.al, s.db, AuditActionCreate, svc.Name, []uuid.UUID{}, rolesAfter)
+	return s.toV3ServiceAccount(ctx, s.db, sa, &svc)
+}
+
+func (s *serviceAccountService) GetByName(ctx context.Context, sa *userv3.ServiceAccount) (*userv3.ServiceAccount, error) {
+	svc, err := s.getServiceAccount(ctx, s.db, sa.GetMetadata())
+	if err != nil {
+		return &userv3.ServiceAccount{}, err
+	}
+	return s.toV3ServiceAccount(ctx, s.db, sa, svc)
+}
+
+func (s *serviceAccountService) Update(ctx context.Context, sa *userv3.ServiceAccount) (*userv3.ServiceAccount, error) {
+	svc, err := s.getServiceAccount(ctx, s.db, sa.GetMetadata())
+	if err != nil {
+		return &userv3.ServiceAccount{}, err
+	}
+	svc.Description = sa.GetMetadata().GetDescription()
+	svc.ModifiedAt = time.Now()
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return &userv3.ServiceAccount{}, err
+	}
+
+	rolesBefore, err := s.deleteServiceAccountRoleRelations(ctx, tx, svc.ID, sa)
+	if err != nil {
+		tx.Rollback()
+		return &userv3.ServiceAccount{}, err
+	}
+	rolesAfter, err := s.createServiceAccountRoleRelations(ctx, tx, sa, parsedIds{Id: svc.ID, Partner: svc.PartnerId, Organization: svc.OrganizationId})
+	if err != nil {
+		tx.Rollback()
+		return &userv3.ServiceAccount{}, err
+	}
+
+	_, err = dao.Update(ctx, tx, svc.ID, svc)
+	if err != nil {
+		tx.Rollback()
+		return &userv3.ServiceAccount{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		_log.Warn("unable to commit changes", err)
+	}
+
+	CreateServiceAccountAuditEvent(ctx, s.al, s.db, AuditActionUpdate, svc.Name, rolesBefore, rolesAfter)
+	return s.toV3ServiceAccount(ctx, s.db, sa, svc)
+}
+
+func (s *serviceAccountService) Delete(ctx context.Context, sa *userv3.ServiceAccount) (*userv3.ServiceAccount, error) {
+	svc, err := s.getServiceAccount(ctx, s.db, sa.GetMetadata())
+	if err != nil {
+		return &userv3.ServiceAccount{}, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return &userv3.ServiceAccount{}, err
+	}
+
+	rolesBefore, err := s.deleteServiceAccountRoleRelations(ctx, tx, svc.ID, sa)
+	if err != nil {
+		tx.Rollback()
+		return &userv3.ServiceAccount{}, err
+	}
+
+	// api keys of the service account stop working along with it
+	_, err = tx.NewUpdate().Model((*models.ApiKey)(nil)).
+		Set("trash = ?", true).
+		Where("account_id = ?", svc.ID).
+		Exec(ctx)
+	if err != nil {
+		tx.Rollback()
+		return &userv3.ServiceAccount{}, err
+	}
+
+	err = dao.Delete(ctx, tx, svc.ID, svc)
+	if err != nil {
+		tx.Rollback()
+		return &userv3.ServiceAccount{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		_log.Warn("unable to commit changes", err)
+	}
+
+	CreateServiceAccountAuditEvent(ctx, s.al, s.db, AuditActionDelete, svc.Name, rolesBefore, []uuid.UUID{})
+	return sa, nil
+}
+
+func (s *serviceAccountService) List(ctx context.Context, opts ...query.Option) (*userv3.ServiceAccountList, error) {
+	var items []*userv3.ServiceAccount
+	saList := &userv3.ServiceAccountList{
+		ApiVersion: apiVersion,
+		Kind:       serviceAccountListKind,
+		Metadata: &commonv3.ListMetadata{
+			Count: 0,
+		},
+	}
+
+	queryOptions := commonv3.QueryOptions{}
+	for _, opt := range opts {
+		opt(&queryOptions)
+	}
+
+	orgId, err := dao.GetOrganizationId(ctx, s.db, queryOptions.Organization)
+	if err != nil {
+		return saList, err
+	}
+	partId, err := dao.GetPartnerId(ctx, s.db, queryOptions.Partner)
+	if err != nil {
+		return saList, err
+	}
+	var svcs []models.ServiceAccount
+	entities, err := dao.ListFiltered(ctx, s.db,
+		uuid.NullUUID{UUID: partId, Valid: true}, uuid.NullUUID{UUID: orgId, Valid: true},
+		uuid.NullUUID{Valid: false},
+		&svcs,
+		queryOptions.Q,
+		queryOptions.OrderBy,
+		queryOptions.Order,
+		int(queryOptions.Limit),
+		int(queryOptions.Offset),
+	)
+	if err != nil {
+		return saList, err
+	}
+	if svcs, ok := entities.(*[]models.ServiceAccount); ok {
+		for _, svc := range *svcs {
+			entry := &userv3.ServiceAccount{Metadata: &commonv3.Metadata{
+				Organization: queryOptions.Organization,
+				Partner:      queryOptions.Partner,
+			}}
+			entry, err = s.toV3ServiceAccount(ctx, s.db, entry, &svc)
+			if err != nil {
+				return saList, err
+			}
+			items = append(items, entry)
+		}
+
+		saList.Metadata = &commonv3.ListMetadata{
+			Count: int64(len(items)),
+		}
+		saList.Items = items
+	}
+
+	return saList, nil
+}
+
+func (s *serviceAccountService) CreateApiKey(ctx context.Context, req *rpcv3.ServiceAccountApiKeyRequest) (*models.ApiKey, error) {
+	svc, err := s.getServiceAccount(ctx, s.db, req.GetMetadata())
+	if err != nil {
+		return nil, err
+	}
+	return s.ks.CreateForServiceAccount(ctx, &rpcv3.ApiKeyRequest{
+		Username:       svc.Name,
+		Id:             svc.ID.String(),
+		OrganizationId: svc.OrganizationId.String(),
+		PartnerId:      svc.PartnerId.String(),
+		ExpiresAt:      req.GetExpiresAt(),
+		Permissions:    req.GetPermissions(),
+		Projects:       req.GetProjects(),
+	})
+}
+
+func (s *serviceAccountService) ListApiKeys(ctx context.Context, req *rpcv3.ServiceAccountApiKeyRequest) (*rpcv3.UserListApiKeysResponse, error) {
+	svc, err := s.getServiceAccount(ctx, s.db, req.GetMetadata())
+	if err != nil {
+		return nil, err
+	}
+	return s.ks.List(ctx, &rpcv3.ApiKeyRequest{Username: svc.ID.String()})
+}
+
+func (s *serviceAccountService) DeleteApiKey(ctx context.Context, req *rpcv3.ServiceAccountApiKeyRequest) (*rpcv3.UserDeleteApiKeysResponse, error) {
+	svc, err := s.getServiceAccount(ctx, s.db, req.GetMetadata())
+	if err != nil {
+		return nil, err
+	}
+	return s.ks.Delete(ctx, &rpcv3.ApiKeyRequest{Username: svc.ID.String(), Id: req.GetId()})
+}
@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	userrpcv3 "github.com/paralus/paralus/proto/rpc/user"
+	v3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	userv3 "github.com/paralus/paralus/proto/types/userpb/v3"
+)
+
+func TestCreateServiceAccountNoRoles(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	mazc := mockAuthzClient{}
+	sas := NewServiceAccountService(db, &mazc, NewApiKeyService(db, getLogger()), getLogger())
+
+	suuid := uuid.New().String()
+
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+	addUnavailableExpectation(mock, "serviceaccount", puuid, ouuid, suuid)
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO "authsrv_serviceaccount"`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(suuid))
+	mock.ExpectCommit()
+	addUserRoleMappingsFetchExpectation(mock, suuid, puuid)
+
+	sa := &userv3.ServiceAccount{
+		Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Name: "serviceaccount-" + suuid},
+		Spec:     &userv3.ServiceAccountSpec{},
+	}
+	sa, err := sas.Create(context.Background(), sa)
+	if err != nil {
+		t.Fatal("could not create service account:", err)
+	}
+	if sa.GetMetadata().GetName() != "serviceaccount-"+suuid {
+		t.Error("invalid name returned")
+	}
+	if sa.GetKind() != "ServiceAccount" {
+		t.Errorf("invalid kind returned, expected ServiceAccount; got '%v'", sa.GetKind())
+	}
+	performBasicAuthzChecks(t, mazc, 0, 0, 0, 0, 0, 0)
+}
+
+func TestCreateServiceAccountWithRoles(t *testing.T) {
+	tt := []struct {
+		name       string
+		roles      []*userv3.ProjectNamespaceRole
+		dbname     string
+		scope      string
+		shouldfail bool
+	}{
+		{"org role", []*userv3.ProjectNamespaceRole{{Role: "role-name"}}, "authsrv_accountresourcerole", "organization", false},
+		{"project role", []*userv3.ProjectNamespaceRole{{Role: "role-name", Project: "project"}}, "authsrv_projectaccountresourcerole", "project", false},
+		{"namespace role", []*userv3.ProjectNamespaceRole{{Role: "role-name", Project: "project", Namespace: "ns"}}, "authsrv_projectaccountnamespacerole", "namespace", false},
+		{"system role", []*userv3.ProjectNamespaceRole{{Role: "role-name"}}, "authsrv_accountresourcerole", "system", true},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock := getDB(t)
+			defer db.Close()
+
+			mazc := mockAuthzClient{}
+			sas := NewServiceAccountService(db, &mazc, NewApiKeyService(db, getLogger()), getLogger())
+
+			suuid := uuid.New().String()
+
+			puuid, ouuid := addParterOrgFetchExpectation(mock)
+			addUnavailableExpectation(mock, "serviceaccount", puuid, ouuid, suuid)
+			mock.ExpectBegin()
+			mock.ExpectQuery(`INSERT INTO "authsrv_serviceaccount"`).
+				WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(suuid))
+			addResourceRoleFetchExpectation(mock, tc.scope)
+			if tc.roles[0].Project != "" {
+				mock.ExpectQuery(`SELECT "project"."id" FROM "authsrv_project" AS "project"`).
+					WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.New().String()))
+			}
+			if tc.shouldfail {
+				mock.ExpectRollback()
+			} else {
+				mock.ExpectQuery(fmt.Sprintf(`INSERT INTO "%v"`, tc.dbname)).
+					WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.New().String()))
+				mock.ExpectCommit()
+				addUserRoleMappingsFetchExpectation(mock, suuid, puuid)
+			}
+
+			sa := &userv3.ServiceAccount{
+				Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Name: "serviceaccount-" + suuid},
+				Spec:     &userv3.ServiceAccountSpec{ProjectNamespaceRoles: tc.roles},
+			}
+			_, err := sas.Create(context.Background(), sa)
+			if tc.shouldfail {
+				if err == nil {
+					t.Fatal("service account created with invalid role")
+				}
+				performBasicAuthzChecks(t, mazc, 0, 0, 0, 0, 0, 0)
+				return
+			}
+			if err != nil {
+				t.Fatal("could not create service account:", err)
+			}
+			performBasicAuthzChecks(t, mazc, 1, 0, 0, 0, 0, 0)
+			if sub := mazc.cp[0].Policies[0].Sub; sub != "sa:"+suuid {
+				t.Errorf("invalid sub in policy sent to authz; expected 'sa:%v', got '%v'", suuid, sub)
+			}
+		})
+	}
+}
+
+func TestCreateServiceAccountDuplicate(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	mazc := mockAuthzClient{}
+	sas := NewServiceAccountService(db, &mazc, NewApiKeyService(db, getLogger()), getLogger())
+
+	suuid := uuid.New().String()
+
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+	mock.ExpectQuery(`SELECT "serviceaccount"."id" FROM "authsrv_serviceaccount" AS "serviceaccount" WHERE .organization_id = '` + ouuid + `'. AND .partner_id = '` + puuid + `'. AND .name = 'serviceaccount-` + suuid + `'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(suuid))
+
+	sa := &userv3.ServiceAccount{
+		Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Name: "serviceaccount-" + suuid},
+	}
+	_, err := sas.Create(context.Background(), sa)
+	if err == nil {
+		t.Fatal("created duplicate service account")
+	}
+}
+
+func TestServiceAccountDelete(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	mazc := mockAuthzClient{}
+	sas := NewServiceAccountService(db, &mazc, NewApiKeyService(db, getLogger()), getLogger())
+
+	suuid := uuid.New().String()
+
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+	addFetchByNameExpectation(mock, "serviceaccount", suuid)
+	mock.ExpectBegin()
+	addUserRoleMappingsUpdateExpectation(mock, suuid)
+	mock.ExpectExec(`UPDATE "authsrv_apikey" AS "apikey" SET trash = TRUE WHERE .account_id = '` + suuid + `'.`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	addDeleteExpectation(mock, "serviceaccount", suuid)
+	mock.ExpectCommit()
+
+	sa := &userv3.ServiceAccount{
+		Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Name: "serviceaccount-" + suuid},
+	}
+	_, err := sas.Delete(context.Background(), sa)
+	if err != nil {
+		t.Fatal("could not delete service account:", err)
+	}
+	performBasicAuthzChecks(t, mazc, 0, 1, 0, 0, 0, 0)
+	if sub := mazc.dp[0].Sub; sub != "sa:"+suuid {
+		t.Errorf("invalid sub in policy sent to authz; expected 'sa:%v', got '%v'", suuid, sub)
+	}
+}
+
+func TestServiceAccountGetByName(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	mazc := mockAuthzClient{}
+	sas := NewServiceAccountService(db, &mazc, NewApiKeyService(db, getLogger()), getLogger())
+
+	suuid := uuid.New().String()
+
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+	addFetchByNameExpectation(mock, "serviceaccount", suuid)
+	addUserRoleMappingsFetchExpectation(mock, suuid, puuid)
+
+	sa := &userv3.ServiceAccount{
+		Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Name: "serviceaccount-" + suuid},
+	}
+	sa, err := sas.GetByName(context.Background(), sa)
+	if err != nil {
+		t.Fatal("could not get service account:", err)
+	}
+	if len(sa.GetSpec().GetProjectNamespaceRoles()) != 3 {
+		t.Errorf("invalid number of roles returned for service account, expected 3; got '%v'", len(sa.GetSpec().GetProjectNamespaceRoles()))
+	}
+}
+
+func TestServiceAccountCreateApiKey(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	mazc := mockAuthzClient{}
+	sas := NewServiceAccountService(db, &mazc, NewApiKeyService(db, getLogger()), getLogger())
+
+	suuid := uuid.New().String()
+	auuid := uuid.New().String()
+
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+	addFetchByNameExpectation(mock, "serviceaccount", suuid)
+	mock.ExpectQuery(`INSERT INTO "authsrv_apikey" .*'` + suuid + `'.* TRUE`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(auuid, "serviceaccount-"+suuid))
+
+	key, err := sas.CreateApiKey(context.Background(), &userrpcv3.ServiceAccountApiKeyRequest{
+		Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Name: "serviceaccount-" + suuid},
+	})
+	if err != nil {
+		t.Fatal("could not create api key for service account:", err)
+	}
+	if !key.IsServiceAccount {
+		t.Error("api key not marked as service account key")
+	}
+	if key.AccountID.String() != suuid {
+		t.Errorf("invalid account for api key, expected '%v'; got '%v'", suuid, key.AccountID)
+	}
+}
@@ -0,0 +1,421 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/dao"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/paralus/paralus/pkg/jwks"
+	"github.com/paralus/paralus/pkg/query"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	userv3 "github.com/paralus/paralus/proto/types/userpb/v3"
+	bun "github.com/uptrace/bun"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	trustPolicyKind     = "TrustPolicy"
+	trustPolicyListKind = "TrustPolicyList"
+
+	// trustPolicyJwksCacheTTL is how long keys fetched from the jwks
+	// url of an issuer are used before fetching them again
+	trustPolicyJwksCacheTTL = time.Hour
+)
+
+// ErrUntrustedToken is returned when no trust policy accepts a
+// workload identity token
+var ErrUntrustedToken = errors.New("no trust policy matches token")
+
+// workload identity tokens are only accepted when signed with one of
+// these asymmetric algorithms
+var trustPolicySigningMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+
+// TrustPolicyService is the interface for trust policy operations
+type TrustPolicyService interface {
+	// create trust policy
+	Create(context.Context, *userv3.TrustPolicy) (*userv3.TrustPolicy, error)
+	// get trust policy by name
+	GetByName(context.Context, *userv3.TrustPolicy) (*userv3.TrustPolicy, error)
+	// update trust policy
+	Update(context.Context, *userv3.TrustPolicy) (*userv3.TrustPolicy, error)
+	// delete trust policy
+	Delete(context.Context, *userv3.TrustPolicy) (*userv3.TrustPolicy, error)
+	// list trust policies
+	List(context.Context, ...query.Option) (*userv3.TrustPolicyList, error)
+	// Authenticate returns the service account a workload identity
+	// token is trusted as
+	Authenticate(ctx context.Context, token string) (*models.ServiceAccount, error)
+}
+
+// trustPolicyService implements TrustPolicyService
+type trustPolicyService struct {
+	db   *bun.DB
+	al   *zap.Logger
+	keys *jwks.Fetcher
+}
+
+// NewTrustPolicyService return new trust policy service
+func NewTrustPolicyService(db *bun.DB, al *zap.Logger) TrustPolicyService {
+	return &trustPolicyService{db: db, al: al, keys: jwks.NewFetcher(nil, trustPolicyJwksCacheTTL)}
+}
+
+func (s *trustPolicyService) getPartnerOrganization(ctx context.Context, meta *commonv3.Metadata) (uuid.UUID, uuid.UUID, error) {
+	partnerId, err := dao.GetPartnerId(ctx, s.db, meta.GetPartner())
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	organizationId, err := dao.GetOrganizationId(ctx, s.db, meta.GetOrganization())
+	if err != nil {
+		return partnerId, uuid.Nil, err
+	}
+	return partnerId, organizationId, nil
+}
+
+func (s *trustPolicyService) getTrustPolicy(ctx context.Context, meta *commonv3.Metadata) (*models.TrustPolicy, error) {
+	partnerId, organizationId, err := s.getPartnerOrganization(ctx, meta)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get partner and org id")
+	}
+	entity, err := dao.GetByNamePartnerOrg(ctx, s.db, meta.GetName(), uuid.NullUUID{UUID: partnerId, Valid: true}, uuid.NullUUID{UUID: organizationId, Valid: true}, &models.TrustPolicy{})
+	if err != nil {
+		return nil, fmt.Errorf("no trust policy found with name '%v'", meta.GetName())
+	}
+	if tp, ok := entity.(*models.TrustPolicy); ok {
+		return tp, nil
+	}
+	return nil, fmt.Errorf("no trust policy found with name '%v'", meta.GetName())
+}
+
+// fromV3TrustPolicy validates the spec of tp and copies it to the model
+func (s *trustPolicyService) fromV3TrustPolicy(ctx context.Context, tp *userv3.TrustPolicy, policy *models.TrustPolicy) error {
+	spec := tp.GetSpec()
+	if spec.GetIssuer() == "" {
+		return fmt.Errorf("issuer is required")
+	}
+	if spec.GetAudience() == "" {
+		return fmt.Errorf("audience is required")
+	}
+	switch {
+	case spec.GetJwksUrl() != "" && spec.GetJwks() != "":
+		return fmt.Errorf("only one of jwksUrl and jwks can be set")
+	case spec.GetJwksUrl() != "":
+		u, err := url.Parse(spec.GetJwksUrl())
+		if err != nil || u.Scheme != "https" || u.Host == "" {
+			return fmt.Errorf("jwksUrl should be a https url")
+		}
+	case spec.GetJwks() != "":
+		keys, err := jwks.Parse([]byte(spec.GetJwks()))
+		if err != nil {
+			return err
+		}
+		if len(keys) == 0 {
+			return fmt.Errorf("no signing keys in jwks")
+		}
+	default:
+		return fmt.Errorf("one of jwksUrl and jwks is required")
+	}
+
+	matchers := map[string]string{}
+	for _, m := range spec.GetClaimMatchers() {
+		if m.GetClaim() == "" {
+			return fmt.Errorf("claim matcher without claim")
+		}
+		if _, ok := matchers[m.GetClaim()]; ok {
+			return fmt.Errorf("duplicate claim matcher for '%v'", m.GetClaim())
+		}
+		matchers[m.GetClaim()] = m.GetValue()
+	}
+
+	if spec.GetServiceAccount() == "" {
+		return fmt.Errorf("service account is required")
+	}
+	entity, err := dao.GetIdByNamePartnerOrg(ctx, s.db, spec.GetServiceAccount(), uuid.NullUUID{UUID: policy.PartnerId, Valid: true}, uuid.NullUUID{UUID: policy.OrganizationId, Valid: true}, &models.ServiceAccount{})
+	if err != nil {
+		return fmt.Errorf("unable to find service account '%v'", spec.GetServiceAccount())
+	}
+	sa, ok := entity.(*models.ServiceAccount)
+	if !ok {
+		return fmt.Errorf("unable to find service account '%v'", spec.GetServiceAccount())
+	}
+
+	policy.Description = tp.GetMetadata().GetDescription()
+	policy.Issuer = spec.GetIssuer()
+	policy.JwksURL = spec.GetJwksUrl()
+	policy.Jwks = spec.GetJwks()
+	policy.Audience = spec.GetAudience()
+	policy.ClaimMatchers = matchers
+	policy.ServiceAccountId = sa.ID
+	return nil
+}
+
+func (s *trustPolicyService) toV3TrustPolicy(ctx context.Context, tp *userv3.TrustPolicy, policy *models.TrustPolicy) (*userv3.TrustPolicy, error) {
+	labels := make(map[string]string)
+	labels["organization"] = tp.GetMetadata().GetOrganization()
+	labels["partner"] = tp.GetMetadata().GetPartner()
+
+	tp.ApiVersion = apiVersion
+	tp.Kind = trustPolicyKind
+	tp.Metadata = &commonv3.Metadata{
+		Name:         policy.Name,
+		Description:  policy.Description,
+		Id:           policy.ID.String(),
+		Organization: tp.GetMetadata().GetOrganization(),
+		Partner:      tp.GetMetadata().GetPartner(),
+		Labels:       labels,
+		ModifiedAt:   timestamppb.New(policy.ModifiedAt),
+		CreatedAt:    timestamppb.New(policy.CreatedAt),
+	}
+
+	var saName string
+	entity, err := dao.GetNameById(ctx, s.db, policy.ServiceAccountId, &models.ServiceAccount{})
+	if err != nil {
+		return &userv3.TrustPolicy{}, err
+	}
+	if sa, ok := entity.(*models.ServiceAccount); ok {
+		saName = sa.Name
+	}
+
+	// map iteration order is random, keep the output stable
+	claims := make([]string, 0, len(policy.ClaimMatchers))
+	for c := range policy.ClaimMatchers {
+		claims = append(claims, c)
+	}
+	sort.Strings(claims)
+	matchers := []*userv3.ClaimMatcher{}
+	for _, c := range claims {
+		matchers = append(matchers, &userv3.ClaimMatcher{Claim: c, Value: policy.ClaimMatchers[c]})
+	}
+
+	tp.Spec = &userv3.TrustPolicySpec{
+		Issuer:         policy.Issuer,
+		JwksUrl:        policy.JwksURL,
+		Jwks:           policy.Jwks,
+		Audience:       policy.Audience,
+		ClaimMatchers:  matchers,
+		ServiceAccount: saName,
+	}
+	return tp, nil
+}
+
+func (s *trustPolicyService) Create(ctx context.Context, tp *userv3.TrustPolicy) (*userv3.TrustPolicy, error) {
+	partnerId, organizationId, err := s.getPartnerOrganization(ctx, tp.GetMetadata())
+	if err != nil {
+		return nil, fmt.Errorf("unable to get partner and org id")
+	}
+	e, _ := dao.GetIdByNamePartnerOrg(ctx, s.db, tp.GetMetadata().GetName(), uuid.NullUUID{UUID: partnerId, Valid: true}, uuid.NullUUID{UUID: organizationId, Valid: true}, &models.TrustPolicy{})
+	if e != nil {
+		return nil, fmt.Errorf("trust policy '%v' already exists", tp.GetMetadata().GetName())
+	}
+
+	policy := models.TrustPolicy{
+		Name:           tp.GetMetadata().GetName(),
+		CreatedAt:      time.Now(),
+		ModifiedAt:     time.Now(),
+		Trash:          false,
+		OrganizationId: organizationId,
+		PartnerId:      partnerId,
+	}
+	if err := s.fromV3TrustPolicy(ctx, tp, &policy); err != nil {
+		return nil, err
+	}
+
+	_, err = dao.Create(ctx, s.db, &policy)
+	if err != nil {
+		return &userv3.TrustPolicy{}, err
+	}
+
+	CreateTrustPolicyAuditEvent(ctx, s.al, AuditActionCreate, policy.Name)
+	return s.toV3TrustPolicy(ctx, tp, &policy)
+}
+
+func (s *trustPolicyService) GetByName(ctx context.Context, tp *userv3.TrustPolicy) (*userv3.TrustPolicy, error) {
+	policy, err := s.getTrustPolicy(ctx, tp.GetMetadata())
+	if err != nil {
+		return &userv3.TrustPolicy{}, err
+	}
+	return s.toV3TrustPolicy(ctx, tp, policy)
+}
+
+func (s *trustPolicyService) Update(ctx context.Context, tp *userv3.TrustPolicy) (*userv3.TrustPolicy, error) {
+	policy, err := s.getTrustPolicy(ctx, tp.GetMetadata())
+	if err != nil {
+		return &userv3.TrustPolicy{}, err
+	}
+	if err := s.fromV3TrustPolicy(ctx, tp, policy); err != nil {
+		return &userv3.TrustPolicy{}, err
+	}
+	policy.ModifiedAt = time.Now()
+
+	_, err = dao.Update(ctx, s.db, policy.ID, policy)
+	if err != nil {
+		return &userv3.TrustPolicy{}, err
+	}
+
+	CreateTrustPolicyAuditEvent(ctx, s.al, AuditActionUpdate, policy.Name)
+	return s.toV3TrustPolicy(ctx, tp, policy)
+}
+
+func (s *trustPolicyService) Delete(ctx context.Context, tp *userv3.TrustPolicy) (*userv3.TrustPolicy, error) {
+	policy, err := s.getTrustPolicy(ctx, tp.GetMetadata())
+	if err != nil {
+		return &userv3.TrustPolicy{}, err
+	}
+	err = dao.Delete(ctx, s.db, policy.ID, policy)
+	if err != nil {
+		return &userv3.TrustPolicy{}, err
+	}
+
+	CreateTrustPolicyAuditEvent(ctx, s.al, AuditActionDelete, policy.Name)
+	return tp, nil
+}
+
+func (s *trustPolicyService) List(ctx context.Context, opts ...query.Option) (*userv3.TrustPolicyList, error) {
+	var items []*userv3.TrustPolicy
+	tpList := &userv3.TrustPolicyList{
+		ApiVersion: apiVersion,
+		Kind:       trustPolicyListKind,
+		Metadata: &commonv3.ListMetadata{
+			Count: 0,
+		},
+	}
+
+	queryOptions := commonv3.QueryOptions{}
+	for _, opt := range opts {
+		opt(&queryOptions)
+	}
+
+	orgId, err := dao.GetOrganizationId(ctx, s.db, queryOptions.Organization)
+	if err != nil {
+		return tpList, err
+	}
+	partId, err := dao.GetPartnerId(ctx, s.db, queryOptions.Partner)
+	if err != nil {
+		return tpList, err
+	}
+	var policies []models.TrustPolicy
+	entities, err := dao.ListFiltered(ctx, s.db,
+		uuid.NullUUID{UUID: partId, Valid: true}, uuid.NullUUID{UUID: orgId, Valid: true},
+		uuid.NullUUID{Valid: false},
+		&policies,
+		queryOptions.Q,
+		queryOptions.OrderBy,
+		queryOptions.Order,
+		int(queryOptions.Limit),
+		int(queryOptions.Offset),
+	)
+	if err != nil {
+		return tpList, err
+	}
+	if policies, ok := entities.(*[]models.TrustPolicy); ok {
+		for _, policy := range *policies {
+			entry := &userv3.TrustPolicy{Metadata: &commonv3.Metadata{
+				Organization: queryOptions.Organization,
+				Partner:      queryOptions.Partner,
+			}}
+			entry, err = s.toV3TrustPolicy(ctx, entry, &policy)
+			if err != nil {
+				return tpList, err
+			}
+			items = append(items, entry)
+		}
+
+		tpList.Metadata = &commonv3.ListMetadata{
+			Count: int64(len(items)),
+		}
+		tpList.Items = items
+	}
+
+	return tpList, nil
+}
+
+func (s *trustPolicyService) Authenticate(ctx context.Context, token string) (*models.ServiceAccount, error) {
+	// the issuer is only used to pick the candidate policies, the
+	// token is verified against each of them
+	claims := jwt.MapClaims{}
+	_, _, err := jwt.NewParser().ParseUnverified(token, claims)
+	if err != nil {
+		return nil, ErrUntrustedToken
+	}
+	issuer, _ := claims["iss"].(string)
+	if issuer == "" {
+		return nil, ErrUntrustedToken
+	}
+
+	var policies []models.TrustPolicy
+	err = s.db.NewSelect().Model(&policies).
+		Where("issuer = ?", issuer).
+		Where("trash = ?", false).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, policy := range policies {
+		if err := s.verify(ctx, &policy, token); err != nil {
+			_log.Debugw("trust policy does not match token", "policy", policy.Name, "error", err)
+			continue
+		}
+		var sa models.ServiceAccount
+		err = s.db.NewSelect().Model(&sa).
+			Where("id = ?", policy.ServiceAccountId).
+			Where("organization_id = ?", policy.OrganizationId).
+			Where("trash = ?", false).
+			Scan(ctx)
+		if err != nil {
+			_log.Infow("service account of trust policy not found", "policy", policy.Name, "error", err)
+			continue
+		}
+		return &sa, nil
+	}
+	return nil, ErrUntrustedToken
+}
+
+// verify checks the signature and claims of token against policy
+func (s *trustPolicyService) verify(ctx context.Context, policy *models.TrustPolicy, token string) error {
+	claims := jwt.MapClaims{}
+	parser := jwt.NewParser(jwt.WithValidMethods(trustPolicySigningMethods))
+	_, err := parser.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		if policy.Jwks != "" {
+			keys, err := jwks.Parse([]byte(policy.Jwks))
+			if err != nil {
+				return nil, err
+			}
+			key, ok := keys.Key(kid)
+			if !ok {
+				return nil, fmt.Errorf("unknown key '%v'", kid)
+			}
+			return key, nil
+		}
+		return s.keys.Key(ctx, policy.JwksURL, kid)
+	})
+	if err != nil {
+		return err
+	}
+
+	// the parser only checks exp when present, workload identity
+	// tokens have to be short lived
+	if !claims.VerifyExpiresAt(time.Now().Unix(), true) {
+		return fmt.Errorf("token without expiry")
+	}
+	if !claims.VerifyIssuer(policy.Issuer, true) {
+		return fmt.Errorf("issuer mismatch")
+	}
+	if !claims.VerifyAudience(policy.Audience, true) {
+		return fmt.Errorf("audience mismatch")
+	}
+	for claim, value := range policy.ClaimMatchers {
+		if v, ok := claims[claim].(string); !ok || v != value {
+			return fmt.Errorf("claim '%v' mismatch", claim)
+		}
+	}
+	return nil
+}
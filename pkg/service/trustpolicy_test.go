@@ -0,0 +1,252 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/pkg/jwks"
+	v3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	userv3 "github.com/paralus/paralus/proto/types/userpb/v3"
+)
+
+const testIssuer = "https://token.actions.githubusercontent.com"
+
+func testJwks(kid string, key *rsa.PublicKey) string {
+	return fmt.Sprintf(`{"keys":[{"kty":"RSA","kid":"%s","use":"sig","n":"%s","e":"%s"}]}`, kid,
+		base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()))
+}
+
+func signTestToken(t *testing.T, key *rsa.PrivateKey, kid string, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal("unable to sign token:", err)
+	}
+	return signed
+}
+
+func addTrustPolicyFetchExpectation(mock sqlmock.Sqlmock, jwksInline, jwksURL, said string) {
+	mock.ExpectQuery(`SELECT "trustpolicy"."id", .* FROM "authsrv_trustpolicy" AS "trustpolicy" WHERE .issuer = '` + testIssuer + `'. AND .trash = FALSE.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "issuer", "jwks_url", "jwks", "audience", "claim_matchers", "service_account_id"}).
+			AddRow(uuid.NewString(), "trustpolicy-ci", testIssuer, jwksURL, jwksInline, "paralus", []byte(`{"repository":"org/repo"}`), said))
+}
+
+func addTrustPolicyServiceAccountExpectation(mock sqlmock.Sqlmock, said string) {
+	mock.ExpectQuery(`SELECT "serviceaccount"."id", .* FROM "authsrv_serviceaccount" AS "serviceaccount" WHERE .id = '` + said + `'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(said, "serviceaccount-ci"))
+}
+
+func TestTrustPolicyAuthenticate(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	valid := func() jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss":        testIssuer,
+			"aud":        "paralus",
+			"exp":        time.Now().Add(time.Minute).Unix(),
+			"repository": "org/repo",
+		}
+	}
+	without := func(claim string) jwt.MapClaims {
+		c := valid()
+		delete(c, claim)
+		return c
+	}
+	with := func(claim string, value interface{}) jwt.MapClaims {
+		c := valid()
+		c[claim] = value
+		return c
+	}
+
+	tt := []struct {
+		name    string
+		token   func(t *testing.T) string
+		matches bool
+	}{
+		{"valid token", func(t *testing.T) string { return signTestToken(t, key, "k1", valid()) }, true},
+		{"audience list", func(t *testing.T) string {
+			return signTestToken(t, key, "k1", with("aud", []string{"other", "paralus"}))
+		}, true},
+		{"wrong audience", func(t *testing.T) string { return signTestToken(t, key, "k1", with("aud", "other")) }, false},
+		{"claim mismatch", func(t *testing.T) string {
+			return signTestToken(t, key, "k1", with("repository", "org/other"))
+		}, false},
+		{"claim missing", func(t *testing.T) string { return signTestToken(t, key, "k1", without("repository")) }, false},
+		{"expired", func(t *testing.T) string {
+			return signTestToken(t, key, "k1", with("exp", time.Now().Add(-time.Minute).Unix()))
+		}, false},
+		{"no expiry", func(t *testing.T) string { return signTestToken(t, key, "k1", without("exp")) }, false},
+		{"untrusted key", func(t *testing.T) string { return signTestToken(t, other, "k1", valid()) }, false},
+		{"hmac signed", func(t *testing.T) string {
+			token, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, valid()).SignedString([]byte("secret"))
+			return token
+		}, false},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock := getDB(t)
+			defer db.Close()
+
+			tps := NewTrustPolicyService(db, getLogger())
+			said := uuid.NewString()
+
+			addTrustPolicyFetchExpectation(mock, testJwks("k1", &key.PublicKey), "", said)
+			if tc.matches {
+				addTrustPolicyServiceAccountExpectation(mock, said)
+			}
+
+			sa, err := tps.Authenticate(context.Background(), tc.token(t))
+			if tc.matches {
+				if err != nil {
+					t.Fatal("token not accepted:", err)
+				}
+				if sa.ID.String() != said {
+					t.Errorf("invalid service account, expected '%v'; got '%v'", said, sa.ID)
+				}
+				return
+			}
+			if err != ErrUntrustedToken {
+				t.Errorf("expected untrusted token; got '%v'", err)
+			}
+		})
+	}
+}
+
+func TestTrustPolicyAuthenticateJwksURL(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, testJwks("k1", &key.PublicKey))
+	}))
+	defer srv.Close()
+
+	db, mock := getDB(t)
+	defer db.Close()
+
+	tps := &trustPolicyService{db: db, al: getLogger(), keys: jwks.NewFetcher(srv.Client(), time.Hour)}
+	said := uuid.NewString()
+
+	addTrustPolicyFetchExpectation(mock, "", srv.URL, said)
+	addTrustPolicyServiceAccountExpectation(mock, said)
+
+	token := signTestToken(t, key, "k1", jwt.MapClaims{
+		"iss":        testIssuer,
+		"aud":        "paralus",
+		"exp":        time.Now().Add(time.Minute).Unix(),
+		"repository": "org/repo",
+	})
+	sa, err := tps.Authenticate(context.Background(), token)
+	if err != nil {
+		t.Fatal("token not accepted:", err)
+	}
+	if sa.Name != "serviceaccount-ci" {
+		t.Errorf("invalid service account, expected 'serviceaccount-ci'; got '%v'", sa.Name)
+	}
+}
+
+func TestTrustPolicyAuthenticateUnknownIssuer(t *testing.T) {
+	db, _ := getDB(t)
+	defer db.Close()
+
+	tps := NewTrustPolicyService(db, getLogger())
+	if _, err := tps.Authenticate(context.Background(), "not-a-token"); err != ErrUntrustedToken {
+		t.Errorf("expected untrusted token; got '%v'", err)
+	}
+}
+
+func TestCreateTrustPolicyInvalid(t *testing.T) {
+	tt := []struct {
+		name string
+		spec *userv3.TrustPolicySpec
+	}{
+		{"no issuer", &userv3.TrustPolicySpec{Audience: "paralus", Jwks: `{"keys":[]}`, ServiceAccount: "sa"}},
+		{"no audience", &userv3.TrustPolicySpec{Issuer: testIssuer, Jwks: `{"keys":[]}`, ServiceAccount: "sa"}},
+		{"no keys", &userv3.TrustPolicySpec{Issuer: testIssuer, Audience: "paralus", ServiceAccount: "sa"}},
+		{"empty jwks", &userv3.TrustPolicySpec{Issuer: testIssuer, Audience: "paralus", Jwks: `{"keys":[]}`, ServiceAccount: "sa"}},
+		{"http jwks url", &userv3.TrustPolicySpec{Issuer: testIssuer, Audience: "paralus", JwksUrl: "http://example.com/jwks", ServiceAccount: "sa"}},
+		{"both jwks", &userv3.TrustPolicySpec{Issuer: testIssuer, Audience: "paralus", JwksUrl: "https://example.com/jwks", Jwks: `{"keys":[]}`, ServiceAccount: "sa"}},
+		{"duplicate matcher", &userv3.TrustPolicySpec{Issuer: testIssuer, Audience: "paralus", JwksUrl: "https://example.com/jwks", ServiceAccount: "sa",
+			ClaimMatchers: []*userv3.ClaimMatcher{{Claim: "repository", Value: "a"}, {Claim: "repository", Value: "b"}}}},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock := getDB(t)
+			defer db.Close()
+
+			tps := NewTrustPolicyService(db, getLogger())
+			tuuid := uuid.NewString()
+
+			puuid, ouuid := addParterOrgFetchExpectation(mock)
+			addUnavailableExpectation(mock, "trustpolicy", puuid, ouuid, tuuid)
+
+			tp := &userv3.TrustPolicy{
+				Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Name: "trustpolicy-" + tuuid},
+				Spec:     tc.spec,
+			}
+			if _, err := tps.Create(context.Background(), tp); err == nil {
+				t.Error("created invalid trust policy")
+			}
+		})
+	}
+}
+
+func TestCreateTrustPolicy(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	tps := NewTrustPolicyService(db, getLogger())
+	tuuid := uuid.NewString()
+	suuid := uuid.NewString()
+
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+	addUnavailableExpectation(mock, "trustpolicy", puuid, ouuid, tuuid)
+	mock.ExpectQuery(`SELECT "serviceaccount"."id" FROM "authsrv_serviceaccount" AS "serviceaccount" WHERE .organization_id = '` + ouuid + `'. AND .partner_id = '` + puuid + `'. AND .name = 'serviceaccount-ci'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(suuid))
+	mock.ExpectQuery(`INSERT INTO "authsrv_trustpolicy" .*'{"repository":"org/repo"}', '` + suuid + `'`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(tuuid))
+	mock.ExpectQuery(`SELECT "serviceaccount"."name" FROM "authsrv_serviceaccount" AS "serviceaccount" WHERE .id = '` + suuid + `'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("serviceaccount-ci"))
+
+	tp := &userv3.TrustPolicy{
+		Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Name: "trustpolicy-" + tuuid},
+		Spec: &userv3.TrustPolicySpec{
+			Issuer:         testIssuer,
+			JwksUrl:        testIssuer + "/.well-known/jwks",
+			Audience:       "paralus",
+			ClaimMatchers:  []*userv3.ClaimMatcher{{Claim: "repository", Value: "org/repo"}},
+			ServiceAccount: "serviceaccount-ci",
+		},
+	}
+	tp, err := tps.Create(context.Background(), tp)
+	if err != nil {
+		t.Fatal("could not create trust policy:", err)
+	}
+	if tp.GetSpec().GetServiceAccount() != "serviceaccount-ci" {
+		t.Errorf("invalid service account, expected 'serviceaccount-ci'; got '%v'", tp.GetSpec().GetServiceAccount())
+	}
+	if len(tp.GetSpec().GetClaimMatchers()) != 1 {
+		t.Errorf("invalid number of claim matchers, expected 1; got '%v'", len(tp.GetSpec().GetClaimMatchers()))
+	}
+}
@@ -22,12 +22,17 @@ import (
 	userrpcv3 "github.com/paralus/paralus/proto/rpc/user"
 	authzv1 "github.com/paralus/paralus/proto/types/authz"
 	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	systemv3 "github.com/paralus/paralus/proto/types/systempb/v3"
 	userv3 "github.com/paralus/paralus/proto/types/userpb/v3"
 )
 
 const (
-	userKind     = "User"
-	userListKind = "UserList"
+	userKind            = "User"
+	userListKind        = "UserList"
+	userSessionListKind = "UserSessionList"
+	// providers a session of a user was created by
+	sessionProviderKratos = "kratos"
+	sessionProviderSAML   = "saml"
 )
 
 // GroupService is the interface for group operations
@@ -56,15 +61,26 @@ type UserService interface {
 	ForgotPassword(context.Context, *userrpcv3.UserForgotPasswordRequest) (*userrpcv3.UserForgotPasswordResponse, error)
 	// Generate auditLog event
 	CreateLoginAuditLog(context.Context, *userrpcv3.UserLoginAuditRequest) (*userrpcv3.UserLoginAuditResponse, error)
+	// list active sessions of user
+	ListSessions(context.Context, *userrpcv3.UserSessionRequest) (*userv3.UserSessionList, error)
+	// revoke a session of user
+	RevokeSession(context.Context, *userrpcv3.UserSessionRequest) error
+	// revoke all sessions of user
+	RevokeSessions(context.Context, *userrpcv3.UserSessionRequest) error
+	// revoke sessions, kubeconfigs and api keys of user
+	RevokeAccess(context.Context, *userrpcv3.UserSessionRequest) (*userrpcv3.UserRevokeAccessResponse, error)
+	// deactivate or activate user
+	SetActive(context.Context, string, bool) error
 }
 
 type userService struct {
 	ap  providers.AuthProvider
-	db  *bun.DB
+	db  bun.IDB
 	azc AuthzService
 	ks  ApiKeyService
 	cc  common.CliConfigDownloadData
 	al  *zap.Logger
+	lo  AccountLockoutService
 	dev bool
 }
 
@@ -83,7 +99,7 @@ type parsedIds struct {
 }
 
 func NewUserService(ap providers.AuthProvider, db *bun.DB, azc AuthzService, kss ApiKeyService, cfg common.CliConfigDownloadData, al *zap.Logger, dev bool) UserService {
-	return &userService{ap: ap, db: db, azc: azc, ks: kss, cc: cfg, al: al, dev: dev}
+	return &userService{ap: ap, db: db, azc: azc, ks: kss, cc: cfg, al: al, lo: NewAccountLockoutService(db, al), dev: dev}
 }
 
 func getUserTraits(traits map[string]interface{}) userTraits {
@@ -132,6 +148,10 @@ func (s *userService) createUserRoleRelations(ctx context.Context, db bun.IDB, u
 		if len(pnr.GetGroup()) > 0 {
 			continue
 		}
+		w, err := getBindingWindow(pnr)
+		if err != nil {
+			return &userv3.User{}, nil, err
+		}
 		role := pnr.GetRole()
 		if role == "" {
 			return &userv3.User{}, nil, fmt.Errorf("cannot use empty role")
@@ -166,11 +186,13 @@ func (s *userService) createUserRoleRelations(ctx context.Context, db bun.IDB, u
 				PartnerId:      ids.Partner,
 				OrganizationId: ids.Organization, // Not really used
 				AccountId:      ids.Id,
-				Active:         true,
+				StartsAt:       w.StartsAt,
+				ExpiresAt:      w.ExpiresAt,
+				Active:         w.active(time.Now()),
 			}
 			ars = append(ars, ar)
 
-			ps = append(ps, &authzv1.Policy{
+			ps = w.appendPolicy(ps, &authzv1.Policy{
 				Sub:  "u:" + user.GetMetadata().GetName(),
 				Ns:   "*",
 				Proj: "*",
@@ -191,11 +213,13 @@ func (s *userService) createUserRoleRelations(ctx context.Context, db bun.IDB, u
 				PartnerId:      ids.Partner,
 				OrganizationId: ids.Organization,
 				AccountId:      ids.Id,
-				Active:         true,
+				StartsAt:       w.StartsAt,
+				ExpiresAt:      w.ExpiresAt,
+				Active:         w.active(time.Now()),
 			}
 			ars = append(ars, ar)
 
-			ps = append(ps, &authzv1.Policy{
+			ps = w.appendPolicy(ps, &authzv1.Policy{
 				Sub:  "u:" + user.GetMetadata().GetName(),
 				Ns:   "*",
 				Proj: "*",
@@ -224,11 +248,13 @@ func (s *userService) createUserRoleRelations(ctx context.Context, db bun.IDB, u
 				OrganizationId: ids.Organization,
 				AccountId:      ids.Id,
 				ProjectId:      projectId,
-				Active:         true,
+				StartsAt:       w.StartsAt,
+				ExpiresAt:      w.ExpiresAt,
+				Active:         w.active(time.Now()),
 			}
 			pars = append(pars, par)
 
-			ps = append(ps, &authzv1.Policy{
+			ps = w.appendPolicy(ps, &authzv1.Policy{
 				Sub:  "u:" + user.GetMetadata().GetName(),
 				Ns:   "*",
 				Proj: project,
@@ -248,23 +274,31 @@ func (s *userService) createUserRoleRelations(ctx context.Context, db bun.IDB, u
 			}
 
 			namespace := pnr.GetNamespace()
+			if IsNamespacePattern(namespace) || pnr.GetNamespaceSelector() != "" {
+				if err := validateBindingNamespace(namespace, pnr.GetNamespaceSelector()); err != nil {
+					return user, nil, err
+				}
+			}
 			panrObj := models.ProjectAccountNamespaceRole{
-				CreatedAt:      time.Now(),
-				ModifiedAt:     time.Now(),
-				Trash:          false,
-				PartnerId:      ids.Partner,
-				OrganizationId: ids.Organization,
-				RoleId:         roleId,
-				AccountId:      ids.Id,
-				ProjectId:      projectId,
-				Namespace:      namespace,
-				Active:         true,
+				CreatedAt:         time.Now(),
+				ModifiedAt:        time.Now(),
+				Trash:             false,
+				PartnerId:         ids.Partner,
+				OrganizationId:    ids.Organization,
+				RoleId:            roleId,
+				AccountId:         ids.Id,
+				ProjectId:         projectId,
+				Namespace:         namespace,
+				NamespaceSelector: pnr.GetNamespaceSelector(),
+				StartsAt:          w.StartsAt,
+				ExpiresAt:         w.ExpiresAt,
+				Active:            w.active(time.Now()),
 			}
 			panr = append(panr, panrObj)
 
-			ps = append(ps, &authzv1.Policy{
+			ps = w.appendPolicy(ps, &authzv1.Policy{
 				Sub:  "u:" + user.GetMetadata().GetName(),
-				Ns:   namespace,
+				Ns:   policyNamespace(namespace),
 				Proj: project,
 				Org:  org,
 				Obj:  role,
@@ -584,18 +618,27 @@ func (s *userService) GetByName(ctx context.Context, user *userv3.User) (*userv3
 
 func (s *userService) GetUserInfo(ctx context.Context, user *userv3.User) (*userv3.UserInfo, error) {
 	username := ""
+	var partnerId, organizationId uuid.UUID
 	if s.dev {
 		username = user.GetMetadata().GetName()
 		if len(username) == 0 {
 			_log.Warn("Unable to fetch username. Don't use DEV mode when using from UI.")
 			return &userv3.UserInfo{}, fmt.Errorf("username should be provided")
 		}
+		partnerId, organizationId, _ = s.getPartnerOrganization(ctx, s.db, user)
 	} else {
 		sd, ok := GetSessionDataFromContext(ctx)
 		if !ok {
 			return &userv3.UserInfo{}, fmt.Errorf("cannot get user info without auth")
 		}
 		username = sd.Username
+		partnerId, _ = uuid.Parse(sd.Partner)
+		organizationId, _ = uuid.Parse(sd.Organization)
+	}
+	// permissions denied by deny policies are not effective
+	denyPolicies, err := dao.GetDenyPolicies(ctx, s.db, organizationId, partnerId)
+	if err != nil {
+		return &userv3.UserInfo{}, err
 	}
 
 	entity, err := dao.GetUserByEmail(ctx, s.db, username, &models.KratosIdentities{})
@@ -647,6 +690,26 @@ func (s *userService) GetUserInfo(ctx context.Context, user *userv3.User) (*user
 				roleMap[p.Role] = rps
 				scope = rle.Scope
 			}
+			if len(denyPolicies) > 0 {
+				projectId := uuid.Nil
+				if p.Project != "" {
+					entity, err := dao.GetIdByNamePartnerOrg(ctx, s.db, p.Project, uuid.NullUUID{UUID: partnerId, Valid: true}, uuid.NullUUID{UUID: organizationId, Valid: true}, &models.Project{})
+					if err != nil {
+						return &userv3.UserInfo{}, err
+					}
+					if proj, ok := entity.(*models.Project); ok {
+						projectId = proj.ID
+					}
+				}
+				denied := DeniedPermissions(denyPolicies, username, append(user.Spec.Groups, user.Spec.IdpGroups...), projectId, p.Namespace)
+				effective := []string{}
+				for _, rp := range rps {
+					if !denied[rp] {
+						effective = append(effective, rp)
+					}
+				}
+				rps = effective
+			}
 			permissions = append(
 				permissions,
 				&userv3.Permission{
@@ -735,11 +798,16 @@ func (s *userService) Update(ctx context.Context, user *userv3.User) (*userv3.Us
 
 		if usr.IdentityCredential.IdentityCredentialType.Name == "password" {
 			// Don't update details for non local(IDP) users
-			err = s.ap.Update(ctx, usr.ID.String(), map[string]interface{}{
+			traits := map[string]interface{}{
 				"email":      user.GetMetadata().GetName(),
 				"first_name": user.GetSpec().GetFirstName(),
 				"last_name":  user.GetSpec().GetLastName(),
-			}, providers.IdentityPublicMetadata{
+			}
+			// groups of SAML users are synced from the trait
+			if idpGroups, ok := usr.Traits["idp_groups"]; ok {
+				traits["idp_groups"] = idpGroups
+			}
+			err = s.ap.Update(ctx, usr.ID.String(), traits, providers.IdentityPublicMetadata{
 				ForceReset:   user.GetSpec().ForceReset,
 				Organization: organizationId.String(),
 				Partner:      partnerId.String(),
@@ -1011,11 +1079,15 @@ func (s *userService) RetrieveCliConfig(ctx context.Context, req *userrpcv3.ApiK
 	}
 
 	if apikey == nil {
-		apikey, err = s.ks.Create(ctx, &userrpcv3.ApiKeyRequest{Username: req.Username, Id: req.Id})
+		apikey, err = s.ks.Create(ctx, &userrpcv3.ApiKeyRequest{
+			Username:       req.Username,
+			Id:             req.Id,
+			OrganizationId: org.ID.String(),
+			PartnerId:      part.ID.String(),
+		})
 		if err != nil {
 			return nil, err
 		}
-		CreateApiKeyAuditEvent(ctx, s.al, AuditActionCreate, req.Username)
 	}
 
 	cliConfig := &common.CliConfigDownloadData{
@@ -1027,6 +1099,7 @@ func (s *userService) RetrieveCliConfig(ctx context.Context, req *userrpcv3.ApiK
 		Project:      proj.Name,
 		Organization: org.Name,
 		Partner:      part.Name,
+		AuthScheme:   orgApiKeyAuthScheme(&org),
 	}
 
 	DownloadCliConfigAuditEvent(ctx, s.al, AuditActionDownload, req.Username)
@@ -1048,8 +1121,42 @@ func (s *userService) UpdateIdpUserGroupPolicy(ctx context.Context, op, id, trai
 	if err != nil {
 		return fmt.Errorf("encountered error unmarshing payload to userInfo: %s", err)
 	}
+
+	// syncs happen in the background, changes are recorded as done by
+	// the user whose claims they are based on
+	if _, ok := GetSessionDataFromContext(ctx); !ok {
+		ctx = context.WithValue(ctx, common.SessionDataKey, &commonv3.SessionData{Username: userInfo.Email})
+	}
+
+	// Groups of users of a provider with claim mapping rules are the
+	// groups the rules resolve their claims to, otherwise the idp
+	// groups are used as they are.
+	var (
+		providerName string
+		mapped       *systemv3.ClaimMappingDryRunStatus
+	)
+	if op != "DELETE" {
+		provider, err := dao.GetOIDCProviderOfUser(ctx, s.db, userUUID)
+		if err != nil && err != sql.ErrNoRows {
+			return fmt.Errorf("unable to find oidc provider of user with id %s: %s", id, err)
+		}
+		if provider != nil {
+			providerName = provider.Name
+			if rules := providerClaimMappings(provider); len(rules) > 0 {
+				var claims map[string]interface{}
+				if err := json.Unmarshal([]byte(traits), &claims); err != nil {
+					return fmt.Errorf("encountered error unmarshing payload to claims: %s", err)
+				}
+				mapped, err = s.syncClaimMappedGroups(ctx, provider, rules, claims)
+				if err != nil {
+					return err
+				}
+				userInfo.IdpGroups = mapped.Groups
+			}
+		}
+	}
 	// Early return if idpGroups is empty.
-	if len(userInfo.IdpGroups) == 0 {
+	if len(userInfo.IdpGroups) == 0 && mapped == nil {
 		return fmt.Errorf("empty idp groups for user with id %s", id)
 	}
 
@@ -1106,6 +1213,11 @@ func (s *userService) UpdateIdpUserGroupPolicy(ctx context.Context, op, id, trai
 	default:
 		return fmt.Errorf("unsupported %s operation in payload", op)
 	}
+
+	if mapped == nil {
+		mapped = &systemv3.ClaimMappingDryRunStatus{Groups: userInfo.IdpGroups}
+	}
+	IdpGroupSyncAuditEvent(ctx, s.al, userInfo.Email, op, claimMappingSyncDetail(providerName, mapped))
 	return nil
 }
 
@@ -1132,9 +1244,19 @@ func (s *userService) ForgotPassword(ctx context.Context, req *userrpcv3.UserFor
 }
 
 func (s *userService) CreateLoginAuditLog(ctx context.Context, req *userrpcv3.UserLoginAuditRequest) (*userrpcv3.UserLoginAuditResponse, error) {
-	uid, err := uuid.Parse(req.UserId)
-	if err != nil {
-		return &userrpcv3.UserLoginAuditResponse{}, fmt.Errorf("unable to create login audit event. reason: uid parse error.%v", err.Error())
+	var uid uuid.UUID
+	if req.UserId == "" && req.Username != "" {
+		entity, err := dao.GetUserIdByEmail(ctx, s.db, req.Username, &models.KratosIdentities{})
+		if err != nil {
+			return &userrpcv3.UserLoginAuditResponse{}, fmt.Errorf("unable to create login audit event. reason: user not found")
+		}
+		uid = entity.(*models.KratosIdentities).ID
+	} else {
+		var err error
+		uid, err = uuid.Parse(req.UserId)
+		if err != nil {
+			return &userrpcv3.UserLoginAuditResponse{}, fmt.Errorf("unable to create login audit event. reason: uid parse error.%v", err.Error())
+		}
 	}
 
 	entities, err := dao.GetUserNamesByIds(ctx, s.db, []uuid.UUID{uid}, &models.KratosIdentities{})
@@ -1146,6 +1268,28 @@ func (s *userService) CreateLoginAuditLog(ctx context.Context, req *userrpcv3.Us
 	}
 	username := entities[0]
 	new_ctx := context.WithValue(ctx, common.SessionDataKey, &commonv3.SessionData{Username: username})
+
+	if req.Failed {
+		FailedUserLoginAuditEvent(new_ctx, s.al, username, "invalid credentials")
+		if _, err := s.lo.RecordFailure(ctx, uid, uuid.Nil, username); err != nil {
+			return &userrpcv3.UserLoginAuditResponse{}, fmt.Errorf("unable to record failed login. reason: internal error. %v", err.Error())
+		}
+		return &userrpcv3.UserLoginAuditResponse{}, nil
+	}
+
+	// a locked account does not get to login, the error interrupts
+	// the login flow when the webhook is configured to do so
+	locked, err := s.lo.IsLocked(ctx, uid)
+	if err != nil {
+		return &userrpcv3.UserLoginAuditResponse{}, fmt.Errorf("unable to create login audit event. reason: internal error. %v", err.Error())
+	}
+	if locked {
+		FailedUserLoginAuditEvent(new_ctx, s.al, username, ErrAccountLocked.Error())
+		return &userrpcv3.UserLoginAuditResponse{}, ErrAccountLocked
+	}
+	if err := s.lo.RecordSuccess(ctx, uid); err != nil {
+		_log.Warnw("unable to reset failed login attempts", "user", username, "error", err)
+	}
 	CreateUserLoginAuditEvent(new_ctx, s.al, "login", username)
 
 	return &userrpcv3.UserLoginAuditResponse{}, nil
@@ -0,0 +1,278 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/paralus/paralus/internal/dao"
+	"github.com/paralus/paralus/internal/models"
+	userrpcv3 "github.com/paralus/paralus/proto/rpc/user"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	userv3 "github.com/paralus/paralus/proto/types/userpb/v3"
+)
+
+// getUserID returns the identity id of username
+func (s *userService) getUserID(ctx context.Context, username string) (uuid.UUID, error) {
+	entity, err := dao.GetUserIdByEmail(ctx, s.db, username, &models.KratosIdentities{})
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("no user found with username '%v'", username)
+	}
+	if usr, ok := entity.(*models.KratosIdentities); ok {
+		return usr.ID, nil
+	}
+	return uuid.Nil, fmt.Errorf("no user found with username '%v'", username)
+}
+
+// getOrganizationUserID returns the identity id of username, users of
+// other organizations than the one of the caller are out of reach
+func (s *userService) getOrganizationUserID(ctx context.Context, username string) (uuid.UUID, error) {
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok {
+		return uuid.Nil, fmt.Errorf("unable to get user without auth")
+	}
+	orgID, err := uuid.Parse(sd.Organization)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("unable to get organization")
+	}
+	uid, err := s.getUserID(ctx, username)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if _, err := dao.GetOrganizationUser(ctx, s.db, orgID, uid); err != nil {
+		return uuid.Nil, fmt.Errorf("user '%v' does not belong to the organization", username)
+	}
+	return uid, nil
+}
+
+func (s *userService) ListSessions(ctx context.Context, req *userrpcv3.UserSessionRequest) (*userv3.UserSessionList, error) {
+	sessionList := &userv3.UserSessionList{
+		ApiVersion: apiVersion,
+		Kind:       userSessionListKind,
+		Metadata: &commonv3.ListMetadata{
+			Count: 0,
+		},
+	}
+	uid, err := s.getOrganizationUserID(ctx, req.GetUsername())
+	if err != nil {
+		return sessionList, err
+	}
+	sessions, err := s.ap.ListSessions(ctx, uid.String())
+	if err != nil {
+		return sessionList, err
+	}
+
+	// last seen times are recorded by the auth middleware
+	var activities []models.SessionActivity
+	err = s.db.NewSelect().Model(&activities).
+		Where("account_id = ?", uid).
+		Scan(ctx)
+	if err != nil && err != sql.ErrNoRows {
+		return sessionList, err
+	}
+	lastSeen := make(map[string]time.Time, len(activities))
+	for _, a := range activities {
+		lastSeen[a.SessionId] = a.LastActiveAt
+	}
+
+	for _, ses := range sessions {
+		us := &userv3.UserSession{
+			Id:              ses.ID,
+			IpAddress:       ses.IPAddress,
+			UserAgent:       ses.UserAgent,
+			Location:        ses.Location,
+			CreatedAt:       timestamppb.New(ses.IssuedAt),
+			AuthenticatedAt: timestamppb.New(ses.AuthenticatedAt),
+			ExpiresAt:       timestamppb.New(ses.ExpiresAt),
+			Aal:             ses.AAL,
+			Provider:        sessionProviderKratos,
+		}
+		if t, ok := lastSeen[ses.ID]; ok {
+			us.LastSeenAt = timestamppb.New(t)
+		}
+		sessionList.Items = append(sessionList.Items, us)
+	}
+
+	samlSessions, err := dao.GetSAMLSessions(ctx, s.db, uid)
+	if err != nil {
+		return sessionList, err
+	}
+	for _, ses := range samlSessions {
+		us := &userv3.UserSession{
+			Id:              ses.ID.String(),
+			IpAddress:       ses.IpAddress,
+			UserAgent:       ses.UserAgent,
+			CreatedAt:       timestamppb.New(ses.CreatedAt),
+			AuthenticatedAt: timestamppb.New(ses.AuthenticatedAt),
+			ExpiresAt:       timestamppb.New(ses.ExpiresAt),
+			Aal:             ses.Aal,
+			Provider:        sessionProviderSAML,
+		}
+		if t, ok := lastSeen[us.Id]; ok {
+			us.LastSeenAt = timestamppb.New(t)
+		}
+		sessionList.Items = append(sessionList.Items, us)
+	}
+	sessionList.Metadata.Count = int64(len(sessionList.Items))
+	return sessionList, nil
+}
+
+func (s *userService) RevokeSession(ctx context.Context, req *userrpcv3.UserSessionRequest) error {
+	uid, err := s.getOrganizationUserID(ctx, req.GetUsername())
+	if err != nil {
+		return err
+	}
+	// only sessions of the user in the path can be revoked
+	if id, err := uuid.Parse(req.GetId()); err == nil {
+		deleted, err := dao.DeleteSAMLSession(ctx, s.db, uid, id)
+		if err != nil {
+			return err
+		}
+		if deleted {
+			RevokeUserSessionAuditEvent(ctx, s.al, req.GetUsername(), req.GetId())
+			return nil
+		}
+	}
+	sessions, err := s.ap.ListSessions(ctx, uid.String())
+	if err != nil {
+		return err
+	}
+	for _, ses := range sessions {
+		if ses.ID == req.GetId() {
+			if err := s.ap.RevokeSession(ctx, ses.ID); err != nil {
+				return err
+			}
+			RevokeUserSessionAuditEvent(ctx, s.al, req.GetUsername(), ses.ID)
+			return nil
+		}
+	}
+	return fmt.Errorf("no active session '%v' found for user '%v'", req.GetId(), req.GetUsername())
+}
+
+func (s *userService) RevokeSessions(ctx context.Context, req *userrpcv3.UserSessionRequest) error {
+	uid, err := s.getOrganizationUserID(ctx, req.GetUsername())
+	if err != nil {
+		return err
+	}
+	if _, err := dao.DeleteSAMLSessions(ctx, s.db, uid); err != nil {
+		return err
+	}
+	if err := s.ap.RevokeSessions(ctx, uid.String()); err != nil {
+		return err
+	}
+	RevokeUserSessionAuditEvent(ctx, s.al, req.GetUsername(), "")
+	return nil
+}
+
+// RevokeAccess revokes everything a user can authenticate with, to
+// offboard a user or respond to an incident without deleting them
+func (s *userService) RevokeAccess(ctx context.Context, req *userrpcv3.UserSessionRequest) (*userrpcv3.UserRevokeAccessResponse, error) {
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("unable to revoke access without auth")
+	}
+	if sd.Username == req.GetUsername() {
+		return nil, fmt.Errorf("you cannot revoke access of your own account")
+	}
+	orgID, err := uuid.Parse(sd.Organization)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get organization")
+	}
+	partnerID, err := uuid.Parse(sd.Partner)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get partner")
+	}
+	// kubeconfigs are revoked in the organization of the caller
+	uid, err := s.getOrganizationUserID(ctx, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+	sessions, err := s.ap.ListSessions(ctx, uid.String())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &userrpcv3.UserRevokeAccessResponse{}
+	err = s.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		// kubeconfigs issued before now are rejected by the relay
+		kr := &models.KubeconfigRevocation{
+			OrganizationId: orgID,
+			PartnerId:      partnerID,
+			AccountId:      uid,
+			RevokedAt:      time.Now(),
+		}
+		_, err := dao.GetKubeconfigRevocation(ctx, tx, orgID, uid, false)
+		if err == sql.ErrNoRows {
+			kr.CreatedAt = time.Now()
+			err = dao.CreateKubeconfigRevocation(ctx, tx, kr)
+		} else if err == nil {
+			err = dao.UpdateKubeconfigRevocation(ctx, tx, kr)
+		}
+		if err != nil {
+			return err
+		}
+		resp.KubeconfigsRevoked = true
+
+		res, err := tx.NewUpdate().Model((*models.ApiKey)(nil)).
+			Set("trash = ?", true).
+			Where("account_id = ?", uid).
+			Where("trash = ?", false).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		keys, _ := res.RowsAffected()
+		resp.RevokedApiKeys = int32(keys)
+
+		samlSessions, err := dao.DeleteSAMLSessions(ctx, tx, uid)
+		if err != nil {
+			return err
+		}
+
+		// sessions are revoked last, the changes above are rolled
+		// back if kratos fails
+		if err := s.ap.RevokeSessions(ctx, uid.String()); err != nil {
+			return err
+		}
+		resp.RevokedSessions = int32(len(sessions) + samlSessions)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to revoke access of user '%v'; %v", req.GetUsername(), err)
+	}
+
+	RevokeUserAccessAuditEvent(ctx, s.al, req.GetUsername(), resp)
+	return resp, nil
+}
+
+// SetActive deactivates or activates a user. Deactivated users cannot
+// log in and everything they could authenticate with is revoked.
+func (s *userService) SetActive(ctx context.Context, username string, active bool) error {
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok {
+		return fmt.Errorf("unable to update user without auth")
+	}
+	if !active && sd.Username == username {
+		return fmt.Errorf("you cannot deactivate your own account")
+	}
+	uid, err := s.getOrganizationUserID(ctx, username)
+	if err != nil {
+		return err
+	}
+	if err := s.ap.SetActive(ctx, uid.String(), active); err != nil {
+		return fmt.Errorf("unable to update user '%v'; %v", username, err)
+	}
+	if !active {
+		if _, err := s.RevokeAccess(ctx, &userrpcv3.UserSessionRequest{Username: username}); err != nil {
+			return err
+		}
+	}
+
+	SetUserActiveAuditEvent(ctx, s.al, username, active)
+	return nil
+}
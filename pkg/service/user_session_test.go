@@ -0,0 +1,299 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	providers "github.com/paralus/paralus/internal/provider/kratos"
+	"github.com/paralus/paralus/pkg/common"
+	userrpcv3 "github.com/paralus/paralus/proto/rpc/user"
+	v3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+)
+
+func addUserIdFetchExpectation(mock sqlmock.Sqlmock, uuuid string) {
+	mock.ExpectQuery(`SELECT "identities"."id" FROM "identities" WHERE .*traits ->> 'email' = 'user-` + uuuid + `'`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuuid))
+}
+
+func TestUserListSessions(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	uuuid := uuid.New().String()
+	ouuid := uuid.New().String()
+	ap := &mockAuthProvider{s: map[string][]providers.Session{
+		uuuid: {
+			{ID: "session-1", IPAddress: "10.0.0.1", UserAgent: "firefox", AAL: "aal1", IssuedAt: time.Now().Add(-time.Hour)},
+			{ID: "session-2", IPAddress: "10.0.0.2", UserAgent: "curl", AAL: "aal2"},
+		},
+	}}
+	us := NewUserService(ap, db, &mockAuthzClient{}, nil, common.CliConfigDownloadData{}, getLogger(), true)
+
+	lastSeen := time.Now().Add(-time.Minute)
+	addUserIdFetchExpectation(mock, uuuid)
+	addOrganizationUserFetchExpectation(mock, ouuid, uuuid)
+	mock.ExpectQuery(`SELECT "sessionactivity"."session_id", .* FROM "authsrv_session_activity" AS "sessionactivity" WHERE .account_id = '` + uuuid + `'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"session_id", "account_id", "last_active_at"}).AddRow("session-1", uuuid, lastSeen))
+	mock.ExpectQuery(`SELECT .* FROM "authsrv_saml_session" AS "samlsession" WHERE .account_id = '` + uuuid + `'. AND .expires_at > .*`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "account_id", "ip_address", "aal"}).AddRow(uuid.New().String(), uuuid, "10.0.0.3", "aal2"))
+
+	ctx := context.WithValue(context.Background(), common.SessionDataKey, &v3.SessionData{Username: "admin", Organization: ouuid})
+	sessions, err := us.ListSessions(ctx, &userrpcv3.UserSessionRequest{Username: "user-" + uuuid})
+	if err != nil {
+		t.Fatal("could not list sessions:", err)
+	}
+	if sessions.Metadata.Count != 3 || len(sessions.Items) != 3 {
+		t.Fatalf("expected 3 sessions; got %v", sessions.Items)
+	}
+	if sessions.Items[0].IpAddress != "10.0.0.1" || sessions.Items[0].Provider != "kratos" || sessions.Items[0].LastSeenAt.AsTime().Unix() != lastSeen.Unix() {
+		t.Errorf("unexpected session %v", sessions.Items[0])
+	}
+	if sessions.Items[1].LastSeenAt != nil || sessions.Items[1].Aal != "aal2" {
+		t.Errorf("unexpected session %v", sessions.Items[1])
+	}
+	if sessions.Items[2].IpAddress != "10.0.0.3" || sessions.Items[2].Provider != "saml" {
+		t.Errorf("unexpected saml session %v", sessions.Items[2])
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUserRevokeSession(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	uuuid := uuid.New().String()
+	ouuid := uuid.New().String()
+	ap := &mockAuthProvider{s: map[string][]providers.Session{uuuid: {{ID: "session-1"}}}}
+	us := NewUserService(ap, db, &mockAuthzClient{}, nil, common.CliConfigDownloadData{}, getLogger(), true)
+	ctx := context.WithValue(context.Background(), common.SessionDataKey, &v3.SessionData{Username: "admin", Organization: ouuid})
+
+	addUserIdFetchExpectation(mock, uuuid)
+	addOrganizationUserFetchExpectation(mock, ouuid, uuuid)
+	err := us.RevokeSession(ctx, &userrpcv3.UserSessionRequest{Username: "user-" + uuuid, Id: "session-1"})
+	if err != nil {
+		t.Fatal("could not revoke session:", err)
+	}
+	if len(ap.rs) != 1 || ap.rs[0] != "session-1" {
+		t.Errorf("expected session-1 revoked; got %v", ap.rs)
+	}
+
+	// sessions of other users can not be revoked through the user
+	addUserIdFetchExpectation(mock, uuuid)
+	addOrganizationUserFetchExpectation(mock, ouuid, uuuid)
+	err = us.RevokeSession(ctx, &userrpcv3.UserSessionRequest{Username: "user-" + uuuid, Id: "session-2"})
+	if err == nil {
+		t.Error("revoked session not belonging to user")
+	}
+
+	// sessions of SAML logins are kept by paralus
+	suuid := uuid.New().String()
+	addUserIdFetchExpectation(mock, uuuid)
+	addOrganizationUserFetchExpectation(mock, ouuid, uuuid)
+	mock.ExpectExec(`DELETE FROM "authsrv_saml_session" AS "samlsession" WHERE .account_id = '` + uuuid + `'. AND .id = '` + suuid + `'.`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	err = us.RevokeSession(ctx, &userrpcv3.UserSessionRequest{Username: "user-" + uuuid, Id: suuid})
+	if err != nil {
+		t.Fatal("could not revoke saml session:", err)
+	}
+	if len(ap.rs) != 1 {
+		t.Errorf("expected no kratos session revoked; got %v", ap.rs)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUserRevokeAccess(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	uuuid := uuid.New().String()
+	puuid := uuid.New().String()
+	ouuid := uuid.New().String()
+	ap := &mockAuthProvider{s: map[string][]providers.Session{uuuid: {{ID: "session-1"}, {ID: "session-2"}}}}
+	us := NewUserService(ap, db, &mockAuthzClient{}, nil, common.CliConfigDownloadData{}, getLogger(), true)
+
+	addUserIdFetchExpectation(mock, uuuid)
+	addOrganizationUserFetchExpectation(mock, ouuid, uuuid)
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT .* FROM "sentry_kubeconfig_revocation" AS "kr" WHERE .*organization_id = '` + ouuid + `'.*account_id = '` + uuuid + `'`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery(`INSERT INTO "sentry_kubeconfig_revocation" .* RETURNING "id"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.New().String()))
+	mock.ExpectExec(`UPDATE "authsrv_apikey" AS "apikey" SET trash = TRUE WHERE .account_id = '` + uuuid + `'. AND .trash = FALSE.`).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec(`DELETE FROM "authsrv_saml_session" AS "samlsession" WHERE .account_id = '` + uuuid + `'. AND .expires_at > .*`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	ctx := context.WithValue(context.Background(), common.SessionDataKey, &v3.SessionData{Username: "admin", Partner: puuid, Organization: ouuid})
+	resp, err := us.RevokeAccess(ctx, &userrpcv3.UserSessionRequest{Username: "user-" + uuuid})
+	if err != nil {
+		t.Fatal("could not revoke access:", err)
+	}
+	if resp.RevokedSessions != 3 || resp.RevokedApiKeys != 3 || !resp.KubeconfigsRevoked {
+		t.Errorf("unexpected response %v", resp)
+	}
+	if len(ap.rs) != 2 {
+		t.Errorf("expected 2 sessions revoked; got %v", ap.rs)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func addOrganizationUserFetchExpectation(mock sqlmock.Sqlmock, ouuid, uuuid string) {
+	mock.ExpectQuery(`SELECT .* FROM "identities" WHERE .id = '` + uuuid + `'. AND .metadata_public ->> 'Organization' = '` + ouuid + `'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuuid))
+}
+
+func TestUserRevokeAccessOtherOrganization(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	uuuid := uuid.New().String()
+	ouuid := uuid.New().String()
+	ap := &mockAuthProvider{s: map[string][]providers.Session{uuuid: {{ID: "session-1"}}}}
+	us := NewUserService(ap, db, &mockAuthzClient{}, nil, common.CliConfigDownloadData{}, getLogger(), true)
+
+	addUserIdFetchExpectation(mock, uuuid)
+	mock.ExpectQuery(`SELECT .* FROM "identities" WHERE .id = '` + uuuid + `'. AND .metadata_public ->> 'Organization' = '` + ouuid + `'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	ctx := context.WithValue(context.Background(), common.SessionDataKey, &v3.SessionData{Username: "admin", Partner: uuid.New().String(), Organization: ouuid})
+	if _, err := us.RevokeAccess(ctx, &userrpcv3.UserSessionRequest{Username: "user-" + uuuid}); err == nil {
+		t.Error("revoked access of user of another organization")
+	}
+	if len(ap.rs) != 0 {
+		t.Errorf("expected no sessions revoked; got %v", ap.rs)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUserRevokeAccessSelf(t *testing.T) {
+	db, _ := getDB(t)
+	defer db.Close()
+
+	us := NewUserService(&mockAuthProvider{}, db, &mockAuthzClient{}, nil, common.CliConfigDownloadData{}, getLogger(), true)
+	ctx := context.WithValue(context.Background(), common.SessionDataKey, &v3.SessionData{Username: "user-1"})
+	if _, err := us.RevokeAccess(ctx, &userrpcv3.UserSessionRequest{Username: "user-1"}); err == nil {
+		t.Error("user able to revoke access of their own account")
+	}
+}
+
+func TestUserSetActive(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	uuuid := uuid.New().String()
+	puuid := uuid.New().String()
+	ouuid := uuid.New().String()
+	ap := &mockAuthProvider{s: map[string][]providers.Session{uuuid: {{ID: "session-1"}}}}
+	us := NewUserService(ap, db, &mockAuthzClient{}, nil, common.CliConfigDownloadData{}, getLogger(), true)
+
+	// deactivation revokes the access of the user
+	addUserIdFetchExpectation(mock, uuuid)
+	addOrganizationUserFetchExpectation(mock, ouuid, uuuid)
+	addUserIdFetchExpectation(mock, uuuid)
+	addOrganizationUserFetchExpectation(mock, ouuid, uuuid)
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT .* FROM "sentry_kubeconfig_revocation" AS "kr" WHERE .*organization_id = '` + ouuid + `'.*account_id = '` + uuuid + `'`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery(`INSERT INTO "sentry_kubeconfig_revocation" .* RETURNING "id"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.New().String()))
+	mock.ExpectExec(`UPDATE "authsrv_apikey" AS "apikey" SET trash = TRUE WHERE .account_id = '` + uuuid + `'. AND .trash = FALSE.`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM "authsrv_saml_session" AS "samlsession" WHERE .account_id = '` + uuuid + `'. AND .expires_at > .*`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	ctx := context.WithValue(context.Background(), common.SessionDataKey, &v3.SessionData{Username: "admin", Partner: puuid, Organization: ouuid})
+	if err := us.SetActive(ctx, "user-"+uuuid, false); err != nil {
+		t.Fatal("could not deactivate user:", err)
+	}
+	if active, ok := ap.a[uuuid]; !ok || active {
+		t.Errorf("user not deactivated in auth provider; got %v", ap.a)
+	}
+	if len(ap.rs) != 1 {
+		t.Errorf("expected 1 session revoked; got %v", ap.rs)
+	}
+
+	// activation does not touch credentials
+	addUserIdFetchExpectation(mock, uuuid)
+	addOrganizationUserFetchExpectation(mock, ouuid, uuuid)
+	if err := us.SetActive(ctx, "user-"+uuuid, true); err != nil {
+		t.Fatal("could not activate user:", err)
+	}
+	if !ap.a[uuuid] {
+		t.Errorf("user not activated in auth provider; got %v", ap.a)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUserSetActiveSelf(t *testing.T) {
+	db, _ := getDB(t)
+	defer db.Close()
+
+	us := NewUserService(&mockAuthProvider{}, db, &mockAuthzClient{}, nil, common.CliConfigDownloadData{}, getLogger(), true)
+	ctx := context.WithValue(context.Background(), common.SessionDataKey, &v3.SessionData{Username: "user-1"})
+	if err := us.SetActive(ctx, "user-1", false); err == nil {
+		t.Error("user able to deactivate their own account")
+	}
+}
+
+func TestUserSessionsOtherOrganization(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	uuuid := uuid.New().String()
+	ouuid := uuid.New().String()
+	ap := &mockAuthProvider{s: map[string][]providers.Session{uuuid: {{ID: "session-1"}}}}
+	us := NewUserService(ap, db, &mockAuthzClient{}, nil, common.CliConfigDownloadData{}, getLogger(), true)
+	ctx := context.WithValue(context.Background(), common.SessionDataKey, &v3.SessionData{Username: "admin", Organization: ouuid})
+
+	// users of other organizations are neither listed, revoked nor
+	// deactivated in kratos
+	tt := []struct {
+		name string
+		call func() error
+	}{
+		{"list sessions", func() error {
+			_, err := us.ListSessions(ctx, &userrpcv3.UserSessionRequest{Username: "user-" + uuuid})
+			return err
+		}},
+		{"revoke session", func() error {
+			return us.RevokeSession(ctx, &userrpcv3.UserSessionRequest{Username: "user-" + uuuid, Id: "session-1"})
+		}},
+		{"revoke sessions", func() error {
+			return us.RevokeSessions(ctx, &userrpcv3.UserSessionRequest{Username: "user-" + uuuid})
+		}},
+		{"deactivate", func() error {
+			return us.SetActive(ctx, "user-"+uuuid, false)
+		}},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			addUserIdFetchExpectation(mock, uuuid)
+			mock.ExpectQuery(`SELECT .* FROM "identities" WHERE .id = '` + uuuid + `'. AND .metadata_public ->> 'Organization' = '` + ouuid + `'.`).
+				WillReturnRows(sqlmock.NewRows([]string{"id"}))
+			if err := tc.call(); err == nil {
+				t.Error("reached user of another organization")
+			}
+			if len(ap.rs) != 0 || len(ap.a) != 0 {
+				t.Errorf("expected kratos untouched; got revoked %v, active %v", ap.rs, ap.a)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -8,41 +8,76 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
-	"os"
 	"strings"
 
 	"github.com/crewjam/saml"
 	"github.com/crewjam/saml/samlsp"
+	"github.com/google/uuid"
 	"github.com/paralus/paralus/internal/dao"
 	"github.com/paralus/paralus/internal/models"
+	"github.com/paralus/paralus/pkg/service"
 )
 
-func newSAMLMiddlewareFromIDP(idp models.Idp) (*SAMLMiddleware, error) {
-	rootURL, err := url.Parse(os.Getenv("APP_HOST_HTTP"))
+// getIdp returns the idp with id
+func (s *SAMLService) getIdp(ctx context.Context, id string) (*models.Idp, error) {
+	idpID, err := uuid.Parse(id)
 	if err != nil {
 		return nil, err
 	}
+	idp := &models.Idp{}
+	if _, err := dao.GetByID(ctx, s.db, idpID, idp); err != nil {
+		return nil, err
+	}
+	return idp, nil
+}
 
-	var idpMetadata *saml.EntityDescriptor
-	if len(idp.Metadata) == 0 {
-		idpMetadataURL, err := url.Parse(idp.MetadataURL)
-		if err != nil {
-			return nil, err
-		}
-		idpMetadata, err = samlsp.FetchMetadata(context.Background(), http.DefaultClient,
-			*idpMetadataURL)
-	} else {
-		idpMetadata, err = samlsp.ParseMetadata(idp.Metadata)
-		if err != nil {
-			return nil, err
-		}
+// getIdpByDomain returns the idp users of the email domain log in with
+func (s *SAMLService) getIdpByDomain(ctx context.Context, domain string) (*models.Idp, error) {
+	idp := &models.Idp{}
+	if _, err := dao.GetX(ctx, s.db, "domain", domain, idp); err != nil {
+		return nil, err
+	}
+	return idp, nil
+}
+
+// idpMetadata returns the metadata uploaded for the idp or fetches it
+// from its metadata url
+func idpMetadata(ctx context.Context, idp *models.Idp) (*saml.EntityDescriptor, error) {
+	if len(idp.Metadata) > 0 {
+		return samlsp.ParseMetadata(idp.Metadata)
+	}
+	if idp.MetadataURL == "" {
+		return nil, fmt.Errorf("idp %s has no metadata", idp.Name)
+	}
+	idpMetadataURL, err := url.Parse(idp.MetadataURL)
+	if err != nil {
+		return nil, err
 	}
+	return samlsp.FetchMetadata(ctx, http.DefaultClient, *idpMetadataURL)
+}
 
-	acsURL, err := url.Parse(fmt.Sprintf("%s://%s/auth/v3/sso/acs/%s", rootURL.Scheme, rootURL.Host, idp.Id))
+func (s *SAMLService) newSAMLMiddlewareFromIDP(ctx context.Context, idp *models.Idp) (*SAMLMiddleware, error) {
+	rootURL, err := url.Parse(s.host)
+	if err != nil {
+		return nil, err
+	}
+	metadata, err := idpMetadata(ctx, idp)
 	if err != nil {
 		return nil, err
 	}
 
+	acsURL, err := url.Parse(service.GenerateAcsURL(idp.Id.String(), s.host))
+	if err != nil {
+		return nil, err
+	}
+	metadataURL, err := url.Parse(fmt.Sprintf("%s/auth/v3/sso/metadata/%s", rootURL.String(), idp.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	if idp.SpCert == "" || idp.SpKey == "" {
+		return nil, fmt.Errorf("idp %s has no service provider certificate, update the idp to generate one", idp.Name)
+	}
 	keyPair, err := tls.X509KeyPair([]byte(idp.SpCert), []byte(idp.SpKey))
 	if err != nil {
 		return nil, err
@@ -52,33 +87,92 @@ func newSAMLMiddlewareFromIDP(idp models.Idp) (*SAMLMiddleware, error) {
 		return nil, err
 	}
 
+	// cookies of pending requests have to be sent along with the
+	// cross site post of the idp
+	sameSite := http.SameSiteLaxMode
+	if rootURL.Scheme == "https" {
+		sameSite = http.SameSiteNoneMode
+	}
 	opts := samlsp.Options{
-		EntityID:           "",
+		EntityID:           acsURL.String(),
 		URL:                *rootURL,
 		Key:                keyPair.PrivateKey.(*rsa.PrivateKey),
 		Certificate:        keyPair.Leaf,
-		AllowIDPInitiated:  false,
-		DefaultRedirectURI: "/prelogin",
-		IDPMetadata:        idpMetadata,
+		AllowIDPInitiated:  true,
+		DefaultRedirectURI: defaultRedirectURI,
+		IDPMetadata:        metadata,
 		SignRequest:        false,
+		CookieSameSite:     sameSite,
 	}
 	sp := samlsp.DefaultServiceProvider(opts)
 	sp.AcsURL = *acsURL
+	sp.MetadataURL = *metadataURL
 	m := &samlsp.Middleware{
 		ServiceProvider: sp,
 		Binding:         "",
 		ResponseBinding: saml.HTTPPostBinding,
 		OnError:         samlsp.DefaultOnError,
-		Session:         samlsp.DefaultSessionProvider(opts),
+		Session:         &sessionProvider{s: s, idp: idp, secure: rootURL.Scheme == "https"},
 	}
 	m.RequestTracker = samlsp.DefaultRequestTracker(opts, &m.ServiceProvider)
-	if opts.UseArtifactResponse {
-		m.ResponseBinding = saml.HTTPArtifactBinding
-	}
 	return &SAMLMiddleware{m}, nil
 }
 
-// SAMLAuth is an authentication middleware.
+// isLocalPath checks if uri is a path on the paralus host, only those
+// are followed after a login
+func isLocalPath(uri string) bool {
+	return strings.HasPrefix(uri, "/") && !strings.HasPrefix(uri, "//") && !strings.HasPrefix(uri, "/\\")
+}
+
+// startAuthFlow redirects to the idp, returnTo is where the user is
+// sent after the login
+func (m *SAMLMiddleware) startAuthFlow(w http.ResponseWriter, r *http.Request, returnTo string) {
+	if !isLocalPath(returnTo) {
+		returnTo = defaultRedirectURI
+	}
+	// the request tracker remembers the url of the request
+	r = r.Clone(r.Context())
+	r.URL = &url.URL{Path: returnTo}
+	if i := strings.Index(returnTo, "?"); i >= 0 {
+		r.URL = &url.URL{Path: returnTo[:i], RawQuery: returnTo[i+1:]}
+	}
+	m.HandleStartAuthFlow(w, r)
+}
+
+// ServeMetadata serves the service provider metadata of an idp
+func (s *SAMLService) ServeMetadata(w http.ResponseWriter, r *http.Request) {
+	idp, err := s.getIdp(r.Context(), r.PathValue("id"))
+	if err != nil {
+		http.Error(w, "No idp found", http.StatusNotFound)
+		return
+	}
+	m, err := s.newSAMLMiddlewareFromIDP(r.Context(), idp)
+	if err != nil {
+		_log.Errorw("unable to create saml service provider", "idp", idp.Name, "error", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	m.ServeMetadata(w, r)
+}
+
+// ServeLogin starts a SP-initiated login with an idp
+func (s *SAMLService) ServeLogin(w http.ResponseWriter, r *http.Request) {
+	idp, err := s.getIdp(r.Context(), r.PathValue("id"))
+	if err != nil {
+		http.Error(w, "No idp found", http.StatusNotFound)
+		return
+	}
+	m, err := s.newSAMLMiddlewareFromIDP(r.Context(), idp)
+	if err != nil {
+		_log.Errorw("unable to create saml service provider", "idp", idp.Name, "error", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	m.startAuthFlow(w, r, r.URL.Query().Get("return_to"))
+}
+
+// SAMLAuth is an authentication middleware. Users without a SAML
+// session are sent to the idp of the domain of their username.
 func (s *SAMLService) SAMLAuth(handler http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		err := r.ParseForm()
@@ -94,19 +188,15 @@ func (s *SAMLService) SAMLAuth(handler http.Handler) http.Handler {
 		}
 		domain := strings.SplitN(username, "@", 2)[1]
 
-		entity, err := dao.GetX(context.Background(), s.db, "domain", domain, &models.Idp{})
+		idp, err := s.getIdpByDomain(r.Context(), domain)
 		if err != nil {
-			http.Error(w, "No idp found for domain", http.StatusInternalServerError)
-			return
-		}
-		idp, ok := entity.(models.Idp)
-		if !ok {
-			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			http.Error(w, "No idp found for domain", http.StatusNotFound)
 			return
 		}
 
-		m, err := newSAMLMiddlewareFromIDP(idp)
+		m, err := s.newSAMLMiddlewareFromIDP(r.Context(), idp)
 		if err != nil {
+			_log.Errorw("unable to create saml service provider", "idp", idp.Name, "error", err)
 			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
 			return
 		}
@@ -114,56 +204,86 @@ func (s *SAMLService) SAMLAuth(handler http.Handler) http.Handler {
 		session, err := m.Session.GetSession(r)
 		if session != nil {
 			r = r.WithContext(samlsp.ContextWithSession(r.Context(), session))
-			w.Write([]byte("authentiated successfully"))
+			handler.ServeHTTP(w, r)
 			return
 		}
 		if err == samlsp.ErrNoSession {
-			m.HandleStartAuthFlow(w, r)
-			return
-		} else {
-			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			m.startAuthFlow(w, r, r.PostForm.Get("return_to"))
 			return
 		}
+		_log.Errorw("unable to get saml session", "error", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
 	})
 }
 
-// ServeACS performs SAML Response assertions.
+// ServeACS performs SAML Response assertions. Responses to requests
+// of SP-initiated logins and unsolicited responses of IdP-initiated
+// logins are accepted.
 func (s *SAMLService) ServeACS(w http.ResponseWriter, r *http.Request) {
-	r.ParseForm()
-	base, _ := url.Parse(os.Getenv("APP_HOST_HTTP"))
-	acsURL := base.ResolveReference(r.URL)
-
-	entity, err := dao.GetX(context.Background(), s.db, "acs_url", acsURL.String(), &models.Idp{})
-	if err != nil {
-		http.Error(w, "No Idp for ACS URL", http.StatusInternalServerError)
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "failed to parse form data", http.StatusBadRequest)
 		return
 	}
-	idp, ok := entity.(models.Idp)
-	if !ok {
-		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+	idp, err := s.getIdp(r.Context(), r.PathValue("id"))
+	if err != nil {
+		http.Error(w, "No Idp for ACS URL", http.StatusNotFound)
 		return
 	}
 
-	m, err := newSAMLMiddlewareFromIDP(idp)
+	m, err := s.newSAMLMiddlewareFromIDP(r.Context(), idp)
 	if err != nil {
+		_log.Errorw("unable to create saml service provider", "idp", idp.Name, "error", err)
 		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
 		return
 	}
 
-	possibleRequestIDs := []string{}
-	if m.ServiceProvider.AllowIDPInitiated {
-		possibleRequestIDs = append(possibleRequestIDs, "")
+	possibleRequestIDs := []string{""}
+	redirectURI := defaultRedirectURI
+	relayState := r.Form.Get("RelayState")
+	var trackedRequest *samlsp.TrackedRequest
+	if relayState != "" {
+		if tr, err := m.RequestTracker.GetTrackedRequest(r, relayState); err == nil {
+			trackedRequest = tr
+			possibleRequestIDs = append(possibleRequestIDs, tr.SAMLRequestID)
+		} else if isLocalPath(relayState) {
+			// relay state of IdP-initiated logins
+			redirectURI = relayState
+		}
 	}
 
-	trackedRequests := m.RequestTracker.GetTrackedRequests(r)
-	for _, tr := range trackedRequests {
-		possibleRequestIDs = append(possibleRequestIDs, tr.SAMLRequestID)
-	}
 	assertion, err := m.ServiceProvider.ParseResponse(r, possibleRequestIDs)
 	if err != nil {
+		reason := err.Error()
+		if ire, ok := err.(*saml.InvalidResponseError); ok {
+			reason = ire.PrivateErr.Error()
+		}
+		service.FailedSAMLLoginAuditEvent(s.al, clientSessionData(r), "", idp.Name, reason)
 		m.OnError(w, r, err)
 		return
 	}
-	m.CreateSessionFromAssertion(w, r, assertion, m.ServiceProvider.DefaultRedirectURI)
-	return
+	if trackedRequest != nil {
+		if err := m.RequestTracker.StopTrackingRequest(w, r, relayState); err != nil {
+			m.OnError(w, r, err)
+			return
+		}
+		redirectURI = trackedRequest.URI
+	}
+
+	if err := m.Session.CreateSession(w, r, assertion); err != nil {
+		_log.Infow("refusing saml login", "idp", idp.Name, "error", err)
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+	http.Redirect(w, r, redirectURI, http.StatusFound)
+}
+
+// ServeLogout ends the SAML session of the request
+func (s *SAMLService) ServeLogout(w http.ResponseWriter, r *http.Request) {
+	p := &sessionProvider{s: s, secure: strings.HasPrefix(s.host, "https://")}
+	if err := p.DeleteSession(w, r); err != nil {
+		_log.Errorw("unable to delete saml session", "error", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
 }
@@ -1,8 +1,29 @@
 package saml
 
 import (
+	"net/http"
+	"strings"
+	"time"
+
 	"github.com/crewjam/saml/samlsp"
+	providers "github.com/paralus/paralus/internal/provider/kratos"
+	"github.com/paralus/paralus/pkg/log"
 	"github.com/uptrace/bun"
+	"go.uber.org/zap"
+)
+
+var _log = log.GetLogger()
+
+const (
+	// SessionCookieName is the cookie holding the token of a SAML
+	// login session
+	SessionCookieName = "paralus_saml_session"
+	// sessionLifespan is how long a SAML login session lasts unless
+	// the assertion ends it earlier
+	sessionLifespan = 24 * time.Hour
+	// defaultRedirectURI is where users land after a login which was
+	// not started from a page of the console
+	defaultRedirectURI = "/"
 )
 
 type SAMLMiddleware struct {
@@ -10,11 +31,34 @@ type SAMLMiddleware struct {
 }
 
 type SAMLService struct {
-	db *bun.DB
+	db   *bun.DB
+	host string
+	ap   providers.AuthProvider
+	al   *zap.Logger
 }
 
-func NewSAMLService(db *bun.DB) *SAMLService {
+// NewSAMLService returns the SAML service provider of paralus. host is
+// the url the ACS urls of the idps are generated for.
+func NewSAMLService(db *bun.DB, host string, ap providers.AuthProvider, al *zap.Logger) *SAMLService {
+	if !strings.Contains(host, "://") {
+		host = "http://" + host
+	}
 	return &SAMLService{
-		db: db,
+		db:   db,
+		host: host,
+		ap:   ap,
+		al:   al,
 	}
 }
+
+// Handler returns the handler of the SAML endpoints, it is mounted on
+// the API server next to the gateway
+func (s *SAMLService) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /auth/v3/sso/metadata/{id}", s.ServeMetadata)
+	mux.HandleFunc("GET /auth/v3/sso/login/{id}", s.ServeLogin)
+	mux.Handle("POST /auth/v3/sso/login", s.SAMLAuth(http.RedirectHandler(defaultRedirectURI, http.StatusFound)))
+	mux.HandleFunc("POST /auth/v3/sso/acs/{id}", s.ServeACS)
+	mux.HandleFunc("POST /auth/v3/sso/logout", s.ServeLogout)
+	return mux
+}
@@ -1 +1,315 @@
 package saml
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"encoding/xml"
+	"html"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/crewjam/saml"
+	"github.com/crewjam/saml/logger"
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/models"
+	providers "github.com/paralus/paralus/internal/provider/kratos"
+	"github.com/paralus/paralus/pkg/service"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"go.uber.org/zap"
+)
+
+const testHost = "https://paralus.local"
+
+func getDB(t *testing.T) (*bun.DB, sqlmock.Sqlmock) {
+	sqldb, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	if err != nil {
+		t.Fatal("unable to create sqlmock:", err)
+	}
+	return bun.NewDB(sqldb, pgdialect.New()), mock
+}
+
+func newKeyPair(t *testing.T, cn string) (*rsa.PrivateKey, *x509.Certificate) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key, cert
+}
+
+// fakeAuthProvider records the identities provisioned by logins
+type fakeAuthProvider struct {
+	providers.AuthProvider
+	created []map[string]interface{}
+	meta    []providers.IdentityPublicMetadata
+}
+
+func (p *fakeAuthProvider) Create(ctx context.Context, password string, traits map[string]interface{}, metadata providers.IdentityPublicMetadata) (string, error) {
+	p.created = append(p.created, traits)
+	p.meta = append(p.meta, metadata)
+	return uuid.New().String(), nil
+}
+
+// localIdp is a stand-in idp which asserts a fixed user
+type localIdp struct {
+	saml.IdentityProvider
+	sp   *saml.EntityDescriptor
+	user *saml.Session
+}
+
+func (l *localIdp) GetServiceProvider(r *http.Request, serviceProviderID string) (*saml.EntityDescriptor, error) {
+	if l.sp == nil || serviceProviderID != l.sp.EntityID {
+		return nil, os.ErrNotExist
+	}
+	return l.sp, nil
+}
+
+func (l *localIdp) GetSession(w http.ResponseWriter, r *http.Request, req *saml.IdpAuthnRequest) *saml.Session {
+	return l.user
+}
+
+type testSetup struct {
+	s    *SAMLService
+	ap   *fakeAuthProvider
+	mock sqlmock.Sqlmock
+	idp  *models.Idp
+	lidp *localIdp
+}
+
+func newTestSetup(t *testing.T) *testSetup {
+	db, mock := getDB(t)
+	t.Cleanup(func() { db.Close() })
+
+	idpKey, idpCert := newKeyPair(t, "idp.example.com")
+	lidp := &localIdp{
+		user: &saml.Session{
+			ID:            "idp-session",
+			CreateTime:    time.Now(),
+			ExpireTime:    time.Now().Add(time.Hour),
+			NameID:        "jane@example.com",
+			NameIDFormat:  string(saml.EmailAddressNameIDFormat),
+			UserGivenName: "Jane",
+			UserSurname:   "Doe",
+			Groups:        []string{"developers", "admins"},
+		},
+	}
+	lidp.IdentityProvider = saml.IdentityProvider{
+		Key:                     idpKey,
+		Certificate:             idpCert,
+		Logger:                  logger.DefaultLogger,
+		MetadataURL:             url.URL{Scheme: "https", Host: "idp.example.com", Path: "/metadata"},
+		SSOURL:                  url.URL{Scheme: "https", Host: "idp.example.com", Path: "/sso"},
+		ServiceProviderProvider: lidp,
+		SessionProvider:         lidp,
+	}
+	idpMetadata, err := xml.Marshal(lidp.Metadata())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	spKey, spCert := newKeyPair(t, "paralus.local")
+	idp := &models.Idp{
+		Id:                 uuid.New(),
+		Name:               "example",
+		Domain:             "example.com",
+		OrganizationId:     uuid.New(),
+		PartnerId:          uuid.New(),
+		SpCert:             string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: spCert.Raw})),
+		SpKey:              string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(spKey)})),
+		Metadata:           idpMetadata,
+		GroupAttributeName: "eduPersonAffiliation",
+	}
+
+	ap := &fakeAuthProvider{}
+	s := NewSAMLService(db, testHost, ap, zap.NewNop())
+	m, err := s.newSAMLMiddlewareFromIDP(context.Background(), idp)
+	if err != nil {
+		t.Fatal("unable to create service provider:", err)
+	}
+	lidp.sp = m.ServiceProvider.Metadata()
+	return &testSetup{s: s, ap: ap, mock: mock, idp: idp, lidp: lidp}
+}
+
+func (ts *testSetup) expectIdp() {
+	ts.mock.ExpectQuery(`SELECT .* FROM "authsrv_idp" AS "idp" WHERE .id = '` + ts.idp.Id.String() + `'. AND .trash = FALSE.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "domain", "organization_id", "partner_id", "sp_cert", "sp_key", "metadata", "group_attribute_name"}).
+			AddRow(ts.idp.Id.String(), ts.idp.Name, ts.idp.Domain, ts.idp.OrganizationId.String(), ts.idp.PartnerId.String(), ts.idp.SpCert, ts.idp.SpKey, ts.idp.Metadata, ts.idp.GroupAttributeName))
+}
+
+var samlResponseRe = regexp.MustCompile(`name="SAMLResponse" value="([^"]+)"`)
+
+// idpInitiatedResponse returns the SAML response the idp posts to the
+// ACS url on an IdP-initiated login
+func (ts *testSetup) idpInitiatedResponse(t *testing.T) string {
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "https://idp.example.com/login", nil)
+	ts.lidp.ServeIDPInitiated(w, r, ts.lidp.sp.EntityID, "")
+	match := samlResponseRe.FindStringSubmatch(w.Body.String())
+	if match == nil {
+		t.Fatalf("idp did not respond with a SAML response: %d %s", w.Code, w.Body.String())
+	}
+	return html.UnescapeString(match[1])
+}
+
+func (ts *testSetup) postACS(samlResponse, relayState string) *httptest.ResponseRecorder {
+	form := url.Values{"SAMLResponse": {samlResponse}, "RelayState": {relayState}}
+	r := httptest.NewRequest(http.MethodPost, testHost+"/auth/v3/sso/acs/"+ts.idp.Id.String(), strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	r.RemoteAddr = "10.0.0.1:4321"
+	// a forwarded address set by the client is not trusted
+	r.Header.Set("X-Forwarded-For", "203.0.113.7")
+	w := httptest.NewRecorder()
+	ts.s.Handler().ServeHTTP(w, r)
+	return w
+}
+
+func TestIdpInitiatedLogin(t *testing.T) {
+	ts := newTestSetup(t)
+	samlResponse := ts.idpInitiatedResponse(t)
+
+	ts.expectIdp()
+	ts.mock.ExpectQuery(`SELECT .* FROM "identities" WHERE .traits ->> 'email' = 'jane@example.com'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	ts.mock.ExpectExec(`DELETE FROM "authsrv_saml_session" AS "samlsession" WHERE .expires_at < .*`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	ts.mock.ExpectQuery(`INSERT INTO "authsrv_saml_session" .*'jane@example.com', '` + ts.idp.Id.String() + `'.*'10.0.0.1'.* RETURNING`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(uuid.New().String(), time.Now()))
+
+	w := ts.postACS(samlResponse, "/clusters")
+	if w.Code != http.StatusFound || w.Header().Get("Location") != "/clusters" {
+		t.Fatalf("expected redirect to /clusters; got %d %s %s", w.Code, w.Header().Get("Location"), w.Body.String())
+	}
+	var cookie *http.Cookie
+	for _, c := range w.Result().Cookies() {
+		if c.Name == SessionCookieName {
+			cookie = c
+		}
+	}
+	if cookie == nil || cookie.Value == "" || !cookie.HttpOnly || !cookie.Secure {
+		t.Errorf("expected secure session cookie; got %v", cookie)
+	}
+
+	if len(ts.ap.created) != 1 {
+		t.Fatalf("expected user to be provisioned; got %v", ts.ap.created)
+	}
+	traits := ts.ap.created[0]
+	if traits["email"] != "jane@example.com" || traits["first_name"] != "Jane" || traits["last_name"] != "Doe" {
+		t.Errorf("unexpected traits %v", traits)
+	}
+	if groups, _ := traits["idp_groups"].([]string); len(groups) != 2 || groups[0] != "developers" || groups[1] != "admins" {
+		t.Errorf("expected idp groups to be mapped; got %v", traits["idp_groups"])
+	}
+	if ts.ap.meta[0].Organization != ts.idp.OrganizationId.String() || ts.ap.meta[0].Partner != ts.idp.PartnerId.String() {
+		t.Errorf("user provisioned outside of the organization of the idp: %v", ts.ap.meta[0])
+	}
+	if err := ts.mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestLoginRefusesOtherDomain(t *testing.T) {
+	ts := newTestSetup(t)
+	ts.lidp.user.NameID = "jane@other.com"
+	samlResponse := ts.idpInitiatedResponse(t)
+
+	ts.expectIdp()
+	w := ts.postACS(samlResponse, "")
+	if w.Code != http.StatusForbidden {
+		t.Errorf("expected login to be refused; got %d", w.Code)
+	}
+	if len(ts.ap.created) != 0 {
+		t.Errorf("user of other domain provisioned: %v", ts.ap.created)
+	}
+	if err := ts.mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestLoginRefusesTamperedResponse(t *testing.T) {
+	ts := newTestSetup(t)
+	samlResponse := ts.idpInitiatedResponse(t)
+
+	// responses have to be signed by the idp of the ACS url
+	other := newTestSetup(t)
+	other.idp.Id = ts.idp.Id
+	other.expectIdp()
+	w := other.postACS(samlResponse, "")
+	if w.Code != http.StatusForbidden {
+		t.Errorf("expected response of other idp to be refused; got %d", w.Code)
+	}
+	if len(other.ap.created) != 0 {
+		t.Errorf("user provisioned from unverified response: %v", other.ap.created)
+	}
+}
+
+func TestSPInitiatedLogin(t *testing.T) {
+	ts := newTestSetup(t)
+
+	ts.expectIdp()
+	w := httptest.NewRecorder()
+	ts.s.Handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, testHost+"/auth/v3/sso/metadata/"+ts.idp.Id.String(), nil))
+	if w.Code != http.StatusOK || !strings.Contains(w.Body.String(), service.GenerateAcsURL(ts.idp.Id.String(), testHost)) {
+		t.Errorf("expected metadata with ACS url; got %d %s", w.Code, w.Body.String())
+	}
+
+	ts.expectIdp()
+	w = httptest.NewRecorder()
+	ts.s.Handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, testHost+"/auth/v3/sso/login/"+ts.idp.Id.String()+"?return_to=/projects", nil))
+	if w.Code != http.StatusFound || !strings.HasPrefix(w.Header().Get("Location"), "https://idp.example.com/sso?SAMLRequest=") {
+		t.Fatalf("expected redirect to idp; got %d %s", w.Code, w.Header().Get("Location"))
+	}
+	if err := ts.mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestIsLocalPath(t *testing.T) {
+	for uri, local := range map[string]bool{
+		"/":                   true,
+		"/clusters?project=a": true,
+		"":                    false,
+		"//evil.com":          false,
+		"/\\evil.com":         false,
+		"https://evil.com/x":  false,
+		"javascript:alert(1)": false,
+	} {
+		if isLocalPath(uri) != local {
+			t.Errorf("isLocalPath(%q) != %v", uri, local)
+		}
+	}
+}
+
+func TestSessionToken(t *testing.T) {
+	if token := SessionToken("ory_kratos_session=abc; " + SessionCookieName + "=xyz"); token != "xyz" {
+		t.Errorf("expected token xyz; got %q", token)
+	}
+	if token := SessionToken("ory_kratos_session=abc"); token != "" {
+		t.Errorf("expected no token; got %q", token)
+	}
+}
@@ -0,0 +1,324 @@
+package saml
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/crewjam/saml"
+	"github.com/crewjam/saml/samlsp"
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/dao"
+	"github.com/paralus/paralus/internal/models"
+	providers "github.com/paralus/paralus/internal/provider/kratos"
+	"github.com/paralus/paralus/pkg/service"
+	"github.com/paralus/paralus/pkg/utils"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	"github.com/uptrace/bun"
+)
+
+// mfaContextClasses are authentication context classes idps assert
+// when the user authenticated with more than one factor
+var mfaContextClasses = map[string]bool{
+	"http://schemas.microsoft.com/claims/multipleauthn":                  true,
+	"https://refeds.org/profile/mfa":                                     true,
+	"urn:oasis:names:tc:SAML:2.0:ac:classes:MobileTwoFactorContract":     true,
+	"urn:oasis:names:tc:SAML:2.0:ac:classes:MobileTwoFactorUnregistered": true,
+	"urn:oasis:names:tc:SAML:2.0:ac:classes:TimeSyncToken":               true,
+}
+
+// attribute names idps commonly assert the traits of users with
+var (
+	emailAttributes = []string{
+		"email", "mail", "emailaddress",
+		"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/emailaddress",
+		"urn:oid:0.9.2342.19200300.100.1.3",
+	}
+	firstNameAttributes = []string{
+		"firstName", "first_name", "givenName",
+		"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/givenname",
+		"urn:oid:2.5.4.42",
+	}
+	lastNameAttributes = []string{
+		"lastName", "last_name", "sn", "surname",
+		"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/surname",
+		"urn:oid:2.5.4.4",
+	}
+)
+
+// assertedUser is the user an idp asserted
+type assertedUser struct {
+	email     string
+	firstName string
+	lastName  string
+	groups    []string
+}
+
+// attributeValues returns the values of the first attribute of the
+// assertion named, or friendly named, with one of names
+func attributeValues(assertion *saml.Assertion, names ...string) []string {
+	for _, name := range names {
+		for _, stmt := range assertion.AttributeStatements {
+			for _, attr := range stmt.Attributes {
+				if attr.Name != name && attr.FriendlyName != name {
+					continue
+				}
+				var values []string
+				for _, v := range attr.Values {
+					if v.Value != "" {
+						values = append(values, v.Value)
+					}
+				}
+				return values
+			}
+		}
+	}
+	return nil
+}
+
+func firstValue(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// userFromAssertion returns the user of the assertion, groups are read
+// from the group attribute configured for the idp
+func userFromAssertion(assertion *saml.Assertion, groupAttribute string) assertedUser {
+	var user assertedUser
+	if assertion.Subject != nil && assertion.Subject.NameID != nil && strings.Contains(assertion.Subject.NameID.Value, "@") {
+		user.email = assertion.Subject.NameID.Value
+	}
+	if user.email == "" {
+		user.email = firstValue(attributeValues(assertion, emailAttributes...))
+	}
+	user.email = strings.ToLower(strings.TrimSpace(user.email))
+	user.firstName = firstValue(attributeValues(assertion, firstNameAttributes...))
+	user.lastName = firstValue(attributeValues(assertion, lastNameAttributes...))
+	if groupAttribute != "" {
+		user.groups = attributeValues(assertion, groupAttribute)
+	}
+	if user.groups == nil {
+		user.groups = []string{}
+	}
+	return user
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// SessionToken returns the SAML session token of a Cookie header
+func SessionToken(cookie string) string {
+	r := http.Request{Header: http.Header{"Cookie": []string{cookie}}}
+	c, err := r.Cookie(SessionCookieName)
+	if err != nil {
+		return ""
+	}
+	return c.Value
+}
+
+// GetSession returns the active SAML session of a session token
+func GetSession(ctx context.Context, db bun.IDB, token string) (*models.SAMLSession, error) {
+	return dao.GetSAMLSession(ctx, db, hashToken(token))
+}
+
+// clientSessionData returns session data describing the client of a
+// browser request, used for audit events of SAML logins. The client
+// address is the peer of the request, X-Forwarded-For is set by the
+// client and can't be trusted.
+func clientSessionData(r *http.Request) *commonv3.SessionData {
+	ip := r.RemoteAddr
+	if host, _, err := net.SplitHostPort(ip); err == nil {
+		ip = host
+	}
+	return &commonv3.SessionData{
+		ClientIp:   ip,
+		ClientUa:   r.UserAgent(),
+		ClientHost: r.Host,
+		ClientType: commonv3.ClientType_BROWSER,
+		AuthType:   commonv3.AuthType_SAMLLogin,
+	}
+}
+
+// sessionProvider keeps SAML logins as sessions of paralus, kratos
+// has no way to issue sessions for users authenticated elsewhere
+type sessionProvider struct {
+	s      *SAMLService
+	idp    *models.Idp
+	secure bool
+}
+
+var _ samlsp.SessionProvider = (*sessionProvider)(nil)
+
+// provision creates the user of a first login in the organization of
+// the idp, traits of returning users are updated from the assertion.
+// Groups are synced from the idp_groups trait.
+func (s *SAMLService) provision(ctx context.Context, idp *models.Idp, user assertedUser) (uuid.UUID, error) {
+	identity := &models.KratosIdentities{}
+	_, err := dao.GetUserByEmail(ctx, s.db, user.email, identity)
+	if err == sql.ErrNoRows {
+		id, err := s.ap.Create(ctx, utils.GetRandomPassword(32), map[string]interface{}{
+			"email":      user.email,
+			"first_name": user.firstName,
+			"last_name":  user.lastName,
+			"idp_groups": user.groups,
+		}, providers.IdentityPublicMetadata{
+			Organization: idp.OrganizationId.String(),
+			Partner:      idp.PartnerId.String(),
+		})
+		if err != nil {
+			return uuid.Nil, err
+		}
+		return uuid.Parse(id)
+	}
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if org, _ := identity.MetadataPublic["Organization"].(string); org != idp.OrganizationId.String() {
+		return uuid.Nil, fmt.Errorf("user %s belongs to another organization", user.email)
+	}
+	// users deprovisioned over SCIM stay deactivated
+	if identity.State != "" && identity.State != "active" {
+		return uuid.Nil, fmt.Errorf("user %s is deactivated", user.email)
+	}
+	traits := map[string]interface{}{}
+	for k, v := range identity.Traits {
+		traits[k] = v
+	}
+	if user.firstName != "" {
+		traits["first_name"] = user.firstName
+	}
+	if user.lastName != "" {
+		traits["last_name"] = user.lastName
+	}
+	traits["idp_groups"] = user.groups
+	forceReset, _ := identity.MetadataPublic["ForceReset"].(bool)
+	err = s.ap.Update(ctx, identity.ID.String(), traits, providers.IdentityPublicMetadata{ForceReset: forceReset})
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return identity.ID, nil
+}
+
+// CreateSession provisions the user of the assertion and starts a
+// session for it
+func (p *sessionProvider) CreateSession(w http.ResponseWriter, r *http.Request, assertion *saml.Assertion) error {
+	ctx := r.Context()
+	sd := clientSessionData(r)
+	user := userFromAssertion(assertion, p.idp.GroupAttributeName)
+	refuse := func(reason string) error {
+		service.FailedSAMLLoginAuditEvent(p.s.al, sd, user.email, p.idp.Name, reason)
+		return errors.New(reason)
+	}
+
+	if user.email == "" {
+		return refuse("assertion has no email address")
+	}
+	sd.Username = user.email
+	if domain := user.email[strings.LastIndex(user.email, "@")+1:]; !strings.EqualFold(domain, p.idp.Domain) {
+		return refuse(fmt.Sprintf("email domain %s is not the domain of idp %s", domain, p.idp.Name))
+	}
+	accountID, err := p.s.provision(ctx, p.idp, user)
+	if err != nil {
+		_log.Errorw("unable to provision saml user", "user", user.email, "error", err)
+		return refuse("unable to provision user")
+	}
+
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return err
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+	now := time.Now()
+	session := &models.SAMLSession{
+		TokenHash:       hashToken(token),
+		AccountId:       accountID,
+		Username:        user.email,
+		IdpId:           p.idp.Id,
+		OrganizationId:  p.idp.OrganizationId,
+		PartnerId:       p.idp.PartnerId,
+		IpAddress:       sd.ClientIp,
+		UserAgent:       sd.ClientUa,
+		Aal:             "aal1",
+		AuthenticatedAt: now,
+		ExpiresAt:       now.Add(sessionLifespan),
+	}
+	if len(assertion.AuthnStatements) > 0 {
+		stmt := assertion.AuthnStatements[0]
+		if !stmt.AuthnInstant.IsZero() {
+			session.AuthenticatedAt = stmt.AuthnInstant
+		}
+		if stmt.SessionNotOnOrAfter != nil && stmt.SessionNotOnOrAfter.Before(session.ExpiresAt) {
+			session.ExpiresAt = *stmt.SessionNotOnOrAfter
+		}
+		if stmt.AuthnContext.AuthnContextClassRef != nil && mfaContextClasses[stmt.AuthnContext.AuthnContextClassRef.Value] {
+			session.Aal = "aal2"
+		}
+	}
+	if err := dao.CreateSAMLSession(ctx, p.s.db, session); err != nil {
+		return err
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     SessionCookieName,
+		Value:    token,
+		Path:     "/",
+		Expires:  session.ExpiresAt,
+		HttpOnly: true,
+		Secure:   p.secure,
+		SameSite: http.SameSiteLaxMode,
+	})
+	sd.Account = accountID.String()
+	sd.Organization = p.idp.OrganizationId.String()
+	sd.Partner = p.idp.PartnerId.String()
+	service.SAMLLoginAuditEvent(p.s.al, sd, p.idp.Name)
+	return nil
+}
+
+// DeleteSession ends the session of the request
+func (p *sessionProvider) DeleteSession(w http.ResponseWriter, r *http.Request) error {
+	if c, err := r.Cookie(SessionCookieName); err == nil && c.Value != "" {
+		if err := dao.DeleteSAMLSessionByToken(r.Context(), p.s.db, hashToken(c.Value)); err != nil {
+			return err
+		}
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     SessionCookieName,
+		Value:    "",
+		Path:     "/",
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   p.secure,
+		SameSite: http.SameSiteLaxMode,
+	})
+	return nil
+}
+
+// GetSession returns the session of the request
+func (p *sessionProvider) GetSession(r *http.Request) (samlsp.Session, error) {
+	c, err := r.Cookie(SessionCookieName)
+	if err != nil || c.Value == "" {
+		return nil, samlsp.ErrNoSession
+	}
+	session, err := GetSession(r.Context(), p.s.db, c.Value)
+	if err == sql.ErrNoRows {
+		return nil, samlsp.ErrNoSession
+	}
+	if err != nil {
+		return nil, err
+	}
+	return session, nil
+}
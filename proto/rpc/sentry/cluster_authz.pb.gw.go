@@ -67,6 +67,86 @@ func local_request_ClusterAuthorizationService_GetUserAuthorization_0(ctx contex
 
 }
 
+func request_ClusterAuthorizationService_PreviewUserAuthorization_0(ctx context.Context, marshaler runtime.Marshaler, client ClusterAuthorizationServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq PreviewUserAuthorizationRequest
+	var metadata runtime.ServerMetadata
+
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && err != io.EOF {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	var (
+		val string
+		ok  bool
+		err error
+		_   = err
+	)
+
+	val, ok = pathParams["metadata.partner"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "metadata.partner")
+	}
+
+	err = runtime.PopulateFieldFromPath(&protoReq, "metadata.partner", val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "metadata.partner", err)
+	}
+
+	val, ok = pathParams["metadata.organization"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "metadata.organization")
+	}
+
+	err = runtime.PopulateFieldFromPath(&protoReq, "metadata.organization", val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "metadata.organization", err)
+	}
+
+	msg, err := client.PreviewUserAuthorization(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+
+}
+
+func local_request_ClusterAuthorizationService_PreviewUserAuthorization_0(ctx context.Context, marshaler runtime.Marshaler, server ClusterAuthorizationServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq PreviewUserAuthorizationRequest
+	var metadata runtime.ServerMetadata
+
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && err != io.EOF {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	var (
+		val string
+		ok  bool
+		err error
+		_   = err
+	)
+
+	val, ok = pathParams["metadata.partner"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "metadata.partner")
+	}
+
+	err = runtime.PopulateFieldFromPath(&protoReq, "metadata.partner", val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "metadata.partner", err)
+	}
+
+	val, ok = pathParams["metadata.organization"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "metadata.organization")
+	}
+
+	err = runtime.PopulateFieldFromPath(&protoReq, "metadata.organization", val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "metadata.organization", err)
+	}
+
+	msg, err := server.PreviewUserAuthorization(ctx, &protoReq)
+	return msg, metadata, err
+
+}
+
 // RegisterClusterAuthorizationServiceHandlerServer registers the http handlers for service ClusterAuthorizationService to "mux".
 // UnaryRPC     :call ClusterAuthorizationServiceServer directly.
 // StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
@@ -98,6 +178,31 @@ func RegisterClusterAuthorizationServiceHandlerServer(ctx context.Context, mux *
 
 	})
 
+	mux.Handle("POST", pattern_ClusterAuthorizationService_PreviewUserAuthorization_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/paralus.dev.sentry.rpc.ClusterAuthorizationService/PreviewUserAuthorization", runtime.WithHTTPPathPattern("/v2/sentry/authorization/partner/{metadata.partner}/organization/{metadata.organization}/preview"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_ClusterAuthorizationService_PreviewUserAuthorization_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_ClusterAuthorizationService_PreviewUserAuthorization_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
 	return nil
 }
 
@@ -161,13 +266,39 @@ func RegisterClusterAuthorizationServiceHandlerClient(ctx context.Context, mux *
 
 	})
 
+	mux.Handle("POST", pattern_ClusterAuthorizationService_PreviewUserAuthorization_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/paralus.dev.sentry.rpc.ClusterAuthorizationService/PreviewUserAuthorization", runtime.WithHTTPPathPattern("/v2/sentry/authorization/partner/{metadata.partner}/organization/{metadata.organization}/preview"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_ClusterAuthorizationService_PreviewUserAuthorization_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_ClusterAuthorizationService_PreviewUserAuthorization_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
 	return nil
 }
 
 var (
 	pattern_ClusterAuthorizationService_GetUserAuthorization_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"v2", "sentry", "authorization", "user"}, ""))
+
+	pattern_ClusterAuthorizationService_PreviewUserAuthorization_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5, 1, 0, 4, 1, 5, 6, 2, 7}, []string{"v2", "sentry", "authorization", "partner", "metadata.partner", "organization", "metadata.organization", "preview"}, ""))
 )
 
 var (
 	forward_ClusterAuthorizationService_GetUserAuthorization_0 = runtime.ForwardResponseMessage
+
+	forward_ClusterAuthorizationService_PreviewUserAuthorization_0 = runtime.ForwardResponseMessage
 )
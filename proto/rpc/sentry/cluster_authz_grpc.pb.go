@@ -19,7 +19,8 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	ClusterAuthorizationService_GetUserAuthorization_FullMethodName = "/paralus.dev.sentry.rpc.ClusterAuthorizationService/GetUserAuthorization"
+	ClusterAuthorizationService_GetUserAuthorization_FullMethodName     = "/paralus.dev.sentry.rpc.ClusterAuthorizationService/GetUserAuthorization"
+	ClusterAuthorizationService_PreviewUserAuthorization_FullMethodName = "/paralus.dev.sentry.rpc.ClusterAuthorizationService/PreviewUserAuthorization"
 )
 
 // ClusterAuthorizationServiceClient is the client API for ClusterAuthorizationService service.
@@ -27,6 +28,7 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ClusterAuthorizationServiceClient interface {
 	GetUserAuthorization(ctx context.Context, in *GetUserAuthorizationRequest, opts ...grpc.CallOption) (*GetUserAuthorizationResponse, error)
+	PreviewUserAuthorization(ctx context.Context, in *PreviewUserAuthorizationRequest, opts ...grpc.CallOption) (*PreviewUserAuthorizationResponse, error)
 }
 
 type clusterAuthorizationServiceClient struct {
@@ -46,11 +48,21 @@ func (c *clusterAuthorizationServiceClient) GetUserAuthorization(ctx context.Con
 	return out, nil
 }
 
+func (c *clusterAuthorizationServiceClient) PreviewUserAuthorization(ctx context.Context, in *PreviewUserAuthorizationRequest, opts ...grpc.CallOption) (*PreviewUserAuthorizationResponse, error) {
+	out := new(PreviewUserAuthorizationResponse)
+	err := c.cc.Invoke(ctx, ClusterAuthorizationService_PreviewUserAuthorization_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ClusterAuthorizationServiceServer is the server API for ClusterAuthorizationService service.
 // All implementations should embed UnimplementedClusterAuthorizationServiceServer
 // for forward compatibility
 type ClusterAuthorizationServiceServer interface {
 	GetUserAuthorization(context.Context, *GetUserAuthorizationRequest) (*GetUserAuthorizationResponse, error)
+	PreviewUserAuthorization(context.Context, *PreviewUserAuthorizationRequest) (*PreviewUserAuthorizationResponse, error)
 }
 
 // UnimplementedClusterAuthorizationServiceServer should be embedded to have forward compatible implementations.
@@ -60,6 +72,9 @@ type UnimplementedClusterAuthorizationServiceServer struct {
 func (UnimplementedClusterAuthorizationServiceServer) GetUserAuthorization(context.Context, *GetUserAuthorizationRequest) (*GetUserAuthorizationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserAuthorization not implemented")
 }
+func (UnimplementedClusterAuthorizationServiceServer) PreviewUserAuthorization(context.Context, *PreviewUserAuthorizationRequest) (*PreviewUserAuthorizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewUserAuthorization not implemented")
+}
 
 // UnsafeClusterAuthorizationServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to ClusterAuthorizationServiceServer will
@@ -90,6 +105,24 @@ func _ClusterAuthorizationService_GetUserAuthorization_Handler(srv interface{},
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClusterAuthorizationService_PreviewUserAuthorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewUserAuthorizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterAuthorizationServiceServer).PreviewUserAuthorization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterAuthorizationService_PreviewUserAuthorization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterAuthorizationServiceServer).PreviewUserAuthorization(ctx, req.(*PreviewUserAuthorizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ClusterAuthorizationService_ServiceDesc is the grpc.ServiceDesc for ClusterAuthorizationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -101,6 +134,10 @@ var ClusterAuthorizationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserAuthorization",
 			Handler:    _ClusterAuthorizationService_GetUserAuthorization_Handler,
 		},
+		{
+			MethodName: "PreviewUserAuthorization",
+			Handler:    _ClusterAuthorizationService_PreviewUserAuthorization_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/rpc/sentry/cluster_authz.proto",
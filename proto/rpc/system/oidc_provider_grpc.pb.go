@@ -21,11 +21,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	OIDCProviderService_CreateOIDCProvider_FullMethodName = "/paralus.dev.rpc.system.v3.OIDCProviderService/CreateOIDCProvider"
-	OIDCProviderService_GetOIDCProvider_FullMethodName    = "/paralus.dev.rpc.system.v3.OIDCProviderService/GetOIDCProvider"
-	OIDCProviderService_ListOIDCProvider_FullMethodName   = "/paralus.dev.rpc.system.v3.OIDCProviderService/ListOIDCProvider"
-	OIDCProviderService_UpdateOIDCProvider_FullMethodName = "/paralus.dev.rpc.system.v3.OIDCProviderService/UpdateOIDCProvider"
-	OIDCProviderService_DeleteOIDCProvider_FullMethodName = "/paralus.dev.rpc.system.v3.OIDCProviderService/DeleteOIDCProvider"
+	OIDCProviderService_CreateOIDCProvider_FullMethodName  = "/paralus.dev.rpc.system.v3.OIDCProviderService/CreateOIDCProvider"
+	OIDCProviderService_GetOIDCProvider_FullMethodName     = "/paralus.dev.rpc.system.v3.OIDCProviderService/GetOIDCProvider"
+	OIDCProviderService_ListOIDCProvider_FullMethodName    = "/paralus.dev.rpc.system.v3.OIDCProviderService/ListOIDCProvider"
+	OIDCProviderService_UpdateOIDCProvider_FullMethodName  = "/paralus.dev.rpc.system.v3.OIDCProviderService/UpdateOIDCProvider"
+	OIDCProviderService_DeleteOIDCProvider_FullMethodName  = "/paralus.dev.rpc.system.v3.OIDCProviderService/DeleteOIDCProvider"
+	OIDCProviderService_DryRunClaimMappings_FullMethodName = "/paralus.dev.rpc.system.v3.OIDCProviderService/DryRunClaimMappings"
 )
 
 // OIDCProviderServiceClient is the client API for OIDCProviderService service.
@@ -37,6 +38,7 @@ type OIDCProviderServiceClient interface {
 	ListOIDCProvider(ctx context.Context, in *v31.Empty, opts ...grpc.CallOption) (*v3.OIDCProviderList, error)
 	UpdateOIDCProvider(ctx context.Context, in *v3.OIDCProvider, opts ...grpc.CallOption) (*v3.OIDCProvider, error)
 	DeleteOIDCProvider(ctx context.Context, in *v3.OIDCProvider, opts ...grpc.CallOption) (*v31.Empty, error)
+	DryRunClaimMappings(ctx context.Context, in *v3.ClaimMappingDryRun, opts ...grpc.CallOption) (*v3.ClaimMappingDryRun, error)
 }
 
 type oIDCProviderServiceClient struct {
@@ -92,6 +94,15 @@ func (c *oIDCProviderServiceClient) DeleteOIDCProvider(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *oIDCProviderServiceClient) DryRunClaimMappings(ctx context.Context, in *v3.ClaimMappingDryRun, opts ...grpc.CallOption) (*v3.ClaimMappingDryRun, error) {
+	out := new(v3.ClaimMappingDryRun)
+	err := c.cc.Invoke(ctx, OIDCProviderService_DryRunClaimMappings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OIDCProviderServiceServer is the server API for OIDCProviderService service.
 // All implementations should embed UnimplementedOIDCProviderServiceServer
 // for forward compatibility
@@ -101,6 +112,7 @@ type OIDCProviderServiceServer interface {
 	ListOIDCProvider(context.Context, *v31.Empty) (*v3.OIDCProviderList, error)
 	UpdateOIDCProvider(context.Context, *v3.OIDCProvider) (*v3.OIDCProvider, error)
 	DeleteOIDCProvider(context.Context, *v3.OIDCProvider) (*v31.Empty, error)
+	DryRunClaimMappings(context.Context, *v3.ClaimMappingDryRun) (*v3.ClaimMappingDryRun, error)
 }
 
 // UnimplementedOIDCProviderServiceServer should be embedded to have forward compatible implementations.
@@ -122,6 +134,9 @@ func (UnimplementedOIDCProviderServiceServer) UpdateOIDCProvider(context.Context
 func (UnimplementedOIDCProviderServiceServer) DeleteOIDCProvider(context.Context, *v3.OIDCProvider) (*v31.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteOIDCProvider not implemented")
 }
+func (UnimplementedOIDCProviderServiceServer) DryRunClaimMappings(context.Context, *v3.ClaimMappingDryRun) (*v3.ClaimMappingDryRun, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DryRunClaimMappings not implemented")
+}
 
 // UnsafeOIDCProviderServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to OIDCProviderServiceServer will
@@ -224,6 +239,24 @@ func _OIDCProviderService_DeleteOIDCProvider_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OIDCProviderService_DryRunClaimMappings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v3.ClaimMappingDryRun)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OIDCProviderServiceServer).DryRunClaimMappings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OIDCProviderService_DryRunClaimMappings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OIDCProviderServiceServer).DryRunClaimMappings(ctx, req.(*v3.ClaimMappingDryRun))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OIDCProviderService_ServiceDesc is the grpc.ServiceDesc for OIDCProviderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -251,6 +284,10 @@ var OIDCProviderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteOIDCProvider",
 			Handler:    _OIDCProviderService_DeleteOIDCProvider_Handler,
 		},
+		{
+			MethodName: "DryRunClaimMappings",
+			Handler:    _OIDCProviderService_DryRunClaimMappings_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/rpc/system/oidc_provider.proto",
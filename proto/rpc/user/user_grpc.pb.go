@@ -31,8 +31,14 @@ const (
 	UserService_DeleteUser_FullMethodName           = "/paralus.dev.rpc.user.v3.UserService/DeleteUser"
 	UserService_DownloadCliConfig_FullMethodName    = "/paralus.dev.rpc.user.v3.UserService/DownloadCliConfig"
 	UserService_UserListApiKeys_FullMethodName      = "/paralus.dev.rpc.user.v3.UserService/UserListApiKeys"
+	UserService_UserCreateApiKey_FullMethodName     = "/paralus.dev.rpc.user.v3.UserService/UserCreateApiKey"
+	UserService_UserRotateApiKey_FullMethodName     = "/paralus.dev.rpc.user.v3.UserService/UserRotateApiKey"
 	UserService_UserDeleteApiKeys_FullMethodName    = "/paralus.dev.rpc.user.v3.UserService/UserDeleteApiKeys"
 	UserService_UserForgotPassword_FullMethodName   = "/paralus.dev.rpc.user.v3.UserService/UserForgotPassword"
+	UserService_UserListSessions_FullMethodName     = "/paralus.dev.rpc.user.v3.UserService/UserListSessions"
+	UserService_UserRevokeSession_FullMethodName    = "/paralus.dev.rpc.user.v3.UserService/UserRevokeSession"
+	UserService_UserRevokeSessions_FullMethodName   = "/paralus.dev.rpc.user.v3.UserService/UserRevokeSessions"
+	UserService_UserRevokeAccess_FullMethodName     = "/paralus.dev.rpc.user.v3.UserService/UserRevokeAccess"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -49,8 +55,14 @@ type UserServiceClient interface {
 	DeleteUser(ctx context.Context, in *v3.User, opts ...grpc.CallOption) (*UserDeleteApiKeysResponse, error)
 	DownloadCliConfig(ctx context.Context, in *CliConfigRequest, opts ...grpc.CallOption) (*v31.HttpBody, error)
 	UserListApiKeys(ctx context.Context, in *ApiKeyRequest, opts ...grpc.CallOption) (*UserListApiKeysResponse, error)
+	UserCreateApiKey(ctx context.Context, in *ApiKeyRequest, opts ...grpc.CallOption) (*ApiKeyResponse, error)
+	UserRotateApiKey(ctx context.Context, in *ApiKeyRequest, opts ...grpc.CallOption) (*ApiKeyResponse, error)
 	UserDeleteApiKeys(ctx context.Context, in *ApiKeyRequest, opts ...grpc.CallOption) (*UserDeleteApiKeysResponse, error)
 	UserForgotPassword(ctx context.Context, in *UserForgotPasswordRequest, opts ...grpc.CallOption) (*UserForgotPasswordResponse, error)
+	UserListSessions(ctx context.Context, in *UserSessionRequest, opts ...grpc.CallOption) (*v3.UserSessionList, error)
+	UserRevokeSession(ctx context.Context, in *UserSessionRequest, opts ...grpc.CallOption) (*UserRevokeSessionsResponse, error)
+	UserRevokeSessions(ctx context.Context, in *UserSessionRequest, opts ...grpc.CallOption) (*UserRevokeSessionsResponse, error)
+	UserRevokeAccess(ctx context.Context, in *UserSessionRequest, opts ...grpc.CallOption) (*UserRevokeAccessResponse, error)
 }
 
 type userServiceClient struct {
@@ -151,6 +163,24 @@ func (c *userServiceClient) UserListApiKeys(ctx context.Context, in *ApiKeyReque
 	return out, nil
 }
 
+func (c *userServiceClient) UserCreateApiKey(ctx context.Context, in *ApiKeyRequest, opts ...grpc.CallOption) (*ApiKeyResponse, error) {
+	out := new(ApiKeyResponse)
+	err := c.cc.Invoke(ctx, UserService_UserCreateApiKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UserRotateApiKey(ctx context.Context, in *ApiKeyRequest, opts ...grpc.CallOption) (*ApiKeyResponse, error) {
+	out := new(ApiKeyResponse)
+	err := c.cc.Invoke(ctx, UserService_UserRotateApiKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) UserDeleteApiKeys(ctx context.Context, in *ApiKeyRequest, opts ...grpc.CallOption) (*UserDeleteApiKeysResponse, error) {
 	out := new(UserDeleteApiKeysResponse)
 	err := c.cc.Invoke(ctx, UserService_UserDeleteApiKeys_FullMethodName, in, out, opts...)
@@ -169,6 +199,42 @@ func (c *userServiceClient) UserForgotPassword(ctx context.Context, in *UserForg
 	return out, nil
 }
 
+func (c *userServiceClient) UserListSessions(ctx context.Context, in *UserSessionRequest, opts ...grpc.CallOption) (*v3.UserSessionList, error) {
+	out := new(v3.UserSessionList)
+	err := c.cc.Invoke(ctx, UserService_UserListSessions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UserRevokeSession(ctx context.Context, in *UserSessionRequest, opts ...grpc.CallOption) (*UserRevokeSessionsResponse, error) {
+	out := new(UserRevokeSessionsResponse)
+	err := c.cc.Invoke(ctx, UserService_UserRevokeSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UserRevokeSessions(ctx context.Context, in *UserSessionRequest, opts ...grpc.CallOption) (*UserRevokeSessionsResponse, error) {
+	out := new(UserRevokeSessionsResponse)
+	err := c.cc.Invoke(ctx, UserService_UserRevokeSessions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UserRevokeAccess(ctx context.Context, in *UserSessionRequest, opts ...grpc.CallOption) (*UserRevokeAccessResponse, error) {
+	out := new(UserRevokeAccessResponse)
+	err := c.cc.Invoke(ctx, UserService_UserRevokeAccess_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations should embed UnimplementedUserServiceServer
 // for forward compatibility
@@ -183,8 +249,14 @@ type UserServiceServer interface {
 	DeleteUser(context.Context, *v3.User) (*UserDeleteApiKeysResponse, error)
 	DownloadCliConfig(context.Context, *CliConfigRequest) (*v31.HttpBody, error)
 	UserListApiKeys(context.Context, *ApiKeyRequest) (*UserListApiKeysResponse, error)
+	UserCreateApiKey(context.Context, *ApiKeyRequest) (*ApiKeyResponse, error)
+	UserRotateApiKey(context.Context, *ApiKeyRequest) (*ApiKeyResponse, error)
 	UserDeleteApiKeys(context.Context, *ApiKeyRequest) (*UserDeleteApiKeysResponse, error)
 	UserForgotPassword(context.Context, *UserForgotPasswordRequest) (*UserForgotPasswordResponse, error)
+	UserListSessions(context.Context, *UserSessionRequest) (*v3.UserSessionList, error)
+	UserRevokeSession(context.Context, *UserSessionRequest) (*UserRevokeSessionsResponse, error)
+	UserRevokeSessions(context.Context, *UserSessionRequest) (*UserRevokeSessionsResponse, error)
+	UserRevokeAccess(context.Context, *UserSessionRequest) (*UserRevokeAccessResponse, error)
 }
 
 // UnimplementedUserServiceServer should be embedded to have forward compatible implementations.
@@ -221,12 +293,30 @@ func (UnimplementedUserServiceServer) DownloadCliConfig(context.Context, *CliCon
 func (UnimplementedUserServiceServer) UserListApiKeys(context.Context, *ApiKeyRequest) (*UserListApiKeysResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UserListApiKeys not implemented")
 }
+func (UnimplementedUserServiceServer) UserCreateApiKey(context.Context, *ApiKeyRequest) (*ApiKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserCreateApiKey not implemented")
+}
+func (UnimplementedUserServiceServer) UserRotateApiKey(context.Context, *ApiKeyRequest) (*ApiKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserRotateApiKey not implemented")
+}
 func (UnimplementedUserServiceServer) UserDeleteApiKeys(context.Context, *ApiKeyRequest) (*UserDeleteApiKeysResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UserDeleteApiKeys not implemented")
 }
 func (UnimplementedUserServiceServer) UserForgotPassword(context.Context, *UserForgotPasswordRequest) (*UserForgotPasswordResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UserForgotPassword not implemented")
 }
+func (UnimplementedUserServiceServer) UserListSessions(context.Context, *UserSessionRequest) (*v3.UserSessionList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserListSessions not implemented")
+}
+func (UnimplementedUserServiceServer) UserRevokeSession(context.Context, *UserSessionRequest) (*UserRevokeSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserRevokeSession not implemented")
+}
+func (UnimplementedUserServiceServer) UserRevokeSessions(context.Context, *UserSessionRequest) (*UserRevokeSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserRevokeSessions not implemented")
+}
+func (UnimplementedUserServiceServer) UserRevokeAccess(context.Context, *UserSessionRequest) (*UserRevokeAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserRevokeAccess not implemented")
+}
 
 // UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to UserServiceServer will
@@ -419,6 +509,42 @@ func _UserService_UserListApiKeys_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_UserCreateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UserCreateApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UserCreateApiKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UserCreateApiKey(ctx, req.(*ApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UserRotateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UserRotateApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UserRotateApiKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UserRotateApiKey(ctx, req.(*ApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_UserDeleteApiKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ApiKeyRequest)
 	if err := dec(in); err != nil {
@@ -455,6 +581,78 @@ func _UserService_UserForgotPassword_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_UserListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UserListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UserListSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UserListSessions(ctx, req.(*UserSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UserRevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UserRevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UserRevokeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UserRevokeSession(ctx, req.(*UserSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UserRevokeSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UserRevokeSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UserRevokeSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UserRevokeSessions(ctx, req.(*UserSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UserRevokeAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UserRevokeAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UserRevokeAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UserRevokeAccess(ctx, req.(*UserSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -502,6 +700,14 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UserListApiKeys",
 			Handler:    _UserService_UserListApiKeys_Handler,
 		},
+		{
+			MethodName: "UserCreateApiKey",
+			Handler:    _UserService_UserCreateApiKey_Handler,
+		},
+		{
+			MethodName: "UserRotateApiKey",
+			Handler:    _UserService_UserRotateApiKey_Handler,
+		},
 		{
 			MethodName: "UserDeleteApiKeys",
 			Handler:    _UserService_UserDeleteApiKeys_Handler,
@@ -510,6 +716,22 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UserForgotPassword",
 			Handler:    _UserService_UserForgotPassword_Handler,
 		},
+		{
+			MethodName: "UserListSessions",
+			Handler:    _UserService_UserListSessions_Handler,
+		},
+		{
+			MethodName: "UserRevokeSession",
+			Handler:    _UserService_UserRevokeSession_Handler,
+		},
+		{
+			MethodName: "UserRevokeSessions",
+			Handler:    _UserService_UserRevokeSessions_Handler,
+		},
+		{
+			MethodName: "UserRevokeAccess",
+			Handler:    _UserService_UserRevokeAccess_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/rpc/user/user.proto",
@@ -20,7 +20,10 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	AuthService_IsRequestAllowed_FullMethodName = "/paralus.dev.rpc.auth.v3.AuthService/IsRequestAllowed"
+	AuthService_IsRequestAllowed_FullMethodName      = "/paralus.dev.rpc.auth.v3.AuthService/IsRequestAllowed"
+	AuthService_ExplainRequest_FullMethodName        = "/paralus.dev.rpc.auth.v3.AuthService/ExplainRequest"
+	AuthService_EffectiveAccess_FullMethodName       = "/paralus.dev.rpc.auth.v3.AuthService/EffectiveAccess"
+	AuthService_ListPermissionHolders_FullMethodName = "/paralus.dev.rpc.auth.v3.AuthService/ListPermissionHolders"
 )
 
 // AuthServiceClient is the client API for AuthService service.
@@ -28,6 +31,17 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type AuthServiceClient interface {
 	IsRequestAllowed(ctx context.Context, in *v3.IsRequestAllowedRequest, opts ...grpc.CallOption) (*v3.IsRequestAllowedResponse, error)
+	// ExplainRequest evaluates the authorization of a request on
+	// behalf of another user and reports how the decision was made.
+	// Send Accept: application/yaml for CLI friendly output.
+	ExplainRequest(ctx context.Context, in *v3.ExplainRequestRequest, opts ...grpc.CallOption) (*v3.ExplainRequestResponse, error)
+	// EffectiveAccess resolves the permissions a user, service account,
+	// group or api key holds in the organization, its projects and
+	// namespaces together with the roles and groups granting them.
+	EffectiveAccess(ctx context.Context, in *v3.EffectiveAccessRequest, opts ...grpc.CallOption) (*v3.EffectiveAccessResponse, error)
+	// ListPermissionHolders lists the users, service accounts and
+	// groups holding a permission in a project.
+	ListPermissionHolders(ctx context.Context, in *v3.PermissionHoldersRequest, opts ...grpc.CallOption) (*v3.PermissionHoldersResponse, error)
 }
 
 type authServiceClient struct {
@@ -47,11 +61,49 @@ func (c *authServiceClient) IsRequestAllowed(ctx context.Context, in *v3.IsReque
 	return out, nil
 }
 
+func (c *authServiceClient) ExplainRequest(ctx context.Context, in *v3.ExplainRequestRequest, opts ...grpc.CallOption) (*v3.ExplainRequestResponse, error) {
+	out := new(v3.ExplainRequestResponse)
+	err := c.cc.Invoke(ctx, AuthService_ExplainRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) EffectiveAccess(ctx context.Context, in *v3.EffectiveAccessRequest, opts ...grpc.CallOption) (*v3.EffectiveAccessResponse, error) {
+	out := new(v3.EffectiveAccessResponse)
+	err := c.cc.Invoke(ctx, AuthService_EffectiveAccess_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ListPermissionHolders(ctx context.Context, in *v3.PermissionHoldersRequest, opts ...grpc.CallOption) (*v3.PermissionHoldersResponse, error) {
+	out := new(v3.PermissionHoldersResponse)
+	err := c.cc.Invoke(ctx, AuthService_ListPermissionHolders_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthServiceServer is the server API for AuthService service.
 // All implementations should embed UnimplementedAuthServiceServer
 // for forward compatibility
 type AuthServiceServer interface {
 	IsRequestAllowed(context.Context, *v3.IsRequestAllowedRequest) (*v3.IsRequestAllowedResponse, error)
+	// ExplainRequest evaluates the authorization of a request on
+	// behalf of another user and reports how the decision was made.
+	// Send Accept: application/yaml for CLI friendly output.
+	ExplainRequest(context.Context, *v3.ExplainRequestRequest) (*v3.ExplainRequestResponse, error)
+	// EffectiveAccess resolves the permissions a user, service account,
+	// group or api key holds in the organization, its projects and
+	// namespaces together with the roles and groups granting them.
+	EffectiveAccess(context.Context, *v3.EffectiveAccessRequest) (*v3.EffectiveAccessResponse, error)
+	// ListPermissionHolders lists the users, service accounts and
+	// groups holding a permission in a project.
+	ListPermissionHolders(context.Context, *v3.PermissionHoldersRequest) (*v3.PermissionHoldersResponse, error)
 }
 
 // UnimplementedAuthServiceServer should be embedded to have forward compatible implementations.
@@ -61,6 +113,15 @@ type UnimplementedAuthServiceServer struct {
 func (UnimplementedAuthServiceServer) IsRequestAllowed(context.Context, *v3.IsRequestAllowedRequest) (*v3.IsRequestAllowedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IsRequestAllowed not implemented")
 }
+func (UnimplementedAuthServiceServer) ExplainRequest(context.Context, *v3.ExplainRequestRequest) (*v3.ExplainRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainRequest not implemented")
+}
+func (UnimplementedAuthServiceServer) EffectiveAccess(context.Context, *v3.EffectiveAccessRequest) (*v3.EffectiveAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EffectiveAccess not implemented")
+}
+func (UnimplementedAuthServiceServer) ListPermissionHolders(context.Context, *v3.PermissionHoldersRequest) (*v3.PermissionHoldersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPermissionHolders not implemented")
+}
 
 // UnsafeAuthServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to AuthServiceServer will
@@ -91,6 +152,60 @@ func _AuthService_IsRequestAllowed_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_ExplainRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v3.ExplainRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ExplainRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ExplainRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ExplainRequest(ctx, req.(*v3.ExplainRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_EffectiveAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v3.EffectiveAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).EffectiveAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_EffectiveAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).EffectiveAccess(ctx, req.(*v3.EffectiveAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ListPermissionHolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v3.PermissionHoldersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ListPermissionHolders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ListPermissionHolders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ListPermissionHolders(ctx, req.(*v3.PermissionHoldersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuthService_ServiceDesc is the grpc.ServiceDesc for AuthService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -102,6 +217,18 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "IsRequestAllowed",
 			Handler:    _AuthService_IsRequestAllowed_Handler,
 		},
+		{
+			MethodName: "ExplainRequest",
+			Handler:    _AuthService_ExplainRequest_Handler,
+		},
+		{
+			MethodName: "EffectiveAccess",
+			Handler:    _AuthService_EffectiveAccess_Handler,
+		},
+		{
+			MethodName: "ListPermissionHolders",
+			Handler:    _AuthService_ListPermissionHolders_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/rpc/v3/auth.proto",
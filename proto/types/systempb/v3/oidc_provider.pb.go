@@ -9,6 +9,7 @@ package systemv3
 import (
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
 	v3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	v31 "github.com/paralus/paralus/proto/types/userpb/v3"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	structpb "google.golang.org/protobuf/types/known/structpb"
@@ -23,6 +24,55 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ClaimMatchType int32
+
+const (
+	ClaimMatchType_ClaimMatchExact ClaimMatchType = 0
+	ClaimMatchType_ClaimMatchGlob  ClaimMatchType = 1
+	ClaimMatchType_ClaimMatchRegex ClaimMatchType = 2
+)
+
+// Enum value maps for ClaimMatchType.
+var (
+	ClaimMatchType_name = map[int32]string{
+		0: "ClaimMatchExact",
+		1: "ClaimMatchGlob",
+		2: "ClaimMatchRegex",
+	}
+	ClaimMatchType_value = map[string]int32{
+		"ClaimMatchExact": 0,
+		"ClaimMatchGlob":  1,
+		"ClaimMatchRegex": 2,
+	}
+)
+
+func (x ClaimMatchType) Enum() *ClaimMatchType {
+	p := new(ClaimMatchType)
+	*p = x
+	return p
+}
+
+func (x ClaimMatchType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ClaimMatchType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_types_systempb_v3_oidc_provider_proto_enumTypes[0].Descriptor()
+}
+
+func (ClaimMatchType) Type() protoreflect.EnumType {
+	return &file_proto_types_systempb_v3_oidc_provider_proto_enumTypes[0]
+}
+
+func (x ClaimMatchType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ClaimMatchType.Descriptor instead.
+func (ClaimMatchType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_types_systempb_v3_oidc_provider_proto_rawDescGZIP(), []int{0}
+}
+
 type OIDCProvider struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -107,18 +157,19 @@ type OIDCProviderSpec struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProviderName    string           `protobuf:"bytes,1,opt,name=providerName,proto3" json:"providerName,omitempty"` // enumeration?
-	MapperUrl       string           `protobuf:"bytes,2,opt,name=mapperUrl,proto3" json:"mapperUrl,omitempty"`
-	MapperFilename  string           `protobuf:"bytes,3,opt,name=mapperFilename,proto3" json:"mapperFilename,omitempty"`
-	ClientId        string           `protobuf:"bytes,4,opt,name=clientId,proto3" json:"clientId,omitempty"`
-	ClientSecret    string           `protobuf:"bytes,5,opt,name=clientSecret,proto3" json:"clientSecret,omitempty"`
-	Scopes          []string         `protobuf:"bytes,6,rep,name=scopes,proto3" json:"scopes,omitempty"`
-	IssuerUrl       string           `protobuf:"bytes,7,opt,name=issuerUrl,proto3" json:"issuerUrl,omitempty"`
-	AuthUrl         string           `protobuf:"bytes,8,opt,name=authUrl,proto3" json:"authUrl,omitempty"`
-	TokenUrl        string           `protobuf:"bytes,9,opt,name=tokenUrl,proto3" json:"tokenUrl,omitempty"`
-	RequestedClaims *structpb.Struct `protobuf:"bytes,10,opt,name=requestedClaims,proto3" json:"requestedClaims,omitempty"` // JSON object
-	Predefined      bool             `protobuf:"varint,11,opt,name=predefined,proto3" json:"predefined,omitempty"`
-	CallbackUrl     string           `protobuf:"bytes,12,opt,name=callbackUrl,proto3" json:"callbackUrl,omitempty"`
+	ProviderName    string              `protobuf:"bytes,1,opt,name=providerName,proto3" json:"providerName,omitempty"` // enumeration?
+	MapperUrl       string              `protobuf:"bytes,2,opt,name=mapperUrl,proto3" json:"mapperUrl,omitempty"`
+	MapperFilename  string              `protobuf:"bytes,3,opt,name=mapperFilename,proto3" json:"mapperFilename,omitempty"`
+	ClientId        string              `protobuf:"bytes,4,opt,name=clientId,proto3" json:"clientId,omitempty"`
+	ClientSecret    string              `protobuf:"bytes,5,opt,name=clientSecret,proto3" json:"clientSecret,omitempty"`
+	Scopes          []string            `protobuf:"bytes,6,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	IssuerUrl       string              `protobuf:"bytes,7,opt,name=issuerUrl,proto3" json:"issuerUrl,omitempty"`
+	AuthUrl         string              `protobuf:"bytes,8,opt,name=authUrl,proto3" json:"authUrl,omitempty"`
+	TokenUrl        string              `protobuf:"bytes,9,opt,name=tokenUrl,proto3" json:"tokenUrl,omitempty"`
+	RequestedClaims *structpb.Struct    `protobuf:"bytes,10,opt,name=requestedClaims,proto3" json:"requestedClaims,omitempty"` // JSON object
+	Predefined      bool                `protobuf:"varint,11,opt,name=predefined,proto3" json:"predefined,omitempty"`
+	CallbackUrl     string              `protobuf:"bytes,12,opt,name=callbackUrl,proto3" json:"callbackUrl,omitempty"`
+	ClaimMappings   []*ClaimMappingRule `protobuf:"bytes,13,rep,name=claimMappings,proto3" json:"claimMappings,omitempty"`
 }
 
 func (x *OIDCProviderSpec) Reset() {
@@ -237,6 +288,368 @@ func (x *OIDCProviderSpec) GetCallbackUrl() string {
 	return ""
 }
 
+func (x *OIDCProviderSpec) GetClaimMappings() []*ClaimMappingRule {
+	if x != nil {
+		return x.ClaimMappings
+	}
+	return nil
+}
+
+type ClaimMappingRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Claim                 string                      `protobuf:"bytes,1,opt,name=claim,proto3" json:"claim,omitempty"`
+	Match                 ClaimMatchType              `protobuf:"varint,2,opt,name=match,proto3,enum=paralus.dev.types.system.v3.ClaimMatchType" json:"match,omitempty"`
+	Pattern               string                      `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Group                 string                      `protobuf:"bytes,4,opt,name=group,proto3" json:"group,omitempty"`
+	CreateGroup           bool                        `protobuf:"varint,5,opt,name=createGroup,proto3" json:"createGroup,omitempty"`
+	ProjectNamespaceRoles []*v31.ProjectNamespaceRole `protobuf:"bytes,6,rep,name=projectNamespaceRoles,proto3" json:"projectNamespaceRoles,omitempty"`
+}
+
+func (x *ClaimMappingRule) Reset() {
+	*x = ClaimMappingRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_types_systempb_v3_oidc_provider_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaimMappingRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimMappingRule) ProtoMessage() {}
+
+func (x *ClaimMappingRule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_types_systempb_v3_oidc_provider_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimMappingRule.ProtoReflect.Descriptor instead.
+func (*ClaimMappingRule) Descriptor() ([]byte, []int) {
+	return file_proto_types_systempb_v3_oidc_provider_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ClaimMappingRule) GetClaim() string {
+	if x != nil {
+		return x.Claim
+	}
+	return ""
+}
+
+func (x *ClaimMappingRule) GetMatch() ClaimMatchType {
+	if x != nil {
+		return x.Match
+	}
+	return ClaimMatchType_ClaimMatchExact
+}
+
+func (x *ClaimMappingRule) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *ClaimMappingRule) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *ClaimMappingRule) GetCreateGroup() bool {
+	if x != nil {
+		return x.CreateGroup
+	}
+	return false
+}
+
+func (x *ClaimMappingRule) GetProjectNamespaceRoles() []*v31.ProjectNamespaceRole {
+	if x != nil {
+		return x.ProjectNamespaceRoles
+	}
+	return nil
+}
+
+type ClaimMappingDryRun struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metadata *v3.Metadata              `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Spec     *ClaimMappingDryRunSpec   `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Status   *ClaimMappingDryRunStatus `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *ClaimMappingDryRun) Reset() {
+	*x = ClaimMappingDryRun{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_types_systempb_v3_oidc_provider_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaimMappingDryRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimMappingDryRun) ProtoMessage() {}
+
+func (x *ClaimMappingDryRun) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_types_systempb_v3_oidc_provider_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimMappingDryRun.ProtoReflect.Descriptor instead.
+func (*ClaimMappingDryRun) Descriptor() ([]byte, []int) {
+	return file_proto_types_systempb_v3_oidc_provider_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ClaimMappingDryRun) GetMetadata() *v3.Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *ClaimMappingDryRun) GetSpec() *ClaimMappingDryRunSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *ClaimMappingDryRun) GetStatus() *ClaimMappingDryRunStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type ClaimMappingDryRunSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Claims        *structpb.Struct    `protobuf:"bytes,1,opt,name=claims,proto3" json:"claims,omitempty"`
+	ClaimMappings []*ClaimMappingRule `protobuf:"bytes,2,rep,name=claimMappings,proto3" json:"claimMappings,omitempty"`
+}
+
+func (x *ClaimMappingDryRunSpec) Reset() {
+	*x = ClaimMappingDryRunSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_types_systempb_v3_oidc_provider_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaimMappingDryRunSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimMappingDryRunSpec) ProtoMessage() {}
+
+func (x *ClaimMappingDryRunSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_types_systempb_v3_oidc_provider_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimMappingDryRunSpec.ProtoReflect.Descriptor instead.
+func (*ClaimMappingDryRunSpec) Descriptor() ([]byte, []int) {
+	return file_proto_types_systempb_v3_oidc_provider_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ClaimMappingDryRunSpec) GetClaims() *structpb.Struct {
+	if x != nil {
+		return x.Claims
+	}
+	return nil
+}
+
+func (x *ClaimMappingDryRunSpec) GetClaimMappings() []*ClaimMappingRule {
+	if x != nil {
+		return x.ClaimMappings
+	}
+	return nil
+}
+
+type ClaimMappingMatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rule  int32  `protobuf:"varint,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	Claim string `protobuf:"bytes,2,opt,name=claim,proto3" json:"claim,omitempty"`
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Group string `protobuf:"bytes,4,opt,name=group,proto3" json:"group,omitempty"`
+}
+
+func (x *ClaimMappingMatch) Reset() {
+	*x = ClaimMappingMatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_types_systempb_v3_oidc_provider_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaimMappingMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimMappingMatch) ProtoMessage() {}
+
+func (x *ClaimMappingMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_types_systempb_v3_oidc_provider_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimMappingMatch.ProtoReflect.Descriptor instead.
+func (*ClaimMappingMatch) Descriptor() ([]byte, []int) {
+	return file_proto_types_systempb_v3_oidc_provider_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ClaimMappingMatch) GetRule() int32 {
+	if x != nil {
+		return x.Rule
+	}
+	return 0
+}
+
+func (x *ClaimMappingMatch) GetClaim() string {
+	if x != nil {
+		return x.Claim
+	}
+	return ""
+}
+
+func (x *ClaimMappingMatch) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *ClaimMappingMatch) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+type ClaimMappingDryRunStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Matches               []*ClaimMappingMatch        `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	Groups                []string                    `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
+	CreatedGroups         []string                    `protobuf:"bytes,3,rep,name=createdGroups,proto3" json:"createdGroups,omitempty"`
+	IgnoredGroups         []string                    `protobuf:"bytes,4,rep,name=ignoredGroups,proto3" json:"ignoredGroups,omitempty"`
+	ProjectNamespaceRoles []*v31.ProjectNamespaceRole `protobuf:"bytes,5,rep,name=projectNamespaceRoles,proto3" json:"projectNamespaceRoles,omitempty"`
+}
+
+func (x *ClaimMappingDryRunStatus) Reset() {
+	*x = ClaimMappingDryRunStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_types_systempb_v3_oidc_provider_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaimMappingDryRunStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimMappingDryRunStatus) ProtoMessage() {}
+
+func (x *ClaimMappingDryRunStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_types_systempb_v3_oidc_provider_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimMappingDryRunStatus.ProtoReflect.Descriptor instead.
+func (*ClaimMappingDryRunStatus) Descriptor() ([]byte, []int) {
+	return file_proto_types_systempb_v3_oidc_provider_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ClaimMappingDryRunStatus) GetMatches() []*ClaimMappingMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *ClaimMappingDryRunStatus) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *ClaimMappingDryRunStatus) GetCreatedGroups() []string {
+	if x != nil {
+		return x.CreatedGroups
+	}
+	return nil
+}
+
+func (x *ClaimMappingDryRunStatus) GetIgnoredGroups() []string {
+	if x != nil {
+		return x.IgnoredGroups
+	}
+	return nil
+}
+
+func (x *ClaimMappingDryRunStatus) GetProjectNamespaceRoles() []*v31.ProjectNamespaceRole {
+	if x != nil {
+		return x.ProjectNamespaceRoles
+	}
+	return nil
+}
+
 type OIDCProviderList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -251,7 +664,7 @@ type OIDCProviderList struct {
 func (x *OIDCProviderList) Reset() {
 	*x = OIDCProviderList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_types_systempb_v3_oidc_provider_proto_msgTypes[2]
+		mi := &file_proto_types_systempb_v3_oidc_provider_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -264,7 +677,7 @@ func (x *OIDCProviderList) String() string {
 func (*OIDCProviderList) ProtoMessage() {}
 
 func (x *OIDCProviderList) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_types_systempb_v3_oidc_provider_proto_msgTypes[2]
+	mi := &file_proto_types_systempb_v3_oidc_provider_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -277,7 +690,7 @@ func (x *OIDCProviderList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OIDCProviderList.ProtoReflect.Descriptor instead.
 func (*OIDCProviderList) Descriptor() ([]byte, []int) {
-	return file_proto_types_systempb_v3_oidc_provider_proto_rawDescGZIP(), []int{2}
+	return file_proto_types_systempb_v3_oidc_provider_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *OIDCProviderList) GetApiVersion() string {
@@ -319,81 +732,265 @@ var file_proto_types_systempb_v3_oidc_provider_proto_rawDesc = []byte{
 	0x6f, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62,
 	0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61,
-	0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e,
-	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf3,
-	0x04, 0x0a, 0x0c, 0x4f, 0x49, 0x44, 0x43, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12,
-	0x70, 0x0a, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x50, 0x92, 0x41, 0x4d, 0x2a, 0x0b, 0x41, 0x50, 0x49, 0x20, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x32, 0x28, 0x41, 0x50, 0x49, 0x20, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x4f, 0x49, 0x44, 0x43, 0x50, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x3a,
-	0x14, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x6b, 0x38, 0x73, 0x6d, 0x67, 0x6d, 0x74, 0x2e,
-	0x69, 0x6f, 0x2f, 0x76, 0x33, 0x52, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x4e, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x3a, 0x92, 0x41, 0x37, 0x2a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x32, 0x21, 0x4b, 0x69, 0x6e, 0x64,
-	0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x4f, 0x49, 0x44, 0x43, 0x50, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x3a, 0x0c, 0x4f,
-	0x49, 0x44, 0x43, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x04, 0x6b, 0x69, 0x6e,
-	0x64, 0x12, 0x77, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20,
+	0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x75, 0x73, 0x65, 0x72,
+	0x70, 0x62, 0x2f, 0x76, 0x33, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70,
+	0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
+	0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xf3, 0x04, 0x0a, 0x0c, 0x4f, 0x49, 0x44, 0x43, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x12, 0x70, 0x0a, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x50, 0x92, 0x41, 0x4d, 0x2a, 0x0b, 0x41, 0x50, 0x49,
+	0x20, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x32, 0x28, 0x41, 0x50, 0x49, 0x20, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x4f, 0x49, 0x44,
+	0x43, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x3a, 0x14, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x6b, 0x38, 0x73, 0x6d, 0x67,
+	0x6d, 0x74, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x33, 0x52, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x4e, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x3a, 0x92, 0x41, 0x37, 0x2a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x32, 0x21, 0x4b,
+	0x69, 0x6e, 0x64, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x4f, 0x49, 0x44, 0x43, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x3a, 0x0c, 0x4f, 0x49, 0x44, 0x43, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x12, 0x77, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x75, 0x73,
+	0x2e, 0x64, 0x65, 0x76, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x34, 0x92,
+	0x41, 0x31, 0x2a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x32, 0x25, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x4f, 0x49,
+	0x44, 0x43, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x6f, 0x0a,
+	0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x70, 0x61,
+	0x72, 0x61, 0x6c, 0x75, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x33, 0x2e, 0x4f, 0x49, 0x44, 0x43, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x42, 0x2c, 0x92, 0x41, 0x29, 0x2a,
+	0x04, 0x53, 0x70, 0x65, 0x63, 0x32, 0x21, 0x53, 0x70, 0x65, 0x63, 0x20, 0x6f, 0x66, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x4f, 0x49, 0x44, 0x43, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x20,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x62,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x75, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x42, 0x25, 0x92, 0x41, 0x22, 0x2a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x32, 0x16, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x40, 0x01, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x3a, 0x53, 0x92, 0x41, 0x50, 0x0a, 0x4e, 0x2a, 0x0c, 0x4f, 0x49, 0x44, 0x43, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x32, 0x18, 0x4f, 0x70, 0x65, 0x6e, 0x20, 0x49, 0x64,
+	0x20, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x20, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0xd2, 0x01, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0xd2, 0x01,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0xd2, 0x01, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xd2, 0x01, 0x04, 0x73, 0x70, 0x65, 0x63, 0x22, 0xbc, 0x05, 0x0a, 0x10, 0x4f, 0x49, 0x44, 0x43,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x12, 0x22, 0x0a, 0x0c,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x55, 0x72, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x55, 0x72, 0x6c, 0x12, 0x26,
+	0x0a, 0x0e, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x46, 0x69,
+	0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x55, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x55, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x75, 0x74, 0x68, 0x55, 0x72, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x75, 0x74, 0x68, 0x55, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x55,
+	0x72, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x55,
+	0x72, 0x6c, 0x12, 0x41, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x43,
+	0x6c, 0x61, 0x69, 0x6d, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x52, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x43,
+	0x6c, 0x61, 0x69, 0x6d, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69,
+	0x6e, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x64, 0x65,
+	0x66, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63,
+	0x6b, 0x55, 0x72, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x61, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x55, 0x72, 0x6c, 0x12, 0xcd, 0x01, 0x0a, 0x0d, 0x63, 0x6c, 0x61, 0x69,
+	0x6d, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2d, 0x2e, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x75, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x33, 0x2e, 0x43, 0x6c,
+	0x61, 0x69, 0x6d, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x42, 0x78,
+	0x92, 0x41, 0x75, 0x2a, 0x0e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x20, 0x4d, 0x61, 0x70, 0x70, 0x69,
+	0x6e, 0x67, 0x73, 0x32, 0x63, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x20, 0x6d, 0x61, 0x70, 0x70, 0x69,
+	0x6e, 0x67, 0x20, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x75, 0x73, 0x65,
+	0x72, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x20, 0x74, 0x6f, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x20, 0x61, 0x6e, 0x64,
+	0x20, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2c, 0x20, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65,
+	0x64, 0x20, 0x69, 0x6e, 0x20, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x20, 0x6f, 0x6e, 0x20, 0x65, 0x76,
+	0x65, 0x72, 0x79, 0x20, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x0d, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x4d,
+	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x3a, 0x3d, 0x92, 0x41, 0x3a, 0x0a, 0x38, 0x2a, 0x1a,
+	0x4f, 0x49, 0x44, 0x43, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x20, 0x53, 0x70, 0x65,
+	0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x1a, 0x4f, 0x49, 0x44, 0x43,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x90, 0x07, 0x0a, 0x10, 0x43, 0x6c, 0x61, 0x69, 0x6d,
+	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x61, 0x0a, 0x05, 0x63,
+	0x6c, 0x61, 0x69, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4b, 0x92, 0x41, 0x48, 0x2a,
+	0x05, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x32, 0x3f, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x75,
+	0x6c, 0x65, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x20, 0x6f, 0x6e, 0x2c, 0x20, 0x65,
+	0x2e, 0x67, 0x2e, 0x20, 0x69, 0x64, 0x70, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x20, 0x6f,
+	0x72, 0x20, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x12, 0x83,
+	0x01, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b,
+	0x2e, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x75, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x33, 0x2e, 0x43, 0x6c, 0x61,
+	0x69, 0x6d, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x42, 0x40, 0x92, 0x41, 0x3d,
+	0x2a, 0x05, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x32, 0x34, 0x48, 0x6f, 0x77, 0x20, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6c, 0x61, 0x69, 0x6d,
+	0x20, 0x61, 0x72, 0x65, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x20, 0x77, 0x69, 0x74,
+	0x68, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x52, 0x05, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x12, 0x6e, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x54, 0x92, 0x41, 0x51, 0x2a, 0x07, 0x50, 0x61, 0x74, 0x74,
+	0x65, 0x72, 0x6e, 0x32, 0x46, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2c, 0x20, 0x67, 0x6c, 0x6f, 0x62,
+	0x20, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x20, 0x65, 0x78, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x6f, 0x66,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x20, 0x61, 0x72, 0x65, 0x20, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x20, 0x77, 0x69, 0x74, 0x68, 0x52, 0x07, 0x70, 0x61, 0x74,
+	0x74, 0x65, 0x72, 0x6e, 0x12, 0xac, 0x01, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x95, 0x01, 0x92, 0x41, 0x91, 0x01, 0x2a, 0x05, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x32, 0x87, 0x01, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x75, 0x73, 0x65, 0x72, 0x73,
+	0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x61, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67,
+	0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x61, 0x72, 0x65, 0x20, 0x61, 0x64, 0x64, 0x65, 0x64,
+	0x20, 0x74, 0x6f, 0x2c, 0x20, 0x24, 0x31, 0x20, 0x6f, 0x72, 0x20, 0x24, 0x7b, 0x6e, 0x61, 0x6d,
+	0x65, 0x7d, 0x20, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x20, 0x73, 0x75, 0x62, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x72, 0x65, 0x67, 0x75, 0x6c, 0x61,
+	0x72, 0x20, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x20, 0x44, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x64, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x52, 0x05, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x12, 0x5c, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x42, 0x3a, 0x92, 0x41, 0x37, 0x2a, 0x0c, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x32, 0x27, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x77, 0x68,
+	0x65, 0x6e, 0x20, 0x69, 0x74, 0x20, 0x64, 0x6f, 0x65, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x65,
+	0x78, 0x69, 0x73, 0x74, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x12, 0xab, 0x01, 0x0a, 0x15, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2f, 0x2e, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x75, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x33, 0x2e, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x6f,
+	0x6c, 0x65, 0x42, 0x44, 0x92, 0x41, 0x41, 0x2a, 0x17, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x20, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x20, 0x52, 0x6f, 0x6c, 0x65, 0x73,
+	0x32, 0x26, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x20, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x20,
+	0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x6f, 0x66, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x72, 0x75, 0x6c, 0x65, 0x52, 0x15, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x3a,
+	0x68, 0x92, 0x41, 0x65, 0x0a, 0x63, 0x2a, 0x10, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x4d, 0x61, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x32, 0x3d, 0x4d, 0x61, 0x70, 0x73, 0x20, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x63, 0x6c, 0x61, 0x69, 0x6d,
+	0x20, 0x74, 0x6f, 0x20, 0x61, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x61, 0x6e, 0x64, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x20, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65,
+	0x64, 0x20, 0x74, 0x6f, 0x20, 0x69, 0x74, 0xd2, 0x01, 0x05, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0xd2,
+	0x01, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x22, 0xff, 0x03, 0x0a, 0x12, 0x43, 0x6c,
+	0x61, 0x69, 0x6d, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e,
+	0x12, 0x82, 0x01, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x75, 0x73, 0x2e, 0x64, 0x65,
 	0x76, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
-	0x33, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x34, 0x92, 0x41, 0x31, 0x2a,
-	0x08, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x32, 0x25, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x33, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x3f, 0x92, 0x41, 0x3c, 0x2a,
+	0x08, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x32, 0x30, 0x4d, 0x65, 0x74, 0x61, 0x64,
 	0x61, 0x74, 0x61, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x4f, 0x49, 0x44, 0x43, 0x50,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x6f, 0x0a, 0x04, 0x73, 0x70,
-	0x65, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x70, 0x61, 0x72, 0x61, 0x6c,
-	0x75, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x73, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x2e, 0x76, 0x33, 0x2e, 0x4f, 0x49, 0x44, 0x43, 0x50, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x42, 0x2c, 0x92, 0x41, 0x29, 0x2a, 0x04, 0x53, 0x70,
-	0x65, 0x63, 0x32, 0x21, 0x53, 0x70, 0x65, 0x63, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20,
-	0x4f, 0x49, 0x44, 0x43, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x20, 0x72, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x62, 0x0a, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x61,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x75, 0x6c, 0x65,
+	0x73, 0x20, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x20, 0x74, 0x6f, 0x52, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x74, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x75, 0x73, 0x2e, 0x64, 0x65,
+	0x76, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76,
+	0x33, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x44, 0x72,
+	0x79, 0x52, 0x75, 0x6e, 0x53, 0x70, 0x65, 0x63, 0x42, 0x2b, 0x92, 0x41, 0x28, 0x2a, 0x04, 0x53,
+	0x70, 0x65, 0x63, 0x32, 0x20, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x65,
+	0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x75, 0x6c, 0x65,
+	0x73, 0x20, 0x66, 0x6f, 0x72, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x78, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x70, 0x61,
 	0x72, 0x61, 0x6c, 0x75, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x42, 0x25, 0x92, 0x41, 0x22, 0x2a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x32, 0x16, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x40, 0x01, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x3a,
-	0x53, 0x92, 0x41, 0x50, 0x0a, 0x4e, 0x2a, 0x0c, 0x4f, 0x49, 0x44, 0x43, 0x50, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x32, 0x18, 0x4f, 0x70, 0x65, 0x6e, 0x20, 0x49, 0x64, 0x20, 0x43, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x20, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0xd2, 0x01,
-	0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0xd2, 0x01, 0x04, 0x6b, 0x69,
-	0x6e, 0x64, 0xd2, 0x01, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xd2, 0x01, 0x04,
-	0x73, 0x70, 0x65, 0x63, 0x22, 0xec, 0x03, 0x0a, 0x10, 0x4f, 0x49, 0x44, 0x43, 0x50, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a,
-	0x09, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x55, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x55, 0x72, 0x6c, 0x12, 0x26, 0x0a, 0x0e, 0x6d,
-	0x61, 0x70, 0x70, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x65, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
-	0x22, 0x0a, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63,
-	0x72, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x06, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x69,
-	0x73, 0x73, 0x75, 0x65, 0x72, 0x55, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x55, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x75, 0x74,
-	0x68, 0x55, 0x72, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x75, 0x74, 0x68,
-	0x55, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x55, 0x72, 0x6c, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x55, 0x72, 0x6c, 0x12,
-	0x41, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x43, 0x6c, 0x61, 0x69,
-	0x6d, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63,
-	0x74, 0x52, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x43, 0x6c, 0x61, 0x69,
-	0x6d, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e,
-	0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x55, 0x72,
-	0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63,
-	0x6b, 0x55, 0x72, 0x6c, 0x3a, 0x3d, 0x92, 0x41, 0x3a, 0x0a, 0x38, 0x2a, 0x1a, 0x4f, 0x49, 0x44,
-	0x43, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x20, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x1a, 0x4f, 0x49, 0x44, 0x43, 0x50, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x22, 0x8e, 0x04, 0x0a, 0x10, 0x4f, 0x49, 0x44, 0x43, 0x50, 0x72, 0x6f, 0x76,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x33, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x4d,
+	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x42, 0x29, 0x92, 0x41, 0x26, 0x2a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x32,
+	0x1a, 0x57, 0x68, 0x61, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73,
+	0x20, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x20, 0x74, 0x6f, 0x40, 0x01, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x3a, 0x74, 0x92, 0x41, 0x71, 0x0a, 0x6f, 0x2a, 0x12, 0x43, 0x6c,
+	0x61, 0x69, 0x6d, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e,
+	0x32, 0x47, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x20, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x20, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x61, 0x20, 0x73, 0x65, 0x74, 0x20,
+	0x6f, 0x66, 0x20, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0xd2, 0x01, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xd2, 0x01, 0x04, 0x73, 0x70, 0x65, 0x63, 0x22, 0xdb, 0x02, 0x0a, 0x16,
+	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x44, 0x72, 0x79, 0x52,
+	0x75, 0x6e, 0x53, 0x70, 0x65, 0x63, 0x12, 0x79, 0x0a, 0x06, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42,
+	0x48, 0x92, 0x41, 0x45, 0x2a, 0x06, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x32, 0x3b, 0x43, 0x6c,
+	0x61, 0x69, 0x6d, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x75, 0x73, 0x65, 0x72, 0x2c, 0x20,
+	0x61, 0x73, 0x20, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x64, 0x20, 0x69, 0x6e, 0x74, 0x6f, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x74, 0x72, 0x61, 0x69, 0x74, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x06, 0x63, 0x6c, 0x61, 0x69, 0x6d,
+	0x73, 0x12, 0xc5, 0x01, 0x0a, 0x0d, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x4d, 0x61, 0x70, 0x70, 0x69,
+	0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x70, 0x61, 0x72, 0x61,
+	0x6c, 0x75, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x33, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x4d, 0x61, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x42, 0x70, 0x92, 0x41, 0x6d, 0x2a, 0x0e, 0x43,
+	0x6c, 0x61, 0x69, 0x6d, 0x20, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x32, 0x5b, 0x52,
+	0x75, 0x6c, 0x65, 0x73, 0x20, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x64, 0x20, 0x69,
+	0x6e, 0x73, 0x74, 0x65, 0x61, 0x64, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x75,
+	0x6c, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x2c, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x72, 0x79, 0x20, 0x6f, 0x75, 0x74, 0x20,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x20, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x20, 0x73,
+	0x61, 0x76, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x65, 0x6d, 0x52, 0x0d, 0x63, 0x6c, 0x61, 0x69,
+	0x6d, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x87, 0x02, 0x0a, 0x11, 0x43, 0x6c,
+	0x61, 0x69, 0x6d, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12,
+	0x39, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x25, 0x92,
+	0x41, 0x22, 0x2a, 0x04, 0x52, 0x75, 0x6c, 0x65, 0x32, 0x1a, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x20,
+	0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x20,
+	0x72, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x3d, 0x0a, 0x05, 0x63, 0x6c,
+	0x61, 0x69, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x27, 0x92, 0x41, 0x24, 0x2a, 0x05,
+	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x32, 0x1b, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x20, 0x6f, 0x66, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x20, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x12, 0x3d, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x27, 0x92, 0x41, 0x24, 0x2a, 0x05, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x32, 0x1b, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x20, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6c, 0x61, 0x69,
+	0x6d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x39, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0x92, 0x41, 0x20, 0x2a, 0x05, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x32, 0x17, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x74, 0x68, 0x65, 0x20, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x20, 0x6d, 0x61, 0x70, 0x73, 0x20, 0x74, 0x6f, 0x52, 0x05, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x22, 0x8d, 0x05, 0x0a, 0x18, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x4d, 0x61, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x7d, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2e, 0x2e, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x75, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x33, 0x2e,
+	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x74, 0x63,
+	0x68, 0x42, 0x33, 0x92, 0x41, 0x30, 0x2a, 0x07, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x32,
+	0x25, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x63, 0x6c, 0x61, 0x69, 0x6d,
+	0x73, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12,
+	0x49, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42,
+	0x31, 0x92, 0x41, 0x2e, 0x2a, 0x06, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x32, 0x24, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x75, 0x73, 0x65, 0x72, 0x20, 0x77, 0x6f,
+	0x75, 0x6c, 0x64, 0x20, 0x62, 0x65, 0x20, 0x61, 0x20, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x20,
+	0x6f, 0x66, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x68, 0x0a, 0x0d, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x42, 0x42, 0x92, 0x41, 0x3f, 0x2a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x20,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x32, 0x2d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x20, 0x74,
+	0x68, 0x61, 0x74, 0x20, 0x64, 0x6f, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x65, 0x78, 0x69, 0x73, 0x74,
+	0x20, 0x61, 0x6e, 0x64, 0x20, 0x77, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x62, 0x65, 0x20, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x12, 0x68, 0x0a, 0x0d, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x42, 0x42, 0x92, 0x41, 0x3f,
+	0x2a, 0x0e, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x20, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x32, 0x2d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x64, 0x6f,
+	0x20, 0x6e, 0x6f, 0x74, 0x20, 0x65, 0x78, 0x69, 0x73, 0x74, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x77,
+	0x6f, 0x75, 0x6c, 0x64, 0x20, 0x62, 0x65, 0x20, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x52,
+	0x0d, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0xd2,
+	0x01, 0x0a, 0x15, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f,
+	0x2e, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x75, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x33, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x42,
+	0x6b, 0x92, 0x41, 0x68, 0x2a, 0x17, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x20, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x20, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x32, 0x4d, 0x52,
+	0x6f, 0x6c, 0x65, 0x73, 0x20, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x20, 0x74, 0x68, 0x72,
+	0x6f, 0x75, 0x67, 0x68, 0x20, 0x74, 0x68, 0x65, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2c,
+	0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x69, 0x73, 0x20, 0x73, 0x65, 0x74, 0x20, 0x74, 0x6f,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x67, 0x72, 0x61, 0x6e, 0x74,
+	0x69, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x6f, 0x6c, 0x65, 0x52, 0x15, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x6f,
+	0x6c, 0x65, 0x73, 0x22, 0x8e, 0x04, 0x0a, 0x10, 0x4f, 0x49, 0x44, 0x43, 0x50, 0x72, 0x6f, 0x76,
 	0x69, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x77, 0x0a, 0x0a, 0x61, 0x70, 0x69, 0x56,
 	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x57, 0x92, 0x41,
 	0x54, 0x2a, 0x0b, 0x41, 0x50, 0x49, 0x20, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x32, 0x2d,
@@ -426,7 +1023,12 @@ var file_proto_types_systempb_v3_oidc_provider_proto_rawDesc = []byte{
 	0x74, 0x65, 0x6d, 0x73, 0x3a, 0x2e, 0x92, 0x41, 0x2b, 0x0a, 0x29, 0x2a, 0x10, 0x4f, 0x49, 0x44,
 	0x43, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x32, 0x13, 0x4f,
 	0x49, 0x44, 0x43, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x20, 0x6c, 0x69,
-	0x73, 0x74, 0x40, 0x01, 0x42, 0x82, 0x02, 0x0a, 0x1f, 0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x61, 0x72,
+	0x73, 0x74, 0x40, 0x01, 0x2a, 0x4e, 0x0a, 0x0e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x45, 0x78, 0x61, 0x63, 0x74, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x43,
+	0x6c, 0x61, 0x69, 0x6d, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x47, 0x6c, 0x6f, 0x62, 0x10, 0x01, 0x12,
+	0x13, 0x0a, 0x0f, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x67,
+	0x65, 0x78, 0x10, 0x02, 0x42, 0x82, 0x02, 0x0a, 0x1f, 0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x61, 0x72,
 	0x61, 0x6c, 0x75, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x73,
 	0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x33, 0x42, 0x11, 0x4f, 0x69, 0x64, 0x63, 0x50, 0x72,
 	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3b, 0x67,
@@ -458,28 +1060,46 @@ func file_proto_types_systempb_v3_oidc_provider_proto_rawDescGZIP() []byte {
 	return file_proto_types_systempb_v3_oidc_provider_proto_rawDescData
 }
 
-var file_proto_types_systempb_v3_oidc_provider_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_proto_types_systempb_v3_oidc_provider_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_types_systempb_v3_oidc_provider_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_proto_types_systempb_v3_oidc_provider_proto_goTypes = []interface{}{
-	(*OIDCProvider)(nil),     // 0: paralus.dev.types.system.v3.OIDCProvider
-	(*OIDCProviderSpec)(nil), // 1: paralus.dev.types.system.v3.OIDCProviderSpec
-	(*OIDCProviderList)(nil), // 2: paralus.dev.types.system.v3.OIDCProviderList
-	(*v3.Metadata)(nil),      // 3: paralus.dev.types.common.v3.Metadata
-	(*v3.Status)(nil),        // 4: paralus.dev.types.common.v3.Status
-	(*structpb.Struct)(nil),  // 5: google.protobuf.Struct
-	(*v3.ListMetadata)(nil),  // 6: paralus.dev.types.common.v3.ListMetadata
+	(ClaimMatchType)(0),              // 0: paralus.dev.types.system.v3.ClaimMatchType
+	(*OIDCProvider)(nil),             // 1: paralus.dev.types.system.v3.OIDCProvider
+	(*OIDCProviderSpec)(nil),         // 2: paralus.dev.types.system.v3.OIDCProviderSpec
+	(*ClaimMappingRule)(nil),         // 3: paralus.dev.types.system.v3.ClaimMappingRule
+	(*ClaimMappingDryRun)(nil),       // 4: paralus.dev.types.system.v3.ClaimMappingDryRun
+	(*ClaimMappingDryRunSpec)(nil),   // 5: paralus.dev.types.system.v3.ClaimMappingDryRunSpec
+	(*ClaimMappingMatch)(nil),        // 6: paralus.dev.types.system.v3.ClaimMappingMatch
+	(*ClaimMappingDryRunStatus)(nil), // 7: paralus.dev.types.system.v3.ClaimMappingDryRunStatus
+	(*OIDCProviderList)(nil),         // 8: paralus.dev.types.system.v3.OIDCProviderList
+	(*v3.Metadata)(nil),              // 9: paralus.dev.types.common.v3.Metadata
+	(*v3.Status)(nil),                // 10: paralus.dev.types.common.v3.Status
+	(*structpb.Struct)(nil),          // 11: google.protobuf.Struct
+	(*v31.ProjectNamespaceRole)(nil), // 12: paralus.dev.types.user.v3.ProjectNamespaceRole
+	(*v3.ListMetadata)(nil),          // 13: paralus.dev.types.common.v3.ListMetadata
 }
 var file_proto_types_systempb_v3_oidc_provider_proto_depIdxs = []int32{
-	3, // 0: paralus.dev.types.system.v3.OIDCProvider.metadata:type_name -> paralus.dev.types.common.v3.Metadata
-	1, // 1: paralus.dev.types.system.v3.OIDCProvider.spec:type_name -> paralus.dev.types.system.v3.OIDCProviderSpec
-	4, // 2: paralus.dev.types.system.v3.OIDCProvider.status:type_name -> paralus.dev.types.common.v3.Status
-	5, // 3: paralus.dev.types.system.v3.OIDCProviderSpec.requestedClaims:type_name -> google.protobuf.Struct
-	6, // 4: paralus.dev.types.system.v3.OIDCProviderList.metadata:type_name -> paralus.dev.types.common.v3.ListMetadata
-	0, // 5: paralus.dev.types.system.v3.OIDCProviderList.items:type_name -> paralus.dev.types.system.v3.OIDCProvider
-	6, // [6:6] is the sub-list for method output_type
-	6, // [6:6] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	9,  // 0: paralus.dev.types.system.v3.OIDCProvider.metadata:type_name -> paralus.dev.types.common.v3.Metadata
+	2,  // 1: paralus.dev.types.system.v3.OIDCProvider.spec:type_name -> paralus.dev.types.system.v3.OIDCProviderSpec
+	10, // 2: paralus.dev.types.system.v3.OIDCProvider.status:type_name -> paralus.dev.types.common.v3.Status
+	11, // 3: paralus.dev.types.system.v3.OIDCProviderSpec.requestedClaims:type_name -> google.protobuf.Struct
+	3,  // 4: paralus.dev.types.system.v3.OIDCProviderSpec.claimMappings:type_name -> paralus.dev.types.system.v3.ClaimMappingRule
+	0,  // 5: paralus.dev.types.system.v3.ClaimMappingRule.match:type_name -> paralus.dev.types.system.v3.ClaimMatchType
+	12, // 6: paralus.dev.types.system.v3.ClaimMappingRule.projectNamespaceRoles:type_name -> paralus.dev.types.user.v3.ProjectNamespaceRole
+	9,  // 7: paralus.dev.types.system.v3.ClaimMappingDryRun.metadata:type_name -> paralus.dev.types.common.v3.Metadata
+	5,  // 8: paralus.dev.types.system.v3.ClaimMappingDryRun.spec:type_name -> paralus.dev.types.system.v3.ClaimMappingDryRunSpec
+	7,  // 9: paralus.dev.types.system.v3.ClaimMappingDryRun.status:type_name -> paralus.dev.types.system.v3.ClaimMappingDryRunStatus
+	11, // 10: paralus.dev.types.system.v3.ClaimMappingDryRunSpec.claims:type_name -> google.protobuf.Struct
+	3,  // 11: paralus.dev.types.system.v3.ClaimMappingDryRunSpec.claimMappings:type_name -> paralus.dev.types.system.v3.ClaimMappingRule
+	6,  // 12: paralus.dev.types.system.v3.ClaimMappingDryRunStatus.matches:type_name -> paralus.dev.types.system.v3.ClaimMappingMatch
+	12, // 13: paralus.dev.types.system.v3.ClaimMappingDryRunStatus.projectNamespaceRoles:type_name -> paralus.dev.types.user.v3.ProjectNamespaceRole
+	13, // 14: paralus.dev.types.system.v3.OIDCProviderList.metadata:type_name -> paralus.dev.types.common.v3.ListMetadata
+	1,  // 15: paralus.dev.types.system.v3.OIDCProviderList.items:type_name -> paralus.dev.types.system.v3.OIDCProvider
+	16, // [16:16] is the sub-list for method output_type
+	16, // [16:16] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_proto_types_systempb_v3_oidc_provider_proto_init() }
@@ -513,6 +1133,66 @@ func file_proto_types_systempb_v3_oidc_provider_proto_init() {
 			}
 		}
 		file_proto_types_systempb_v3_oidc_provider_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClaimMappingRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_types_systempb_v3_oidc_provider_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClaimMappingDryRun); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_types_systempb_v3_oidc_provider_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClaimMappingDryRunSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_types_systempb_v3_oidc_provider_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClaimMappingMatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_types_systempb_v3_oidc_provider_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClaimMappingDryRunStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_types_systempb_v3_oidc_provider_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OIDCProviderList); i {
 			case 0:
 				return &v.state
@@ -530,13 +1210,14 @@ func file_proto_types_systempb_v3_oidc_provider_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_types_systempb_v3_oidc_provider_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   3,
+			NumEnums:      1,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_proto_types_systempb_v3_oidc_provider_proto_goTypes,
 		DependencyIndexes: file_proto_types_systempb_v3_oidc_provider_proto_depIdxs,
+		EnumInfos:         file_proto_types_systempb_v3_oidc_provider_proto_enumTypes,
 		MessageInfos:      file_proto_types_systempb_v3_oidc_provider_proto_msgTypes,
 	}.Build()
 	File_proto_types_systempb_v3_oidc_provider_proto = out.File
@@ -0,0 +1,1013 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.1
+// 	protoc        (unknown)
+// source: proto/types/userpb/v3/ldap_connector.proto
+
+package userv3
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	v3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type LdapConnector struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApiVersion string             `protobuf:"bytes,1,opt,name=apiVersion,proto3" json:"apiVersion,omitempty"`
+	Kind       string             `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Metadata   *v3.Metadata       `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Spec       *LdapConnectorSpec `protobuf:"bytes,4,opt,name=spec,proto3" json:"spec,omitempty"`
+	Status     *v3.Status         `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *LdapConnector) Reset() {
+	*x = LdapConnector{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_types_userpb_v3_ldap_connector_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LdapConnector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LdapConnector) ProtoMessage() {}
+
+func (x *LdapConnector) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_types_userpb_v3_ldap_connector_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LdapConnector.ProtoReflect.Descriptor instead.
+func (*LdapConnector) Descriptor() ([]byte, []int) {
+	return file_proto_types_userpb_v3_ldap_connector_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LdapConnector) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+func (x *LdapConnector) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *LdapConnector) GetMetadata() *v3.Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *LdapConnector) GetSpec() *LdapConnectorSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *LdapConnector) GetStatus() *v3.Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type LdapConnectorSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url                 string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	BindDn              string                 `protobuf:"bytes,2,opt,name=bindDn,proto3" json:"bindDn,omitempty"`
+	BindPassword        string                 `protobuf:"bytes,3,opt,name=bindPassword,proto3" json:"bindPassword,omitempty"`
+	StartTls            bool                   `protobuf:"varint,4,opt,name=startTls,proto3" json:"startTls,omitempty"`
+	InsecureSkipVerify  bool                   `protobuf:"varint,5,opt,name=insecureSkipVerify,proto3" json:"insecureSkipVerify,omitempty"`
+	GroupSearchBases    []string               `protobuf:"bytes,6,rep,name=groupSearchBases,proto3" json:"groupSearchBases,omitempty"`
+	GroupFilter         string                 `protobuf:"bytes,7,opt,name=groupFilter,proto3" json:"groupFilter,omitempty"`
+	GroupNameAttribute  string                 `protobuf:"bytes,8,opt,name=groupNameAttribute,proto3" json:"groupNameAttribute,omitempty"`
+	GroupIdAttribute    string                 `protobuf:"bytes,9,opt,name=groupIdAttribute,proto3" json:"groupIdAttribute,omitempty"`
+	MemberAttribute     string                 `protobuf:"bytes,10,opt,name=memberAttribute,proto3" json:"memberAttribute,omitempty"`
+	UserEmailAttribute  string                 `protobuf:"bytes,11,opt,name=userEmailAttribute,proto3" json:"userEmailAttribute,omitempty"`
+	NestedGroups        bool                   `protobuf:"varint,12,opt,name=nestedGroups,proto3" json:"nestedGroups,omitempty"`
+	SyncIntervalMinutes int32                  `protobuf:"varint,13,opt,name=syncIntervalMinutes,proto3" json:"syncIntervalMinutes,omitempty"`
+	LastSyncAt          *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=lastSyncAt,proto3" json:"lastSyncAt,omitempty"`
+	LastSyncError       string                 `protobuf:"bytes,15,opt,name=lastSyncError,proto3" json:"lastSyncError,omitempty"`
+	LastSyncDiff        *LdapSyncDiff          `protobuf:"bytes,16,opt,name=lastSyncDiff,proto3" json:"lastSyncDiff,omitempty"`
+}
+
+func (x *LdapConnectorSpec) Reset() {
+	*x = LdapConnectorSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_types_userpb_v3_ldap_connector_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LdapConnectorSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LdapConnectorSpec) ProtoMessage() {}
+
+func (x *LdapConnectorSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_types_userpb_v3_ldap_connector_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LdapConnectorSpec.ProtoReflect.Descriptor instead.
+func (*LdapConnectorSpec) Descriptor() ([]byte, []int) {
+	return file_proto_types_userpb_v3_ldap_connector_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LdapConnectorSpec) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *LdapConnectorSpec) GetBindDn() string {
+	if x != nil {
+		return x.BindDn
+	}
+	return ""
+}
+
+func (x *LdapConnectorSpec) GetBindPassword() string {
+	if x != nil {
+		return x.BindPassword
+	}
+	return ""
+}
+
+func (x *LdapConnectorSpec) GetStartTls() bool {
+	if x != nil {
+		return x.StartTls
+	}
+	return false
+}
+
+func (x *LdapConnectorSpec) GetInsecureSkipVerify() bool {
+	if x != nil {
+		return x.InsecureSkipVerify
+	}
+	return false
+}
+
+func (x *LdapConnectorSpec) GetGroupSearchBases() []string {
+	if x != nil {
+		return x.GroupSearchBases
+	}
+	return nil
+}
+
+func (x *LdapConnectorSpec) GetGroupFilter() string {
+	if x != nil {
+		return x.GroupFilter
+	}
+	return ""
+}
+
+func (x *LdapConnectorSpec) GetGroupNameAttribute() string {
+	if x != nil {
+		return x.GroupNameAttribute
+	}
+	return ""
+}
+
+func (x *LdapConnectorSpec) GetGroupIdAttribute() string {
+	if x != nil {
+		return x.GroupIdAttribute
+	}
+	return ""
+}
+
+func (x *LdapConnectorSpec) GetMemberAttribute() string {
+	if x != nil {
+		return x.MemberAttribute
+	}
+	return ""
+}
+
+func (x *LdapConnectorSpec) GetUserEmailAttribute() string {
+	if x != nil {
+		return x.UserEmailAttribute
+	}
+	return ""
+}
+
+func (x *LdapConnectorSpec) GetNestedGroups() bool {
+	if x != nil {
+		return x.NestedGroups
+	}
+	return false
+}
+
+func (x *LdapConnectorSpec) GetSyncIntervalMinutes() int32 {
+	if x != nil {
+		return x.SyncIntervalMinutes
+	}
+	return 0
+}
+
+func (x *LdapConnectorSpec) GetLastSyncAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSyncAt
+	}
+	return nil
+}
+
+func (x *LdapConnectorSpec) GetLastSyncError() string {
+	if x != nil {
+		return x.LastSyncError
+	}
+	return ""
+}
+
+func (x *LdapConnectorSpec) GetLastSyncDiff() *LdapSyncDiff {
+	if x != nil {
+		return x.LastSyncDiff
+	}
+	return nil
+}
+
+type LdapGroupMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Group string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	User  string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *LdapGroupMember) Reset() {
+	*x = LdapGroupMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_types_userpb_v3_ldap_connector_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LdapGroupMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LdapGroupMember) ProtoMessage() {}
+
+func (x *LdapGroupMember) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_types_userpb_v3_ldap_connector_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LdapGroupMember.ProtoReflect.Descriptor instead.
+func (*LdapGroupMember) Descriptor() ([]byte, []int) {
+	return file_proto_types_userpb_v3_ldap_connector_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LdapGroupMember) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *LdapGroupMember) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+type LdapSyncDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupsAdded    []string           `protobuf:"bytes,1,rep,name=groupsAdded,proto3" json:"groupsAdded,omitempty"`
+	GroupsRemoved  []string           `protobuf:"bytes,2,rep,name=groupsRemoved,proto3" json:"groupsRemoved,omitempty"`
+	MembersAdded   []*LdapGroupMember `protobuf:"bytes,3,rep,name=membersAdded,proto3" json:"membersAdded,omitempty"`
+	MembersRemoved []*LdapGroupMember `protobuf:"bytes,4,rep,name=membersRemoved,proto3" json:"membersRemoved,omitempty"`
+	SkippedMembers []*LdapGroupMember `protobuf:"bytes,5,rep,name=skippedMembers,proto3" json:"skippedMembers,omitempty"`
+	Conflicts      []string           `protobuf:"bytes,6,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+}
+
+func (x *LdapSyncDiff) Reset() {
+	*x = LdapSyncDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_types_userpb_v3_ldap_connector_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LdapSyncDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LdapSyncDiff) ProtoMessage() {}
+
+func (x *LdapSyncDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_types_userpb_v3_ldap_connector_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LdapSyncDiff.ProtoReflect.Descriptor instead.
+func (*LdapSyncDiff) Descriptor() ([]byte, []int) {
+	return file_proto_types_userpb_v3_ldap_connector_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LdapSyncDiff) GetGroupsAdded() []string {
+	if x != nil {
+		return x.GroupsAdded
+	}
+	return nil
+}
+
+func (x *LdapSyncDiff) GetGroupsRemoved() []string {
+	if x != nil {
+		return x.GroupsRemoved
+	}
+	return nil
+}
+
+func (x *LdapSyncDiff) GetMembersAdded() []*LdapGroupMember {
+	if x != nil {
+		return x.MembersAdded
+	}
+	return nil
+}
+
+func (x *LdapSyncDiff) GetMembersRemoved() []*LdapGroupMember {
+	if x != nil {
+		return x.MembersRemoved
+	}
+	return nil
+}
+
+func (x *LdapSyncDiff) GetSkippedMembers() []*LdapGroupMember {
+	if x != nil {
+		return x.SkippedMembers
+	}
+	return nil
+}
+
+func (x *LdapSyncDiff) GetConflicts() []string {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+type LdapConnectorSync struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metadata *v3.Metadata  `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	DryRun   bool          `protobuf:"varint,2,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	Diff     *LdapSyncDiff `protobuf:"bytes,3,opt,name=diff,proto3" json:"diff,omitempty"`
+}
+
+func (x *LdapConnectorSync) Reset() {
+	*x = LdapConnectorSync{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_types_userpb_v3_ldap_connector_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LdapConnectorSync) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LdapConnectorSync) ProtoMessage() {}
+
+func (x *LdapConnectorSync) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_types_userpb_v3_ldap_connector_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LdapConnectorSync.ProtoReflect.Descriptor instead.
+func (*LdapConnectorSync) Descriptor() ([]byte, []int) {
+	return file_proto_types_userpb_v3_ldap_connector_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LdapConnectorSync) GetMetadata() *v3.Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *LdapConnectorSync) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *LdapConnectorSync) GetDiff() *LdapSyncDiff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+type LdapConnectorList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApiVersion string           `protobuf:"bytes,1,opt,name=apiVersion,proto3" json:"apiVersion,omitempty"`
+	Kind       string           `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Metadata   *v3.ListMetadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Items      []*LdapConnector `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *LdapConnectorList) Reset() {
+	*x = LdapConnectorList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_types_userpb_v3_ldap_connector_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LdapConnectorList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LdapConnectorList) ProtoMessage() {}
+
+func (x *LdapConnectorList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_types_userpb_v3_ldap_connector_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LdapConnectorList.ProtoReflect.Descriptor instead.
+func (*LdapConnectorList) Descriptor() ([]byte, []int) {
+	return file_proto_types_userpb_v3_ldap_connector_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *LdapConnectorList) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+func (x *LdapConnectorList) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *LdapConnectorList) GetMetadata() *v3.ListMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *LdapConnectorList) GetItems() []*LdapConnector {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+var File_proto_types_userpb_v3_ldap_connector_proto protoreflect.FileDescriptor
+
+var file_proto_types_userpb_v3_ldap_connector_proto_rawDesc = []byte{
+	0x0a, 0x2a, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x75, 0x73,
+	0x65, 0x72, 0x70, 0x62, 0x2f, 0x76, 0x33, 0x2f, 0x6c, 0x64, 0x61, 0x70, 0x5f, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x19, 0x70, 0x61,
+	0x72, 0x61, 0x6c, 0x75, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x33, 0x1a, 0x24, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2f, 0x76, 0x33,
+	0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61,
+	0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb7,
+	0x05, 0x0a, 0x0d, 0x4c, 0x64, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x12, 0x72, 0x0a, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x52, 0x92, 0x41, 0x4f, 0x2a, 0x0b, 0x41, 0x50, 0x49, 0x20, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x32, 0x2a, 0x41, 0x50, 0x49, 0x20, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x4c, 0x44, 0x41, 0x50, 0x20,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x3a, 0x14, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x6b, 0x38, 0x73, 0x6d, 0x67,
+	0x6d, 0x74, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x33, 0x52, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x51, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x3d, 0x92, 0x41, 0x3a, 0x2a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x32, 0x23, 0x4b,
+	0x69, 0x6e, 0x64, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x4c, 0x44, 0x41, 0x50, 0x20,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x3a, 0x0d, 0x4c, 0x64, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x79, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x61, 0x72, 0x61,
+	0x6c, 0x75, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x42, 0x36, 0x92, 0x41, 0x33, 0x2a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x32,
+	0x27, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x4c, 0x44, 0x41, 0x50, 0x20, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x20,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x70, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2c, 0x2e, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x75, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x33, 0x2e, 0x4c, 0x64, 0x61,
+	0x70, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x70, 0x65, 0x63, 0x42, 0x2e,
+	0x92, 0x41, 0x2b, 0x2a, 0x04, 0x53, 0x70, 0x65, 0x63, 0x32, 0x23, 0x53, 0x70, 0x65, 0x63, 0x20,
+	0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x4c, 0x44, 0x41, 0x50, 0x20, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x04,
+	0x73, 0x70, 0x65, 0x63, 0x12, 0x62, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x75, 0x73, 0x2e, 0x64,
+	0x65, 0x76, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x33, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x25, 0x92, 0x41, 0x22, 0x2a, 0x06,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x32, 0x16, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x20, 0x6f,
+	0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x40, 0x01,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x3a, 0x8d, 0x01, 0x92, 0x41, 0x89, 0x01, 0x0a,
+	0x86, 0x01, 0x2a, 0x0d, 0x4c, 0x64, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x32, 0x4f, 0x4c, 0x44, 0x41, 0x50, 0x20, 0x6f, 0x72, 0x20, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x20, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x20, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x6e, 0x20,
+	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x61, 0x72, 0x65,
+	0x20, 0x73, 0x79, 0x6e, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x69, 0x7a, 0x65, 0x64, 0x20, 0x66, 0x72,
+	0x6f, 0x6d, 0xd2, 0x01, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0xd2,
+	0x01, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0xd2, 0x01, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0xd2, 0x01, 0x04, 0x73, 0x70, 0x65, 0x63, 0x22, 0x80, 0x10, 0x0a, 0x11, 0x4c, 0x64, 0x61,
+	0x70, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x70, 0x65, 0x63, 0x12, 0x6c,
+	0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x5a, 0x92, 0x41, 0x57,
+	0x2a, 0x03, 0x55, 0x52, 0x4c, 0x32, 0x30, 0x55, 0x52, 0x4c, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x20, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2c, 0x20, 0x6c, 0x64, 0x61, 0x70, 0x3a, 0x2f, 0x2f, 0x20, 0x6f, 0x72, 0x20, 0x6c,
+	0x64, 0x61, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x4a, 0x1e, 0x22, 0x6c, 0x64, 0x61, 0x70, 0x73, 0x3a,
+	0x2f, 0x2f, 0x6c, 0x64, 0x61, 0x70, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63,
+	0x6f, 0x6d, 0x3a, 0x36, 0x33, 0x36, 0x22, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x5a, 0x0a, 0x06,
+	0x62, 0x69, 0x6e, 0x64, 0x44, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x42, 0x92, 0x41,
+	0x3f, 0x2a, 0x07, 0x42, 0x69, 0x6e, 0x64, 0x20, 0x44, 0x4e, 0x32, 0x34, 0x44, 0x4e, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x20, 0x62, 0x69, 0x6e,
+	0x64, 0x73, 0x20, 0x61, 0x73, 0x2c, 0x20, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73,
+	0x20, 0x62, 0x69, 0x6e, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x65, 0x6d, 0x70, 0x74, 0x79,
+	0x52, 0x06, 0x62, 0x69, 0x6e, 0x64, 0x44, 0x6e, 0x12, 0x92, 0x01, 0x0a, 0x0c, 0x62, 0x69, 0x6e,
+	0x64, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x6e, 0x92, 0x41, 0x6b, 0x2a, 0x0d, 0x42, 0x69, 0x6e, 0x64, 0x20, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x32, 0x5a, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x20, 0x6f, 0x66,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x69, 0x6e, 0x64, 0x20, 0x44, 0x4e, 0x2c, 0x20, 0x6e, 0x65,
+	0x76, 0x65, 0x72, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x2e, 0x20, 0x54, 0x68,
+	0x65, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x20, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x20, 0x69, 0x73, 0x20, 0x6b, 0x65, 0x70, 0x74, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0x20, 0x6f, 0x6e, 0x20, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x0c, 0x62, 0x69, 0x6e, 0x64, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x54, 0x0a,
+	0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x42,
+	0x38, 0x92, 0x41, 0x35, 0x2a, 0x08, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x4c, 0x53, 0x32, 0x29,
+	0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x20, 0x6c, 0x64, 0x61, 0x70, 0x3a, 0x2f, 0x2f, 0x20,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68,
+	0x20, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x4c, 0x53, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x54, 0x6c, 0x73, 0x12, 0x87, 0x01, 0x0a, 0x12, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65,
+	0x53, 0x6b, 0x69, 0x70, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x42, 0x57, 0x92, 0x41, 0x54, 0x2a, 0x14, 0x49, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x20,
+	0x53, 0x6b, 0x69, 0x70, 0x20, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x32, 0x3c, 0x53, 0x6b, 0x69,
+	0x70, 0x20, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f,
+	0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x12, 0x69, 0x6e, 0x73, 0x65, 0x63,
+	0x75, 0x72, 0x65, 0x53, 0x6b, 0x69, 0x70, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x12, 0x62, 0x0a,
+	0x10, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x61, 0x73, 0x65,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x42, 0x36, 0x92, 0x41, 0x33, 0x2a, 0x12, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x20, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x20, 0x42, 0x61, 0x73, 0x65, 0x73,
+	0x32, 0x1d, 0x44, 0x4e, 0x73, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x20, 0x61, 0x72, 0x65,
+	0x20, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x64, 0x20, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x52,
+	0x10, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x61, 0x73, 0x65,
+	0x73, 0x12, 0x74, 0x0a, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x52, 0x92, 0x41, 0x4f, 0x2a, 0x0c, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x20, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x32, 0x23, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x20,
+	0x74, 0x6f, 0x20, 0x73, 0x79, 0x6e, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x69, 0x7a, 0x65, 0x3a, 0x1a,
+	0x28, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x3d, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x4f, 0x66, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x29, 0x52, 0x0b, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x76, 0x0a, 0x12, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x4e, 0x61, 0x6d, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x46, 0x92, 0x41, 0x43, 0x2a, 0x14, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x20,
+	0x4e, 0x61, 0x6d, 0x65, 0x20, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x32, 0x27,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x75, 0x73,
+	0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x3a, 0x02, 0x63, 0x6e, 0x52, 0x12, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12,
+	0x95, 0x01, 0x0a, 0x10, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x69, 0x92, 0x41, 0x66, 0x2a,
+	0x12, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x49, 0x44, 0x20, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x32, 0x45, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x20, 0x6f,
+	0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x20, 0x69, 0x64, 0x20,
+	0x6f, 0x66, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2c, 0x20, 0x6f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x47, 0x55, 0x49, 0x44, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x20, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x3a, 0x09, 0x65, 0x6e, 0x74, 0x72,
+	0x79, 0x55, 0x55, 0x49, 0x44, 0x52, 0x10, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x76, 0x0a, 0x0f, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x4c, 0x92, 0x41, 0x49, 0x2a, 0x10, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x32, 0x2d, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x44, 0x4e, 0x73, 0x20, 0x6f, 0x66,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x20, 0x6f, 0x66, 0x20,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x3a, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x0f,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12,
+	0x9d, 0x01, 0x0a, 0x12, 0x75, 0x73, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x6d, 0x92, 0x41,
+	0x6a, 0x2a, 0x14, 0x55, 0x73, 0x65, 0x72, 0x20, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x20, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x32, 0x4c, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x20,
+	0x6f, 0x66, 0x20, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2c, 0x20, 0x75, 0x73, 0x65, 0x72, 0x73, 0x20,
+	0x61, 0x72, 0x65, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x70,
+	0x61, 0x72, 0x61, 0x6c, 0x75, 0x73, 0x20, 0x75, 0x73, 0x65, 0x72, 0x73, 0x20, 0x62, 0x79, 0x20,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x3a, 0x04, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x12, 0x75, 0x73, 0x65,
+	0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12,
+	0x62, 0x0a, 0x0c, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x08, 0x42, 0x3e, 0x92, 0x41, 0x3b, 0x2a, 0x0d, 0x4e, 0x65, 0x73, 0x74,
+	0x65, 0x64, 0x20, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x32, 0x2a, 0x49, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x20, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x0c, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x12, 0xa4, 0x01, 0x0a, 0x13, 0x73, 0x79, 0x6e, 0x63, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x72, 0x92, 0x41, 0x6f, 0x2a, 0x15, 0x53, 0x79, 0x6e, 0x63, 0x20, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x20, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x32, 0x56, 0x4d,
+	0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x20, 0x62, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x20, 0x73,
+	0x79, 0x6e, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2c,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x20, 0x69,
+	0x73, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x20, 0x73, 0x79, 0x6e, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x69,
+	0x7a, 0x65, 0x64, 0x20, 0x6f, 0x6e, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x77,
+	0x68, 0x65, 0x6e, 0x20, 0x30, 0x52, 0x13, 0x73, 0x79, 0x6e, 0x63, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x71, 0x0a, 0x0a, 0x6c, 0x61,
+	0x73, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x35, 0x92, 0x41, 0x32, 0x2a,
+	0x0c, 0x4c, 0x61, 0x73, 0x74, 0x20, 0x53, 0x79, 0x6e, 0x63, 0x20, 0x41, 0x74, 0x32, 0x20, 0x54,
+	0x69, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6c, 0x61, 0x73, 0x74, 0x20,
+	0x73, 0x79, 0x6e, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x40,
+	0x01, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x74, 0x12, 0x5f, 0x0a,
+	0x0d, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x39, 0x92, 0x41, 0x36, 0x2a, 0x0f, 0x4c, 0x61, 0x73, 0x74, 0x20,
+	0x53, 0x79, 0x6e, 0x63, 0x20, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x32, 0x21, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6c, 0x61, 0x73, 0x74, 0x20, 0x73, 0x79,
+	0x6e, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x40, 0x01, 0x52,
+	0x0d, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x87,
+	0x01, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x44, 0x69, 0x66, 0x66, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x75, 0x73, 0x2e,
+	0x64, 0x65, 0x76, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76,
+	0x33, 0x2e, 0x4c, 0x64, 0x61, 0x70, 0x53, 0x79, 0x6e, 0x63, 0x44, 0x69, 0x66, 0x66, 0x42, 0x3a,
+	0x92, 0x41, 0x37, 0x2a, 0x0e, 0x4c, 0x61, 0x73, 0x74, 0x20, 0x53, 0x79, 0x6e, 0x63, 0x20, 0x44,
+	0x69, 0x66, 0x66, 0x32, 0x23, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x6c, 0x61, 0x73, 0x74, 0x20, 0x73, 0x79, 0x6e, 0x63, 0x68, 0x72, 0x6f,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x40, 0x01, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74,
+	0x53, 0x79, 0x6e, 0x63, 0x44, 0x69, 0x66, 0x66, 0x3a, 0x41, 0x92, 0x41, 0x3e, 0x0a, 0x3c, 0x2a,
+	0x1c, 0x4c, 0x44, 0x41, 0x50, 0x20, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x20,
+	0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x1c, 0x4c,
+	0x44, 0x41, 0x50, 0x20, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x20, 0x73, 0x70,
+	0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x78, 0x0a, 0x0f, 0x4c,
+	0x64, 0x61, 0x70, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x33,
+	0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0x92,
+	0x41, 0x1a, 0x2a, 0x05, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x32, 0x11, 0x4e, 0x61, 0x6d, 0x65, 0x20,
+	0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x05, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x12, 0x30, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x1c, 0x92, 0x41, 0x19, 0x2a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x32, 0x11, 0x45, 0x6d,
+	0x61, 0x69, 0x6c, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x75, 0x73, 0x65, 0x72, 0x52,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0xcc, 0x06, 0x0a, 0x0c, 0x4c, 0x64, 0x61, 0x70, 0x53, 0x79,
+	0x6e, 0x63, 0x44, 0x69, 0x66, 0x66, 0x12, 0x58, 0x0a, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x41, 0x64, 0x64, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x36, 0x92, 0x41, 0x33,
+	0x2a, 0x0c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x20, 0x41, 0x64, 0x64, 0x65, 0x64, 0x32, 0x23,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x20, 0x66,
+	0x6f, 0x72, 0x20, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x20, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x52, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x41, 0x64, 0x64, 0x65, 0x64,
+	0x12, 0x73, 0x0a, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x4d, 0x92, 0x41, 0x4a, 0x2a, 0x0e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x20, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x32, 0x38, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x20, 0x61, 0x73, 0x20,
+	0x74, 0x68, 0x65, 0x69, 0x72, 0x20, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x20,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x6e, 0x6f, 0x20, 0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x72, 0x20,
+	0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x79, 0x0a, 0x0c, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x41, 0x64, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x70, 0x61,
+	0x72, 0x61, 0x6c, 0x75, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x33, 0x2e, 0x4c, 0x64, 0x61, 0x70, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x29, 0x92, 0x41, 0x26, 0x2a, 0x0d, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x20, 0x41, 0x64, 0x64, 0x65, 0x64, 0x32, 0x15, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x20, 0x61, 0x64, 0x64, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x52, 0x0c, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x41, 0x64, 0x64, 0x65, 0x64,
+	0x12, 0x83, 0x01, 0x0a, 0x0e, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x70, 0x61, 0x72, 0x61,
+	0x6c, 0x75, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x2e, 0x76, 0x33, 0x2e, 0x4c, 0x64, 0x61, 0x70, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x2f, 0x92, 0x41, 0x2c, 0x2a, 0x0f, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x73, 0x20, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x32, 0x19, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x20, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x0e, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x92, 0x01, 0x0a, 0x0e, 0x73, 0x6b, 0x69, 0x70, 0x70,
+	0x65, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2a, 0x2e, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x75, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x33, 0x2e, 0x4c, 0x64, 0x61, 0x70,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x3e, 0x92, 0x41, 0x3b,
+	0x2a, 0x0f, 0x53, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x20, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x32, 0x28, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x20, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x20, 0x61, 0x20, 0x70,
+	0x61, 0x72, 0x61, 0x6c, 0x75, 0x73, 0x20, 0x75, 0x73, 0x65, 0x72, 0x52, 0x0e, 0x73, 0x6b, 0x69,
+	0x70, 0x70, 0x65, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x8a, 0x01, 0x0a, 0x09,
+	0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x42,
+	0x6c, 0x92, 0x41, 0x69, 0x2a, 0x09, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x32,
+	0x5c, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x73, 0x79, 0x6e, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x69, 0x7a,
+	0x65, 0x64, 0x20, 0x61, 0x73, 0x20, 0x61, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x6f, 0x66,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x20, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x09, 0x63,
+	0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x3a, 0x4a, 0x92, 0x41, 0x47, 0x0a, 0x45, 0x2a,
+	0x0e, 0x4c, 0x44, 0x41, 0x50, 0x20, 0x53, 0x79, 0x6e, 0x63, 0x20, 0x44, 0x69, 0x66, 0x66, 0x32,
+	0x31, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x73, 0x79,
+	0x6e, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66,
+	0x20, 0x61, 0x6e, 0x20, 0x4c, 0x44, 0x41, 0x50, 0x20, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x40, 0x01, 0x22, 0x9e, 0x03, 0x0a, 0x11, 0x4c, 0x64, 0x61, 0x70, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x70, 0x0a, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70,
+	0x61, 0x72, 0x61, 0x6c, 0x75, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x42, 0x2d, 0x92, 0x41, 0x2a, 0x2a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x32, 0x1e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x20, 0x6f, 0x66, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x4c, 0x44, 0x41, 0x50, 0x20, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x54, 0x0a, 0x06,
+	0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x42, 0x3c, 0x92, 0x41,
+	0x39, 0x2a, 0x07, 0x44, 0x72, 0x79, 0x20, 0x52, 0x75, 0x6e, 0x32, 0x2e, 0x4f, 0x6e, 0x6c, 0x79,
+	0x20, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x79, 0x6e, 0x63, 0x68,
+	0x72, 0x6f, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52,
+	0x75, 0x6e, 0x12, 0x68, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x75, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x33, 0x2e, 0x4c, 0x64, 0x61,
+	0x70, 0x53, 0x79, 0x6e, 0x63, 0x44, 0x69, 0x66, 0x66, 0x42, 0x2b, 0x92, 0x41, 0x28, 0x2a, 0x04,
+	0x44, 0x69, 0x66, 0x66, 0x32, 0x1e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x20, 0x6f, 0x66,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x79, 0x6e, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x40, 0x01, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x3a, 0x57, 0x92, 0x41,
+	0x54, 0x0a, 0x52, 0x2a, 0x11, 0x4c, 0x64, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x53, 0x79, 0x6e, 0x63, 0x32, 0x32, 0x53, 0x79, 0x6e, 0x63, 0x68, 0x72, 0x6f, 0x6e,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x6e, 0x20, 0x4c, 0x44, 0x41, 0x50,
+	0x20, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0xd2, 0x01, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xef, 0x03, 0x0a, 0x11, 0x4c, 0x64, 0x61, 0x70, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x63, 0x0a, 0x0a, 0x61,
+	0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x43, 0x92, 0x41, 0x40, 0x2a, 0x0b, 0x41, 0x50, 0x49, 0x20, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x32, 0x2f, 0x41, 0x50, 0x49, 0x20, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x6f,
+	0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x4c, 0x44, 0x41, 0x50, 0x20, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x40, 0x01, 0x52, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x49, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x35,
+	0x92, 0x41, 0x32, 0x2a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x32, 0x28, 0x4b, 0x69, 0x6e, 0x64, 0x20,
+	0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x4c, 0x44, 0x41, 0x50, 0x20, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x40, 0x01, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x84, 0x01, 0x0a, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x75, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x3d, 0x92, 0x41, 0x3a, 0x2a, 0x08,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x32, 0x2c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x4c, 0x44, 0x41, 0x50, 0x20, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x40, 0x01, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x72, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x75, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x33, 0x2e, 0x4c, 0x64,
+	0x61, 0x70, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x32, 0x92, 0x41, 0x2f,
+	0x2a, 0x05, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x32, 0x24, 0x4c, 0x69, 0x73, 0x74, 0x20, 0x6f, 0x66,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x4c, 0x44, 0x41, 0x50, 0x20, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x40, 0x01, 0x52,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x3a, 0x2f, 0x92, 0x41, 0x2c, 0x0a, 0x2a, 0x2a, 0x11, 0x4c,
+	0x64, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x4c, 0x69, 0x73, 0x74,
+	0x32, 0x13, 0x4c, 0x44, 0x41, 0x50, 0x20, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x20, 0x6c, 0x69, 0x73, 0x74, 0x40, 0x01, 0x42, 0xf5, 0x01, 0x0a, 0x1d, 0x63, 0x6f, 0x6d, 0x2e,
+	0x70, 0x61, 0x72, 0x61, 0x6c, 0x75, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x33, 0x42, 0x12, 0x4c, 0x64, 0x61, 0x70, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
+	0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x61, 0x72, 0x61,
+	0x6c, 0x75, 0x73, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x75, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x70, 0x62, 0x2f, 0x76,
+	0x33, 0x3b, 0x75, 0x73, 0x65, 0x72, 0x76, 0x33, 0xa2, 0x02, 0x04, 0x50, 0x44, 0x54, 0x55, 0xaa,
+	0x02, 0x19, 0x50, 0x61, 0x72, 0x61, 0x6c, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x76, 0x2e, 0x54, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x56, 0x33, 0xca, 0x02, 0x19, 0x50, 0x61,
+	0x72, 0x61, 0x6c, 0x75, 0x73, 0x5c, 0x44, 0x65, 0x76, 0x5c, 0x54, 0x79, 0x70, 0x65, 0x73, 0x5c,
+	0x55, 0x73, 0x65, 0x72, 0x5c, 0x56, 0x33, 0xe2, 0x02, 0x25, 0x50, 0x61, 0x72, 0x61, 0x6c, 0x75,
+	0x73, 0x5c, 0x44, 0x65, 0x76, 0x5c, 0x54, 0x79, 0x70, 0x65, 0x73, 0x5c, 0x55, 0x73, 0x65, 0x72,
+	0x5c, 0x56, 0x33, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
+	0x02, 0x1d, 0x50, 0x61, 0x72, 0x61, 0x6c, 0x75, 0x73, 0x3a, 0x3a, 0x44, 0x65, 0x76, 0x3a, 0x3a,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x3a, 0x3a, 0x55, 0x73, 0x65, 0x72, 0x3a, 0x3a, 0x56, 0x33, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_types_userpb_v3_ldap_connector_proto_rawDescOnce sync.Once
+	file_proto_types_userpb_v3_ldap_connector_proto_rawDescData = file_proto_types_userpb_v3_ldap_connector_proto_rawDesc
+)
+
+func file_proto_types_userpb_v3_ldap_connector_proto_rawDescGZIP() []byte {
+	file_proto_types_userpb_v3_ldap_connector_proto_rawDescOnce.Do(func() {
+		file_proto_types_userpb_v3_ldap_connector_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_types_userpb_v3_ldap_connector_proto_rawDescData)
+	})
+	return file_proto_types_userpb_v3_ldap_connector_proto_rawDescData
+}
+
+var file_proto_types_userpb_v3_ldap_connector_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_proto_types_userpb_v3_ldap_connector_proto_goTypes = []interface{}{
+	(*LdapConnector)(nil),         // 0: paralus.dev.types.user.v3.LdapConnector
+	(*LdapConnectorSpec)(nil),     // 1: paralus.dev.types.user.v3.LdapConnectorSpec
+	(*LdapGroupMember)(nil),       // 2: paralus.dev.types.user.v3.LdapGroupMember
+	(*LdapSyncDiff)(nil),          // 3: paralus.dev.types.user.v3.LdapSyncDiff
+	(*LdapConnectorSync)(nil),     // 4: paralus.dev.types.user.v3.LdapConnectorSync
+	(*LdapConnectorList)(nil),     // 5: paralus.dev.types.user.v3.LdapConnectorList
+	(*v3.Metadata)(nil),           // 6: paralus.dev.types.common.v3.Metadata
+	(*v3.Status)(nil),             // 7: paralus.dev.types.common.v3.Status
+	(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
+	(*v3.ListMetadata)(nil),       // 9: paralus.dev.types.common.v3.ListMetadata
+}
+var file_proto_types_userpb_v3_ldap_connector_proto_depIdxs = []int32{
+	6,  // 0: paralus.dev.types.user.v3.LdapConnector.metadata:type_name -> paralus.dev.types.common.v3.Metadata
+	1,  // 1: paralus.dev.types.user.v3.LdapConnector.spec:type_name -> paralus.dev.types.user.v3.LdapConnectorSpec
+	7,  // 2: paralus.dev.types.user.v3.LdapConnector.status:type_name -> paralus.dev.types.common.v3.Status
+	8,  // 3: paralus.dev.types.user.v3.LdapConnectorSpec.lastSyncAt:type_name -> google.protobuf.Timestamp
+	3,  // 4: paralus.dev.types.user.v3.LdapConnectorSpec.lastSyncDiff:type_name -> paralus.dev.types.user.v3.LdapSyncDiff
+	2,  // 5: paralus.dev.types.user.v3.LdapSyncDiff.membersAdded:type_name -> paralus.dev.types.user.v3.LdapGroupMember
+	2,  // 6: paralus.dev.types.user.v3.LdapSyncDiff.membersRemoved:type_name -> paralus.dev.types.user.v3.LdapGroupMember
+	2,  // 7: paralus.dev.types.user.v3.LdapSyncDiff.skippedMembers:type_name -> paralus.dev.types.user.v3.LdapGroupMember
+	6,  // 8: paralus.dev.types.user.v3.LdapConnectorSync.metadata:type_name -> paralus.dev.types.common.v3.Metadata
+	3,  // 9: paralus.dev.types.user.v3.LdapConnectorSync.diff:type_name -> paralus.dev.types.user.v3.LdapSyncDiff
+	9,  // 10: paralus.dev.types.user.v3.LdapConnectorList.metadata:type_name -> paralus.dev.types.common.v3.ListMetadata
+	0,  // 11: paralus.dev.types.user.v3.LdapConnectorList.items:type_name -> paralus.dev.types.user.v3.LdapConnector
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_proto_types_userpb_v3_ldap_connector_proto_init() }
+func file_proto_types_userpb_v3_ldap_connector_proto_init() {
+	if File_proto_types_userpb_v3_ldap_connector_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_types_userpb_v3_ldap_connector_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LdapConnector); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_types_userpb_v3_ldap_connector_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LdapConnectorSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_types_userpb_v3_ldap_connector_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LdapGroupMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_types_userpb_v3_ldap_connector_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LdapSyncDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_types_userpb_v3_ldap_connector_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LdapConnectorSync); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_types_userpb_v3_ldap_connector_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LdapConnectorList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_types_userpb_v3_ldap_connector_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_proto_types_userpb_v3_ldap_connector_proto_goTypes,
+		DependencyIndexes: file_proto_types_userpb_v3_ldap_connector_proto_depIdxs,
+		MessageInfos:      file_proto_types_userpb_v3_ldap_connector_proto_msgTypes,
+	}.Build()
+	File_proto_types_userpb_v3_ldap_connector_proto = out.File
+	file_proto_types_userpb_v3_ldap_connector_proto_rawDesc = nil
+	file_proto_types_userpb_v3_ldap_connector_proto_goTypes = nil
+	file_proto_types_userpb_v3_ldap_connector_proto_depIdxs = nil
+}
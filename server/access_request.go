@@ -0,0 +1,38 @@
+package server
+
+import (
+	"context"
+
+	"github.com/paralus/paralus/pkg/service"
+	rpcv3 "github.com/paralus/paralus/proto/rpc/user"
+	userpbv3 "github.com/paralus/paralus/proto/types/userpb/v3"
+)
+
+type accessRequestServer struct {
+	service.AccessRequestService
+}
+
+// NewAccessRequestServer returns new access request server implementation
+func NewAccessRequestServer(ars service.AccessRequestService) rpcv3.AccessRequestServiceServer {
+	return &accessRequestServer{ars}
+}
+
+func (s *accessRequestServer) CreateAccessRequest(ctx context.Context, req *userpbv3.AccessRequest) (*userpbv3.AccessRequest, error) {
+	return s.Create(ctx, req)
+}
+
+func (s *accessRequestServer) GetAccessRequests(ctx context.Context, req *userpbv3.AccessRequestQuery) (*userpbv3.AccessRequestList, error) {
+	return s.List(ctx, req)
+}
+
+func (s *accessRequestServer) GetAccessRequest(ctx context.Context, req *userpbv3.AccessRequest) (*userpbv3.AccessRequest, error) {
+	return s.GetByName(ctx, req)
+}
+
+func (s *accessRequestServer) ApproveAccessRequest(ctx context.Context, req *userpbv3.AccessRequestReview) (*userpbv3.AccessRequest, error) {
+	return s.Approve(ctx, req)
+}
+
+func (s *accessRequestServer) DenyAccessRequest(ctx context.Context, req *userpbv3.AccessRequestReview) (*userpbv3.AccessRequest, error) {
+	return s.Deny(ctx, req)
+}
@@ -0,0 +1,56 @@
+package server
+
+import (
+	"context"
+	"strings"
+
+	"github.com/paralus/paralus/pkg/service"
+	rpcv3 "github.com/paralus/paralus/proto/rpc/user"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	userpbv3 "github.com/paralus/paralus/proto/types/userpb/v3"
+)
+
+type accessReviewServer struct {
+	service.AccessReviewService
+}
+
+// NewAccessReviewServer returns new access review server implementation
+func NewAccessReviewServer(arvs service.AccessReviewService) rpcv3.AccessReviewServiceServer {
+	return &accessReviewServer{arvs}
+}
+
+func (s *accessReviewServer) CreateAccessReview(ctx context.Context, req *userpbv3.AccessReview) (*userpbv3.AccessReview, error) {
+	return s.Create(ctx, req)
+}
+
+func (s *accessReviewServer) GetAccessReviews(ctx context.Context, req *userpbv3.AccessReviewQuery) (*userpbv3.AccessReviewList, error) {
+	return s.List(ctx, req)
+}
+
+func (s *accessReviewServer) GetAccessReview(ctx context.Context, req *userpbv3.AccessReview) (*userpbv3.AccessReview, error) {
+	return s.GetByName(ctx, req)
+}
+
+func (s *accessReviewServer) CloseAccessReview(ctx context.Context, req *userpbv3.AccessReview) (*userpbv3.AccessReview, error) {
+	return s.Close(ctx, req)
+}
+
+func (s *accessReviewServer) GetAccessReviewItems(ctx context.Context, req *userpbv3.AccessReviewItemQuery) (*userpbv3.AccessReviewItemList, error) {
+	return s.ListItems(ctx, req)
+}
+
+func (s *accessReviewServer) DecideAccessReviewItem(ctx context.Context, req *userpbv3.AccessReviewDecision) (*userpbv3.AccessReviewItem, error) {
+	return s.Decide(ctx, req)
+}
+
+func (s *accessReviewServer) GetAccessReviewReport(ctx context.Context, req *userpbv3.AccessReviewReportRequest) (*commonv3.HttpBody, error) {
+	report, err := s.Report(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	contentType := "application/json"
+	if strings.EqualFold(req.GetFormat(), "csv") {
+		contentType = "text/csv"
+	}
+	return &commonv3.HttpBody{ContentType: contentType, Data: report}, nil
+}
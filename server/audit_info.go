@@ -42,6 +42,18 @@ func (s *auditInfoServer) LookupUser(ctx context.Context, req *sentryrpc.LookupU
 		}, nil
 	}
 
+	if attrs.ServiceAccount {
+		return &sentryrpc.LookupUserResponse{
+			UserName:       attrs.Username,
+			AccountID:      attrs.AccountID,
+			IsSSO:          kubeconfig.GetStringFromBool(attrs.IsSSO),
+			OrganizationID: attrs.OrganizationID,
+			PartnerID:      attrs.PartnerID,
+			SessionType:    kubeconfig.GetSessionTypeString(attrs.SessionType),
+			ActorType:      "SERVICE_ACCOUNT",
+		}, nil
+	}
+
 	accountID := attrs.AccountID
 
 	var userName string
@@ -58,6 +70,7 @@ func (s *auditInfoServer) LookupUser(ctx context.Context, req *sentryrpc.LookupU
 		OrganizationID: attrs.OrganizationID,
 		PartnerID:      attrs.PartnerID,
 		SessionType:    kubeconfig.GetSessionTypeString(attrs.SessionType),
+		ActorType:      "USER",
 	}, nil
 }
 
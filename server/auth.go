@@ -20,3 +20,15 @@ func NewAuthServer(as authv3.AuthService) rpcv3.AuthServiceServer {
 func (s *authServer) IsRequestAllowed(ctx context.Context, ira *v3.IsRequestAllowedRequest) (*v3.IsRequestAllowedResponse, error) {
 	return s.as.IsRequestAllowed(ctx, ira)
 }
+
+func (s *authServer) ExplainRequest(ctx context.Context, req *v3.ExplainRequestRequest) (*v3.ExplainRequestResponse, error) {
+	return s.as.ExplainRequest(ctx, req)
+}
+
+func (s *authServer) EffectiveAccess(ctx context.Context, req *v3.EffectiveAccessRequest) (*v3.EffectiveAccessResponse, error) {
+	return s.as.EffectiveAccess(ctx, req)
+}
+
+func (s *authServer) ListPermissionHolders(ctx context.Context, req *v3.PermissionHoldersRequest) (*v3.PermissionHoldersResponse, error) {
+	return s.as.ListPermissionHolders(ctx, req)
+}
@@ -0,0 +1,30 @@
+package server
+
+import (
+	"context"
+
+	"github.com/paralus/paralus/pkg/service"
+	rpcv3 "github.com/paralus/paralus/proto/rpc/user"
+	userpbv3 "github.com/paralus/paralus/proto/types/userpb/v3"
+)
+
+type breakGlassServer struct {
+	service.BreakGlassService
+}
+
+// NewBreakGlassServer returns new break-glass server implementation
+func NewBreakGlassServer(bgs service.BreakGlassService) rpcv3.BreakGlassServiceServer {
+	return &breakGlassServer{bgs}
+}
+
+func (s *breakGlassServer) CreateBreakGlass(ctx context.Context, req *userpbv3.BreakGlass) (*userpbv3.BreakGlass, error) {
+	return s.Create(ctx, req)
+}
+
+func (s *breakGlassServer) GetBreakGlasses(ctx context.Context, req *userpbv3.BreakGlassQuery) (*userpbv3.BreakGlassList, error) {
+	return s.List(ctx, req)
+}
+
+func (s *breakGlassServer) GetBreakGlass(ctx context.Context, req *userpbv3.BreakGlass) (*userpbv3.BreakGlass, error) {
+	return s.GetByName(ctx, req)
+}
@@ -29,6 +29,16 @@ func (s *clusterAuthzServer) GetUserAuthorization(ctx context.Context, req *sent
 	return resp, nil
 }
 
+// PreviewUserAuthorization returns the authorization profile of user for a given cluster without applying it
+func (s *clusterAuthzServer) PreviewUserAuthorization(ctx context.Context, req *sentryrpc.PreviewUserAuthorizationRequest) (*sentryrpc.PreviewUserAuthorizationResponse, error) {
+	resp, err := authz.PreviewAuthorization(ctx, req, s.bs, s.aps, s.gps, s.krs, s.kcs, s.kss, s.ns)
+	if err != nil {
+		_log.Errorw("error previewing auth profile", "req", req, "error", err.Error())
+		return nil, err
+	}
+	return resp, nil
+}
+
 // NewClusterAuthzServer returns New ClusterAuthzServer
 func NewClusterAuthzServer(bs service.BootstrapService, aps service.AccountPermissionService, gps service.GroupPermissionService, krs service.KubeconfigRevocationService, kcs service.KubectlClusterSettingsService, kss service.KubeconfigSettingService, ns service.NamespaceService) sentryrpc.ClusterAuthorizationServiceServer {
 	return &clusterAuthzServer{
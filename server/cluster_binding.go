@@ -0,0 +1,57 @@
+package server
+
+import (
+	"context"
+
+	"github.com/paralus/paralus/pkg/service"
+	rpcv3 "github.com/paralus/paralus/proto/rpc/role"
+	v3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	rolepbv3 "github.com/paralus/paralus/proto/types/rolepb/v3"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type clusterBindingServer struct {
+	service.ClusterBindingService
+}
+
+// NewClusterBindingServer returns new cluster binding server implementation
+func NewClusterBindingServer(cbs service.ClusterBindingService) rpcv3.ClusterBindingServiceServer {
+	return &clusterBindingServer{cbs}
+}
+
+func updateClusterBindingStatus(req *rolepbv3.ClusterBinding, resp *rolepbv3.ClusterBinding, err error) *rolepbv3.ClusterBinding {
+	if err != nil {
+		req.Status = &v3.Status{
+			ConditionStatus: v3.ConditionStatus_StatusFailed,
+			LastUpdated:     timestamppb.Now(),
+			Reason:          err.Error(),
+		}
+		return req
+	}
+	resp.Status = &v3.Status{ConditionStatus: v3.ConditionStatus_StatusOK}
+	return resp
+}
+
+func (s *clusterBindingServer) CreateClusterBinding(ctx context.Context, req *rolepbv3.ClusterBinding) (*rolepbv3.ClusterBinding, error) {
+	resp, err := s.Create(ctx, req)
+	return updateClusterBindingStatus(req, resp, err), err
+}
+
+func (s *clusterBindingServer) GetClusterBindings(ctx context.Context, req *rolepbv3.ClusterBinding) (*rolepbv3.ClusterBindingList, error) {
+	return s.List(ctx, req)
+}
+
+func (s *clusterBindingServer) GetClusterBinding(ctx context.Context, req *rolepbv3.ClusterBinding) (*rolepbv3.ClusterBinding, error) {
+	resp, err := s.GetByName(ctx, req)
+	return updateClusterBindingStatus(req, resp, err), err
+}
+
+func (s *clusterBindingServer) UpdateClusterBinding(ctx context.Context, req *rolepbv3.ClusterBinding) (*rolepbv3.ClusterBinding, error) {
+	resp, err := s.Update(ctx, req)
+	return updateClusterBindingStatus(req, resp, err), err
+}
+
+func (s *clusterBindingServer) DeleteClusterBinding(ctx context.Context, req *rolepbv3.ClusterBinding) (*rolepbv3.ClusterBinding, error) {
+	resp, err := s.Delete(ctx, req)
+	return updateClusterBindingStatus(req, resp, err), err
+}
@@ -0,0 +1,31 @@
+package server
+
+import (
+	"context"
+
+	"github.com/paralus/paralus/pkg/service"
+	rpcv3 "github.com/paralus/paralus/proto/rpc/system"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	systemv3 "github.com/paralus/paralus/proto/types/systempb/v3"
+)
+
+type configBundleServer struct {
+	service.ConfigBundleService
+}
+
+// NewConfigBundleServer returns new config bundle server implementation
+func NewConfigBundleServer(cbs service.ConfigBundleService) rpcv3.ConfigBundleServiceServer {
+	return &configBundleServer{cbs}
+}
+
+func (s *configBundleServer) ExportConfigBundle(ctx context.Context, req *systemv3.ConfigBundleExportRequest) (*commonv3.HttpBody, error) {
+	bundle, err := s.Export(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &commonv3.HttpBody{ContentType: "application/yaml", Data: bundle}, nil
+}
+
+func (s *configBundleServer) ApplyConfigBundle(ctx context.Context, req *systemv3.ConfigBundleApplyRequest) (*systemv3.ConfigBundleApplyResponse, error) {
+	return s.Apply(ctx, req)
+}
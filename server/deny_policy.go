@@ -0,0 +1,57 @@
+package server
+
+import (
+	"context"
+
+	"github.com/paralus/paralus/pkg/service"
+	rpcv3 "github.com/paralus/paralus/proto/rpc/role"
+	v3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	rolepbv3 "github.com/paralus/paralus/proto/types/rolepb/v3"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type denyPolicyServer struct {
+	service.DenyPolicyService
+}
+
+// NewDenyPolicyServer returns new deny policy server implementation
+func NewDenyPolicyServer(dps service.DenyPolicyService) rpcv3.DenyPolicyServiceServer {
+	return &denyPolicyServer{dps}
+}
+
+func updateDenyPolicyStatus(req *rolepbv3.DenyPolicy, resp *rolepbv3.DenyPolicy, err error) *rolepbv3.DenyPolicy {
+	if err != nil {
+		req.Status = &v3.Status{
+			ConditionStatus: v3.ConditionStatus_StatusFailed,
+			LastUpdated:     timestamppb.Now(),
+			Reason:          err.Error(),
+		}
+		return req
+	}
+	resp.Status = &v3.Status{ConditionStatus: v3.ConditionStatus_StatusOK}
+	return resp
+}
+
+func (s *denyPolicyServer) CreateDenyPolicy(ctx context.Context, req *rolepbv3.DenyPolicy) (*rolepbv3.DenyPolicy, error) {
+	resp, err := s.Create(ctx, req)
+	return updateDenyPolicyStatus(req, resp, err), err
+}
+
+func (s *denyPolicyServer) GetDenyPolicies(ctx context.Context, req *rolepbv3.DenyPolicy) (*rolepbv3.DenyPolicyList, error) {
+	return s.List(ctx, req)
+}
+
+func (s *denyPolicyServer) GetDenyPolicy(ctx context.Context, req *rolepbv3.DenyPolicy) (*rolepbv3.DenyPolicy, error) {
+	resp, err := s.GetByName(ctx, req)
+	return updateDenyPolicyStatus(req, resp, err), err
+}
+
+func (s *denyPolicyServer) UpdateDenyPolicy(ctx context.Context, req *rolepbv3.DenyPolicy) (*rolepbv3.DenyPolicy, error) {
+	resp, err := s.Update(ctx, req)
+	return updateDenyPolicyStatus(req, resp, err), err
+}
+
+func (s *denyPolicyServer) DeleteDenyPolicy(ctx context.Context, req *rolepbv3.DenyPolicy) (*rolepbv3.DenyPolicy, error) {
+	resp, err := s.Delete(ctx, req)
+	return updateDenyPolicyStatus(req, resp, err), err
+}
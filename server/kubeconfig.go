@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/paralus/paralus/internal/constants"
+	"github.com/paralus/paralus/pkg/gateway"
 	"github.com/paralus/paralus/pkg/query"
 	"github.com/paralus/paralus/pkg/sentry/cryptoutil"
 	"github.com/paralus/paralus/pkg/sentry/kubeconfig"
@@ -57,6 +58,9 @@ func (s *kubeConfigServer) GetForClusterWebSession(ctx context.Context, in *sent
 
 func (s *kubeConfigServer) GetForUser(ctx context.Context, in *sentryrpc.GetForUserRequest) (*commonv3.HttpBody, error) {
 	config, err := kubeconfig.GetConfigForUser(ctx, s.bs, s.aps, s.gps, in, s.pf, s.kss, s.ks, s.os, s.ps, s.al)
+	if err == service.ErrStepUpRequired {
+		return nil, gateway.StepUpError(err.Error())
+	}
 	if err != nil {
 		_log.Errorw("error generating kubeconfig", "error", err.Error())
 		return nil, err
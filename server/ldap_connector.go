@@ -0,0 +1,62 @@
+package server
+
+import (
+	"context"
+
+	"github.com/paralus/paralus/pkg/query"
+	"github.com/paralus/paralus/pkg/service"
+	rpcv3 "github.com/paralus/paralus/proto/rpc/user"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	userpbv3 "github.com/paralus/paralus/proto/types/userpb/v3"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type ldapConnectorServer struct {
+	service.LdapConnectorService
+}
+
+// NewLdapConnectorServer returns new ldap connector server implementation
+func NewLdapConnectorServer(lcs service.LdapConnectorService) rpcv3.LdapConnectorServiceServer {
+	return &ldapConnectorServer{lcs}
+}
+
+func updateLdapConnectorStatus(req *userpbv3.LdapConnector, resp *userpbv3.LdapConnector, err error) *userpbv3.LdapConnector {
+	if err != nil {
+		req.Status = &commonv3.Status{
+			ConditionStatus: commonv3.ConditionStatus_StatusFailed,
+			LastUpdated:     timestamppb.Now(),
+			Reason:          err.Error(),
+		}
+		return req
+	}
+	resp.Status = &commonv3.Status{ConditionStatus: commonv3.ConditionStatus_StatusOK}
+	return resp
+}
+
+func (s *ldapConnectorServer) CreateLdapConnector(ctx context.Context, req *userpbv3.LdapConnector) (*userpbv3.LdapConnector, error) {
+	resp, err := s.Create(ctx, req)
+	return updateLdapConnectorStatus(req, resp, err), err
+}
+
+func (s *ldapConnectorServer) GetLdapConnectors(ctx context.Context, req *commonv3.QueryOptions) (*userpbv3.LdapConnectorList, error) {
+	return s.List(ctx, query.WithOptions(req))
+}
+
+func (s *ldapConnectorServer) GetLdapConnector(ctx context.Context, req *userpbv3.LdapConnector) (*userpbv3.LdapConnector, error) {
+	resp, err := s.GetByName(ctx, req)
+	return updateLdapConnectorStatus(req, resp, err), err
+}
+
+func (s *ldapConnectorServer) UpdateLdapConnector(ctx context.Context, req *userpbv3.LdapConnector) (*userpbv3.LdapConnector, error) {
+	resp, err := s.Update(ctx, req)
+	return updateLdapConnectorStatus(req, resp, err), err
+}
+
+func (s *ldapConnectorServer) DeleteLdapConnector(ctx context.Context, req *userpbv3.LdapConnector) (*userpbv3.LdapConnector, error) {
+	resp, err := s.Delete(ctx, req)
+	return updateLdapConnectorStatus(req, resp, err), err
+}
+
+func (s *ldapConnectorServer) SyncLdapConnector(ctx context.Context, req *userpbv3.LdapConnectorSync) (*userpbv3.LdapConnectorSync, error) {
+	return s.Sync(ctx, req)
+}
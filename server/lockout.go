@@ -0,0 +1,33 @@
+package server
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/pkg/query"
+	"github.com/paralus/paralus/pkg/service"
+	rpcv3 "github.com/paralus/paralus/proto/rpc/user"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	userpbv3 "github.com/paralus/paralus/proto/types/userpb/v3"
+)
+
+type accountLockoutServer struct {
+	service.AccountLockoutService
+}
+
+// NewAccountLockoutServer returns new account lockout server implementation
+func NewAccountLockoutServer(ls service.AccountLockoutService) rpcv3.AccountLockoutServiceServer {
+	return &accountLockoutServer{ls}
+}
+
+func (s *accountLockoutServer) GetLockedAccounts(ctx context.Context, req *commonv3.QueryOptions) (*userpbv3.LockedAccountList, error) {
+	return s.List(ctx, query.WithOptions(req))
+}
+
+func (s *accountLockoutServer) UnlockAccount(ctx context.Context, req *rpcv3.UnlockAccountRequest) (*userpbv3.LockedAccount, error) {
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return s.Unlock(ctx, req.GetOrganization(), id)
+}
@@ -0,0 +1,53 @@
+package server
+
+import (
+	"context"
+
+	"github.com/paralus/paralus/pkg/query"
+	"github.com/paralus/paralus/pkg/service"
+	rpcv3 "github.com/paralus/paralus/proto/rpc/user"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	userpbv3 "github.com/paralus/paralus/proto/types/userpb/v3"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type scimTokenServer struct {
+	service.ScimTokenService
+}
+
+// NewScimTokenServer returns new scim token server implementation
+func NewScimTokenServer(sts service.ScimTokenService) rpcv3.ScimTokenServiceServer {
+	return &scimTokenServer{sts}
+}
+
+func updateScimTokenStatus(req *userpbv3.ScimToken, resp *userpbv3.ScimToken, err error) *userpbv3.ScimToken {
+	if err != nil {
+		req.Status = &commonv3.Status{
+			ConditionStatus: commonv3.ConditionStatus_StatusFailed,
+			LastUpdated:     timestamppb.Now(),
+			Reason:          err.Error(),
+		}
+		return req
+	}
+	resp.Status = &commonv3.Status{ConditionStatus: commonv3.ConditionStatus_StatusOK}
+	return resp
+}
+
+func (s *scimTokenServer) CreateScimToken(ctx context.Context, req *userpbv3.ScimToken) (*userpbv3.ScimToken, error) {
+	resp, err := s.Create(ctx, req)
+	return updateScimTokenStatus(req, resp, err), err
+}
+
+func (s *scimTokenServer) GetScimTokens(ctx context.Context, req *commonv3.QueryOptions) (*userpbv3.ScimTokenList, error) {
+	return s.List(ctx, query.WithOptions(req))
+}
+
+func (s *scimTokenServer) GetScimToken(ctx context.Context, req *userpbv3.ScimToken) (*userpbv3.ScimToken, error) {
+	resp, err := s.GetByName(ctx, req)
+	return updateScimTokenStatus(req, resp, err), err
+}
+
+func (s *scimTokenServer) DeleteScimToken(ctx context.Context, req *userpbv3.ScimToken) (*userpbv3.ScimToken, error) {
+	resp, err := s.Delete(ctx, req)
+	return updateScimTokenStatus(req, resp, err), err
+}
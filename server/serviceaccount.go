@@ -0,0 +1,75 @@
+package server
+
+import (
+	"context"
+
+	"github.com/paralus/paralus/pkg/query"
+	"github.com/paralus/paralus/pkg/service"
+	rpcv3 "github.com/paralus/paralus/proto/rpc/user"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	userpbv3 "github.com/paralus/paralus/proto/types/userpb/v3"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type serviceAccountServer struct {
+	service.ServiceAccountService
+}
+
+// NewServiceAccountServer returns new service account server implementation
+func NewServiceAccountServer(sas service.ServiceAccountService) rpcv3.ServiceAccountServiceServer {
+	return &serviceAccountServer{sas}
+}
+
+func updateServiceAccountStatus(req *userpbv3.ServiceAccount, resp *userpbv3.ServiceAccount, err error) *userpbv3.ServiceAccount {
+	if err != nil {
+		req.Status = &commonv3.Status{
+			ConditionStatus: commonv3.ConditionStatus_StatusFailed,
+			LastUpdated:     timestamppb.Now(),
+			Reason:          err.Error(),
+		}
+		return req
+	}
+	resp.Status = &commonv3.Status{ConditionStatus: commonv3.ConditionStatus_StatusOK}
+	return resp
+}
+
+func (s *serviceAccountServer) CreateServiceAccount(ctx context.Context, req *userpbv3.ServiceAccount) (*userpbv3.ServiceAccount, error) {
+	resp, err := s.Create(ctx, req)
+	return updateServiceAccountStatus(req, resp, err), err
+}
+
+func (s *serviceAccountServer) GetServiceAccounts(ctx context.Context, req *commonv3.QueryOptions) (*userpbv3.ServiceAccountList, error) {
+	return s.List(ctx, query.WithOptions(req))
+}
+
+func (s *serviceAccountServer) GetServiceAccount(ctx context.Context, req *userpbv3.ServiceAccount) (*userpbv3.ServiceAccount, error) {
+	resp, err := s.GetByName(ctx, req)
+	return updateServiceAccountStatus(req, resp, err), err
+}
+
+func (s *serviceAccountServer) UpdateServiceAccount(ctx context.Context, req *userpbv3.ServiceAccount) (*userpbv3.ServiceAccount, error) {
+	resp, err := s.Update(ctx, req)
+	return updateServiceAccountStatus(req, resp, err), err
+}
+
+func (s *serviceAccountServer) DeleteServiceAccount(ctx context.Context, req *userpbv3.ServiceAccount) (*userpbv3.ServiceAccount, error) {
+	resp, err := s.Delete(ctx, req)
+	return updateServiceAccountStatus(req, resp, err), err
+}
+
+func (s *serviceAccountServer) CreateServiceAccountApiKey(ctx context.Context, req *rpcv3.ServiceAccountApiKeyRequest) (*rpcv3.ApiKeyResponse, error) {
+	apikey, err := s.CreateApiKey(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	// the secret is only ever handed out on creation
+	return service.ApiKeyToResponse(apikey, true), nil
+}
+
+func (s *serviceAccountServer) ListServiceAccountApiKeys(ctx context.Context, req *rpcv3.ServiceAccountApiKeyRequest) (*rpcv3.UserListApiKeysResponse, error) {
+	return s.ListApiKeys(ctx, req)
+}
+
+func (s *serviceAccountServer) DeleteServiceAccountApiKey(ctx context.Context, req *rpcv3.ServiceAccountApiKeyRequest) (*rpcv3.UserDeleteApiKeysResponse, error) {
+	return s.DeleteApiKey(ctx, req)
+}
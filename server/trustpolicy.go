@@ -0,0 +1,58 @@
+package server
+
+import (
+	"context"
+
+	"github.com/paralus/paralus/pkg/query"
+	"github.com/paralus/paralus/pkg/service"
+	rpcv3 "github.com/paralus/paralus/proto/rpc/user"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	userpbv3 "github.com/paralus/paralus/proto/types/userpb/v3"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type trustPolicyServer struct {
+	service.TrustPolicyService
+}
+
+// NewTrustPolicyServer returns new trust policy server implementation
+func NewTrustPolicyServer(tps service.TrustPolicyService) rpcv3.TrustPolicyServiceServer {
+	return &trustPolicyServer{tps}
+}
+
+func updateTrustPolicyStatus(req *userpbv3.TrustPolicy, resp *userpbv3.TrustPolicy, err error) *userpbv3.TrustPolicy {
+	if err != nil {
+		req.Status = &commonv3.Status{
+			ConditionStatus: commonv3.ConditionStatus_StatusFailed,
+			LastUpdated:     timestamppb.Now(),
+			Reason:          err.Error(),
+		}
+		return req
+	}
+	resp.Status = &commonv3.Status{ConditionStatus: commonv3.ConditionStatus_StatusOK}
+	return resp
+}
+
+func (s *trustPolicyServer) CreateTrustPolicy(ctx context.Context, req *userpbv3.TrustPolicy) (*userpbv3.TrustPolicy, error) {
+	resp, err := s.Create(ctx, req)
+	return updateTrustPolicyStatus(req, resp, err), err
+}
+
+func (s *trustPolicyServer) GetTrustPolicies(ctx context.Context, req *commonv3.QueryOptions) (*userpbv3.TrustPolicyList, error) {
+	return s.List(ctx, query.WithOptions(req))
+}
+
+func (s *trustPolicyServer) GetTrustPolicy(ctx context.Context, req *userpbv3.TrustPolicy) (*userpbv3.TrustPolicy, error) {
+	resp, err := s.GetByName(ctx, req)
+	return updateTrustPolicyStatus(req, resp, err), err
+}
+
+func (s *trustPolicyServer) UpdateTrustPolicy(ctx context.Context, req *userpbv3.TrustPolicy) (*userpbv3.TrustPolicy, error) {
+	resp, err := s.Update(ctx, req)
+	return updateTrustPolicyStatus(req, resp, err), err
+}
+
+func (s *trustPolicyServer) DeleteTrustPolicy(ctx context.Context, req *userpbv3.TrustPolicy) (*userpbv3.TrustPolicy, error) {
+	resp, err := s.Delete(ctx, req)
+	return updateTrustPolicyStatus(req, resp, err), err
+}
@@ -14,13 +14,15 @@ import (
 )
 
 type userServer struct {
-	us service.UserService
-	ks service.ApiKeyService
+	us            service.UserService
+	ks            service.ApiKeyService
+	webhookSecret string
 }
 
-// NewUserServer returns new user server implementation
-func NewUserServer(ps service.UserService, as service.ApiKeyService) rpcv3.UserServiceServer {
-	return &userServer{us: ps, ks: as}
+// NewUserServer returns new user server implementation, webhookSecret
+// authenticates the login webhook of kratos
+func NewUserServer(ps service.UserService, as service.ApiKeyService, webhookSecret string) rpcv3.UserServiceServer {
+	return &userServer{us: ps, ks: as, webhookSecret: webhookSecret}
 }
 func updateUserStatus(req *userpbv3.User, resp *userpbv3.User, err error) *userpbv3.User {
 	if err != nil {
@@ -113,6 +115,38 @@ func (s *userServer) UserListApiKeys(ctx context.Context, req *rpcv3.ApiKeyReque
 	return s.ks.List(ctx, req)
 }
 
+func (s *userServer) UserCreateApiKey(ctx context.Context, req *rpcv3.ApiKeyRequest) (*rpcv3.ApiKeyResponse, error) {
+	sessData, ok := service.GetSessionDataFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("unable to retrieve session data")
+	}
+	if req.Username != sessData.Account && req.Username != sessData.Username {
+		return nil, fmt.Errorf("api keys can only be created for the current user")
+	}
+	request := &rpcv3.ApiKeyRequest{
+		Username:       sessData.Username,
+		Id:             sessData.Account,
+		OrganizationId: sessData.Organization,
+		PartnerId:      sessData.Partner,
+		ExpiresAt:      req.ExpiresAt,
+		Permissions:    req.Permissions,
+		Projects:       req.Projects,
+	}
+	apikey, err := s.ks.Create(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return service.ApiKeyToResponse(apikey, true), nil
+}
+
+func (s *userServer) UserRotateApiKey(ctx context.Context, req *rpcv3.ApiKeyRequest) (*rpcv3.ApiKeyResponse, error) {
+	apikey, err := s.ks.Rotate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return service.ApiKeyToResponse(apikey, true), nil
+}
+
 func (s *userServer) UserDeleteApiKeys(ctx context.Context, req *rpcv3.ApiKeyRequest) (*rpcv3.UserDeleteApiKeysResponse, error) {
 	_, err := s.ks.Delete(ctx, req)
 	if err != nil {
@@ -126,6 +160,31 @@ func (s *userServer) UserForgotPassword(ctx context.Context, req *rpcv3.UserForg
 
 }
 
+func (s *userServer) UserListSessions(ctx context.Context, req *rpcv3.UserSessionRequest) (*userpbv3.UserSessionList, error) {
+	return s.us.ListSessions(ctx, req)
+}
+
+func (s *userServer) UserRevokeSession(ctx context.Context, req *rpcv3.UserSessionRequest) (*rpcv3.UserRevokeSessionsResponse, error) {
+	if err := s.us.RevokeSession(ctx, req); err != nil {
+		return nil, err
+	}
+	return &rpcv3.UserRevokeSessionsResponse{}, nil
+}
+
+func (s *userServer) UserRevokeSessions(ctx context.Context, req *rpcv3.UserSessionRequest) (*rpcv3.UserRevokeSessionsResponse, error) {
+	if err := s.us.RevokeSessions(ctx, req); err != nil {
+		return nil, err
+	}
+	return &rpcv3.UserRevokeSessionsResponse{}, nil
+}
+
+func (s *userServer) UserRevokeAccess(ctx context.Context, req *rpcv3.UserSessionRequest) (*rpcv3.UserRevokeAccessResponse, error) {
+	return s.us.RevokeAccess(ctx, req)
+}
+
 func (s *userServer) AuditLogWebhook(ctx context.Context, req *rpcv3.UserLoginAuditRequest) (*rpcv3.UserLoginAuditResponse, error) {
+	if err := service.CheckLoginWebhook(ctx, s.webhookSecret, req); err != nil {
+		return nil, err
+	}
 	return s.us.CreateLoginAuditLog(ctx, req)
 }